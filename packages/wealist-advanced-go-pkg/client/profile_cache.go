@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ProfileInvalidationChannel is the Redis Pub/Sub channel user-service publishes profile changes on.
+const ProfileInvalidationChannel = "user:profile:invalidated"
+
+// ProfileInvalidatedEvent is published whenever a user's profile in a workspace changes.
+// WorkspaceID is uuid.Nil when the default (workspace-independent) profile changed.
+type ProfileInvalidatedEvent struct {
+	UserID      uuid.UUID `json:"userId"`
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+}
+
+// PublishProfileInvalidated publishes a profile invalidation event.
+func PublishProfileInvalidated(ctx context.Context, rdb *redis.Client, event ProfileInvalidatedEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile invalidation: %w", err)
+	}
+	return rdb.Publish(ctx, ProfileInvalidationChannel, payload).Err()
+}
+
+// ProfileCache caches workspace profiles in Redis.
+// Each user gets one hash (field = workspace ID), so an invalidation event drops
+// every cached workspace profile of that user with a single DEL.
+type ProfileCache struct {
+	rdb       *redis.Client
+	keyPrefix string
+	ttl       time.Duration
+	logger    *zap.Logger
+}
+
+type cachedProfile struct {
+	Profile  WorkspaceProfile `json:"profile"`
+	CachedAt time.Time        `json:"cachedAt"`
+}
+
+// NewProfileCache creates a new profile cache. keyPrefix namespaces the keys per service (e.g. "board").
+func NewProfileCache(rdb *redis.Client, keyPrefix string, ttl time.Duration, logger *zap.Logger) *ProfileCache {
+	return &ProfileCache{
+		rdb:       rdb,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+		logger:    logger,
+	}
+}
+
+func (c *ProfileCache) key(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:profile:%s", c.keyPrefix, userID.String())
+}
+
+// GetMany returns the cached profiles and the user IDs that have to be fetched.
+// Redis failures are treated as cache misses.
+func (c *ProfileCache) GetMany(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*WorkspaceProfile, []uuid.UUID) {
+	profiles := make(map[uuid.UUID]*WorkspaceProfile, len(userIDs))
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HGet(ctx, c.key(userID), workspaceID.String())
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		c.logger.Warn("Profile cache lookup failed", zap.Error(err))
+		return profiles, userIDs
+	}
+
+	now := time.Now()
+	var missing []uuid.UUID
+	for i, userID := range userIDs {
+		data, err := cmds[i].Bytes()
+		if err != nil {
+			missing = append(missing, userID)
+			continue
+		}
+		var entry cachedProfile
+		if err := json.Unmarshal(data, &entry); err != nil || now.Sub(entry.CachedAt) > c.ttl {
+			missing = append(missing, userID)
+			continue
+		}
+		profile := entry.Profile
+		profiles[userID] = &profile
+	}
+	return profiles, missing
+}
+
+// SetMany stores profiles fetched from user-service.
+func (c *ProfileCache) SetMany(ctx context.Context, workspaceID uuid.UUID, profiles map[uuid.UUID]*WorkspaceProfile) {
+	if len(profiles) == 0 {
+		return
+	}
+
+	now := time.Now()
+	pipe := c.rdb.Pipeline()
+	for userID, profile := range profiles {
+		data, err := json.Marshal(cachedProfile{Profile: *profile, CachedAt: now})
+		if err != nil {
+			continue
+		}
+		key := c.key(userID)
+		pipe.HSet(ctx, key, workspaceID.String(), data)
+		pipe.Expire(ctx, key, c.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.logger.Warn("Profile cache store failed", zap.Error(err))
+	}
+}
+
+// Invalidate drops every cached profile of the user.
+func (c *ProfileCache) Invalidate(ctx context.Context, userID uuid.UUID) {
+	if err := c.rdb.Del(ctx, c.key(userID)).Err(); err != nil {
+		c.logger.Warn("Profile cache invalidation failed",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+	}
+}
+
+// ListenForInvalidations subscribes to profile invalidation events until ctx is cancelled.
+func (c *ProfileCache) ListenForInvalidations(ctx context.Context) {
+	pubsub := c.rdb.Subscribe(ctx, ProfileInvalidationChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var event ProfileInvalidatedEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				c.logger.Warn("Invalid profile invalidation event", zap.String("payload", msg.Payload))
+				continue
+			}
+			c.Invalidate(ctx, event.UserID)
+		}
+	}
+}
@@ -19,11 +19,14 @@ import (
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/config"
+	"project-board-api/internal/converter"
 	"project-board-api/internal/database"
+	"project-board-api/internal/handler"
 	"project-board-api/internal/job"
 	"project-board-api/internal/metrics"
 	"project-board-api/internal/repository"
 	"project-board-api/internal/router"
+	"project-board-api/internal/service"
 
 	// Swagger docs - temporarily disabled for CI compatibility
 	// TODO: Re-enable after resolving genproto conflict
@@ -217,6 +220,12 @@ func main() {
 		m,
 	)
 
+	// 프로필 조회 캐시 (Redis) - user-service 의 무효화 이벤트를 구독
+	if redisClient := database.GetRedis(); redisClient != nil {
+		userClient = client.NewCachedUserClient(ctx, userClient, redisClient, cfg.UserAPI.ProfileCacheTTL, log.Logger)
+		log.Info("User profile cache enabled", zap.Duration("ttl", cfg.UserAPI.ProfileCacheTTL))
+	}
+
 	log.Info("User API client initialized successfully",
 		zap.String("user_base_url", cfg.UserAPI.BaseURL),
 		zap.String("auth_base_url", cfg.AuthAPI.BaseURL),
@@ -271,9 +280,127 @@ func main() {
 		log.Fatal("Failed to schedule cleanup job", zap.Error(err))
 	}
 
+	// Initialize the outbox (shared by the router and the relay job)
+	outbox := service.NewOutbox(
+		repository.NewOutboxRepository(db),
+		repository.NewTxManager(db),
+		notiClient,
+		handler.NewOutboxBroadcaster(),
+		m,
+		log.Logger,
+	)
+
+	// Schedule outbox relay job to run every 5 seconds and purge delivered events daily
+	outboxRelayJob := job.NewOutboxRelayJob(outbox, log.Logger)
+	_, err = c.AddFunc("@every 5s", outboxRelayJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule outbox relay job", zap.Error(err))
+	}
+	_, err = c.AddFunc("@daily", outboxRelayJob.Purge)
+	if err != nil {
+		log.Fatal("Failed to schedule outbox purge job", zap.Error(err))
+	}
+
+	// Initialize automation engine (shared by the router and the due date job)
+	automationRepo := repository.NewAutomationRepository(db)
+	automationEngine := service.NewAutomationEngine(
+		automationRepo,
+		repository.NewBoardRepository(db),
+		repository.NewProjectRepository(db),
+		repository.NewParticipantRepository(db),
+		repository.NewCommentRepository(db),
+		converter.NewFieldOptionConverter(repository.NewFieldOptionRepository(db)),
+		notiClient,
+		outbox,
+		service.NewBoardWatchers(repository.NewWatcherRepository(db), repository.NewProjectRepository(db), log.Logger),
+		log.Logger,
+	)
+
+	// Schedule due date automation job to run every 5 minutes
+	dueDateJob := job.NewAutomationDueDateJob(automationRepo, automationEngine, log.Logger)
+	_, err = c.AddFunc("@every 5m", dueDateJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule due date automation job", zap.Error(err))
+	}
+
+	// Initialize webhook dispatcher (shared by the router and the retry job)
+	webhookDispatcher := service.NewWebhookDispatcher(repository.NewWebhookRepository(db), service.NewWebhookHTTPClient(cfg.Webhook.AllowPrivateTargets), log.Logger)
+
+	// Schedule webhook retry job to run every minute
+	webhookRetryJob := job.NewWebhookRetryJob(webhookDispatcher, log.Logger)
+	_, err = c.AddFunc("@every 1m", webhookRetryJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule webhook retry job", zap.Error(err))
+	}
+
+	// Schedule reminder job to deliver personal board reminders every minute
+	reminderService := service.NewReminderService(
+		repository.NewReminderRepository(db),
+		repository.NewBoardRepository(db),
+		repository.NewProjectRepository(db),
+		converter.NewFieldOptionConverter(repository.NewFieldOptionRepository(db)),
+		notiClient,
+		outbox,
+		log.Logger,
+	)
+	reminderJob := job.NewReminderJob(reminderService, log.Logger)
+	_, err = c.AddFunc("@every 1m", reminderJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule reminder job", zap.Error(err))
+	}
+
+	// Schedule recurrence job to generate the next occurrences of recurring boards every 5 minutes
+	recurrenceService := service.NewRecurrenceService(
+		repository.NewRecurrenceRepository(db),
+		repository.NewBoardRepository(db),
+		repository.NewProjectRepository(db),
+		repository.NewParticipantRepository(db),
+		repository.NewLabelRepository(db),
+		repository.NewFieldOptionRepository(db),
+		converter.NewFieldOptionConverter(repository.NewFieldOptionRepository(db)),
+		notiClient,
+		outbox,
+		log.Logger,
+	)
+	recurrenceJob := job.NewRecurrenceJob(recurrenceService, log.Logger)
+	_, err = c.AddFunc("@every 5m", recurrenceJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule recurrence job", zap.Error(err))
+	}
+
+	// Initialize attachment thumbnailer (shared by the router and the thumbnail job)
+	attachmentThumbnailer := service.NewAttachmentThumbnailer(attachmentRepo, s3Client, log.Logger)
+
+	// Schedule thumbnail job to run every 10 minutes
+	thumbnailJob := job.NewThumbnailJob(attachmentThumbnailer, log.Logger)
+	_, err = c.AddFunc("@every 10m", thumbnailJob.Run)
+	if err != nil {
+		log.Fatal("Failed to schedule thumbnail job", zap.Error(err))
+	}
+
+	// Schedule attachment reconciliation job to run daily (opt-in, report-only unless orphan deletion is enabled)
+	if cfg.AttachmentReconcile.Enabled {
+		reconcileJob := job.NewAttachmentReconcileJob(attachmentRepo, s3Client, m, cfg.AttachmentReconcile, log.Logger)
+		_, err = c.AddFunc("@daily", reconcileJob.Run)
+		if err != nil {
+			log.Fatal("Failed to schedule attachment reconciliation job", zap.Error(err))
+		}
+		log.Info("Attachment reconciliation job scheduled successfully (runs daily)",
+			zap.String("prefix", cfg.AttachmentReconcile.Prefix),
+			zap.Duration("grace_period", cfg.AttachmentReconcile.GracePeriod),
+			zap.Bool("delete_orphans", cfg.AttachmentReconcile.DeleteOrphans),
+		)
+	}
+
 	// Start cron scheduler
 	c.Start()
 	log.Info("Cleanup job scheduled successfully (runs every hour)")
+	log.Info("Due date automation job scheduled successfully (runs every 5 minutes)")
+	log.Info("Webhook retry job scheduled successfully (runs every minute)")
+	log.Info("Reminder job scheduled successfully (runs every minute)")
+	log.Info("Recurrence job scheduled successfully (runs every 5 minutes)")
+	log.Info("Thumbnail job scheduled successfully (runs every 10 minutes)")
+	log.Info("Outbox relay job scheduled successfully (runs every 5 seconds, purges daily)")
 
 	// Log example endpoint URLs for verification
 	log.Info("User API endpoint examples (for debugging)",
@@ -285,19 +412,23 @@ func main() {
 
 	// Setup router with dependency injection
 	routerConfig := router.Config{
-		DB:              db,
-		Logger:          log.Logger,
-		JWTSecret:       cfg.JWT.Secret, // Deprecated: kept for backward compatibility
-		AuthServiceURL:  cfg.AuthAPI.BaseURL,
-		JWTIssuer:       cfg.AuthAPI.JWTIssuer,
-		UserClient:      userClient,
-		NotiClient:      notiClient,
-		BasePath:        cfg.Server.BasePath,
-		Metrics:         m,
-		S3Client:        s3Client,
-		RedisClient:     database.GetRedis(),
-		RateLimitConfig: cfg.RateLimit,
-		ServiceName:     "board-service",
+		DB:                    db,
+		Logger:                log.Logger,
+		JWTSecret:             cfg.JWT.Secret, // Deprecated: kept for backward compatibility
+		AuthServiceURL:        cfg.AuthAPI.BaseURL,
+		JWTIssuer:             cfg.AuthAPI.JWTIssuer,
+		UserClient:            userClient,
+		NotiClient:            notiClient,
+		BasePath:              cfg.Server.BasePath,
+		Metrics:               m,
+		S3Client:              s3Client,
+		RedisClient:           database.GetRedis(),
+		RateLimitConfig:       cfg.RateLimit,
+		ServiceName:           "board-service",
+		AutomationEngine:      automationEngine,
+		WebhookDispatcher:     webhookDispatcher,
+		AttachmentThumbnailer: attachmentThumbnailer,
+		Outbox:                outbox,
 	}
 
 	r := router.Setup(routerConfig)
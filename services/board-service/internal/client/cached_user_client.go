@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+// cachedUserClient decorates a UserClient with a Redis-backed workspace profile cache.
+// Entries expire after the configured TTL and are dropped early when user-service
+// publishes a profile invalidation event.
+type cachedUserClient struct {
+	UserClient
+	cache  *commonclient.ProfileCache
+	logger *zap.Logger
+}
+
+// NewCachedUserClient wraps a UserClient with a workspace profile cache.
+// The invalidation listener runs until ctx is cancelled.
+func NewCachedUserClient(ctx context.Context, inner UserClient, rdb *redis.Client, ttl time.Duration, logger *zap.Logger) UserClient {
+	cache := commonclient.NewProfileCache(rdb, "board", ttl, logger)
+	go cache.ListenForInvalidations(ctx)
+
+	return &cachedUserClient{
+		UserClient: inner,
+		cache:      cache,
+		logger:     logger,
+	}
+}
+
+// GetWorkspaceProfile returns the cached profile or fetches it from user-service
+func (c *cachedUserClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceProfile, error) {
+	cached, missing := c.cache.GetMany(ctx, workspaceID, []uuid.UUID{userID})
+	if len(missing) == 0 {
+		return cached[userID], nil
+	}
+
+	profile, err := c.UserClient.GetWorkspaceProfile(ctx, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+	// Degraded (empty) profiles are not cached so the next request retries user-service
+	if profile != nil && profile.ProfileID != uuid.Nil {
+		c.cache.SetMany(ctx, workspaceID, map[uuid.UUID]*commonclient.WorkspaceProfile{userID: profile})
+	}
+	return profile, nil
+}
+
+// GetWorkspaceProfiles serves cached profiles and fetches only the misses in one batch
+func (c *cachedUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	profiles, missing := c.cache.GetMany(ctx, workspaceID, uniqueUserIDs(userIDs))
+	if len(missing) == 0 {
+		return profiles, nil
+	}
+
+	hits := len(profiles)
+	fetched, err := c.UserClient.GetWorkspaceProfiles(ctx, workspaceID, missing, token)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.SetMany(ctx, workspaceID, fetched)
+
+	for userID, profile := range fetched {
+		profiles[userID] = profile
+	}
+
+	c.logger.Debug("Workspace profiles resolved",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.Int("cache_hits", hits),
+		zap.Int("fetched", len(fetched)),
+	)
+	return profiles, nil
+}
@@ -21,6 +21,9 @@ import (
 type NotificationType string
 
 const (
+	// Project notification types
+	NotificationTypeProjectInvited NotificationType = "PROJECT_INVITED"
+
 	// Board (Kanban) notification types
 	NotificationTypeBoardAssigned        NotificationType = "BOARD_ASSIGNED"
 	NotificationTypeBoardUnassigned      NotificationType = "BOARD_UNASSIGNED"
@@ -30,14 +33,16 @@ const (
 	NotificationTypeBoardCommentAdded    NotificationType = "BOARD_COMMENT_ADDED"
 	NotificationTypeBoardDueSoon         NotificationType = "BOARD_DUE_SOON"
 	NotificationTypeBoardOverdue         NotificationType = "BOARD_OVERDUE"
+	NotificationTypeBoardReminder        NotificationType = "BOARD_REMINDER"
 )
 
 // ResourceType defines resource types matching noti-service
 type ResourceType string
 
 const (
-	ResourceTypeBoard ResourceType = "board"
-	ResourceTypeTask  ResourceType = "task"
+	ResourceTypeProject ResourceType = "project"
+	ResourceTypeBoard   ResourceType = "board"
+	ResourceTypeTask    ResourceType = "task"
 )
 
 // NotificationEvent represents the payload for creating a notification
@@ -50,6 +55,9 @@ type NotificationEvent struct {
 	ResourceID   uuid.UUID              `json:"resourceId"`
 	ResourceName *string                `json:"resourceName,omitempty"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	// Set by the outbox relay: noti-service stores one notification per key and keeps the original event time
+	IdempotencyKey *string    `json:"idempotencyKey,omitempty"`
+	OccurredAt     *time.Time `json:"occurredAt,omitempty"`
 }
 
 // NotiClient defines the interface for notification service interactions
@@ -170,7 +178,11 @@ func (c *notiClient) doRequest(ctx context.Context, url string, payload interfac
 			zap.String("response.body", string(respBody)),
 			zap.Duration("http.duration", duration),
 		)
-		// 알림 전송 실패는 치명적이지 않으므로 로그만 남기고 에러 반환하지 않음
+		// 일시적 장애(5xx, 429)는 에러로 반환하여 outbox relay가 재시도하도록 함
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return fmt.Errorf("noti service returned status %d", resp.StatusCode)
+		}
+		// 그 외 4xx는 재시도해도 실패하므로 로그만 남기고 에러 반환하지 않음
 		return nil
 	}
 
@@ -321,3 +333,44 @@ func NewBoardOverdueNotification(actorID, targetUserID, workspaceID, boardID uui
 		},
 	}
 }
+
+// NewBoardReminderNotification creates a personal reminder notification; the user is both actor and target
+func NewBoardReminderNotification(userID, workspaceID, boardID, reminderID uuid.UUID, boardTitle, note string, remindAt time.Time) *NotificationEvent {
+	title := boardTitle
+	metadata := map[string]interface{}{
+		"boardTitle": boardTitle,
+		"reminderId": reminderID.String(),
+		"remindAt":   remindAt.UTC().Format(time.RFC3339),
+	}
+	if note != "" {
+		metadata["note"] = note
+	}
+	return &NotificationEvent{
+		Type:         NotificationTypeBoardReminder,
+		ActorID:      userID,
+		TargetUserID: userID,
+		WorkspaceID:  workspaceID,
+		ResourceType: ResourceTypeBoard,
+		ResourceID:   boardID,
+		ResourceName: &title,
+		Metadata:     metadata,
+	}
+}
+
+// NewProjectInvitedNotification creates a notification for a member added to a project by invitation
+func NewProjectInvitedNotification(actorID, targetUserID, workspaceID, projectID uuid.UUID, projectName, role string) *NotificationEvent {
+	name := projectName
+	return &NotificationEvent{
+		Type:         NotificationTypeProjectInvited,
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		WorkspaceID:  workspaceID,
+		ResourceType: ResourceTypeProject,
+		ResourceID:   projectID,
+		ResourceName: &name,
+		Metadata: map[string]interface{}{
+			"projectName": projectName,
+			"role":        role,
+		},
+	}
+}
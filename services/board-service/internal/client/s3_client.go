@@ -3,6 +3,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 )
 
@@ -27,6 +29,20 @@ type S3ClientInterface interface {
 	GetFileURL(key string) string
 }
 
+// FileInfo describes a stored file returned by ListFiles
+type FileInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// FileListPage is one page of ListFiles results
+// NextToken is empty on the last page
+type FileListPage struct {
+	Files     []FileInfo
+	NextToken string
+}
+
 // S3Client wraps AWS S3 client and implements S3ClientInterface
 type S3Client struct {
 	client         *s3.Client
@@ -230,6 +246,65 @@ func (c *S3Client) UploadFile(ctx context.Context, key string, file io.Reader, c
 	return fileURL, nil
 }
 
+// DownloadFile opens a file stored in S3 for reading
+// The caller must close the returned reader
+func (c *S3Client) DownloadFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file from S3: %w", err)
+	}
+	return out.Body, nil
+}
+
+// ListFiles lists up to limit files under the prefix, continuing after token (empty for the first page)
+func (c *S3Client) ListFiles(ctx context.Context, prefix, token string, limit int) (*FileListPage, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(c.bucket),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int32(int32(limit)),
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
+
+	out, err := c.client.ListObjectsV2(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in S3: %w", err)
+	}
+
+	page := &FileListPage{Files: make([]FileInfo, 0, len(out.Contents))}
+	for _, object := range out.Contents {
+		page.Files = append(page.Files, FileInfo{
+			Key:          aws.ToString(object.Key),
+			Size:         aws.ToInt64(object.Size),
+			LastModified: aws.ToTime(object.LastModified),
+		})
+	}
+	if aws.ToBool(out.IsTruncated) {
+		page.NextToken = aws.ToString(out.NextContinuationToken)
+	}
+	return page, nil
+}
+
+// FileExists reports whether a file is stored under the key
+func (c *S3Client) FileExists(ctx context.Context, key string) (bool, error) {
+	_, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check file in S3: %w", err)
+	}
+	return true, nil
+}
+
 // DeleteFile deletes a file from S3
 func (c *S3Client) DeleteFile(ctx context.Context, key string) error {
 	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
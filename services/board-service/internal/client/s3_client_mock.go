@@ -1,11 +1,14 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,8 +24,22 @@ type MockS3Client struct {
 	GenerateFileKeyFunc      func(entityType, workspaceID, fileExt string) (string, error)
 	GeneratePresignedURLFunc func(ctx context.Context, entityType, workspaceID, fileName, contentType string) (string, string, error)
 	UploadFileFunc           func(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
+	DownloadFileFunc         func(ctx context.Context, key string) (io.ReadCloser, error)
+	ListFilesFunc            func(ctx context.Context, prefix, token string, limit int) (*FileListPage, error)
+	FileExistsFunc           func(ctx context.Context, key string) (bool, error)
 	DeleteFileFunc           func(ctx context.Context, key string) error
 	GetFileURLFunc           func(key string) string
+
+	// In-memory object store used by the default UploadFile/DownloadFile/DeleteFile
+	mu      sync.Mutex
+	objects map[string]mockS3Object
+}
+
+// mockS3Object is a file kept by the in-memory store
+type mockS3Object struct {
+	data         []byte
+	contentType  string
+	lastModified time.Time
 }
 
 // NewMockS3Client creates a new mock S3 client for testing
@@ -109,10 +126,97 @@ func (m *MockS3Client) UploadFile(ctx context.Context, key string, file io.Reade
 		return m.UploadFileFunc(ctx, key, file, contentType)
 	}
 
-	// Default implementation - just return the URL
+	// Default implementation - keep the file in memory and return the URL
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	m.mu.Lock()
+	if m.objects == nil {
+		m.objects = make(map[string]mockS3Object)
+	}
+	m.objects[key] = mockS3Object{data: data, contentType: contentType, lastModified: time.Now()}
+	m.mu.Unlock()
+
 	return m.GetFileURL(key), nil
 }
 
+// DownloadFile returns a file previously stored with UploadFile
+func (m *MockS3Client) DownloadFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	if m.DownloadFileFunc != nil {
+		return m.DownloadFileFunc(ctx, key)
+	}
+
+	// Default implementation - read from the in-memory store
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	object, ok := m.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("failed to download file from S3: key %s not found", key)
+	}
+	return io.NopCloser(bytes.NewReader(object.data)), nil
+}
+
+// ListFiles lists the in-memory files under the prefix in key order
+// The continuation token is the last key of the previous page
+func (m *MockS3Client) ListFiles(ctx context.Context, prefix, token string, limit int) (*FileListPage, error) {
+	if m.ListFilesFunc != nil {
+		return m.ListFilesFunc(ctx, prefix, token, limit)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0, len(m.objects))
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) && key > token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	page := &FileListPage{}
+	for _, key := range keys {
+		if len(page.Files) == limit {
+			page.NextToken = page.Files[len(page.Files)-1].Key
+			break
+		}
+		object := m.objects[key]
+		page.Files = append(page.Files, FileInfo{Key: key, Size: int64(len(object.data)), LastModified: object.lastModified})
+	}
+	return page, nil
+}
+
+// FileExists reports whether the in-memory store has the key
+func (m *MockS3Client) FileExists(ctx context.Context, key string) (bool, error) {
+	if m.FileExistsFunc != nil {
+		return m.FileExistsFunc(ctx, key)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.objects[key]
+	return ok, nil
+}
+
+// SetLastModified overrides the modification time of a file in the in-memory store
+func (m *MockS3Client) SetLastModified(key string, lastModified time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if object, ok := m.objects[key]; ok {
+		object.lastModified = lastModified
+		m.objects[key] = object
+	}
+}
+
+// StoredContentType returns the content type of a file in the in-memory store
+// The second return value reports whether the file exists
+func (m *MockS3Client) StoredContentType(key string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	object, ok := m.objects[key]
+	return object.contentType, ok
+}
+
 // DeleteFile simulates file deletion
 func (m *MockS3Client) DeleteFile(ctx context.Context, key string) error {
 	if m.DeleteFileFunc != nil {
@@ -120,6 +224,9 @@ func (m *MockS3Client) DeleteFile(ctx context.Context, key string) error {
 	}
 
 	// Default implementation - always succeed
+	m.mu.Lock()
+	delete(m.objects, key)
+	m.mu.Unlock()
 	return nil
 }
 
@@ -32,6 +32,7 @@ type UserClient interface {
 	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
 	GetUserProfile(ctx context.Context, userID uuid.UUID, token string) (*commonclient.UserProfile, error)
 	GetWorkspaceProfile(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceProfile, error)
+	GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error)
 	GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*commonclient.Workspace, error)
 	ValidateToken(ctx context.Context, tokenStr string) (uuid.UUID, error)
 }
@@ -126,7 +127,7 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 	)
 
 	var response commonclient.WorkspaceValidationResponse
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &response); err != nil {
+	if err := c.doRequestWithMetrics(ctx, "GET", url, token, nil, &response); err != nil {
 		c.Logger.Error("Failed to validate workspace member",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -156,7 +157,7 @@ func (c *userClient) GetUserProfile(ctx context.Context, userID uuid.UUID, token
 	)
 
 	var profile commonclient.UserProfile
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &profile); err != nil {
+	if err := c.doRequestWithMetrics(ctx, "GET", url, token, nil, &profile); err != nil {
 		c.Logger.Error("Failed to get user profile",
 			zap.Error(err),
 			zap.String("user_id", userID.String()),
@@ -184,7 +185,7 @@ func (c *userClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userI
 	)
 
 	var profile commonclient.WorkspaceProfile
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &profile); err != nil {
+	if err := c.doRequestWithMetrics(ctx, "GET", url, token, nil, &profile); err != nil {
 		c.Logger.Error("Failed to get workspace profile",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -208,6 +209,72 @@ func (c *userClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userI
 	return &profile, nil
 }
 
+// maxProfileBatchSize is the largest batch user-service accepts on /internal/profiles/batch
+const maxProfileBatchSize = 500
+
+// batchProfileRequest is the body of the batch profile lookup
+type batchProfileRequest struct {
+	WorkspaceID uuid.UUID   `json:"workspaceId"`
+	UserIDs     []uuid.UUID `json:"userIds"`
+}
+
+// batchProfileResponse is the response of the batch profile lookup
+type batchProfileResponse struct {
+	Profiles []commonclient.WorkspaceProfile `json:"profiles"`
+}
+
+// GetWorkspaceProfiles retrieves workspace profiles of several users in as few requests as possible.
+// Users without a profile are missing from the returned map.
+func (c *userClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	profiles := make(map[uuid.UUID]*commonclient.WorkspaceProfile, len(userIDs))
+	userIDs = uniqueUserIDs(userIDs)
+	url := c.BuildURL("/internal/profiles/batch")
+
+	for start := 0; start < len(userIDs); start += maxProfileBatchSize {
+		end := min(start+maxProfileBatchSize, len(userIDs))
+		body := batchProfileRequest{WorkspaceID: workspaceID, UserIDs: userIDs[start:end]}
+
+		var response batchProfileResponse
+		if err := c.doRequestWithMetrics(ctx, "POST", url, token, body, &response); err != nil {
+			c.Logger.Error("Failed to get workspace profiles",
+				zap.Error(err),
+				zap.String("workspace_id", workspaceID.String()),
+				zap.Int("user_count", end-start),
+			)
+			// Graceful degradation: callers render missing profiles as empty
+			return profiles, nil
+		}
+		for i := range response.Profiles {
+			profiles[response.Profiles[i].UserID] = &response.Profiles[i]
+		}
+	}
+
+	c.Logger.Debug("Workspace profiles retrieved",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.Int("requested", len(userIDs)),
+		zap.Int("found", len(profiles)),
+	)
+
+	return profiles, nil
+}
+
+// uniqueUserIDs removes duplicate and nil IDs while keeping the original order
+func uniqueUserIDs(userIDs []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(userIDs))
+	unique := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
 // GetWorkspace retrieves workspace information
 func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*commonclient.Workspace, error) {
 	url := c.BuildURL(fmt.Sprintf("/workspaces/%s", workspaceID.String()))
@@ -218,7 +285,7 @@ func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, to
 	)
 
 	var workspace commonclient.Workspace
-	if err := c.doRequestWithMetrics(ctx, "GET", url, token, &workspace); err != nil {
+	if err := c.doRequestWithMetrics(ctx, "GET", url, token, nil, &workspace); err != nil {
 		c.Logger.Error("Failed to get workspace",
 			zap.Error(err),
 			zap.String("workspace_id", workspaceID.String()),
@@ -241,7 +308,8 @@ func (c *userClient) log(ctx context.Context) *zap.Logger {
 }
 
 // doRequestWithMetrics performs an HTTP request with metrics recording and trace propagation
-func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, token string, result interface{}) error {
+// body, if not nil, is sent as JSON
+func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, token string, body interface{}, result interface{}) error {
 	startTime := time.Now()
 	log := c.log(ctx)
 
@@ -251,7 +319,16 @@ func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, toke
 		zap.String("http.url", url),
 	)
 
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		log.Error("Failed to create HTTP request",
 			zap.Error(err),
@@ -290,7 +367,7 @@ func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, toke
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Error("Failed to read response body",
 			zap.Error(err),
@@ -309,17 +386,17 @@ func (c *userClient) doRequestWithMetrics(ctx context.Context, method, url, toke
 			zap.String("http.method", method),
 			zap.Duration("http.duration", processingTime),
 		)
-		return fmt.Errorf("user API returned status %d: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("user API returned status %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	log.Debug("User service response received",
 		zap.Int("http.status_code", resp.StatusCode),
 		zap.String("http.url", url),
-		zap.Int("http.response_content_length", len(body)),
+		zap.Int("http.response_content_length", len(respBody)),
 		zap.Duration("http.duration", processingTime),
 	)
 
-	if err := json.Unmarshal(body, result); err != nil {
+	if err := json.Unmarshal(respBody, result); err != nil {
 		log.Error("Failed to parse response JSON",
 			zap.Error(err),
 			zap.String("http.url", url),
@@ -321,6 +321,56 @@ func TestUserClient_GetWorkspaceProfile(t *testing.T) {
 	}
 }
 
+func TestUserClient_GetWorkspaceProfiles(t *testing.T) {
+	workspaceID := uuid.New()
+	userA := uuid.New()
+	userB := uuid.New()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPost || r.URL.Path != "/api/internal/profiles/batch" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req batchProfileRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		// Duplicate and nil IDs are dropped before the request
+		if req.WorkspaceID != workspaceID || len(req.UserIDs) != 2 {
+			t.Errorf("unexpected batch request: %+v", req)
+		}
+		json.NewEncoder(w).Encode(batchProfileResponse{Profiles: []WorkspaceProfile{
+			{WorkspaceID: workspaceID, UserID: userA, NickName: "A"},
+		}})
+	}))
+	defer server.Close()
+
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, zap.NewNop(), nil)
+	profiles, err := client.GetWorkspaceProfiles(context.Background(), workspaceID, []uuid.UUID{userA, userB, userA, uuid.Nil}, "test-token")
+	if err != nil {
+		t.Fatalf("GetWorkspaceProfiles() unexpected error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	if len(profiles) != 1 || profiles[userA] == nil || profiles[userA].NickName != "A" {
+		t.Errorf("unexpected profiles: %+v", profiles)
+	}
+
+	// Graceful degradation: a failing user-service yields no profiles and no error
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	client = NewUserClient(failing.URL, failing.URL, 5*time.Second, zap.NewNop(), nil)
+	profiles, err = client.GetWorkspaceProfiles(context.Background(), workspaceID, []uuid.UUID{userA}, "test-token")
+	if err != nil || len(profiles) != 0 {
+		t.Errorf("GetWorkspaceProfiles() = %v, %v; want empty map and nil error", profiles, err)
+	}
+}
+
 func TestUserClient_GetWorkspace(t *testing.T) {
 	workspaceID := uuid.New()
 	ownerID := uuid.New()
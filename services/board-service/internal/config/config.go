@@ -26,6 +26,9 @@ type Config struct {
 	Redis     RedisConfig     `mapstructure:"redis" yaml:"redis"` // ← Redis 추가
 	S3        S3Config        `yaml:"s3"`                         // ← S3 추가
 	RateLimit RateLimitConfig `yaml:"rate_limit"`                 // Rate limiting configuration
+
+	AttachmentReconcile AttachmentReconcileConfig `yaml:"attachment_reconcile"` // S3 ↔ DB 첨부파일 정합성 점검
+	Webhook             WebhookConfig             `yaml:"webhook"`              // 외부 웹훅 전송
 }
 
 // ServerConfig holds server configuration
@@ -73,8 +76,9 @@ type AuthAPIConfig struct {
 
 // UserAPIConfig holds User API configuration
 type UserAPIConfig struct {
-	BaseURL string        `yaml:"base_url"`
-	Timeout time.Duration `yaml:"timeout"`
+	BaseURL         string        `yaml:"base_url"`
+	Timeout         time.Duration `yaml:"timeout"`
+	ProfileCacheTTL time.Duration `yaml:"profile_cache_ttl"` // Redis 프로필 캐시 TTL
 }
 
 // NotiAPIConfig holds Notification API configuration
@@ -113,6 +117,20 @@ type S3Config struct {
 	PublicEndpoint string `yaml:"public_endpoint"` // 브라우저 접근용 공개 엔드포인트 (presigned URL용)
 }
 
+// AttachmentReconcileConfig holds the S3 ↔ database attachment reconciliation settings
+type AttachmentReconcileConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Prefix        string        `yaml:"prefix"`         // S3 key prefix to scan (default "board/")
+	GracePeriod   time.Duration `yaml:"grace_period"`   // orphans younger than this are ignored (default 24h)
+	DeleteOrphans bool          `yaml:"delete_orphans"` // false = report only
+}
+
+// WebhookConfig holds the outgoing webhook delivery settings
+type WebhookConfig struct {
+	// AllowPrivateTargets permits deliveries to loopback, link-local and private addresses (local testing only)
+	AllowPrivateTargets bool `yaml:"allow_private_targets"`
+}
+
 // Load loads configuration from file and environment variables
 // If config file doesn't exist, loads from environment variables only
 func Load(configPath string) (*Config, error) {
@@ -177,8 +195,9 @@ func getDefaultConfig() Config {
 			Timeout: 5 * time.Second,
 		},
 		UserAPI: UserAPIConfig{
-			BaseURL: "http://localhost:8081",
-			Timeout: 5 * time.Second,
+			BaseURL:         "http://localhost:8081",
+			Timeout:         5 * time.Second,
+			ProfileCacheTTL: 5 * time.Minute,
 		},
 		NotiAPI: NotiAPIConfig{
 			BaseURL: "", // Not required - notifications disabled if empty
@@ -314,6 +333,11 @@ func (c *Config) overrideFromEnv() {
 			c.UserAPI.Timeout = d
 		}
 	}
+	if ttl := os.Getenv("USER_PROFILE_CACHE_TTL"); ttl != "" {
+		if d, err := time.ParseDuration(ttl); err == nil {
+			c.UserAPI.ProfileCacheTTL = d
+		}
+	}
 
 	// Noti API - NOTI_SERVICE_URL (알림 전송용)
 	if baseURL := os.Getenv("NOTI_SERVICE_URL"); baseURL != "" {
@@ -387,6 +411,33 @@ func (c *Config) overrideFromEnv() {
 	if c.RateLimit.RequestsPerMinute == 0 {
 		c.RateLimit.RequestsPerMinute = 60 // Default: 60 requests per minute
 	}
+
+	// Attachment reconciliation 환경변수 오버라이드
+	if enabled := os.Getenv("ATTACHMENT_RECONCILE_ENABLED"); enabled != "" {
+		c.AttachmentReconcile.Enabled = enabled == "true"
+	}
+	if deleteOrphans := os.Getenv("ATTACHMENT_RECONCILE_DELETE_ORPHANS"); deleteOrphans != "" {
+		c.AttachmentReconcile.DeleteOrphans = deleteOrphans == "true"
+	}
+	if grace := os.Getenv("ATTACHMENT_RECONCILE_GRACE_PERIOD"); grace != "" {
+		if d, err := time.ParseDuration(grace); err == nil {
+			c.AttachmentReconcile.GracePeriod = d
+		}
+	}
+	if prefix := os.Getenv("ATTACHMENT_RECONCILE_PREFIX"); prefix != "" {
+		c.AttachmentReconcile.Prefix = prefix
+	}
+	if c.AttachmentReconcile.Prefix == "" {
+		c.AttachmentReconcile.Prefix = "board/"
+	}
+	if c.AttachmentReconcile.GracePeriod == 0 {
+		c.AttachmentReconcile.GracePeriod = 24 * time.Hour
+	}
+
+	// Webhook 환경변수 오버라이드
+	if allowPrivate := os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS"); allowPrivate != "" {
+		c.Webhook.AllowPrivateTargets = allowPrivate == "true"
+	}
 }
 
 // validate validates the configuration
@@ -415,6 +466,9 @@ func (c *Config) validate() error {
 	if c.UserAPI.Timeout == 0 {
 		return fmt.Errorf("user api timeout is required")
 	}
+	if c.UserAPI.ProfileCacheTTL <= 0 {
+		c.UserAPI.ProfileCacheTTL = 5 * time.Minute
+	}
 
 	// Validate and normalize User API Base URL
 	if err := c.validateUserAPIBaseURL(); err != nil {
@@ -31,6 +31,10 @@ type FieldOptionConverter interface {
 
 	// ConvertIDsToValuesBatch converts customFields for multiple boards efficiently
 	ConvertIDsToValuesBatch(ctx context.Context, boards []*domain.Board) error
+
+	// StageCategories returns the category of each stage option of a project, keyed by value
+	// Output: {"in_progress": "open", "approved": "done"}
+	StageCategories(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error)
 }
 
 // fieldOptionConverterImpl is the implementation of FieldOptionConverter
@@ -302,3 +306,17 @@ func (c *fieldOptionConverterImpl) ConvertIDsToValuesBatch(
 
 	return nil
 }
+
+// StageCategories returns the category of each stage option of a project, keyed by value
+func (c *fieldOptionConverterImpl) StageCategories(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error) {
+	options, err := c.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldTypeStage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stage options: %w", err)
+	}
+
+	categories := make(map[string]domain.StageCategory, len(options))
+	for _, option := range options {
+		categories[option.Value] = option.Category
+	}
+	return categories, nil
+}
@@ -23,6 +23,7 @@ func AutoMigrate(db *gorm.DB) error {
 	// List of all domain models to migrate
 	models := []interface{}{
 		&domain.Project{},
+		&domain.ProjectCustomRole{},
 		&domain.ProjectMember{},
 		&domain.ProjectJoinRequest{},
 		&domain.Board{},
@@ -30,6 +31,23 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.Comment{},
 		&domain.FieldOption{},
 		&domain.Attachment{},
+		&domain.WorkflowTransition{},
+		&domain.AutomationRule{},
+		&domain.AutomationExecution{},
+		&domain.AutomationDueDateFiring{},
+		&domain.WebhookSubscription{},
+		&domain.WebhookDelivery{},
+		&domain.BoardReference{},
+		&domain.Label{},
+		&domain.Watcher{},
+		&domain.BoardMute{},
+		&domain.OutboxEvent{},
+		&domain.Sprint{},
+		&domain.SprintScopeChange{},
+		&domain.BoardReminder{},
+		&domain.BoardRecurrence{},
+		&domain.ProjectInviteLink{},
+		&domain.ReportTemplate{},
 	}
 
 	// Run auto-migration for all models
@@ -37,6 +55,21 @@ func AutoMigrate(db *gorm.DB) error {
 		return fmt.Errorf("failed to run auto-migration: %w", err)
 	}
 
+	// Assign board keys to rows created before board keys existed
+	if err := BackfillBoardKeys(db); err != nil {
+		return fmt.Errorf("failed to backfill board keys: %w", err)
+	}
+
+	// Index the plain text of boards created before content text existed
+	if err := BackfillBoardContentText(db); err != nil {
+		return fmt.Errorf("failed to backfill board content text: %w", err)
+	}
+
+	// Classify stage options created before stages had a category
+	if err := BackfillStageCategories(db); err != nil {
+		return fmt.Errorf("failed to backfill stage categories: %w", err)
+	}
+
 	return nil
 }
 
@@ -50,6 +83,7 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 	// List of all domain models with their table names
 	models := []modelInfo{
 		{&domain.Project{}, "projects"},
+		{&domain.ProjectCustomRole{}, "project_custom_roles"},
 		{&domain.ProjectMember{}, "project_members"},
 		{&domain.ProjectJoinRequest{}, "project_join_requests"},
 		{&domain.Board{}, "boards"},
@@ -57,6 +91,23 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 		{&domain.Comment{}, "comments"},
 		{&domain.FieldOption{}, "field_options"},
 		{&domain.Attachment{}, "attachments"},
+		{&domain.WorkflowTransition{}, "workflow_transitions"},
+		{&domain.AutomationRule{}, "automation_rules"},
+		{&domain.AutomationExecution{}, "automation_executions"},
+		{&domain.AutomationDueDateFiring{}, "automation_due_date_firings"},
+		{&domain.WebhookSubscription{}, "webhook_subscriptions"},
+		{&domain.WebhookDelivery{}, "webhook_deliveries"},
+		{&domain.BoardReference{}, "board_references"},
+		{&domain.Label{}, "labels"},
+		{&domain.Watcher{}, "watchers"},
+		{&domain.BoardMute{}, "board_mutes"},
+		{&domain.OutboxEvent{}, "outbox_events"},
+		{&domain.Sprint{}, "sprints"},
+		{&domain.SprintScopeChange{}, "sprint_scope_changes"},
+		{&domain.BoardReminder{}, "board_reminders"},
+		{&domain.BoardRecurrence{}, "board_recurrences"},
+		{&domain.ProjectInviteLink{}, "project_invite_links"},
+		{&domain.ReportTemplate{}, "report_templates"},
 	}
 
 	logger.Info("Starting safe auto-migration",
@@ -94,6 +145,24 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 		)
 	}
 
+	// Assign board keys to rows created before board keys existed
+	if err := BackfillBoardKeys(db); err != nil {
+		logger.Error("Failed to backfill board keys", zap.Error(err))
+		return fmt.Errorf("failed to backfill board keys: %w", err)
+	}
+
+	// Index the plain text of boards created before content text existed
+	if err := BackfillBoardContentText(db); err != nil {
+		logger.Error("Failed to backfill board content text", zap.Error(err))
+		return fmt.Errorf("failed to backfill board content text: %w", err)
+	}
+
+	// Classify stage options created before stages had a category
+	if err := BackfillStageCategories(db); err != nil {
+		logger.Error("Failed to backfill stage categories", zap.Error(err))
+		return fmt.Errorf("failed to backfill stage categories: %w", err)
+	}
+
 	logger.Info("Safe auto-migration completed successfully",
 		zap.Int("tables_migrated", len(models)),
 	)
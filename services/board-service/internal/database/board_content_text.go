@@ -0,0 +1,42 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/markdown"
+)
+
+// contentTextBatchSize is the number of boards converted per backfill batch
+const contentTextBatchSize = 500
+
+// BackfillBoardContentText fills the plain-text search column of boards created before it existed
+// It is idempotent and runs after every migration.
+func BackfillBoardContentText(db *gorm.DB) error {
+	lastID := uuid.Nil
+	for {
+		var boards []domain.Board
+		if err := db.Select("id", "content").
+			Where("(content_text IS NULL OR content_text = '') AND content <> '' AND id > ?", lastID).
+			Order("id ASC").
+			Limit(contentTextBatchSize).
+			Find(&boards).Error; err != nil {
+			return fmt.Errorf("failed to find boards without content text: %w", err)
+		}
+		if len(boards) == 0 {
+			return nil
+		}
+
+		for _, board := range boards {
+			if err := db.Model(&domain.Board{}).
+				Where("id = ?", board.ID).
+				UpdateColumn("content_text", markdown.PlainText(board.Content)).Error; err != nil {
+				return fmt.Errorf("failed to backfill content text of board %s: %w", board.ID, err)
+			}
+		}
+		lastID = boards[len(boards)-1].ID
+	}
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// BackfillBoardKeys assigns board keys to data created before board keys existed
+// Projects without a key prefix get one derived from their name, and boards without a number are
+// numbered per project in creation order. It is idempotent and runs after every migration.
+func BackfillBoardKeys(db *gorm.DB) error {
+	if err := backfillProjectKeyPrefixes(db); err != nil {
+		return err
+	}
+
+	var projectIDs []uuid.UUID
+	if err := db.Model(&domain.Board{}).
+		Where("number = 0").
+		Distinct("project_id").
+		Pluck("project_id", &projectIDs).Error; err != nil {
+		return fmt.Errorf("failed to find boards without number: %w", err)
+	}
+
+	for _, projectID := range projectIDs {
+		if err := backfillBoardNumbers(db, projectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillProjectKeyPrefixes derives a unique key prefix for every project that has none
+func backfillProjectKeyPrefixes(db *gorm.DB) error {
+	var projects []domain.Project
+	if err := db.Where("key_prefix = ''").Order("created_at ASC").Find(&projects).Error; err != nil {
+		return fmt.Errorf("failed to find projects without key prefix: %w", err)
+	}
+	if len(projects) == 0 {
+		return nil
+	}
+
+	// Prefixes already in use, per workspace
+	taken := make(map[uuid.UUID]map[string]bool)
+	var existing []domain.Project
+	if err := db.Select("workspace_id", "key_prefix").Where("key_prefix <> ''").Find(&existing).Error; err != nil {
+		return fmt.Errorf("failed to load project key prefixes: %w", err)
+	}
+	for _, p := range existing {
+		if taken[p.WorkspaceID] == nil {
+			taken[p.WorkspaceID] = make(map[string]bool)
+		}
+		taken[p.WorkspaceID][p.KeyPrefix] = true
+	}
+
+	for _, project := range projects {
+		if taken[project.WorkspaceID] == nil {
+			taken[project.WorkspaceID] = make(map[string]bool)
+		}
+		base := domain.DeriveProjectKeyPrefix(project.Name)
+		prefix := base
+		for n := 1; taken[project.WorkspaceID][prefix]; n++ {
+			prefix = domain.ProjectKeyPrefixCandidate(base, n)
+		}
+
+		if err := db.Model(&domain.Project{}).
+			Where("id = ? AND key_prefix = ''", project.ID).
+			UpdateColumn("key_prefix", prefix).Error; err != nil {
+			return fmt.Errorf("failed to set key prefix of project %s: %w", project.ID, err)
+		}
+		taken[project.WorkspaceID][prefix] = true
+	}
+	return nil
+}
+
+// backfillBoardNumbers numbers the unnumbered boards of a project in creation order
+// The numbers are reserved by bumping board_sequence first, the same way BoardRepository.Create does,
+// so boards created concurrently by the running service never collide with backfilled ones
+func backfillBoardNumbers(db *gorm.DB, projectID uuid.UUID) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var boardIDs []uuid.UUID
+		if err := tx.Model(&domain.Board{}).
+			Where("project_id = ? AND number = 0", projectID).
+			Order("created_at ASC, id ASC").
+			Pluck("id", &boardIDs).Error; err != nil {
+			return fmt.Errorf("failed to find boards of project %s: %w", projectID, err)
+		}
+		if len(boardIDs) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&domain.Project{}).
+			Where("id = ?", projectID).
+			UpdateColumn("board_sequence", gorm.Expr("board_sequence + ?", len(boardIDs))).Error; err != nil {
+			return fmt.Errorf("failed to reserve board numbers of project %s: %w", projectID, err)
+		}
+		var sequence int64
+		if err := tx.Model(&domain.Project{}).
+			Where("id = ?", projectID).
+			Select("board_sequence").
+			Scan(&sequence).Error; err != nil {
+			return fmt.Errorf("failed to read board sequence of project %s: %w", projectID, err)
+		}
+
+		next := sequence - int64(len(boardIDs)) + 1
+		for i, boardID := range boardIDs {
+			if err := tx.Model(&domain.Board{}).
+				Where("id = ?", boardID).
+				UpdateColumn("number", next+int64(i)).Error; err != nil {
+				return fmt.Errorf("failed to number board %s: %w", boardID, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// BackfillStageCategories classifies stage options created before stages had a category
+// The former built-in closed stages keep their meaning: approved is done and deleted is cancelled;
+// every other stage is open. It is idempotent and runs after every migration.
+func BackfillStageCategories(db *gorm.DB) error {
+	if err := db.Model(&domain.FieldOption{}).
+		Where("field_type = ? AND (category IS NULL OR category = '')", domain.FieldTypeStage).
+		UpdateColumn("category", gorm.Expr("CASE value WHEN ? THEN ? WHEN ? THEN ? ELSE ? END",
+			"approved", domain.StageCategoryDone,
+			"deleted", domain.StageCategoryCancelled,
+			domain.StageCategoryOpen)).Error; err != nil {
+		return fmt.Errorf("failed to classify stage options: %w", err)
+	}
+	return nil
+}
@@ -23,6 +23,16 @@ const (
 	AttachmentStatusConfirmed AttachmentStatus = "CONFIRMED" // Confirmed status
 )
 
+// ThumbnailStatus represents the progress of thumbnail generation for an image attachment
+// Attachments that are not previewable images keep an empty status
+type ThumbnailStatus string
+
+const (
+	ThumbnailStatusPending ThumbnailStatus = "PENDING" // Waiting for the thumbnail worker
+	ThumbnailStatusReady   ThumbnailStatus = "READY"   // Thumbnail and preview are stored in S3
+	ThumbnailStatusFailed  ThumbnailStatus = "FAILED"  // The image could not be decoded or stored
+)
+
 // Attachment represents a file attachment associated with a board or project
 // This is a polymorphic relationship - EntityID can reference Board, Project, or Comment
 // ⚠️ IMPORTANT: Do not add foreign key constraints on EntityID as it references multiple tables
@@ -37,9 +47,27 @@ type Attachment struct {
 	ContentType string           `gorm:"type:varchar(100);not null" json:"content_type"`
 	UploadedBy  uuid.UUID        `gorm:"type:uuid;not null;index:idx_attachments_uploaded_by" json:"uploaded_by"`
 	ExpiresAt   *time.Time       `gorm:"type:timestamp;index:idx_attachments_expires_at" json:"expires_at"`
+
+	// Derived images generated in the background (S3 keys, empty until READY)
+	ThumbnailStatus ThumbnailStatus `gorm:"type:varchar(20);index:idx_attachments_thumbnail_status" json:"thumbnail_status"`
+	ThumbnailKey    string          `gorm:"type:text" json:"thumbnail_key"`
+	PreviewKey      string          `gorm:"type:text" json:"preview_key"`
 }
 
 // TableName specifies the table name for Attachment
 func (Attachment) TableName() string {
 	return "attachments"
 }
+
+// DerivedFileKeys returns the S3 keys of the generated thumbnail and preview images
+// They have to be deleted together with the original file
+func (a *Attachment) DerivedFileKeys() []string {
+	keys := make([]string, 0, 2)
+	if a.ThumbnailKey != "" {
+		keys = append(keys, a.ThumbnailKey)
+	}
+	if a.PreviewKey != "" {
+		keys = append(keys, a.PreviewKey)
+	}
+	return keys
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// AutomationTrigger represents the board event that starts an automation rule
+type AutomationTrigger string
+
+const (
+	AutomationTriggerBoardCreated   AutomationTrigger = "BOARD_CREATED"
+	AutomationTriggerFieldChanged   AutomationTrigger = "FIELD_CHANGED"
+	AutomationTriggerDueDateReached AutomationTrigger = "DUE_DATE_REACHED"
+	AutomationTriggerCommentAdded   AutomationTrigger = "COMMENT_ADDED"
+)
+
+// AutomationConditionOperator represents how a condition compares a board field
+type AutomationConditionOperator string
+
+const (
+	AutomationOperatorEquals     AutomationConditionOperator = "equals"
+	AutomationOperatorNotEquals  AutomationConditionOperator = "not_equals"
+	AutomationOperatorIsEmpty    AutomationConditionOperator = "is_empty"
+	AutomationOperatorIsNotEmpty AutomationConditionOperator = "is_not_empty"
+)
+
+// AutomationActionType represents what an automation rule does when it fires
+type AutomationActionType string
+
+const (
+	AutomationActionSetField        AutomationActionType = "set_field"
+	AutomationActionAssign          AutomationActionType = "assign"
+	AutomationActionAddParticipants AutomationActionType = "add_participants"
+	AutomationActionPostComment     AutomationActionType = "post_comment"
+	AutomationActionNotify          AutomationActionType = "notify"
+)
+
+// Notify action targets (besides explicit user IDs)
+const (
+	AutomationTargetAssignee     = "assignee"
+	AutomationTargetParticipants = "participants"
+	AutomationTargetAuthor       = "author"
+)
+
+// AutomationExecutionStatus represents the outcome of a rule execution
+type AutomationExecutionStatus string
+
+const (
+	AutomationExecutionSuccess AutomationExecutionStatus = "SUCCESS"
+	AutomationExecutionFailed  AutomationExecutionStatus = "FAILED"
+	AutomationExecutionSkipped AutomationExecutionStatus = "SKIPPED"
+)
+
+// AutomationCondition filters the boards a rule applies to
+// Field is "assignee" or a custom field key (e.g. "stage"); custom fields are compared by value
+type AutomationCondition struct {
+	Field    string                      `json:"field"`
+	Operator AutomationConditionOperator `json:"operator"`
+	Value    string                      `json:"value,omitempty"`
+}
+
+// AutomationAction describes a single step executed when a rule fires
+type AutomationAction struct {
+	Type    AutomationActionType `json:"type"`
+	Field   string               `json:"field,omitempty"`   // set_field: custom field key
+	Value   string               `json:"value,omitempty"`   // set_field: field option value
+	UserID  *uuid.UUID           `json:"userId,omitempty"`  // assign: nil clears the assignee
+	UserIDs []uuid.UUID          `json:"userIds,omitempty"` // add_participants, notify
+	Target  string               `json:"target,omitempty"`  // notify: assignee, participants or author
+	Message string               `json:"message,omitempty"` // post_comment, notify
+}
+
+// AutomationRule represents a per-project "when trigger, if conditions, then actions" rule
+// Actions run on behalf of the rule creator
+type AutomationRule struct {
+	BaseModel
+	ProjectID    uuid.UUID         `gorm:"type:uuid;not null;index:idx_automation_rules_project_trigger,priority:1" json:"project_id"`
+	Name         string            `gorm:"type:varchar(255);not null" json:"name"`
+	Enabled      bool              `gorm:"not null;default:true" json:"enabled"`
+	Trigger      AutomationTrigger `gorm:"type:varchar(50);not null;index:idx_automation_rules_project_trigger,priority:2" json:"trigger"`
+	TriggerField string            `gorm:"type:varchar(100)" json:"trigger_field"` // FIELD_CHANGED only; empty matches any field
+	TriggerValue string            `gorm:"type:varchar(255)" json:"trigger_value"` // FIELD_CHANGED only; empty matches any new value
+	Conditions   datatypes.JSON    `gorm:"type:jsonb" json:"conditions"`           // []AutomationCondition
+	Actions      datatypes.JSON    `gorm:"type:jsonb;not null" json:"actions"`     // []AutomationAction
+	CreatedBy    uuid.UUID         `gorm:"type:uuid;not null" json:"created_by"`
+	Project      Project           `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for AutomationRule
+func (AutomationRule) TableName() string {
+	return "automation_rules"
+}
+
+// AutomationExecution is an execution log entry of an automation rule
+type AutomationExecution struct {
+	BaseModel
+	RuleID    uuid.UUID                 `gorm:"type:uuid;not null;index:idx_automation_executions_rule_id" json:"rule_id"`
+	ProjectID uuid.UUID                 `gorm:"type:uuid;not null;index:idx_automation_executions_project_id" json:"project_id"`
+	BoardID   uuid.UUID                 `gorm:"type:uuid;not null;index:idx_automation_executions_board_id" json:"board_id"`
+	Trigger   AutomationTrigger         `gorm:"type:varchar(50);not null" json:"trigger"`
+	Status    AutomationExecutionStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Depth     int                       `gorm:"not null;default:0" json:"depth"` // chain depth (0 = triggered by a user)
+	Message   string                    `gorm:"type:text" json:"message"`
+	Rule      AutomationRule            `gorm:"foreignKey:RuleID;constraint:OnDelete:CASCADE" json:"rule,omitempty"`
+}
+
+// TableName specifies the table name for AutomationExecution
+func (AutomationExecution) TableName() string {
+	return "automation_executions"
+}
+
+// AutomationDueDateFiring records that a DUE_DATE_REACHED rule fired for a board's due date
+// The primary key lets exactly one replica claim each (rule, board, due date); moving the due date fires again
+type AutomationDueDateFiring struct {
+	RuleID  uuid.UUID      `gorm:"type:uuid;primaryKey" json:"rule_id"`
+	BoardID uuid.UUID      `gorm:"type:uuid;primaryKey;index:idx_automation_due_date_firings_board_id" json:"board_id"`
+	DueDate time.Time      `gorm:"primaryKey" json:"due_date"`
+	FiredAt time.Time      `gorm:"not null" json:"fired_at"`
+	Rule    AutomationRule `gorm:"foreignKey:RuleID;constraint:OnDelete:CASCADE" json:"-"`
+	Board   Board          `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for AutomationDueDateFiring
+func (AutomationDueDateFiring) TableName() string {
+	return "automation_due_date_firings"
+}
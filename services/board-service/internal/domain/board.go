@@ -10,17 +10,23 @@ import (
 // Board represents a work board entity within a project
 type Board struct {
 	BaseModel
-	ProjectID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_project_id" json:"project_id"`
+	ProjectID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_project_id;uniqueIndex:uq_boards_project_number,priority:1" json:"project_id"`
+	Number       int64          `gorm:"not null;default:0;uniqueIndex:uq_boards_project_number,priority:2,where:number > 0" json:"number"` // per-project sequence, 0 until assigned
 	AuthorID     uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_author_id" json:"author_id"`
 	AssigneeID   *uuid.UUID     `gorm:"type:uuid;index:idx_boards_assignee_id" json:"assignee_id"`
+	SprintID     *uuid.UUID     `gorm:"type:uuid;index:idx_boards_sprint_id" json:"sprint_id"`         // nil: backlog
+	RecurrenceID *uuid.UUID     `gorm:"type:uuid;index:idx_boards_recurrence_id" json:"recurrence_id"` // series the board was generated by or is the template of
 	Title        string         `gorm:"type:varchar(255);not null" json:"title"`
 	Content      string         `gorm:"type:text" json:"content"`
+	ContentText  string         `gorm:"type:text" json:"-"` // Markdown을 제거한 본문 (검색용)
 	CustomFields datatypes.JSON `gorm:"type:jsonb" json:"custom_fields"`
 	StartDate    *time.Time     `gorm:"type:timestamp;index:idx_boards_start_date" json:"start_date"`
 	DueDate      *time.Time     `gorm:"type:timestamp;index:idx_boards_due_date" json:"due_date"`
+	CompletedAt  *time.Time     `gorm:"type:timestamp;index:idx_boards_completed_at" json:"completed_at"` // when the board last reached the completed stage, nil while not completed
 	Project      Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
 	Participants []Participant  `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"participants,omitempty"`
 	Comments     []Comment      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"comments,omitempty"`
+	Labels       []Label        `gorm:"many2many:board_labels;constraint:OnDelete:CASCADE" json:"labels,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
 }
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Board key constraints
+// A board key is "<project key prefix>-<board number>", e.g. "WEB-42"
+const (
+	ProjectKeyPrefixMinLen  = 2
+	ProjectKeyPrefixMaxLen  = 10
+	DefaultProjectKeyPrefix = "PRJ"
+)
+
+// FormatBoardKey builds the board key, or "" when the board has no number yet
+func FormatBoardKey(prefix string, number int64) string {
+	if prefix == "" || number <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s-%d", prefix, number)
+}
+
+// ParseBoardKey splits a board key into its prefix and number (case-insensitive prefix)
+func ParseBoardKey(key string) (string, int64, bool) {
+	idx := strings.LastIndex(key, "-")
+	if idx <= 0 || idx == len(key)-1 {
+		return "", 0, false
+	}
+	prefix := strings.ToUpper(key[:idx])
+	if !IsValidProjectKeyPrefix(prefix) {
+		return "", 0, false
+	}
+	number, err := strconv.ParseInt(key[idx+1:], 10, 64)
+	if err != nil || number <= 0 {
+		return "", 0, false
+	}
+	return prefix, number, true
+}
+
+// IsValidProjectKeyPrefix checks the prefix format: 2-10 uppercase letters or digits, starting with a letter
+func IsValidProjectKeyPrefix(prefix string) bool {
+	if len(prefix) < ProjectKeyPrefixMinLen || len(prefix) > ProjectKeyPrefixMaxLen {
+		return false
+	}
+	for i, r := range prefix {
+		switch {
+		case r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// DeriveProjectKeyPrefix suggests a key prefix from a project name
+// Multi-word names use their initials ("Mobile App Launch" -> "MAL"), single words their first
+// four letters ("Website" -> "WEBS"); names without ASCII letters fall back to DefaultProjectKeyPrefix
+func DeriveProjectKeyPrefix(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+	})
+	// Drop leading digits so the prefix starts with a letter
+	for i, w := range words {
+		words[i] = strings.TrimLeftFunc(w, unicode.IsDigit)
+	}
+
+	var b strings.Builder
+	nonEmpty := 0
+	for _, w := range words {
+		if w != "" {
+			nonEmpty++
+		}
+	}
+	if nonEmpty >= 2 {
+		for _, w := range words {
+			if w != "" && b.Len() < ProjectKeyPrefixMaxLen {
+				b.WriteByte(w[0])
+			}
+		}
+	} else {
+		for _, w := range words {
+			if w != "" {
+				b.WriteString(w)
+			}
+		}
+	}
+
+	prefix := strings.ToUpper(b.String())
+	if nonEmpty < 2 && len(prefix) > 4 {
+		prefix = prefix[:4]
+	}
+	if !IsValidProjectKeyPrefix(prefix) {
+		return DefaultProjectKeyPrefix
+	}
+	return prefix
+}
+
+// ProjectKeyPrefixCandidate returns the n-th candidate for a prefix that is already taken
+// (n = 0 is the base itself, then BASE2, BASE3, ... trimmed to the maximum length)
+func ProjectKeyPrefixCandidate(base string, n int) string {
+	if n == 0 {
+		return base
+	}
+	suffix := strconv.Itoa(n + 1)
+	if len(base)+len(suffix) > ProjectKeyPrefixMaxLen {
+		base = base[:ProjectKeyPrefixMaxLen-len(suffix)]
+	}
+	return base + suffix
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// BoardReferenceSourceType identifies which kind of text a board reference was parsed from
+type BoardReferenceSourceType string
+
+const (
+	// BoardReferenceSourceBoard is a reference found in a board's content
+	BoardReferenceSourceBoard BoardReferenceSourceType = "BOARD"
+	// BoardReferenceSourceComment is a reference found in a comment
+	BoardReferenceSourceComment BoardReferenceSourceType = "COMMENT"
+)
+
+// BoardReference records that the text of a board or comment links to another board
+// The target board lists it as a "mentioned in" backlink
+type BoardReference struct {
+	BaseModel
+	SourceType    BoardReferenceSourceType `gorm:"type:varchar(20);not null;uniqueIndex:uq_board_references_source_target,priority:1" json:"source_type"`
+	SourceID      uuid.UUID                `gorm:"type:uuid;not null;uniqueIndex:uq_board_references_source_target,priority:2" json:"source_id"` // board or comment ID
+	SourceBoardID uuid.UUID                `gorm:"type:uuid;not null;index:idx_board_references_source_board_id" json:"source_board_id"`         // board holding the text
+	TargetBoardID uuid.UUID                `gorm:"type:uuid;not null;uniqueIndex:uq_board_references_source_target,priority:3;index:idx_board_references_target_board_id" json:"target_board_id"`
+	SourceBoard   Board                    `gorm:"foreignKey:SourceBoardID;constraint:OnDelete:CASCADE" json:"source_board,omitempty"`
+	TargetBoard   Board                    `gorm:"foreignKey:TargetBoardID;constraint:OnDelete:CASCADE" json:"target_board,omitempty"`
+}
+
+// TableName specifies the table name for BoardReference
+func (BoardReference) TableName() string {
+	return "board_references"
+}
+
+// boardReferencePattern matches board URLs (.../boards/<uuid>, .../board/<uuid>) and board:<uuid> tokens
+var boardReferencePattern = regexp.MustCompile(`(?i)(?:\bboard:|/boards?/)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`)
+
+// ExtractBoardReferences returns the distinct board IDs linked from a text, in order of appearance
+func ExtractBoardReferences(text string) []uuid.UUID {
+	matches := boardReferencePattern.FindAllStringSubmatch(text, -1)
+	ids := make([]uuid.UUID, 0, len(matches))
+	seen := make(map[uuid.UUID]bool, len(matches))
+	for _, m := range matches {
+		id, err := uuid.Parse(m[1])
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
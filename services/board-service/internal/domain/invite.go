@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectInviteLink is a shareable link that lets workspace members join a project without approval
+// Members who join through the link receive Role. A link stops working once it expires, is revoked,
+// or has been used MaxUses times (unlimited when MaxUses is nil)
+type ProjectInviteLink struct {
+	BaseModel
+	ProjectID uuid.UUID   `gorm:"type:uuid;not null;index:idx_project_invite_links_project_id" json:"project_id"`
+	Token     string      `gorm:"type:varchar(64);not null;uniqueIndex:uq_project_invite_links_token" json:"token"`
+	Role      ProjectRole `gorm:"type:varchar(50);not null" json:"role"`
+	MaxUses   *int        `json:"max_uses,omitempty"`
+	UseCount  int         `gorm:"not null;default:0" json:"use_count"`
+	ExpiresAt time.Time   `gorm:"type:timestamp;not null" json:"expires_at"`
+	CreatedBy uuid.UUID   `gorm:"type:uuid;not null" json:"created_by"`
+	RevokedAt *time.Time  `gorm:"type:timestamp" json:"revoked_at,omitempty"`
+	RevokedBy *uuid.UUID  `gorm:"type:uuid" json:"revoked_by,omitempty"`
+	Project   Project     `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for ProjectInviteLink
+func (ProjectInviteLink) TableName() string {
+	return "project_invite_links"
+}
+
+// IsUsable reports whether the link can still be accepted at now
+func (l *ProjectInviteLink) IsUsable(now time.Time) bool {
+	if l.RevokedAt != nil || !now.Before(l.ExpiresAt) {
+		return false
+	}
+	return l.MaxUses == nil || l.UseCount < *l.MaxUses
+}
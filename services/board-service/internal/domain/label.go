@@ -0,0 +1,29 @@
+package domain
+
+import "github.com/google/uuid"
+
+// DefaultLabelColor is used when a label is created without a color
+const DefaultLabelColor = "#6B7280"
+
+// Label represents a project-scoped tag that can be attached to any number of boards
+// Assignments live in the board_labels join table (see Board.Labels)
+type Label struct {
+	BaseModel
+	ProjectID   uuid.UUID `gorm:"type:uuid;not null;index:idx_labels_project_id;uniqueIndex:uq_labels_project_name,priority:1" json:"project_id"`
+	Name        string    `gorm:"type:varchar(50);not null;uniqueIndex:uq_labels_project_name,priority:2" json:"name"`
+	Color       string    `gorm:"type:varchar(7);not null" json:"color"` // hex color, e.g. #FF5733
+	Description string    `gorm:"type:varchar(255)" json:"description"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+	Project     Project   `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for Label
+func (Label) TableName() string {
+	return "labels"
+}
+
+// LabelUsage is the number of boards a label is attached to
+type LabelUsage struct {
+	LabelID    uuid.UUID
+	BoardCount int64
+}
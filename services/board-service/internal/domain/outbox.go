@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// OutboxAggregateType identifies the entity an outbox event belongs to
+type OutboxAggregateType string
+
+const (
+	OutboxAggregateBoard    OutboxAggregateType = "board"
+	OutboxAggregateComment  OutboxAggregateType = "comment"
+	OutboxAggregateProject  OutboxAggregateType = "project"
+	OutboxAggregateSprint   OutboxAggregateType = "sprint"
+	OutboxAggregateReminder OutboxAggregateType = "reminder"
+)
+
+// OutboxTopic identifies where the relay delivers an outbox event
+type OutboxTopic string
+
+const (
+	OutboxTopicNotification OutboxTopic = "notification" // POST to noti-service
+	OutboxTopicBroadcast    OutboxTopic = "broadcast"    // Redis pub/sub channel of the project (WebSocket clients)
+)
+
+// OutboxStatus represents the delivery state of an outbox event
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "PENDING"   // waiting for its first or next attempt
+	OutboxStatusDelivered OutboxStatus = "DELIVERED" // accepted by the destination
+	OutboxStatusDead      OutboxStatus = "DEAD"      // retries exhausted or undeliverable
+)
+
+// OutboxEvent is a side effect recorded in the same transaction as the change that caused it
+// ID is assigned by the database in insertion order; the relay delivers the events of one
+// aggregate and topic strictly in ID order
+type OutboxEvent struct {
+	ID             int64               `gorm:"primaryKey;autoIncrement" json:"id"`
+	IdempotencyKey uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_outbox_events_idempotency_key" json:"idempotency_key"`
+	AggregateType  OutboxAggregateType `gorm:"type:varchar(20);not null;index:idx_outbox_events_aggregate,priority:1" json:"aggregate_type"`
+	AggregateID    uuid.UUID           `gorm:"type:uuid;not null;index:idx_outbox_events_aggregate,priority:2" json:"aggregate_id"`
+	Topic          OutboxTopic         `gorm:"type:varchar(20);not null;index:idx_outbox_events_aggregate,priority:3" json:"topic"`
+	EventType      string              `gorm:"type:varchar(50);not null" json:"event_type"`
+	ProjectID      *uuid.UUID          `gorm:"type:uuid" json:"project_id,omitempty"` // broadcast channel
+	Payload        datatypes.JSON      `gorm:"type:jsonb;not null" json:"payload"`
+	Status         OutboxStatus        `gorm:"type:varchar(20);not null;index:idx_outbox_events_status_next,priority:1" json:"status"`
+	Attempts       int                 `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  time.Time           `gorm:"not null;index:idx_outbox_events_status_next,priority:2" json:"next_attempt_at"`
+	LastError      string              `gorm:"type:text" json:"last_error"`
+	CreatedAt      time.Time           `gorm:"not null" json:"created_at"`
+	DeliveredAt    *time.Time          `json:"delivered_at"`
+}
+
+// TableName specifies the table name for OutboxEvent
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
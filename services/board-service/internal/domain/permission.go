@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Permission is an action a project member may perform
+type Permission string
+
+const (
+	PermissionProjectUpdate     Permission = "project.update"      // edit project name, dates and settings
+	PermissionProjectArchive    Permission = "project.archive"     // archive the project (unarchiving stays with OWNER and ADMIN)
+	PermissionProjectDelete     Permission = "project.delete"      // delete the project (owner only, cannot be granted)
+	PermissionBoardCreate       Permission = "board.create"        // create boards
+	PermissionBoardDeleteAny    Permission = "board.delete.any"    // delete boards written by others (authors may always delete their own)
+	PermissionCommentCreate     Permission = "comment.create"      // comment on boards
+	PermissionCommentDeleteAny  Permission = "comment.delete.any"  // delete comments written by others (authors may always delete their own)
+	PermissionAttachmentDelete  Permission = "attachment.delete"   // delete attachments uploaded by others (uploaders may always delete their own)
+	PermissionFieldOptionManage Permission = "field_option.manage" // edit and delete project field options
+	PermissionLabelManage       Permission = "label.manage"        // delete and merge labels
+	PermissionSprintManage      Permission = "sprint.manage"       // create, start, close and delete sprints
+	PermissionWorkflowManage    Permission = "workflow.manage"     // manage workflow transitions
+	PermissionAutomationManage  Permission = "automation.manage"   // manage automation rules
+	PermissionWebhookManage     Permission = "webhook.manage"      // manage outgoing webhooks
+	PermissionMemberInvite      Permission = "member.invite"       // review join requests and invite members
+	PermissionMemberRemove      Permission = "member.remove"       // remove members from the project
+	PermissionRoleManage        Permission = "role.manage"         // define custom roles and change member roles (owner only, cannot be granted)
+)
+
+// AllPermissions lists every permission in display order
+var AllPermissions = []Permission{
+	PermissionProjectUpdate,
+	PermissionProjectArchive,
+	PermissionProjectDelete,
+	PermissionBoardCreate,
+	PermissionBoardDeleteAny,
+	PermissionCommentCreate,
+	PermissionCommentDeleteAny,
+	PermissionAttachmentDelete,
+	PermissionFieldOptionManage,
+	PermissionLabelManage,
+	PermissionSprintManage,
+	PermissionWorkflowManage,
+	PermissionAutomationManage,
+	PermissionWebhookManage,
+	PermissionMemberInvite,
+	PermissionMemberRemove,
+	PermissionRoleManage,
+}
+
+// builtinRolePermissions is the permission matrix of the built-in roles
+// OWNER holds every permission and is resolved separately
+var builtinRolePermissions = map[ProjectRole][]Permission{
+	ProjectRoleAdmin: {
+		PermissionProjectArchive,
+		PermissionBoardCreate,
+		PermissionBoardDeleteAny,
+		PermissionCommentCreate,
+		PermissionCommentDeleteAny,
+		PermissionAttachmentDelete,
+		PermissionFieldOptionManage,
+		PermissionLabelManage,
+		PermissionSprintManage,
+		PermissionWorkflowManage,
+		PermissionAutomationManage,
+		PermissionWebhookManage,
+		PermissionMemberInvite,
+		PermissionMemberRemove,
+	},
+	ProjectRoleMember: {
+		PermissionBoardCreate,
+		PermissionCommentCreate,
+	},
+}
+
+// IsValid reports whether the permission is part of the permission model
+func (p Permission) IsValid() bool {
+	for _, known := range AllPermissions {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGrantable reports whether the permission may be given to a custom role
+// Deleting the project and managing roles stay with the owner so a custom role can never escalate itself
+func (p Permission) IsGrantable() bool {
+	return p.IsValid() && p != PermissionProjectDelete && p != PermissionRoleManage
+}
+
+// IsBuiltinRole reports whether name is one of the built-in project roles
+func IsBuiltinRole(name string) bool {
+	switch ProjectRole(name) {
+	case ProjectRoleOwner, ProjectRoleAdmin, ProjectRoleMember:
+		return true
+	}
+	return false
+}
+
+// RolePermissions returns the permissions of a built-in role
+func RolePermissions(role ProjectRole) []Permission {
+	if role == ProjectRoleOwner {
+		return append([]Permission(nil), AllPermissions...)
+	}
+	return append([]Permission(nil), builtinRolePermissions[role]...)
+}
+
+// ProjectCustomRole is a project-defined role mapping to a set of permissions
+// A member with a custom role gets exactly its permissions instead of those of the built-in role
+type ProjectCustomRole struct {
+	BaseModel
+	ProjectID   uuid.UUID      `gorm:"type:uuid;not null;index:idx_project_custom_roles_project_id;uniqueIndex:uq_project_custom_roles_project_name,priority:1" json:"project_id"`
+	Name        string         `gorm:"type:varchar(50);not null;uniqueIndex:uq_project_custom_roles_project_name,priority:2" json:"name"`
+	Description string         `gorm:"type:varchar(255)" json:"description"`
+	Permissions datatypes.JSON `gorm:"type:jsonb" json:"permissions"` // []Permission
+	CreatedBy   uuid.UUID      `gorm:"type:uuid;not null" json:"created_by"`
+	Project     Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for ProjectCustomRole
+func (ProjectCustomRole) TableName() string {
+	return "project_custom_roles"
+}
+
+// PermissionList decodes the stored permissions, dropping unknown entries
+func (r *ProjectCustomRole) PermissionList() []Permission {
+	var raw []Permission
+	if len(r.Permissions) > 0 {
+		_ = json.Unmarshal(r.Permissions, &raw)
+	}
+	permissions := make([]Permission, 0, len(raw))
+	for _, p := range raw {
+		if p.IsGrantable() {
+			permissions = append(permissions, p)
+		}
+	}
+	return permissions
+}
+
+// Permissions returns the effective permissions of a member
+// The owner always holds every permission; otherwise a custom role replaces the built-in role
+func (m *ProjectMember) Permissions() []Permission {
+	if m.RoleName == ProjectRoleOwner {
+		return RolePermissions(ProjectRoleOwner)
+	}
+	if m.CustomRole != nil {
+		return m.CustomRole.PermissionList()
+	}
+	return RolePermissions(m.RoleName)
+}
+
+// Can reports whether the member holds the permission
+func (m *ProjectMember) Can(permission Permission) bool {
+	for _, p := range m.Permissions() {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBuiltinAdmin reports whether the member is the owner or an admin whose role is not replaced by a custom role
+func (m *ProjectMember) IsBuiltinAdmin() bool {
+	if m.RoleName == ProjectRoleOwner {
+		return true
+	}
+	return m.RoleName == ProjectRoleAdmin && m.CustomRoleID == nil && m.CustomRole == nil
+}
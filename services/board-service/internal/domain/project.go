@@ -9,21 +9,30 @@ import (
 // Project represents a project entity within a workspace
 type Project struct {
 	BaseModel
-	WorkspaceID  uuid.UUID            `gorm:"type:uuid;not null;index:idx_projects_workspace_id" json:"workspace_id"`
-	OwnerID      uuid.UUID            `gorm:"type:uuid;not null;index:idx_projects_owner_id" json:"owner_id"`
-	Name         string               `gorm:"type:varchar(255);not null" json:"name"`
-	Description  string               `gorm:"type:text" json:"description"`
-	StartDate    *time.Time           `gorm:"type:timestamp" json:"start_date,omitempty"`
-	DueDate      *time.Time           `gorm:"type:timestamp" json:"due_date,omitempty"`
-	IsDefault    bool                 `gorm:"default:false;index:idx_projects_is_default" json:"is_default"`
-	IsPublic     bool                 `gorm:"default:false" json:"is_public"`
-	Boards       []Board              `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"boards,omitempty"`
-	Members      []ProjectMember      `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"members,omitempty"`
-	JoinRequests []ProjectJoinRequest `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"join_requests,omitempty"`
+	WorkspaceID   uuid.UUID            `gorm:"type:uuid;not null;index:idx_projects_workspace_id;uniqueIndex:uq_projects_workspace_key_prefix,priority:1" json:"workspace_id"`
+	OwnerID       uuid.UUID            `gorm:"type:uuid;not null;index:idx_projects_owner_id" json:"owner_id"`
+	Name          string               `gorm:"type:varchar(255);not null" json:"name"`
+	Description   string               `gorm:"type:text" json:"description"`
+	StartDate     *time.Time           `gorm:"type:timestamp" json:"start_date,omitempty"`
+	DueDate       *time.Time           `gorm:"type:timestamp" json:"due_date,omitempty"`
+	IsDefault     bool                 `gorm:"default:false;index:idx_projects_is_default" json:"is_default"`
+	IsPublic      bool                 `gorm:"default:false" json:"is_public"`
+	KeyPrefix     string               `gorm:"type:varchar(10);not null;default:'';uniqueIndex:uq_projects_workspace_key_prefix,priority:2,where:key_prefix <> ''" json:"key_prefix"` // board key prefix (WEB in WEB-42), unique per workspace
+	BoardSequence int64                `gorm:"not null;default:0" json:"board_sequence"`                                                                                              // last issued board number
+	ArchivedAt    *time.Time           `gorm:"type:timestamp;index:idx_projects_archived_at" json:"archived_at,omitempty"`                                                            // set while the project is archived (read-only)
+	ArchivedBy    *uuid.UUID           `gorm:"type:uuid" json:"archived_by,omitempty"`
+	Boards        []Board              `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"boards,omitempty"`
+	Members       []ProjectMember      `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"members,omitempty"`
+	JoinRequests  []ProjectJoinRequest `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"join_requests,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
 }
 
+// IsArchived reports whether the project is archived and therefore read-only
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
+
 // ProjectRole represents the role of a project member
 type ProjectRole string
 
@@ -41,6 +50,9 @@ type ProjectMember struct {
 	RoleName  ProjectRole `gorm:"type:varchar(50);not null;index:idx_project_members_role" json:"role_name"`
 	JoinedAt  time.Time   `gorm:"type:timestamp;not null;default:now()" json:"joined_at"`
 	Project   Project     `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	// CustomRoleID optionally replaces the permissions of RoleName (see Permissions)
+	CustomRoleID *uuid.UUID         `gorm:"type:uuid;index:idx_project_members_custom_role_id" json:"custom_role_id,omitempty"`
+	CustomRole   *ProjectCustomRole `gorm:"foreignKey:CustomRoleID;constraint:OnDelete:SET NULL" json:"custom_role,omitempty"`
 }
 
 // ProjectJoinRequestStatus represents the status of a join request
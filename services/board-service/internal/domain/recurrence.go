@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardRecurrence is a series of boards generated from a template board by a recurrence rule (RFC 5545 RRULE subset)
+// The template board stands for the first occurrence at DTStart; every occurrence links back through Board.RecurrenceID
+type BoardRecurrence struct {
+	BaseModel
+	ProjectID       uuid.UUID  `gorm:"type:uuid;not null;index:idx_board_recurrences_project_id" json:"project_id"`
+	TemplateBoardID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:uq_board_recurrences_template_board_id" json:"template_board_id"`
+	RRule           string     `gorm:"column:rrule;type:varchar(255);not null" json:"rrule"`
+	DTStart         time.Time  `gorm:"column:dtstart;type:timestamp;not null" json:"dtstart"`
+	LatestBoardID   uuid.UUID  `gorm:"type:uuid;not null" json:"latest_board_id"`
+	LatestAt        time.Time  `gorm:"type:timestamp;not null" json:"latest_at"`                                    // occurrence date of the latest board
+	OccurrenceCount int        `gorm:"not null;default:1" json:"occurrence_count"`                                  // index of the latest occurrence, the template included
+	NextAt          *time.Time `gorm:"type:timestamp;index:idx_board_recurrences_next_at" json:"next_at,omitempty"` // nil once the rule has ended
+	CreatedBy       uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+	Project         Project    `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for BoardRecurrence
+func (BoardRecurrence) TableName() string {
+	return "board_recurrences"
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReminderStatus is the delivery state of a board reminder
+type ReminderStatus string
+
+const (
+	// ReminderStatusPending waits for its time to come
+	ReminderStatusPending ReminderStatus = "PENDING"
+	// ReminderStatusSent has been handed to noti-service
+	ReminderStatusSent ReminderStatus = "SENT"
+	// ReminderStatusCancelled will not be delivered (see CancelReason)
+	ReminderStatusCancelled ReminderStatus = "CANCELLED"
+)
+
+// ReminderCancelReason records why a reminder was cancelled automatically
+type ReminderCancelReason string
+
+const (
+	// ReminderCancelBoardCompleted is set when the board reached a closed stage (approved or deleted)
+	ReminderCancelBoardCompleted ReminderCancelReason = "BOARD_COMPLETED"
+	// ReminderCancelBoardDeleted is set when the board no longer exists
+	ReminderCancelBoardDeleted ReminderCancelReason = "BOARD_DELETED"
+)
+
+// BoardReminder is a personal reminder of one user about one board
+// It fires at RemindAt, or OffsetMinutes after the board's due date (negative: before) when an offset is set;
+// relative reminders follow due date changes and wait while the board has no due date
+type BoardReminder struct {
+	BaseModel
+	BoardID       uuid.UUID            `gorm:"type:uuid;not null;index:idx_board_reminders_board_user,priority:1" json:"board_id"`
+	UserID        uuid.UUID            `gorm:"type:uuid;not null;index:idx_board_reminders_board_user,priority:2;index:idx_board_reminders_user_id" json:"user_id"`
+	RemindAt      *time.Time           `gorm:"type:timestamp;index:idx_board_reminders_status_remind_at,priority:2" json:"remind_at,omitempty"` // nil for relative reminders
+	OffsetMinutes *int                 `json:"offset_minutes,omitempty"`
+	Note          string               `gorm:"type:varchar(255)" json:"note"`
+	Status        ReminderStatus       `gorm:"type:varchar(20);not null;default:'PENDING';index:idx_board_reminders_status_remind_at,priority:1" json:"status"`
+	SentAt        *time.Time           `gorm:"type:timestamp" json:"sent_at,omitempty"`
+	CancelledAt   *time.Time           `gorm:"type:timestamp" json:"cancelled_at,omitempty"`
+	CancelReason  ReminderCancelReason `gorm:"type:varchar(30)" json:"cancel_reason,omitempty"`
+	Board         Board                `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+}
+
+// TableName specifies the table name for BoardReminder
+func (BoardReminder) TableName() string {
+	return "board_reminders"
+}
+
+// FireAt returns when the reminder is due, resolving an offset against the board's due date
+// It returns nil for a relative reminder whose board has no due date
+func (r *BoardReminder) FireAt(dueDate *time.Time) *time.Time {
+	if r.OffsetMinutes == nil {
+		return r.RemindAt
+	}
+	if dueDate == nil {
+		return nil
+	}
+	at := dueDate.Add(time.Duration(*r.OffsetMinutes) * time.Minute)
+	return &at
+}
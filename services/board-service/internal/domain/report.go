@@ -0,0 +1,36 @@
+package domain
+
+import "github.com/google/uuid"
+
+// ReportFormat is an output format of project reports
+type ReportFormat string
+
+const (
+	ReportFormatJSON     ReportFormat = "json"
+	ReportFormatMarkdown ReportFormat = "markdown"
+	ReportFormatCSV      ReportFormat = "csv"
+)
+
+// IsValid reports whether the format is a supported report format
+func (f ReportFormat) IsValid() bool {
+	return f == ReportFormatJSON || f.IsTemplated()
+}
+
+// IsTemplated reports whether reports of the format are rendered from a template
+func (f ReportFormat) IsTemplated() bool {
+	return f == ReportFormatMarkdown || f == ReportFormatCSV
+}
+
+// ReportTemplate replaces the built-in report template of a format for one workspace
+type ReportTemplate struct {
+	BaseModel
+	WorkspaceID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:uq_report_templates_workspace_format,priority:1" json:"workspace_id"`
+	Format      ReportFormat `gorm:"type:varchar(20);not null;uniqueIndex:uq_report_templates_workspace_format,priority:2" json:"format"`
+	Body        string       `gorm:"type:text;not null" json:"body"`
+	UpdatedBy   uuid.UUID    `gorm:"type:uuid;not null" json:"updated_by"`
+}
+
+// TableName specifies the table name for ReportTemplate
+func (ReportTemplate) TableName() string {
+	return "report_templates"
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SprintState is the lifecycle state of a sprint
+type SprintState string
+
+const (
+	// SprintStatePlanned is a sprint that has not started yet
+	SprintStatePlanned SprintState = "planned"
+	// SprintStateActive is the running sprint; a project has at most one
+	SprintStateActive SprintState = "active"
+	// SprintStateClosed is a finished sprint; it no longer changes
+	SprintStateClosed SprintState = "closed"
+)
+
+// SprintScopeChangeType tells whether a board entered or left a sprint
+type SprintScopeChangeType string
+
+const (
+	// SprintScopeAdded is a board assigned to the sprint while it was active
+	SprintScopeAdded SprintScopeChangeType = "added"
+	// SprintScopeRemoved is a board taken out of the sprint while it was active
+	SprintScopeRemoved SprintScopeChangeType = "removed"
+	// SprintScopeRolledOver is an unfinished board moved on when the sprint was closed
+	SprintScopeRolledOver SprintScopeChangeType = "rolled_over"
+)
+
+// Sprint is a time-boxed iteration (or milestone) of a project
+// Boards join a sprint through Board.SprintID
+type Sprint struct {
+	BaseModel
+	ProjectID uuid.UUID   `gorm:"type:uuid;not null;index:idx_sprints_project_id;uniqueIndex:uq_sprints_project_active,where:state = 'active'" json:"project_id"`
+	Name      string      `gorm:"type:varchar(100);not null" json:"name"`
+	Goal      string      `gorm:"type:varchar(500)" json:"goal"`
+	State     SprintState `gorm:"type:varchar(20);not null;default:'planned'" json:"state"`
+	StartDate time.Time   `gorm:"type:timestamp;not null" json:"start_date"`
+	EndDate   time.Time   `gorm:"type:timestamp;not null" json:"end_date"`
+	StartedAt *time.Time  `gorm:"type:timestamp" json:"started_at,omitempty"`
+	ClosedAt  *time.Time  `gorm:"type:timestamp" json:"closed_at,omitempty"`
+	CreatedBy uuid.UUID   `gorm:"type:uuid;not null" json:"created_by"`
+	Project   Project     `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for Sprint
+func (Sprint) TableName() string {
+	return "sprints"
+}
+
+// SprintScopeChange records a board added to or removed from an active sprint, or rolled over at its close
+// Changes made before the sprint starts are its initial scope and are not recorded
+type SprintScopeChange struct {
+	BaseModel
+	SprintID   uuid.UUID             `gorm:"type:uuid;not null;index:idx_sprint_scope_changes_sprint_id" json:"sprint_id"`
+	BoardID    uuid.UUID             `gorm:"type:uuid;not null" json:"board_id"`
+	ChangeType SprintScopeChangeType `gorm:"type:varchar(20);not null" json:"change_type"`
+	ChangedBy  uuid.UUID             `gorm:"type:uuid;not null" json:"changed_by"`
+	Sprint     Sprint                `gorm:"foreignKey:SprintID;constraint:OnDelete:CASCADE" json:"sprint,omitempty"`
+}
+
+// TableName specifies the table name for SprintScopeChange
+func (SprintScopeChange) TableName() string {
+	return "sprint_scope_changes"
+}
+
+// SprintBoardCount is the number of boards assigned to a sprint
+type SprintBoardCount struct {
+	SprintID   uuid.UUID
+	BoardCount int64
+}
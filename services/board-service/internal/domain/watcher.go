@@ -0,0 +1,54 @@
+package domain
+
+import "github.com/google/uuid"
+
+// WatchTargetType identifies what a watcher subscribes to
+type WatchTargetType string
+
+const (
+	// WatchTargetBoard subscribes to the activity of a single board
+	WatchTargetBoard WatchTargetType = "BOARD"
+	// WatchTargetProject subscribes to the activity of every board in a project
+	WatchTargetProject WatchTargetType = "PROJECT"
+)
+
+// WatchReason records why a user started watching
+type WatchReason string
+
+const (
+	// WatchReasonManual is an explicit watch by the user
+	WatchReasonManual WatchReason = "MANUAL"
+	// WatchReasonCommented is an automatic watch after commenting on a board
+	WatchReasonCommented WatchReason = "COMMENTED"
+	// WatchReasonAssigned is an automatic watch after being assigned to a board
+	WatchReasonAssigned WatchReason = "ASSIGNED"
+)
+
+// Watcher subscribes a user to board notifications for a board or a whole project
+// The assignee and participants of a board receive its notifications without a watcher row
+type Watcher struct {
+	BaseModel
+	TargetType WatchTargetType `gorm:"type:varchar(20);not null;uniqueIndex:uq_watchers_target_user,priority:1;index:idx_watchers_target,priority:1" json:"target_type"`
+	TargetID   uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uq_watchers_target_user,priority:2;index:idx_watchers_target,priority:2" json:"target_id"` // board or project ID
+	UserID     uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uq_watchers_target_user,priority:3;index:idx_watchers_user_id" json:"user_id"`
+	Reason     WatchReason     `gorm:"type:varchar(20);not null;default:'MANUAL'" json:"reason"`
+}
+
+// TableName specifies the table name for Watcher
+func (Watcher) TableName() string {
+	return "watchers"
+}
+
+// BoardMute silences every notification of a board for one user,
+// including the ones they would receive as assignee, participant or project watcher
+type BoardMute struct {
+	BaseModel
+	BoardID uuid.UUID `gorm:"type:uuid;not null;index:idx_board_mutes_board_id;uniqueIndex:uq_board_mutes_board_user" json:"board_id"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uq_board_mutes_board_user" json:"user_id"`
+	Board   Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+}
+
+// TableName specifies the table name for BoardMute
+func (BoardMute) TableName() string {
+	return "board_mutes"
+}
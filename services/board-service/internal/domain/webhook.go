@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// Webhook event types (same as the WebSocket event types broadcast to browsers)
+const (
+	WebhookEventBoardCreated = "BOARD_CREATED"
+	WebhookEventBoardUpdated = "BOARD_UPDATED"
+	WebhookEventBoardMoved   = "BOARD_MOVED"
+	WebhookEventBoardDeleted = "BOARD_DELETED"
+
+	WebhookEventBoardsRescheduled = "BOARDS_RESCHEDULED" // one event for a bulk date shift (payload lists the boards)
+	WebhookEventSprintUpdated     = "SPRINT_UPDATED"     // sprint started, closed or its boards changed (payload is the sprint)
+)
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"   // waiting for the first attempt
+	WebhookDeliveryRetrying  WebhookDeliveryStatus = "RETRYING"  // last attempt failed, retry scheduled
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED" // receiver answered 2xx
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"      // retries exhausted (dead letter)
+)
+
+// WebhookSubscription represents an outgoing webhook of a project
+// Payloads are signed with HMAC-SHA256 using Secret
+type WebhookSubscription struct {
+	BaseModel
+	ProjectID  uuid.UUID      `gorm:"type:uuid;not null;index:idx_webhook_subscriptions_project_id" json:"project_id"`
+	Name       string         `gorm:"type:varchar(255);not null" json:"name"`
+	URL        string         `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret     string         `gorm:"type:varchar(255);not null" json:"-"`
+	EventTypes datatypes.JSON `gorm:"type:jsonb" json:"event_types"` // empty means all events
+	Enabled    bool           `gorm:"not null;default:true" json:"enabled"`
+	CreatedBy  uuid.UUID      `gorm:"type:uuid;not null" json:"created_by"`
+	Project    Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for WebhookSubscription
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery represents one event sent to one subscription, including its retry state
+type WebhookDelivery struct {
+	BaseModel
+	SubscriptionID uuid.UUID             `gorm:"type:uuid;not null;index:idx_webhook_deliveries_subscription_id" json:"subscription_id"`
+	ProjectID      uuid.UUID             `gorm:"type:uuid;not null;index:idx_webhook_deliveries_project_id" json:"project_id"`
+	EventID        uuid.UUID             `gorm:"type:uuid;not null" json:"event_id"` // shared by all deliveries of the same event
+	EventType      string                `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload        datatypes.JSON        `gorm:"type:jsonb;not null" json:"payload"` // exact body that is signed and sent
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;index:idx_webhook_deliveries_status_next,priority:1" json:"status"`
+	Attempts       int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time            `gorm:"index:idx_webhook_deliveries_status_next,priority:2" json:"next_attempt_at"`
+	LastStatusCode int                   `gorm:"not null;default:0" json:"last_status_code"`
+	LastError      string                `gorm:"type:text" json:"last_error"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	Subscription   WebhookSubscription   `gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE" json:"subscription,omitempty"`
+}
+
+// TableName specifies the table name for WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
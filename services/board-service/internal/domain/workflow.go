@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WorkflowAnyStage is a wildcard stage value matching any source stage (including no stage)
+const WorkflowAnyStage = "*"
+
+// WorkflowTransition represents an allowed stage-to-stage move within a project
+// Stages are stored as field option values (e.g. "in_progress"), not option IDs
+// When a project has no transitions defined, boards may move freely between stages
+type WorkflowTransition struct {
+	BaseModel
+	ProjectID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_workflow_transitions_project_id;uniqueIndex:uq_workflow_transitions_project_from_to,priority:1" json:"project_id"`
+	FromStage      string         `gorm:"type:varchar(100);not null;uniqueIndex:uq_workflow_transitions_project_from_to,priority:2" json:"from_stage"` // "*" matches any stage
+	ToStage        string         `gorm:"type:varchar(100);not null;uniqueIndex:uq_workflow_transitions_project_from_to,priority:3" json:"to_stage"`
+	RequiredFields datatypes.JSON `gorm:"type:jsonb" json:"required_fields"` // e.g. ["assignee", "dueDate"]
+	AllowedRoles   datatypes.JSON `gorm:"type:jsonb" json:"allowed_roles"`   // empty means any project member
+	Project        Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+}
+
+// TableName specifies the table name for WorkflowTransition
+func (WorkflowTransition) TableName() string {
+	return "workflow_transitions"
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AutomationConditionRequest represents a rule condition
+// @Description field is "assignee" or a custom field key; operator is equals, not_equals, is_empty or is_not_empty
+type AutomationConditionRequest struct {
+	Field    string `json:"field" binding:"required,max=100" example:"stage"`
+	Operator string `json:"operator" binding:"required" example:"equals"`
+	Value    string `json:"value,omitempty" example:"review"`
+}
+
+// AutomationActionRequest represents a rule action
+// @Description type is set_field, assign, add_participants, post_comment or notify
+// @Description set_field uses field/value, assign uses userId (omit to clear), add_participants uses userIds,
+// @Description post_comment uses message, notify uses message with target (assignee, participants, author) or userIds
+type AutomationActionRequest struct {
+	Type    string      `json:"type" binding:"required" example:"assign"`
+	Field   string      `json:"field,omitempty" example:"stage"`
+	Value   string      `json:"value,omitempty" example:"done"`
+	UserID  *uuid.UUID  `json:"userId,omitempty"`
+	UserIDs []uuid.UUID `json:"userIds,omitempty"`
+	Target  string      `json:"target,omitempty" example:"assignee"`
+	Message string      `json:"message,omitempty" example:"리뷰를 요청합니다"`
+}
+
+// CreateAutomationRuleRequest represents the request to create an automation rule
+// @Description trigger is BOARD_CREATED, FIELD_CHANGED, DUE_DATE_REACHED or COMMENT_ADDED
+// @Description triggerField/triggerValue narrow FIELD_CHANGED to a field (assignee, startDate, dueDate, title, content or a custom field key) and its new value
+type CreateAutomationRuleRequest struct {
+	Name         string                       `json:"name" binding:"required,max=255" example:"리뷰 단계 담당자 지정"`
+	Trigger      string                       `json:"trigger" binding:"required" example:"FIELD_CHANGED"`
+	TriggerField string                       `json:"triggerField,omitempty" binding:"max=100" example:"stage"`
+	TriggerValue string                       `json:"triggerValue,omitempty" binding:"max=255" example:"review"`
+	Conditions   []AutomationConditionRequest `json:"conditions,omitempty" binding:"omitempty,dive"`
+	Actions      []AutomationActionRequest    `json:"actions" binding:"required,min=1,dive"`
+	Enabled      *bool                        `json:"enabled,omitempty" example:"true"`
+}
+
+// UpdateAutomationRuleRequest represents the request to update an automation rule
+// The trigger itself cannot be changed; create a new rule instead
+type UpdateAutomationRuleRequest struct {
+	Name         *string                       `json:"name,omitempty" binding:"omitempty,max=255"`
+	Enabled      *bool                         `json:"enabled,omitempty"`
+	TriggerField *string                       `json:"triggerField,omitempty" binding:"omitempty,max=100"`
+	TriggerValue *string                       `json:"triggerValue,omitempty" binding:"omitempty,max=255"`
+	Conditions   *[]AutomationConditionRequest `json:"conditions,omitempty" binding:"omitempty,dive"`
+	Actions      *[]AutomationActionRequest    `json:"actions,omitempty" binding:"omitempty,min=1,dive"`
+}
+
+// AutomationRuleResponse represents an automation rule
+type AutomationRuleResponse struct {
+	RuleID       uuid.UUID                    `json:"ruleId"`
+	ProjectID    uuid.UUID                    `json:"projectId"`
+	Name         string                       `json:"name"`
+	Enabled      bool                         `json:"enabled"`
+	Trigger      string                       `json:"trigger"`
+	TriggerField string                       `json:"triggerField,omitempty"`
+	TriggerValue string                       `json:"triggerValue,omitempty"`
+	Conditions   []AutomationConditionRequest `json:"conditions"`
+	Actions      []AutomationActionRequest    `json:"actions"`
+	CreatedBy    uuid.UUID                    `json:"createdBy"`
+	CreatedAt    time.Time                    `json:"createdAt"`
+	UpdatedAt    time.Time                    `json:"updatedAt"`
+}
+
+// AutomationExecutionResponse represents an automation execution log entry
+type AutomationExecutionResponse struct {
+	ExecutionID uuid.UUID `json:"executionId"`
+	RuleID      uuid.UUID `json:"ruleId"`
+	BoardID     uuid.UUID `json:"boardId"`
+	Trigger     string    `json:"trigger"`
+	Status      string    `json:"status"`
+	Depth       int       `json:"depth"`
+	Message     string    `json:"message,omitempty"`
+	ExecutedAt  time.Time `json:"executedAt"`
+}
@@ -24,6 +24,7 @@ type CreateBoardRequest struct {
 	DueDate       *time.Time             `json:"dueDate" example:"2024-12-31T23:59:59Z"`
 	Participants  []uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
 	AttachmentIDs []uuid.UUID            `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	LabelIDs      []uuid.UUID            `json:"labelIds,omitempty" binding:"omitempty,max=20" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
 }
 
 // UpdateBoardRequest represents the request to update a board
@@ -41,6 +42,7 @@ type UpdateBoardRequest struct {
 	DueDate       *time.Time              `json:"dueDate" example:"2024-12-31T23:59:59Z"`
 	Participants  []uuid.UUID             `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
 	AttachmentIDs []uuid.UUID             `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	LabelIDs      *[]uuid.UUID            `json:"labelIds,omitempty" binding:"omitempty,max=20" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"` // replaces all labels; [] clears them
 }
 
 // UpdateBoardFieldRequest represents the request to update a single board field
@@ -60,6 +62,10 @@ type AttachmentResponse struct {
 	ContentType string    `json:"contentType" example:"application/pdf"`
 	UploadedBy  uuid.UUID `json:"uploadedBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	UploadedAt  time.Time `json:"uploadedAt" example:"2024-01-15T10:30:00Z"`
+
+	// Generated images of image attachments (omitted until they are ready)
+	ThumbnailURL string `json:"thumbnailUrl,omitempty" example:"https://s3.amazonaws.com/bucket/photo_thumb.jpg"`
+	PreviewURL   string `json:"previewUrl,omitempty" example:"https://s3.amazonaws.com/bucket/photo_preview.jpg"`
 }
 
 // BoardResponse represents the board response
@@ -67,18 +73,26 @@ type AttachmentResponse struct {
 // @Description customFields contains field type as key and value string as value (not UUIDs)
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description participantIds contains an array of user IDs who are participants of the board
+// @Description contentHtml is the sanitized HTML rendering of the Markdown content (only with contentFormat=html)
 type BoardResponse struct {
 	ID             uuid.UUID              `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
 	ProjectID      uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
 	WorkspaceID    uuid.UUID              `json:"workspaceId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Key            string                 `json:"key,omitempty" example:"WEB-42"`
+	Number         int64                  `json:"number" example:"42"`
 	AuthorID       uuid.UUID              `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
 	AssigneeID     *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	SprintID       *uuid.UUID             `json:"sprintId,omitempty" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	RecurrenceID   *uuid.UUID             `json:"recurrenceId,omitempty" example:"8d1c2b3a-4e5f-4a6b-9c7d-0e1f2a3b4c5d"`
 	Title          string                 `json:"title" example:"Implement user authentication"`
 	Content        string                 `json:"content" example:"Add JWT-based authentication to the API"`
+	ContentHTML    string                 `json:"contentHtml,omitempty" example:"<p>Add JWT-based authentication to the API</p>"`
 	CustomFields   map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
 	StartDate      *time.Time             `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
 	DueDate        *time.Time             `json:"dueDate,omitempty" example:"2024-12-31T23:59:59Z"`
+	CompletedAt    *time.Time             `json:"completedAt,omitempty" example:"2024-12-20T15:00:00Z"`
 	ParticipantIDs []uuid.UUID            `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	Labels         []BoardLabelResponse   `json:"labels"`
 	Attachments    []AttachmentResponse   `json:"attachments"`
 	CreatedAt      time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt      time.Time              `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
@@ -96,15 +110,34 @@ type PaginatedBoardsResponse struct {
 // @Description Detailed board response with value-based customFields, participants, and comments
 // @Description customFields contains field type as key and value string as value (not UUIDs)
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
+// @Description mentionedIn lists the boards whose content or comments link to this board, limited to public projects and projects the viewer is a member of
 type BoardDetailResponse struct {
 	BoardResponse
-	Participants []ParticipantResponse `json:"participants"`
-	Comments     []CommentResponse     `json:"comments"`
+	Participants []ParticipantResponse   `json:"participants"`
+	Comments     []CommentResponse       `json:"comments"`
+	MentionedIn  []BoardBacklinkResponse `json:"mentionedIn"`
+}
+
+// BoardBacklinkResponse represents a board that links to the current board
+type BoardBacklinkResponse struct {
+	BoardID    uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	BoardKey   string     `json:"boardKey,omitempty" example:"WEB-7"`
+	Title      string     `json:"title" example:"Login page redesign"`
+	ProjectID  uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	SourceType string     `json:"sourceType" example:"COMMENT" enums:"BOARD,COMMENT"`
+	CommentID  *uuid.UUID `json:"commentId,omitempty" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	CreatedAt  time.Time  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 }
 
 // BoardFilters represents the filter parameters for board queries
+// LabelIDs with LabelMatch "any" (default) keeps boards carrying at least one of the labels,
+// "all" keeps boards carrying every one of them.
+// Query matches the title or the plain text of the content (case-insensitive)
 type BoardFilters struct {
 	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	LabelIDs     []uuid.UUID            `json:"labelIds,omitempty"`
+	LabelMatch   string                 `json:"labelMatch,omitempty"`
+	Query        string                 `json:"q,omitempty"`
 }
 
 // MoveBoardRequest represents the request to move a board
@@ -120,3 +153,21 @@ type MoveBoardResponse struct {
 	NewFieldValue string `json:"newFieldValue"`
 	Message       string `json:"message"`
 }
+
+// BoardMovedPayload is the payload of the BOARD_MOVED event
+type BoardMovedPayload struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	BoardKey string `json:"boardKey"`
+}
+
+// BoardEvent is the real-time message sent to WebSocket clients of a project (types: domain.WebhookEvent*)
+// EventID is unique per event so that clients can drop a message delivered twice
+type BoardEvent struct {
+	Type    string      `json:"type"`
+	Payload interface{} `json:"payload"`
+	BoardID string      `json:"boardId,omitempty"`
+	From    string      `json:"from,omitempty"`
+	To      string      `json:"to,omitempty"`
+	EventID string      `json:"eventId,omitempty"`
+}
@@ -11,7 +11,7 @@ import (
 // @Description attachmentIds is an optional array of attachment IDs to link to the comment
 type CreateCommentRequest struct {
 	BoardID       uuid.UUID   `json:"boardId" binding:"required"`
-	Content       string      `json:"content" binding:"required,min=1"`
+	Content       string      `json:"content" binding:"required,min=1,max=5000"`
 	AttachmentIDs []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
 }
 
@@ -19,16 +19,18 @@ type CreateCommentRequest struct {
 // @Description Request body for updating a comment with optional attachments
 // @Description attachmentIds is an optional array of attachment IDs to add to the comment
 type UpdateCommentRequest struct {
-	Content       string      `json:"content" binding:"required,min=1"`
+	Content       string      `json:"content" binding:"required,min=1,max=5000"`
 	AttachmentIDs []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
 }
 
 // CommentResponse represents the comment response
+// ContentHTML is the sanitized HTML rendering of the Markdown content (only with contentFormat=html)
 type CommentResponse struct {
 	CommentID   uuid.UUID            `json:"commentId"`
 	BoardID     uuid.UUID            `json:"boardId"`
 	UserID      uuid.UUID            `json:"userId"`
 	Content     string               `json:"content"`
+	ContentHTML string               `json:"contentHtml,omitempty"`
 	Attachments []AttachmentResponse `json:"attachments"`
 	CreatedAt   time.Time            `json:"createdAt"`
 	UpdatedAt   time.Time            `json:"updatedAt"`
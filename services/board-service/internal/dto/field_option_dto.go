@@ -15,6 +15,7 @@ type FieldOptionResponse struct {
 	Color           string    `json:"color"`
 	DisplayOrder    int       `json:"displayOrder"`
 	IsSystemDefault bool      `json:"isSystemDefault"`
+	Category        string    `json:"category,omitempty"` // stage options: open, done or cancelled
 	CreatedAt       time.Time `json:"createdAt"`
 	UpdatedAt       time.Time `json:"updatedAt"`
 }
@@ -26,6 +27,7 @@ type CreateFieldOptionRequest struct {
 	Label        string `json:"label" binding:"required,max=200"`
 	Color        string `json:"color" binding:"required,hexcolor"`
 	DisplayOrder int    `json:"displayOrder"`
+	Category     string `json:"category" binding:"omitempty,oneof=open done cancelled"` // stage only, defaults to open
 }
 
 // UpdateFieldOptionRequest represents the request to update a field option
@@ -33,4 +35,5 @@ type UpdateFieldOptionRequest struct {
 	Label        *string `json:"label" binding:"omitempty,max=200"`
 	Color        *string `json:"color" binding:"omitempty,hexcolor"`
 	DisplayOrder *int    `json:"displayOrder"`
+	Category     *string `json:"category" binding:"omitempty,oneof=open done cancelled"` // stage only
 }
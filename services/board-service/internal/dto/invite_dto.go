@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invite link expiry limits
+const (
+	DefaultInviteLinkExpiryHours = 7 * 24
+	MaxInviteLinkExpiryHours     = 30 * 24
+)
+
+// Invite link states reported in InviteLinkResponse
+const (
+	InviteLinkStatusActive    = "ACTIVE"
+	InviteLinkStatusExpired   = "EXPIRED"
+	InviteLinkStatusRevoked   = "REVOKED"
+	InviteLinkStatusExhausted = "EXHAUSTED"
+)
+
+// Reasons a user is skipped by InviteMembers
+const (
+	InviteSkipAlreadyMember      = "ALREADY_MEMBER"
+	InviteSkipNotWorkspaceMember = "NOT_WORKSPACE_MEMBER"
+)
+
+// CreateInviteLinkRequest represents the request to create a project invite link
+// @Description role is granted to everyone who joins through the link (ADMIN requires role.manage).
+// @Description expiresInHours defaults to 7 days (max 30 days); maxUses is unlimited when omitted
+type CreateInviteLinkRequest struct {
+	Role           string `json:"role" binding:"required,oneof=ADMIN MEMBER" example:"MEMBER"`
+	ExpiresInHours *int   `json:"expiresInHours" binding:"omitempty,min=1,max=720" example:"72"`
+	MaxUses        *int   `json:"maxUses" binding:"omitempty,min=1,max=1000" example:"10"`
+}
+
+// InviteLinkResponse represents a project invite link
+type InviteLinkResponse struct {
+	LinkID    uuid.UUID  `json:"linkId" example:"4b5c6d7e-8f9a-4b0c-8d1e-2f3a4b5c6d7e"`
+	ProjectID uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Token     string     `json:"token" example:"b3JhbmdlLWNsb3VkLWludml0ZS1saW5r"`
+	Role      string     `json:"role" example:"MEMBER"`
+	MaxUses   *int       `json:"maxUses,omitempty" example:"10"`
+	UseCount  int        `json:"useCount" example:"3"`
+	Status    string     `json:"status" example:"ACTIVE"`
+	ExpiresAt time.Time  `json:"expiresAt" example:"2024-01-22T10:30:00Z"`
+	CreatedBy uuid.UUID  `json:"createdBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedAt time.Time  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty" example:"2024-01-16T09:00:00Z"`
+}
+
+// InviteMembersRequest represents the request to add workspace members to a project directly
+type InviteMembersRequest struct {
+	UserIDs []uuid.UUID `json:"userIds" binding:"required,min=1,max=50,dive,required"`
+	Role    string      `json:"role" binding:"required,oneof=ADMIN MEMBER" example:"MEMBER"`
+}
+
+// InviteSkippedUser represents a user who was not added by a direct invite
+// @Description reason is ALREADY_MEMBER or NOT_WORKSPACE_MEMBER
+type InviteSkippedUser struct {
+	UserID uuid.UUID `json:"userId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Reason string    `json:"reason" example:"ALREADY_MEMBER"`
+}
+
+// InviteMembersResponse represents the result of a direct invite
+type InviteMembersResponse struct {
+	Added   []*ProjectMemberResponse `json:"added"`
+	Skipped []InviteSkippedUser      `json:"skipped"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Label filter match modes for board listing
+const (
+	LabelMatchAny = "any"
+	LabelMatchAll = "all"
+)
+
+// CreateLabelRequest represents the request to create a label
+// @Description color is a hex color (#RRGGBB); a neutral gray is used when omitted
+type CreateLabelRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=50" example:"frontend"`
+	Color       string `json:"color" binding:"omitempty,hexcolor" example:"#3B82F6"`
+	Description string `json:"description" binding:"max=255" example:"UI work"`
+}
+
+// UpdateLabelRequest represents the request to rename or recolor a label
+// @Description All fields are optional. Renaming to the name of another label fails; merge the labels instead
+type UpdateLabelRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=50" example:"web"`
+	Color       *string `json:"color" binding:"omitempty,hexcolor" example:"#10B981"`
+	Description *string `json:"description" binding:"omitempty,max=255" example:"Web client work"`
+}
+
+// MergeLabelRequest represents the request to merge a label into another
+type MergeLabelRequest struct {
+	TargetLabelID uuid.UUID `json:"targetLabelId" binding:"required" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+}
+
+// LabelResponse represents a project label with its usage count
+type LabelResponse struct {
+	LabelID     uuid.UUID `json:"labelId" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	ProjectID   uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name        string    `json:"name" example:"frontend"`
+	Color       string    `json:"color" example:"#3B82F6"`
+	Description string    `json:"description" example:"UI work"`
+	UsageCount  int64     `json:"usageCount" example:"12"`
+	CreatedBy   uuid.UUID `json:"createdBy" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	CreatedAt   time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+}
+
+// BoardLabelResponse represents a label attached to a board
+type BoardLabelResponse struct {
+	LabelID uuid.UUID `json:"labelId" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	Name    string    `json:"name" example:"frontend"`
+	Color   string    `json:"color" example:"#3B82F6"`
+}
@@ -10,10 +10,12 @@ import (
 // @Description Request body for creating a new project with optional start and due dates
 // @Description startDate and dueDate are optional, but startDate must be before or equal to dueDate if both are provided
 // @Description attachmentIds is an optional array of attachment IDs to link to the project
+// @Description keyPrefix is optional; a prefix is derived from the name when omitted and cannot be changed later
 type CreateProjectRequest struct {
 	WorkspaceID   uuid.UUID   `json:"workspaceId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
 	Name          string      `json:"name" binding:"required,min=2,max=100" example:"Q1 2024 Product Launch"`
 	Description   string      `json:"description" binding:"max=500" example:"Project for launching new product features in Q1 2024"`
+	KeyPrefix     string      `json:"keyPrefix,omitempty" binding:"omitempty,min=2,max=10" example:"WEB"`
 	StartDate     *time.Time  `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
 	DueDate       *time.Time  `json:"dueDate,omitempty" example:"2024-03-31T23:59:59Z"`
 	AttachmentIDs []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
@@ -43,7 +45,10 @@ type ProjectResponse struct {
 	OwnerName   string               `json:"ownerName,omitempty" example:"John Doe"`
 	Name        string               `json:"name" example:"Q1 2024 Product Launch"`
 	Description string               `json:"description" example:"Project for launching new product features in Q1 2024"`
+	KeyPrefix   string               `json:"keyPrefix" example:"WEB"`
 	IsPublic    bool                 `json:"isPublic" example:"true"`
+	IsArchived  bool                 `json:"isArchived" example:"false"`
+	ArchivedAt  *time.Time           `json:"archivedAt,omitempty" example:"2024-04-01T09:00:00Z"`
 	StartDate   *time.Time           `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
 	DueDate     *time.Time           `json:"dueDate,omitempty" example:"2024-03-31T23:59:59Z"`
 	Attachments []AttachmentResponse `json:"attachments"`
@@ -60,6 +65,9 @@ type ProjectMemberResponse struct {
 	UserName  string    `json:"userName,omitempty"`
 	RoleName  string    `json:"roleName"`
 	JoinedAt  time.Time `json:"joinedAt"`
+	// Custom role replacing the permissions of RoleName, if assigned
+	CustomRoleID   *uuid.UUID `json:"customRoleId,omitempty"`
+	CustomRoleName string     `json:"customRoleName,omitempty"`
 }
 
 // UpdateProjectMemberRoleRequest represents the request to update member role
@@ -113,6 +121,7 @@ type ProjectBasicInfo struct {
 	WorkspaceEmail string     `json:"workspaceEmail,omitempty"`
 	Name           string     `json:"name"`
 	Description    string     `json:"description"`
+	KeyPrefix      string     `json:"keyPrefix"`
 	OwnerID        uuid.UUID  `json:"ownerId"`
 	OwnerEmail     string     `json:"ownerEmail,omitempty"`
 	OwnerName      string     `json:"ownerName,omitempty"`
@@ -140,6 +149,7 @@ type FieldOption struct {
 	OptionValue  string `json:"optionValue"`
 	Color        string `json:"color,omitempty"`
 	DisplayOrder int    `json:"displayOrder"`
+	Category     string `json:"category,omitempty"` // stage options: open, done or cancelled
 	FieldID      string `json:"fieldId,omitempty"`
 	Description  string `json:"description,omitempty"`
 }
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateProjectRoleRequest represents the request to define a custom project role
+// @Description permissions must be taken from the permission list (project.delete and role.manage cannot be granted, and only permissions the requester holds may be granted)
+type CreateProjectRoleRequest struct {
+	Name        string   `json:"name" binding:"required,min=1,max=50" example:"Reviewer"`
+	Description string   `json:"description" binding:"max=255" example:"Can comment and clean up comments"`
+	Permissions []string `json:"permissions" example:"comment.create,comment.delete.any"`
+}
+
+// UpdateProjectRoleRequest represents the request to update a custom project role
+// @Description All fields are optional; permissions replaces the whole permission set
+type UpdateProjectRoleRequest struct {
+	Name        *string   `json:"name" binding:"omitempty,min=1,max=50" example:"Reviewer"`
+	Description *string   `json:"description" binding:"omitempty,max=255" example:"Can comment and clean up comments"`
+	Permissions *[]string `json:"permissions" example:"comment.create,comment.delete.any"`
+}
+
+// AssignProjectRoleRequest represents the request to assign a custom role to a member
+// @Description A null customRoleId removes the custom role so the built-in role applies again
+type AssignProjectRoleRequest struct {
+	CustomRoleID *uuid.UUID `json:"customRoleId" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+}
+
+// ProjectRoleResponse represents a built-in or custom project role with its permissions
+type ProjectRoleResponse struct {
+	RoleID      *uuid.UUID `json:"roleId,omitempty" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"` // empty for built-in roles
+	Name        string     `json:"name" example:"Reviewer"`
+	Description string     `json:"description" example:"Can comment and clean up comments"`
+	IsBuiltin   bool       `json:"isBuiltin" example:"false"`
+	Permissions []string   `json:"permissions" example:"comment.create,comment.delete.any"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty" example:"2024-01-15T14:20:00Z"`
+}
+
+// ProjectPermissionsResponse represents the effective permissions of the requester in a project
+type ProjectPermissionsResponse struct {
+	ProjectID      uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	RoleName       string     `json:"roleName" example:"MEMBER"`
+	CustomRoleID   *uuid.UUID `json:"customRoleId,omitempty" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	CustomRoleName string     `json:"customRoleName,omitempty" example:"Reviewer"`
+	Permissions    []string   `json:"permissions" example:"board.create,comment.create"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecurrencePreviewSize is the number of upcoming occurrences listed with a series
+const RecurrencePreviewSize = 5
+
+// SetRecurrenceRequest represents the request to make a board recur
+// @Description rrule is a subset of RFC 5545 RRULE: FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL.
+// @Description dtstart is the first occurrence, represented by the template board itself; it defaults to the board's
+// @Description due date, or its start date. Rules are evaluated in UTC. Generated boards keep the template's start-to-due span
+type SetRecurrenceRequest struct {
+	RRule   string     `json:"rrule" binding:"required,max=255" example:"FREQ=WEEKLY;BYDAY=MO;COUNT=12"`
+	DTStart *time.Time `json:"dtstart" example:"2024-01-15T09:00:00Z"`
+}
+
+// RecurrenceResponse represents a recurring board series
+// @Description nextAt is empty once the rule has ended. upcoming previews the next occurrences
+type RecurrenceResponse struct {
+	RecurrenceID    uuid.UUID   `json:"recurrenceId" example:"8d1c2b3a-4e5f-4a6b-9c7d-0e1f2a3b4c5d"`
+	ProjectID       uuid.UUID   `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	TemplateBoardID uuid.UUID   `json:"templateBoardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	RRule           string      `json:"rrule" example:"FREQ=WEEKLY;BYDAY=MO;COUNT=12"`
+	DTStart         time.Time   `json:"dtstart" example:"2024-01-15T09:00:00Z"`
+	LatestBoardID   uuid.UUID   `json:"latestBoardId" example:"2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"`
+	LatestAt        time.Time   `json:"latestAt" example:"2024-01-22T09:00:00Z"`
+	OccurrenceCount int         `json:"occurrenceCount" example:"2"`
+	NextAt          *time.Time  `json:"nextAt,omitempty" example:"2024-01-29T09:00:00Z"`
+	Upcoming        []time.Time `json:"upcoming"`
+	CreatedBy       uuid.UUID   `json:"createdBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedAt       time.Time   `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxReminderOffsetMinutes bounds offsets relative to the due date (30 days either way)
+	MaxReminderOffsetMinutes = 30 * 24 * 60
+	// MaxPendingRemindersPerBoard limits the pending reminders one user keeps on one board
+	MaxPendingRemindersPerBoard = 10
+	// MaxReminderSnoozeMinutes bounds a snooze given in minutes (7 days)
+	MaxReminderSnoozeMinutes = 7 * 24 * 60
+)
+
+// CreateReminderRequest represents the request to create a personal reminder on a board
+// @Description Set exactly one of remindAt (absolute time) or offsetMinutes (relative to the board's due date, negative: before).
+// @Description Relative reminders follow due date changes
+type CreateReminderRequest struct {
+	RemindAt      *time.Time `json:"remindAt" example:"2024-01-16T09:00:00Z"`
+	OffsetMinutes *int       `json:"offsetMinutes" example:"-1440"`
+	Note          string     `json:"note" binding:"max=255" example:"Check the review comments"`
+}
+
+// SnoozeReminderRequest represents the request to postpone a reminder
+// @Description Set exactly one of minutes (from now) or until. A snoozed reminder fires at an absolute time,
+// @Description and a sent reminder can be snoozed to fire again
+type SnoozeReminderRequest struct {
+	Minutes *int       `json:"minutes" example:"60"`
+	Until   *time.Time `json:"until" example:"2024-01-17T09:00:00Z"`
+}
+
+// ReminderResponse represents a personal board reminder
+// @Description remindAt is the resolved time; it is empty for a relative reminder whose board has no due date
+type ReminderResponse struct {
+	ReminderID    uuid.UUID  `json:"reminderId" example:"6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d"`
+	BoardID       uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ProjectID     uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	BoardTitle    string     `json:"boardTitle" example:"Implement user authentication"`
+	RemindAt      *time.Time `json:"remindAt,omitempty" example:"2024-01-16T09:00:00Z"`
+	OffsetMinutes *int       `json:"offsetMinutes,omitempty" example:"-1440"`
+	Note          string     `json:"note" example:"Check the review comments"`
+	Status        string     `json:"status" example:"PENDING" enums:"PENDING,SENT,CANCELLED"`
+	SentAt        *time.Time `json:"sentAt,omitempty" example:"2024-01-16T09:00:05Z"`
+	CancelledAt   *time.Time `json:"cancelledAt,omitempty" example:"2024-01-15T17:20:00Z"`
+	CancelReason  string     `json:"cancelReason,omitempty" example:"BOARD_COMPLETED" enums:"BOARD_COMPLETED,BOARD_DELETED"`
+	CreatedAt     time.Time  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report range limits
+const (
+	DefaultReportRangeDays = 7
+	MaxReportRangeDays     = 366
+)
+
+// ProjectReportQuery represents the options of a project report request
+// From and To are days (UTC); both are inclusive. They default to the last 7 days including today
+type ProjectReportQuery struct {
+	Format string
+	From   *time.Time
+	To     *time.Time
+}
+
+// ProjectReportResponse represents a snapshot of a project for status reports
+// @Description stages lists every board by stage (field options in display order, then unknown values, then boards without a stage).
+// @Description overdue lists open boards whose due date has passed. completed lists boards that reached the completed stage
+// @Description on the days from ~ to (both inclusive). assignees counts open, overdue and completed boards per assignee.
+// @Description The same structure is the data of the Markdown and CSV templates (Go text/template, field names as in Go: .ProjectName, .Stages, ...)
+type ProjectReportResponse struct {
+	ProjectID   uuid.UUID                `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	WorkspaceID uuid.UUID                `json:"workspaceId" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	ProjectName string                   `json:"projectName" example:"Website Renewal"`
+	KeyPrefix   string                   `json:"keyPrefix" example:"WEB"`
+	GeneratedAt time.Time                `json:"generatedAt" example:"2024-01-19T09:00:00Z"`
+	From        time.Time                `json:"from" example:"2024-01-13T00:00:00Z"`
+	To          time.Time                `json:"to" example:"2024-01-19T00:00:00Z"`
+	TotalBoards int                      `json:"totalBoards" example:"42"`
+	OpenBoards  int                      `json:"openBoards" example:"17"`
+	Stages      []ReportStageResponse    `json:"stages"`
+	Overdue     []ReportBoardResponse    `json:"overdue"`
+	Completed   []ReportBoardResponse    `json:"completed"`
+	Assignees   []ReportAssigneeResponse `json:"assignees"`
+}
+
+// ReportStageResponse represents the boards of one stage
+type ReportStageResponse struct {
+	Value  string                `json:"value" example:"in_progress"`
+	Label  string                `json:"label" example:"진행중"`
+	Closed bool                  `json:"closed" example:"false"`
+	Count  int                   `json:"count" example:"5"`
+	Boards []ReportBoardResponse `json:"boards"`
+}
+
+// ReportBoardResponse represents a board in a report
+type ReportBoardResponse struct {
+	BoardID      uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Key          string     `json:"key" example:"WEB-42"`
+	Title        string     `json:"title" example:"Implement login"`
+	Stage        string     `json:"stage" example:"in_progress"`
+	StageLabel   string     `json:"stageLabel" example:"진행중"`
+	AssigneeID   *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	AssigneeName string     `json:"assigneeName,omitempty" example:"Alice"`
+	DueDate      *time.Time `json:"dueDate,omitempty" example:"2024-01-17T00:00:00Z"`
+	CompletedAt  *time.Time `json:"completedAt,omitempty" example:"2024-01-18T15:00:00Z"`
+	Overdue      bool       `json:"overdue" example:"true"`
+	OverdueDays  int        `json:"overdueDays,omitempty" example:"2"`
+}
+
+// ReportAssigneeResponse represents the board counts of one assignee (AssigneeID is empty for unassigned boards)
+type ReportAssigneeResponse struct {
+	AssigneeID *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Name       string     `json:"name,omitempty" example:"Alice"`
+	Open       int        `json:"open" example:"4"`
+	Overdue    int        `json:"overdue" example:"1"`
+	Completed  int        `json:"completed" example:"3"`
+}
+
+// SetReportTemplateRequest represents the request to replace a report template of a workspace
+// @Description body is a Go text/template executed against ProjectReportResponse; besides the builtins it may call
+// @Description date (YYYY-MM-DD), csv (CSV field) and cell (Markdown table cell)
+type SetReportTemplateRequest struct {
+	Body string `json:"body" binding:"required,max=65536" example:"# {{.ProjectName}}\n{{range .Overdue}}- {{.Key}} {{.Title}}\n{{end}}"`
+}
+
+// ReportTemplateResponse represents the template a workspace uses for a report format
+type ReportTemplateResponse struct {
+	Format    string     `json:"format" example:"markdown"`
+	Body      string     `json:"body"`
+	IsDefault bool       `json:"isDefault" example:"false"`
+	UpdatedBy *uuid.UUID `json:"updatedBy,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+}
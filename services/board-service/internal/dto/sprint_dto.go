@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSprintBoardChanges limits the number of boards added and removed by one request
+const MaxSprintBoardChanges = 200
+
+// CreateSprintRequest represents the request to create a sprint
+// @Description A new sprint is planned; endDate must not be before startDate
+type CreateSprintRequest struct {
+	Name      string    `json:"name" binding:"required,min=1,max=100" example:"Sprint 12"`
+	Goal      string    `json:"goal" binding:"max=500" example:"Ship the new checkout"`
+	StartDate time.Time `json:"startDate" binding:"required" example:"2024-01-08T00:00:00Z"`
+	EndDate   time.Time `json:"endDate" binding:"required" example:"2024-01-21T00:00:00Z"`
+}
+
+// UpdateSprintRequest represents the request to update a sprint
+// @Description All fields are optional. Closed sprints cannot be changed
+type UpdateSprintRequest struct {
+	Name      *string    `json:"name" binding:"omitempty,min=1,max=100" example:"Sprint 12"`
+	Goal      *string    `json:"goal" binding:"omitempty,max=500" example:"Ship the new checkout"`
+	StartDate *time.Time `json:"startDate" example:"2024-01-08T00:00:00Z"`
+	EndDate   *time.Time `json:"endDate" example:"2024-01-21T00:00:00Z"`
+}
+
+// CloseSprintRequest represents the request to close the active sprint
+// @Description Unfinished boards move to nextSprintId, or to the earliest planned sprint when omitted.
+// @Description With toBacklog (or when there is no planned sprint) they go back to the backlog
+type CloseSprintRequest struct {
+	NextSprintID *uuid.UUID `json:"nextSprintId" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	ToBacklog    bool       `json:"toBacklog" example:"false"`
+}
+
+// UpdateSprintBoardsRequest represents the request to add boards to or remove boards from a sprint
+// @Description Added boards leave their previous sprint; removed boards go back to the backlog.
+// @Description Changes made while the sprint is active are reported as scope added/removed
+type UpdateSprintBoardsRequest struct {
+	Add    []uuid.UUID `json:"add" binding:"omitempty,max=200,dive,required"`
+	Remove []uuid.UUID `json:"remove" binding:"omitempty,max=200,dive,required"`
+}
+
+// SprintResponse represents a sprint
+type SprintResponse struct {
+	SprintID   uuid.UUID  `json:"sprintId" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+	ProjectID  uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name       string     `json:"name" example:"Sprint 12"`
+	Goal       string     `json:"goal" example:"Ship the new checkout"`
+	State      string     `json:"state" example:"active" enums:"planned,active,closed"`
+	StartDate  time.Time  `json:"startDate" example:"2024-01-08T00:00:00Z"`
+	EndDate    time.Time  `json:"endDate" example:"2024-01-21T00:00:00Z"`
+	StartedAt  *time.Time `json:"startedAt,omitempty" example:"2024-01-08T09:00:00Z"`
+	ClosedAt   *time.Time `json:"closedAt,omitempty" example:"2024-01-21T18:00:00Z"`
+	BoardCount int64      `json:"boardCount" example:"14"`
+	CreatedBy  uuid.UUID  `json:"createdBy" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	CreatedAt  time.Time  `json:"createdAt" example:"2024-01-05T10:30:00Z"`
+	UpdatedAt  time.Time  `json:"updatedAt" example:"2024-01-08T09:00:00Z"`
+}
+
+// SprintDetailResponse represents a sprint with its boards and scope report
+type SprintDetailResponse struct {
+	SprintResponse
+	Boards []SprintBoardResponse `json:"boards"`
+	Scope  SprintScopeResponse   `json:"scope"`
+}
+
+// SprintBoardResponse represents a board of a sprint
+// @Description completed is true for boards in the approved or deleted stage
+type SprintBoardResponse struct {
+	BoardID    uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Key        string     `json:"key,omitempty" example:"WEB-42"`
+	Title      string     `json:"title" example:"Implement user authentication"`
+	AssigneeID *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Stage      string     `json:"stage,omitempty" example:"in_progress"`
+	Completed  bool       `json:"completed"`
+}
+
+// SprintScopeResponse reports the boards added and removed after the sprint started
+// @Description rolledOver lists the unfinished boards moved on when the sprint was closed.
+// @Description completedCount and remainingCount count the boards currently in the sprint
+type SprintScopeResponse struct {
+	AddedCount      int                         `json:"addedCount" example:"3"`
+	RemovedCount    int                         `json:"removedCount" example:"1"`
+	RolledOverCount int                         `json:"rolledOverCount" example:"0"`
+	CompletedCount  int                         `json:"completedCount" example:"9"`
+	RemainingCount  int                         `json:"remainingCount" example:"5"`
+	Added           []SprintScopeChangeResponse `json:"added"`
+	Removed         []SprintScopeChangeResponse `json:"removed"`
+	RolledOver      []SprintScopeChangeResponse `json:"rolledOver"`
+}
+
+// SprintScopeChangeResponse represents one board added to or removed from an active sprint
+type SprintScopeChangeResponse struct {
+	BoardID   uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ChangedBy uuid.UUID `json:"changedBy" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	ChangedAt time.Time `json:"changedAt" example:"2024-01-10T11:00:00Z"`
+}
+
+// CloseSprintResponse represents the result of closing a sprint
+// @Description nextSprintId is empty when unfinished boards went back to the backlog
+type CloseSprintResponse struct {
+	Sprint       SprintDetailResponse `json:"sprint"`
+	RolledOver   []uuid.UUID          `json:"rolledOver"`
+	NextSprintID *uuid.UUID           `json:"nextSprintId,omitempty" example:"3f2b8c1e-7a4d-4e9b-9c2a-1d5e6f7a8b9c"`
+}
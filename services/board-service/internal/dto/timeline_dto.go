@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Timeline grouping modes
+const (
+	TimelineGroupByAssignee = "assignee"
+	TimelineGroupByStage    = "stage"
+	TimelineGroupByRole     = "role"
+)
+
+// Bulk reschedule modes (which dates are shifted)
+const (
+	RescheduleShiftBoth  = "both"
+	RescheduleShiftStart = "start"
+	RescheduleShiftDue   = "due"
+)
+
+// MaxRescheduleBoards limits the number of boards shifted by one reschedule request
+const MaxRescheduleBoards = 200
+
+// TimelineResponse represents the Gantt view of a project
+// @Description Boards with a start or due date are drawn as bars (barStart ~ barEnd) inside their group.
+// @Description A board with only one date is a single-day bar; boards without dates are listed in unscheduled.
+// @Description overlaps lists pairs of bars of the same assignee that intersect,
+// @Description overruns lists bars ending after the project due date
+type TimelineResponse struct {
+	ProjectID        uuid.UUID                 `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	GroupBy          string                    `json:"groupBy" example:"assignee" enums:"assignee,stage,role"`
+	ProjectStartDate *time.Time                `json:"projectStartDate,omitempty" example:"2024-01-01T00:00:00Z"`
+	ProjectDueDate   *time.Time                `json:"projectDueDate,omitempty" example:"2024-06-30T00:00:00Z"`
+	RangeStart       *time.Time                `json:"rangeStart,omitempty" example:"2024-01-03T00:00:00Z"`
+	RangeEnd         *time.Time                `json:"rangeEnd,omitempty" example:"2024-07-12T00:00:00Z"`
+	Groups           []TimelineGroupResponse   `json:"groups"`
+	Unscheduled      []TimelineItemResponse    `json:"unscheduled"`
+	Overlaps         []TimelineOverlapResponse `json:"overlaps"`
+	Overruns         []TimelineOverrunResponse `json:"overruns"`
+}
+
+// TimelineGroupResponse represents one row group (swimlane) of the timeline
+// @Description key is the assignee ID or the field value; it is empty for boards without an assignee or value
+type TimelineGroupResponse struct {
+	Key   string                 `json:"key" example:"in_progress"`
+	Label string                 `json:"label,omitempty" example:"진행중"`
+	Color string                 `json:"color,omitempty" example:"#F59E0B"`
+	Items []TimelineItemResponse `json:"items"`
+}
+
+// TimelineItemResponse represents a board on the timeline
+type TimelineItemResponse struct {
+	BoardID    uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Key        string     `json:"key,omitempty" example:"WEB-42"`
+	Title      string     `json:"title" example:"Implement user authentication"`
+	AssigneeID *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Stage      string     `json:"stage,omitempty" example:"in_progress"`
+	StartDate  *time.Time `json:"startDate,omitempty" example:"2024-01-10T00:00:00Z"`
+	DueDate    *time.Time `json:"dueDate,omitempty" example:"2024-01-20T00:00:00Z"`
+	BarStart   *time.Time `json:"barStart,omitempty" example:"2024-01-10T00:00:00Z"`
+	BarEnd     *time.Time `json:"barEnd,omitempty" example:"2024-01-20T00:00:00Z"`
+	Overrun    bool       `json:"overrun"`
+}
+
+// TimelineOverlapResponse represents two bars of the same assignee that intersect
+type TimelineOverlapResponse struct {
+	AssigneeID uuid.UUID   `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	BoardIDs   []uuid.UUID `json:"boardIds"`
+	Start      time.Time   `json:"start" example:"2024-01-15T00:00:00Z"`
+	End        time.Time   `json:"end" example:"2024-01-20T00:00:00Z"`
+}
+
+// TimelineOverrunResponse represents a bar that ends after the project due date
+type TimelineOverrunResponse struct {
+	BoardID     uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	BarEnd      time.Time `json:"barEnd" example:"2024-07-12T00:00:00Z"`
+	OverrunDays int       `json:"overrunDays" example:"12"`
+}
+
+// RescheduleBoardsRequest represents the request to shift the dates of several boards
+// @Description offsetDays may be negative. shift selects the dates moved: both (default), start or due.
+// @Description Boards must belong to the project; shifting only one date must keep startDate before dueDate
+type RescheduleBoardsRequest struct {
+	BoardIDs   []uuid.UUID `json:"boardIds" binding:"required,min=1,max=200,dive,required"`
+	OffsetDays int         `json:"offsetDays" binding:"required,min=-3650,max=3650" example:"7"`
+	Shift      string      `json:"shift" binding:"omitempty,oneof=both start due" example:"both"`
+}
+
+// RescheduleBoardsResponse represents the result of a bulk reschedule
+// @Description skipped lists boards without any date (nothing to shift)
+type RescheduleBoardsResponse struct {
+	Boards  []TimelineItemResponse `json:"boards"`
+	Skipped []uuid.UUID            `json:"skipped"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardWatchStatusResponse represents how the requester is subscribed to a board
+// @Description notified is false when the board is muted or the requester has no reason to receive its notifications
+type BoardWatchStatusResponse struct {
+	BoardID         uuid.UUID `json:"boardId" example:"9c0d1e2f-3a4b-5c6d-7e8f-9a0b1c2d3e4f"`
+	Watching        bool      `json:"watching" example:"true"`              // explicit or automatic board watch
+	Reason          string    `json:"reason,omitempty" example:"COMMENTED"` // MANUAL, COMMENTED or ASSIGNED
+	WatchingProject bool      `json:"watchingProject" example:"false"`      // watching every board of the project
+	Involved        bool      `json:"involved" example:"false"`             // assignee or participant
+	Muted           bool      `json:"muted" example:"false"`
+	Notified        bool      `json:"notified" example:"true"`
+}
+
+// ProjectWatchStatusResponse represents whether the requester watches a project
+type ProjectWatchStatusResponse struct {
+	ProjectID uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Watching  bool      `json:"watching" example:"true"`
+}
+
+// WatcherResponse represents a user watching a board
+type WatcherResponse struct {
+	UserID    uuid.UUID `json:"userId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	Reason    string    `json:"reason" example:"MANUAL"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
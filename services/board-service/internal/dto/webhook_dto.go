@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookRequest represents the request to create an outgoing webhook
+// @Description eventTypes filters events (BOARD_CREATED, BOARD_UPDATED, BOARD_MOVED, BOARD_DELETED); empty means all events
+// @Description secret is generated when omitted and returned only once in the create response
+type CreateWebhookRequest struct {
+	Name       string   `json:"name" binding:"required,max=255" example:"CI 연동"`
+	URL        string   `json:"url" binding:"required,max=2048" example:"https://example.com/hooks/board"`
+	EventTypes []string `json:"eventTypes,omitempty" example:"BOARD_CREATED,BOARD_MOVED"`
+	Secret     string   `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	Enabled    *bool    `json:"enabled,omitempty" example:"true"`
+}
+
+// UpdateWebhookRequest represents the request to update an outgoing webhook
+// Setting secret rotates the signing secret
+type UpdateWebhookRequest struct {
+	Name       *string   `json:"name,omitempty" binding:"omitempty,max=255"`
+	URL        *string   `json:"url,omitempty" binding:"omitempty,max=2048"`
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+	Secret     *string   `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	Enabled    *bool     `json:"enabled,omitempty"`
+}
+
+// WebhookResponse represents an outgoing webhook
+// Secret is only populated in the create response
+type WebhookResponse struct {
+	WebhookID  uuid.UUID `json:"webhookId"`
+	ProjectID  uuid.UUID `json:"projectId"`
+	Name       string    `json:"name"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	Enabled    bool      `json:"enabled"`
+	Secret     string    `json:"secret,omitempty"`
+	CreatedBy  uuid.UUID `json:"createdBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// WebhookDeliveryResponse represents a webhook delivery log entry
+// @Description status is PENDING, RETRYING, SUCCEEDED or DEAD (retries exhausted)
+type WebhookDeliveryResponse struct {
+	DeliveryID     uuid.UUID       `json:"deliveryId"`
+	WebhookID      uuid.UUID       `json:"webhookId"`
+	EventID        uuid.UUID       `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastStatusCode int             `json:"lastStatusCode,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// WebhookEventPayload is the JSON body POSTed to webhook receivers
+// The body is signed with HMAC-SHA256 and sent in the X-Wealist-Signature header as "sha256=<hex>"
+type WebhookEventPayload struct {
+	ID         uuid.UUID       `json:"id"` // event ID, identical across subscriptions and redeliveries
+	Type       string          `json:"type"`
+	ProjectID  uuid.UUID       `json:"projectId"`
+	BoardID    string          `json:"boardId,omitempty"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data" swaggertype:"object"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateWorkflowTransitionRequest represents the request to create a workflow transition
+// @Description Request body for declaring an allowed stage-to-stage transition
+// @Description fromStage and toStage are stage values (e.g. "review"); fromStage "*" matches any stage
+// @Description requiredFields lists fields that must be set before the transition (assignee, startDate, dueDate, content, or a custom field key)
+// @Description allowedRoles restricts the transition to project roles (OWNER, ADMIN, MEMBER or a custom role name); empty means any member
+// @Description a member with a custom role matches either its built-in role or the custom role name
+type CreateWorkflowTransitionRequest struct {
+	FromStage      string   `json:"fromStage" binding:"required,max=100" example:"in_progress"`
+	ToStage        string   `json:"toStage" binding:"required,max=100" example:"review"`
+	RequiredFields []string `json:"requiredFields,omitempty" example:"assignee,dueDate"`
+	AllowedRoles   []string `json:"allowedRoles,omitempty" example:"OWNER,ADMIN"`
+}
+
+// UpdateWorkflowTransitionRequest represents the request to update a workflow transition
+type UpdateWorkflowTransitionRequest struct {
+	RequiredFields *[]string `json:"requiredFields,omitempty" example:"assignee"`
+	AllowedRoles   *[]string `json:"allowedRoles,omitempty" example:"ADMIN"`
+}
+
+// WorkflowTransitionResponse represents a workflow transition
+type WorkflowTransitionResponse struct {
+	TransitionID   uuid.UUID `json:"transitionId"`
+	ProjectID      uuid.UUID `json:"projectId"`
+	FromStage      string    `json:"fromStage"`
+	ToStage        string    `json:"toStage"`
+	RequiredFields []string  `json:"requiredFields"`
+	AllowedRoles   []string  `json:"allowedRoles"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Workload view defaults and limits
+const (
+	DefaultWorkloadWeeks       = 4
+	MaxWorkloadWeeks           = 26
+	DefaultWorkloadWeightField = "importance"
+)
+
+// WorkloadQuery represents the options of a workspace workload request
+// From is moved back to the Monday of its week; it defaults to the current week
+type WorkloadQuery struct {
+	From        *time.Time
+	Weeks       int
+	WeightField string
+	AssigneeIDs []uuid.UUID
+	ProjectIDs  []uuid.UUID
+}
+
+// WorkloadResponse represents the open boards of a workspace per assignee, bucketed by week
+// @Description Only boards with an assignee and a stage other than approved/deleted are counted.
+// @Description A board counts in every week its startDate ~ dueDate range touches (a single date is a single day).
+// @Description Each board is weighted by the option of weightField: the first option in display order
+// @Description weighs as many points as the field has options, the last one 1; boards without a value weigh 1
+type WorkloadResponse struct {
+	WorkspaceID uuid.UUID                  `json:"workspaceId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	WeightField string                     `json:"weightField" example:"importance"`
+	From        time.Time                  `json:"from" example:"2024-01-08T00:00:00Z"`
+	To          time.Time                  `json:"to" example:"2024-02-05T00:00:00Z"`
+	Weeks       []time.Time                `json:"weeks"`
+	Projects    []WorkloadProjectResponse  `json:"projects"`
+	Assignees   []WorkloadAssigneeResponse `json:"assignees"`
+}
+
+// WorkloadProjectResponse represents a project included in the workload
+type WorkloadProjectResponse struct {
+	ProjectID uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name      string    `json:"name" example:"Web Renewal"`
+	KeyPrefix string    `json:"keyPrefix,omitempty" example:"WEB"`
+}
+
+// WorkloadAssigneeResponse represents the workload of one assignee
+// @Description buckets has one entry per week of the range; overdue holds boards due before the range,
+// @Description unscheduled holds boards without dates. boards lists every counted board for drill-down
+type WorkloadAssigneeResponse struct {
+	AssigneeID  uuid.UUID                `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	BoardCount  int                      `json:"boardCount" example:"7"`
+	TotalWeight int                      `json:"totalWeight" example:"18"`
+	Buckets     []WorkloadBucketResponse `json:"buckets"`
+	Overdue     WorkloadSummaryResponse  `json:"overdue"`
+	Unscheduled WorkloadSummaryResponse  `json:"unscheduled"`
+	Boards      []WorkloadBoardResponse  `json:"boards"`
+}
+
+// WorkloadBucketResponse represents the boards of an assignee active in one week
+type WorkloadBucketResponse struct {
+	WeekStart  time.Time   `json:"weekStart" example:"2024-01-08T00:00:00Z"`
+	BoardCount int         `json:"boardCount" example:"3"`
+	Weight     int         `json:"weight" example:"8"`
+	BoardIDs   []uuid.UUID `json:"boardIds"`
+}
+
+// WorkloadSummaryResponse represents a set of boards outside of the weekly buckets
+type WorkloadSummaryResponse struct {
+	BoardCount int         `json:"boardCount" example:"1"`
+	Weight     int         `json:"weight" example:"4"`
+	BoardIDs   []uuid.UUID `json:"boardIds"`
+}
+
+// WorkloadBoardResponse represents a board counted in the workload
+type WorkloadBoardResponse struct {
+	BoardID     uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ProjectID   uuid.UUID  `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Key         string     `json:"key,omitempty" example:"WEB-42"`
+	Title       string     `json:"title" example:"Implement user authentication"`
+	Stage       string     `json:"stage,omitempty" example:"in_progress"`
+	WeightValue string     `json:"weightValue,omitempty" example:"urgent"`
+	Weight      int        `json:"weight" example:"4"`
+	StartDate   *time.Time `json:"startDate,omitempty" example:"2024-01-10T00:00:00Z"`
+	DueDate     *time.Time `json:"dueDate,omitempty" example:"2024-01-20T00:00:00Z"`
+}
@@ -6,12 +6,15 @@ import (
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/repository"
+	"project-board-api/internal/service"
 )
 
 // AttachmentHandler handles attachment-related requests
 type AttachmentHandler struct {
 	s3Client       client.S3ClientInterface
 	attachmentRepo repository.AttachmentRepository
+	guard          service.AttachmentGuard       // optional, nil falls back to an uploader-only check
+	thumbnailer    service.AttachmentThumbnailer // optional, nil disables thumbnail generation
 }
 
 // NewAttachmentHandler creates a new AttachmentHandler
@@ -22,6 +25,16 @@ func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repo
 	}
 }
 
+// SetAttachmentGuard registers the guard that applies project permissions and archiving to attachment changes
+func (h *AttachmentHandler) SetAttachmentGuard(guard service.AttachmentGuard) {
+	h.guard = guard
+}
+
+// SetThumbnailer registers the worker that generates thumbnails and previews of uploaded images
+func (h *AttachmentHandler) SetThumbnailer(thumbnailer service.AttachmentThumbnailer) {
+	h.thumbnailer = thumbnailer
+}
+
 // MaxFileSize defines the maximum allowed file size for uploads (50MB).
 const MaxFileSize = 50 * 1024 * 1024
 
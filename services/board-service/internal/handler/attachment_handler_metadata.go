@@ -11,6 +11,7 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/response"
+	"project-board-api/internal/service"
 )
 
 func validateEntityType(entityTypeStr string) (domain.EntityType, error) {
@@ -69,6 +70,23 @@ type AttachmentResponse struct {
 	UploadedBy  uuid.UUID  `json:"uploadedBy"`
 	UploadedAt  time.Time  `json:"uploadedAt"`
 	ExpiresAt   *time.Time `json:"expiresAt"`
+
+	// Generated images of image attachments (URLs are empty until thumbnailStatus is READY)
+	ThumbnailStatus string `json:"thumbnailStatus,omitempty"`
+	ThumbnailURL    string `json:"thumbnailUrl,omitempty"`
+	PreviewURL      string `json:"previewUrl,omitempty"`
+}
+
+// derivedImageURLs returns the download URLs of the thumbnail and preview of an attachment
+func (h *AttachmentHandler) derivedImageURLs(attachment *domain.Attachment) (string, string) {
+	var thumbnailURL, previewURL string
+	if attachment.ThumbnailKey != "" {
+		thumbnailURL = h.s3Client.GetFileURL(attachment.ThumbnailKey)
+	}
+	if attachment.PreviewKey != "" {
+		previewURL = h.s3Client.GetFileURL(attachment.PreviewKey)
+	}
+	return thumbnailURL, previewURL
 }
 
 // SaveAttachmentMetadata godoc
@@ -76,6 +94,7 @@ type AttachmentResponse struct {
 // @Description  Saves attachment metadata to the database after successful S3 upload
 // @Description  Creates a temporary attachment record with 1-hour expiration
 // @Description  The attachment will be linked to an entity (board/comment/project) when that entity is created
+// @Description  JPEG, PNG, GIF and WebP images get a thumbnail and a preview generated in the background (thumbnailStatus PENDING)
 // @Description  Supported entity types: BOARD, COMMENT, PROJECT
 // @Tags         attachments
 // @Accept       json
@@ -173,11 +192,19 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		ExpiresAt:   &expiresAt,
 	}
 
+	// Image thumbnails are generated in the background once the record exists
+	if h.thumbnailer != nil && service.SupportsThumbnail(attachment.ContentType) {
+		attachment.ThumbnailStatus = domain.ThumbnailStatusPending
+	}
+
 	// Save to database
 	if err := h.attachmentRepo.Create(c.Request.Context(), attachment); err != nil {
 		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to save attachment metadata")
 		return
 	}
+	if attachment.ThumbnailStatus == domain.ThumbnailStatusPending {
+		h.thumbnailer.Enqueue(attachment)
+	}
 
 	// Prepare response
 	resp := AttachmentResponse{
@@ -192,6 +219,8 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		UploadedBy:  attachment.UploadedBy,
 		UploadedAt:  attachment.CreatedAt,
 		ExpiresAt:   attachment.ExpiresAt,
+
+		ThumbnailStatus: string(attachment.ThumbnailStatus),
 	}
 
 	response.SendSuccess(c, http.StatusCreated, resp)
@@ -75,6 +75,22 @@ func (m *mockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return nil
 }
 
+func (m *mockAttachmentRepository) UpdateThumbnail(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error {
+	return nil
+}
+
+func (m *mockAttachmentRepository) FindPendingThumbnails(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+	return nil, nil
+}
+
+func (m *mockAttachmentRepository) FindReferencedFileKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	return map[string]bool{}, nil
+}
+
+func (m *mockAttachmentRepository) FindConfirmedAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error) {
+	return nil, nil
+}
+
 // setupAttachmentHandler creates a test handler with a mock S3 client
 func setupAttachmentHandler(t *testing.T) (*AttachmentHandler, *gin.Engine) {
 	gin.SetMode(gin.TestMode)
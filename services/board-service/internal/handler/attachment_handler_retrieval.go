@@ -29,6 +29,7 @@ func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 	for i, attachment := range attachments {
 		// Generate full URL from S3 key when retrieving
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
+		thumbnailURL, previewURL := h.derivedImageURLs(attachment)
 
 		resp[i] = AttachmentResponse{
 			ID:          attachment.ID,
@@ -42,6 +43,10 @@ func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 			UploadedBy:  attachment.UploadedBy,
 			UploadedAt:  attachment.CreatedAt,
 			ExpiresAt:   attachment.ExpiresAt,
+
+			ThumbnailStatus: string(attachment.ThumbnailStatus),
+			ThumbnailURL:    thumbnailURL,
+			PreviewURL:      previewURL,
 		}
 	}
 
@@ -79,6 +84,7 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 	for i, attachment := range attachments {
 		// Generate full URL from S3 key when retrieving
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
+		thumbnailURL, previewURL := h.derivedImageURLs(attachment)
 
 		resp[i] = AttachmentResponse{
 			ID:          attachment.ID,
@@ -92,6 +98,10 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 			UploadedBy:  attachment.UploadedBy,
 			UploadedAt:  attachment.CreatedAt,
 			ExpiresAt:   attachment.ExpiresAt,
+
+			ThumbnailStatus: string(attachment.ThumbnailStatus),
+			ThumbnailURL:    thumbnailURL,
+			PreviewURL:      previewURL,
 		}
 	}
 
@@ -129,6 +139,7 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 	for i, attachment := range attachments {
 		// Generate full URL from S3 key when retrieving
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
+		thumbnailURL, previewURL := h.derivedImageURLs(attachment)
 
 		resp[i] = AttachmentResponse{
 			ID:          attachment.ID,
@@ -142,6 +153,10 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 			UploadedBy:  attachment.UploadedBy,
 			UploadedAt:  attachment.CreatedAt,
 			ExpiresAt:   attachment.ExpiresAt,
+
+			ThumbnailStatus: string(attachment.ThumbnailStatus),
+			ThumbnailURL:    thumbnailURL,
+			PreviewURL:      previewURL,
 		}
 	}
 
@@ -162,6 +177,7 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 // @Failure      401 {object} response.ErrorResponse "Unauthorized - user not authenticated"
 // @Failure      403 {object} response.ErrorResponse "Forbidden - user does not have permission to delete this attachment"
 // @Failure      404 {object} response.ErrorResponse "Attachment not found"
+// @Failure      409 {object} response.ErrorResponse "Project is archived"
 // @Failure      500 {object} response.ErrorResponse "Failed to delete attachment"
 // @Router       /attachments/{attachmentId} [delete]
 func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
@@ -202,8 +218,14 @@ func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
 		return
 	}
 
-	// Verify user has permission to delete (must be the uploader)
-	if attachment.UploadedBy != userID {
+	// Verify user has permission to delete (the uploader, or attachment.delete in the project)
+	// Attachments of archived projects are read-only
+	if h.guard != nil {
+		if err := h.guard.EnsureAttachmentDeletable(c.Request.Context(), attachment, userID); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	} else if attachment.UploadedBy != userID {
 		response.SendError(c, http.StatusForbidden, response.ErrCodeForbidden, "You do not have permission to delete this attachment")
 		return
 	}
@@ -219,6 +241,11 @@ func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
 			c.Error(err)
 		}
 	}
+	for _, derivedKey := range attachment.DerivedFileKeys() {
+		if err := h.s3Client.DeleteFile(c.Request.Context(), derivedKey); err != nil {
+			c.Error(err)
+		}
+	}
 
 	// Delete attachment record from database (soft delete)
 	if err := h.attachmentRepo.Delete(c.Request.Context(), attachmentID); err != nil {
@@ -54,7 +54,10 @@ func setupAttachmentIntegrationTestDB(t *testing.T) *gorm.DB {
 			file_size INTEGER NOT NULL,
 			content_type TEXT NOT NULL,
 			uploaded_by TEXT NOT NULL,
-			expires_at DATETIME
+			expires_at DATETIME,
+			thumbnail_status TEXT,
+			thumbnail_key TEXT,
+			preview_key TEXT
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create attachments table")
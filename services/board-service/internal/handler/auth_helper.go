@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+)
+
+// getRequestUserID extracts the authenticated user ID from the Gin context
+// It writes a 401 response and returns false when the user ID is missing or malformed
+func getRequestUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return uuid.Nil, false
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return uuid.Nil, false
+	}
+	return userUUID, true
+}
+
+// getRequestToken extracts the caller's JWT from the Gin context for calls to other services
+// It writes a 401 response and returns false when the token is missing or malformed
+func getRequestToken(c *gin.Context) (string, bool) {
+	token, exists := c.Get("jwtToken")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "JWT token not found in context")
+		return "", false
+	}
+	tokenStr, ok := token.(string)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid token format")
+		return "", false
+	}
+	return tokenStr, true
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type AutomationHandler struct {
+	automationService service.AutomationService
+}
+
+func NewAutomationHandler(automationService service.AutomationService) *AutomationHandler {
+	return &AutomationHandler{
+		automationService: automationService,
+	}
+}
+
+// GetRules godoc
+// @Summary      자동화 규칙 목록 조회
+// @Description  프로젝트에 정의된 자동화 규칙을 조회합니다
+// @Tags         automation
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.AutomationRuleResponse} "자동화 규칙 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/automation/rules [get]
+func (h *AutomationHandler) GetRules(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	rules, err := h.automationService.GetRules(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, rules)
+}
+
+// CreateRule godoc
+// @Summary      자동화 규칙 생성
+// @Description  "트리거 → 조건 → 액션" 형태의 자동화 규칙을 추가합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  trigger: BOARD_CREATED, FIELD_CHANGED, DUE_DATE_REACHED, COMMENT_ADDED
+// @Description  actions: set_field, assign, add_participants, post_comment, notify (규칙 생성자 이름으로 실행)
+// @Description  규칙은 비동기로 실행되며 실행 결과는 실행 로그에서 확인할 수 있습니다
+// @Tags         automation
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateAutomationRuleRequest true "자동화 규칙 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.AutomationRuleResponse} "자동화 규칙 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/automation/rules [post]
+func (h *AutomationHandler) CreateRule(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateAutomationRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.automationService.CreateRule(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, rule)
+}
+
+// UpdateRule godoc
+// @Summary      자동화 규칙 수정
+// @Description  자동화 규칙의 이름, 활성화 여부, 조건, 액션을 수정합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         automation
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        ruleId path string true "Rule ID (UUID)"
+// @Param        request body dto.UpdateAutomationRuleRequest true "자동화 규칙 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.AutomationRuleResponse} "자동화 규칙 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "자동화 규칙을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/automation/rules/{ruleId} [put]
+func (h *AutomationHandler) UpdateRule(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	ruleID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid rule ID")
+		return
+	}
+
+	var req dto.UpdateAutomationRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.automationService.UpdateRule(c.Request.Context(), projectID, userID, ruleID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, rule)
+}
+
+// DeleteRule godoc
+// @Summary      자동화 규칙 삭제
+// @Description  자동화 규칙과 실행 로그를 삭제합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         automation
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        ruleId path string true "Rule ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "자동화 규칙 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "자동화 규칙을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/automation/rules/{ruleId} [delete]
+func (h *AutomationHandler) DeleteRule(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	ruleID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid rule ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.automationService.DeleteRule(c.Request.Context(), projectID, userID, ruleID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// GetExecutions godoc
+// @Summary      자동화 실행 로그 조회
+// @Description  프로젝트 자동화 규칙의 최근 실행 로그를 조회합니다 (최신순)
+// @Description  루프 방지로 건너뛴 실행은 SKIPPED 상태로 기록됩니다
+// @Tags         automation
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        ruleId query string false "Rule ID (UUID) 필터"
+// @Param        limit query int false "조회 개수 (기본 50, 최대 200)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.AutomationExecutionResponse} "실행 로그 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/automation/executions [get]
+func (h *AutomationHandler) GetExecutions(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var ruleID *uuid.UUID
+	if ruleIDStr := c.Query("ruleId"); ruleIDStr != "" {
+		parsed, err := uuid.Parse(ruleIDStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid rule ID")
+			return
+		}
+		ruleID = &parsed
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid limit")
+			return
+		}
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	executions, err := h.automationService.GetExecutions(c.Request.Context(), projectID, userID, ruleID, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, executions)
+}
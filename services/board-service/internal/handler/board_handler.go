@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -12,7 +13,6 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
-	"project-board-api/internal/client"
 	"project-board-api/internal/database"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -22,13 +22,11 @@ import (
 //
 type BoardHandler struct {
 	boardService service.BoardService
-	notiClient   client.NotiClient
 }
 
-func NewBoardHandler(boardService service.BoardService, notiClient client.NotiClient) *BoardHandler {
+func NewBoardHandler(boardService service.BoardService) *BoardHandler {
 	return &BoardHandler{
 		boardService: boardService,
-		notiClient:   notiClient,
 	}
 }
 
@@ -50,6 +48,7 @@ func NewBoardHandler(boardService service.BoardService, notiClient client.NotiCl
 // @Accept       json
 // @Produce      json
 // @Param        request body dto.CreateBoardRequest true "Board 생성 요청"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      201 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 생성 성공 (participantIds 포함)"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
@@ -86,19 +85,9 @@ func (h *BoardHandler) CreateBoard(c *gin.Context) {
 		zap.String("board.id", board.ID.String()),
 		zap.String("project.id", req.ProjectID.String()))
 
-	// 💡 [수정] 응답을 먼저 보낸 후 브로드캐스트
+	// BOARD_CREATED is broadcast by the service through the event outbox
+	renderBoardContent(c, board)
 	response.SendSuccess(c, http.StatusCreated, board)
-
-	// 💡 [추가] 브로드캐스트
-	event := WSEvent{
-		Type:    "BOARD_CREATED",
-		BoardID: board.ID.String(),
-		Payload: board,
-	}
-	BroadcastEvent(req.ProjectID.String(), event)
-
-	// 🔥 알림 전송: 보드 생성 시 담당자가 지정된 경우
-	go h.sendBoardNotifications(ctx, log, nil, board, nil, board.AssigneeID)
 }
 
 // GetBoard godoc
@@ -112,6 +101,7 @@ func (h *BoardHandler) CreateBoard(c *gin.Context) {
 // @Tags         boards
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=dto.BoardDetailResponse} "Board 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
@@ -128,9 +118,14 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 		return
 	}
 
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
 	log.Debug("GetBoard started", zap.String("board.id", boardID.String()))
 
-	board, err := h.boardService.GetBoard(c.Request.Context(), boardID)
+	board, err := h.boardService.GetBoard(c.Request.Context(), boardID, userID)
 	if err != nil {
 		log.Error("GetBoard service error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -138,6 +133,49 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 	}
 
 	log.Debug("GetBoard completed", zap.String("board.id", boardID.String()))
+	renderBoardDetailContent(c, board)
+	response.SendSuccess(c, http.StatusOK, board)
+}
+
+// GetBoardByKey godoc
+// @Summary      Board 키로 조회
+// @Description  워크스페이스 안에서 사람이 읽을 수 있는 Board 키(예: WEB-42)로 Board 상세 정보를 조회합니다
+// @Description  키의 접두사는 대소문자를 구분하지 않습니다
+// @Tags         boards
+// @Produce      json
+// @Param        key         path      string  true  "Board 키 (예: WEB-42)"
+// @Param        workspaceId query     string  true  "Workspace ID (UUID)"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardDetailResponse} "Board 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board 키 또는 Workspace ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/by-key/{key} [get]
+func (h *BoardHandler) GetBoardByKey(c *gin.Context) {
+	log := getLogger(c)
+
+	key := c.Param("key")
+	workspaceIDStr := c.Query("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		log.Warn("GetBoardByKey invalid workspace ID", zap.String("workspace.id", workspaceIDStr))
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	board, err := h.boardService.GetBoardByKey(c.Request.Context(), workspaceID, key, userID)
+	if err != nil {
+		log.Debug("GetBoardByKey service error", zap.String("board.key", key), zap.Error(err))
+		handleServiceError(c, err)
+		return
+	}
+
+	renderBoardDetailContent(c, board)
 	response.SendSuccess(c, http.StatusOK, board)
 }
 
@@ -152,6 +190,10 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 // @Produce      json
 // @Param        projectId    path      string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
+// @Param        labels       query     string  false  "라벨 ID 필터 (콤마로 구분된 UUID 목록)"
+// @Param        labelMatch   query     string  false  "라벨 필터 방식: any (하나라도 포함, 기본값) 또는 all (모두 포함)" Enums(any, all)
+// @Param        q            query     string  false  "제목 또는 내용(Markdown을 제거한 텍스트) 검색어"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
@@ -183,6 +225,18 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 		filters.CustomFields = customFields
 	}
 
+	if labelsStr := c.Query("labels"); labelsStr != "" {
+		labelIDs, err := parseUUIDList(labelsStr)
+		if err != nil {
+			log.Warn("GetBoardsByProject invalid labels", zap.String("labels", labelsStr))
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid labels format: must be comma-separated UUIDs")
+			return
+		}
+		filters.LabelIDs = labelIDs
+		filters.LabelMatch = c.Query("labelMatch")
+	}
+	filters.Query = strings.TrimSpace(c.Query("q"))
+
 	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
 	if err != nil {
 		log.Error("GetBoardsByProject service error", zap.String("project.id", projectID.String()), zap.Error(err))
@@ -193,9 +247,28 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 	log.Debug("GetBoardsByProject completed",
 		zap.String("project.id", projectID.String()),
 		zap.Int("board.count", len(boards)))
+	renderBoardContent(c, boards...)
 	response.SendSuccess(c, http.StatusOK, boards)
 }
 
+// parseUUIDList parses a comma-separated list of UUIDs
+func parseUUIDList(value string) ([]uuid.UUID, error) {
+	parts := strings.Split(value, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := uuid.Parse(part)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 // GetBoardsByProjectQuery godoc
 // @Summary      Project의 Board 목록 조회 (쿼리 파라미터 방식)
 // @Description  특정 Project에 속한 모든 Board를 조회합니다. 프론트엔드 호환용 엔드포인트
@@ -207,6 +280,10 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 // @Produce      json
 // @Param        projectId    query     string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
+// @Param        labels       query     string  false  "라벨 ID 필터 (콤마로 구분된 UUID 목록)"
+// @Param        labelMatch   query     string  false  "라벨 필터 방식: any (하나라도 포함, 기본값) 또는 all (모두 포함)" Enums(any, all)
+// @Param        q            query     string  false  "제목 또는 내용(Markdown을 제거한 텍스트) 검색어"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
@@ -244,6 +321,18 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 		filters.CustomFields = customFields
 	}
 
+	if labelsStr := c.Query("labels"); labelsStr != "" {
+		labelIDs, err := parseUUIDList(labelsStr)
+		if err != nil {
+			log.Warn("GetBoardsByProjectQuery invalid labels", zap.String("labels", labelsStr))
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid labels format: must be comma-separated UUIDs")
+			return
+		}
+		filters.LabelIDs = labelIDs
+		filters.LabelMatch = c.Query("labelMatch")
+	}
+	filters.Query = strings.TrimSpace(c.Query("q"))
+
 	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
 	if err != nil {
 		log.Error("GetBoardsByProjectQuery service error", zap.String("project.id", projectID.String()), zap.Error(err))
@@ -254,6 +343,7 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 	log.Debug("GetBoardsByProjectQuery completed",
 		zap.String("project.id", projectID.String()),
 		zap.Int("board.count", len(boards)))
+	renderBoardContent(c, boards...)
 	response.SendSuccess(c, http.StatusOK, boards)
 }
 
@@ -270,6 +360,7 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
 // @Param        request body dto.UpdateBoardRequest true "Board 수정 요청"
+// @Param        contentFormat query   string  false  "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 수정 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
@@ -293,16 +384,15 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 		return
 	}
 
-	// 🔥 알림 전송을 위해 기존 board 정보 가져오기
-	oldBoard, _ := h.boardService.GetBoard(c.Request.Context(), boardID)
-	var oldAssigneeID *uuid.UUID
-	if oldBoard != nil && oldBoard.AssigneeID != nil {
-		oldAssigneeID = oldBoard.AssigneeID
-	}
-
 	log.Debug("UpdateBoard started", zap.String("board.id", boardID.String()))
 
-	board, err := h.boardService.UpdateBoard(c.Request.Context(), boardID, &req)
+	// 🔔 Actor is required for workflow role checks and notifications
+	ctx := c.Request.Context()
+	if userID, exists := c.Get("user_id"); exists {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+
+	board, err := h.boardService.UpdateBoard(ctx, boardID, &req)
 	if err != nil {
 		log.Error("UpdateBoard service error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -311,102 +401,9 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 
 	log.Info("Board updated", zap.String("board.id", boardID.String()))
 
-	// 💡 [수정] 응답을 먼저 보낸 후 브로드캐스트
+	// BOARD_UPDATED is broadcast by the service through the event outbox
+	renderBoardContent(c, board)
 	response.SendSuccess(c, http.StatusOK, board)
-
-	// 💡 [추가] 브로드캐스트
-	event := WSEvent{
-		Type:    "BOARD_UPDATED",
-		BoardID: boardID.String(),
-		Payload: board,
-	}
-	BroadcastEvent(board.ProjectID.String(), event)
-
-	// 🔥 알림 전송 (비동기 - 실패해도 응답에 영향 없음)
-	var oldBoardResponse *dto.BoardResponse
-	if oldBoard != nil {
-		oldBoardResponse = &oldBoard.BoardResponse
-	}
-	go h.sendBoardNotifications(c.Request.Context(), log, oldBoardResponse, board, oldAssigneeID, req.AssigneeID)
-}
-
-// sendBoardNotifications sends notifications for board updates
-func (h *BoardHandler) sendBoardNotifications(ctx context.Context, log *zap.Logger, oldBoard, newBoard *dto.BoardResponse, oldAssigneeID, newAssigneeID *uuid.UUID) {
-	if h.notiClient == nil {
-		log.Debug("NotiClient is nil, skipping notification")
-		return
-	}
-
-	// 🔥 Create a new context for async notification (request context may be canceled after response)
-	// Copy user_id from original context
-	notifyCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Get actor ID from context (current user)
-	actorIDValue, exists := ctx.Value("user_id").(uuid.UUID)
-	if !exists {
-		// Try string format
-		if actorIDStr, ok := ctx.Value("user_id").(string); ok {
-			actorIDValue, _ = uuid.Parse(actorIDStr)
-		}
-	}
-
-	log.Debug("sendBoardNotifications called",
-		zap.String("actorId", actorIDValue.String()),
-		zap.Bool("hasNewAssignee", newAssigneeID != nil),
-		zap.Bool("hasOldAssignee", oldAssigneeID != nil))
-
-	// 1. 담당자가 새로 할당된 경우 (BOARD_ASSIGNED)
-	// 자기 자신에게 할당한 경우에도 알림 발송 (사용자 요청)
-	if newAssigneeID != nil && *newAssigneeID != uuid.Nil {
-		// 기존에 할당자가 없었거나, 다른 사람으로 변경된 경우
-		if oldAssigneeID == nil || *oldAssigneeID != *newAssigneeID {
-			notification := client.NewBoardAssignedNotification(
-				actorIDValue,
-				*newAssigneeID,
-				newBoard.WorkspaceID,
-				newBoard.ID,
-				newBoard.Title,
-			)
-			if err := h.notiClient.SendNotification(notifyCtx, notification); err != nil {
-				log.Warn("Failed to send board assignment notification",
-					zap.String("board.id", newBoard.ID.String()),
-					zap.String("targetUserId", newAssigneeID.String()),
-					zap.Error(err))
-			} else {
-				log.Info("Board assignment notification sent",
-					zap.String("board.id", newBoard.ID.String()),
-					zap.String("targetUserId", newAssigneeID.String()),
-					zap.Bool("selfAssignment", actorIDValue == *newAssigneeID))
-			}
-		}
-	}
-
-	// 2. 담당자가 있는 보드가 업데이트된 경우 (BOARD_UPDATED)
-	// 단, 담당자 변경이 아닌 다른 변경사항이 있을 때만
-	// 자기 자신의 보드인 경우에도 알림 발송 (사용자 요청)
-	if oldBoard != nil && oldBoard.AssigneeID != nil && *oldBoard.AssigneeID != uuid.Nil {
-		// 담당자 변경이 아닌 경우에만 알림
-		if newAssigneeID == nil || (oldAssigneeID != nil && *oldAssigneeID == *newAssigneeID) {
-			notification := client.NewBoardUpdatedNotification(
-				actorIDValue,
-				*oldBoard.AssigneeID,
-				newBoard.WorkspaceID,
-				newBoard.ID,
-				newBoard.Title,
-			)
-			if err := h.notiClient.SendNotification(notifyCtx, notification); err != nil {
-				log.Warn("Failed to send board update notification",
-					zap.String("board.id", newBoard.ID.String()),
-					zap.Error(err))
-			} else {
-				log.Info("Board update notification sent",
-					zap.String("board.id", newBoard.ID.String()),
-					zap.String("targetUserId", oldBoard.AssigneeID.String()),
-					zap.Bool("selfUpdate", actorIDValue == *oldBoard.AssigneeID))
-			}
-		}
-	}
 }
 
 // DeleteBoard godoc
@@ -417,6 +414,7 @@ func (h *BoardHandler) sendBoardNotifications(ctx context.Context, log *zap.Logg
 // @Param        boardId path string true "Board ID (UUID)"
 // @Success      200 {object} response.SuccessResponse "Board 삭제 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "다른 사용자의 Board 삭제 권한 없음 (board.delete.any)"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId} [delete]
@@ -433,15 +431,22 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 
 	log.Debug("DeleteBoard started", zap.String("board.id", boardID.String()))
 
+	// Actor is required to check board.delete.any for boards written by others
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+	ctx := context.WithValue(c.Request.Context(), "user_id", userID)
+
 	// 💡 [수정] 삭제 전에 보드 정보 가져오기 (projectId 필요)
-	board, err := h.boardService.GetBoard(c.Request.Context(), boardID)
+	board, err := h.boardService.GetBoard(ctx, boardID, userID)
 	if err != nil {
 		log.Error("DeleteBoard get board error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
 		return
 	}
 
-	err = h.boardService.DeleteBoard(c.Request.Context(), boardID)
+	err = h.boardService.DeleteBoard(ctx, boardID)
 	if err != nil {
 		log.Error("DeleteBoard service error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -452,18 +457,8 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 		zap.String("board.id", boardID.String()),
 		zap.String("project.id", board.ProjectID.String()))
 
-	// 💡 [수정] 응답을 먼저 보낸 후 브로드캐스트
+	// BOARD_DELETED is broadcast by the service through the event outbox
 	response.SendSuccess(c, http.StatusOK, nil)
-
-	// 💡 [추가] 브로드캐스트
-	event := WSEvent{
-		Type:    "BOARD_DELETED",
-		BoardID: boardID.String(),
-		Payload: map[string]string{
-			"boardId": boardID.String(),
-		},
-	}
-	BroadcastEvent(board.ProjectID.String(), event)
 }
 
 // MoveBoard godoc
@@ -514,7 +509,8 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 	newFieldValue := *req.NewFieldValue // 🔥 포인터 역참조
 
 	// 1. 기존 보드 가져오기
-	board, err := h.boardService.GetBoard(ctx, boardID)
+	viewerID, _ := userID.(uuid.UUID)
+	board, err := h.boardService.GetBoard(ctx, boardID, viewerID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -539,11 +535,16 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 	// 변경할 필드만 업데이트
 	existingCustomFields[req.GroupByFieldName] = newFieldValue
 
-	// 2. 필드 값 업데이트
+	// 2. 필드 값 업데이트 (BOARD_MOVED is broadcast by the service through the event outbox)
 	updateReq := &dto.UpdateBoardRequest{
 		CustomFields: &existingCustomFields,
 	}
-	_, err = h.boardService.UpdateBoard(ctx, boardID, updateReq)
+	moved := &dto.BoardMovedPayload{
+		From:     oldGroupValue,
+		To:       newFieldValue,
+		BoardKey: board.Key,
+	}
+	_, err = h.boardService.MoveBoard(ctx, boardID, updateReq, moved)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -562,25 +563,13 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 		})
 	}
 
-	// 4. 실시간 브로드캐스트
-	event := WSEvent{
-		Type:    "BOARD_MOVED",
-		BoardID: boardID.String(),
-		Payload: map[string]string{
-			"from": oldGroupValue,
-			"to":   newFieldValue,
-		},
-	}
-
 	log := getLogger(c)
-	log.Info("Broadcasting BOARD_MOVED event",
+	log.Info("Board moved",
 		zap.String("projectId", projectID.String()),
 		zap.String("boardId", boardID.String()),
 		zap.String("from", oldGroupValue),
 		zap.String("to", newFieldValue))
 
-	BroadcastEvent(projectID.String(), event)
-
 	// 응답
 	response.SendSuccess(c, http.StatusOK, dto.MoveBoardResponse{
 		BoardID:       boardID.String(),
@@ -28,6 +28,7 @@ func NewCommentHandler(commentService service.CommentService) *CommentHandler {
 // @Accept       json
 // @Produce      json
 // @Param        request body dto.CreateCommentRequest true "Comment 생성 요청"
+// @Param        contentFormat query string false "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      201 {object} response.SuccessResponse{data=dto.CommentResponse} "Comment 생성 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
@@ -58,6 +59,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 		return
 	}
 
+	renderCommentContent(c, comment)
 	response.SendSuccess(c, http.StatusCreated, comment)
 }
 
@@ -67,6 +69,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 // @Tags         comments
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
+// @Param        contentFormat query string false "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=[]dto.CommentResponse} "Comment 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
@@ -86,6 +89,7 @@ func (h *CommentHandler) GetComments(c *gin.Context) {
 		return
 	}
 
+	renderCommentContent(c, comments...)
 	response.SendSuccess(c, http.StatusOK, comments)
 }
 
@@ -95,6 +99,7 @@ func (h *CommentHandler) GetComments(c *gin.Context) {
 // @Tags         comments
 // @Produce      json
 // @Param        boardId query string true "Board ID (UUID)"
+// @Param        contentFormat query string false "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=[]dto.CommentResponse} "Comment 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
@@ -119,6 +124,7 @@ func (h *CommentHandler) GetCommentsByQuery(c *gin.Context) {
 		return
 	}
 
+	renderCommentContent(c, comments...)
 	response.SendSuccess(c, http.StatusOK, comments)
 }
 
@@ -130,6 +136,7 @@ func (h *CommentHandler) GetCommentsByQuery(c *gin.Context) {
 // @Produce      json
 // @Param        commentId path string true "Comment ID (UUID)"
 // @Param        request body dto.UpdateCommentRequest true "Comment 수정 요청"
+// @Param        contentFormat query string false "html이면 Markdown을 렌더링한 contentHtml을 함께 반환" Enums(html)
 // @Success      200 {object} response.SuccessResponse{data=dto.CommentResponse} "Comment 수정 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청"
 // @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
@@ -155,6 +162,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 		return
 	}
 
+	renderCommentContent(c, comment)
 	response.SendSuccess(c, http.StatusOK, comment)
 }
 
@@ -166,6 +174,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 // @Param        commentId path string true "Comment ID (UUID)"
 // @Success      200 {object} response.SuccessResponse "Comment 삭제 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID"
+// @Failure      403 {object} response.ErrorResponse "다른 사용자의 댓글 삭제 권한 없음 (comment.delete.any)"
 // @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /comments/{commentId} [delete]
@@ -177,7 +186,12 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 		return
 	}
 
-	err = h.commentService.DeleteComment(c.Request.Context(), commentID)
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	err = h.commentService.DeleteComment(c.Request.Context(), commentID, userID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -20,7 +20,7 @@ type MockCommentService struct {
 	CreateCommentFunc func(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error)
 	GetCommentsFunc   func(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
 	UpdateCommentFunc func(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
-	DeleteCommentFunc func(ctx context.Context, commentID uuid.UUID) error
+	DeleteCommentFunc func(ctx context.Context, commentID, userID uuid.UUID) error
 }
 
 func (m *MockCommentService) CreateComment(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
@@ -44,9 +44,9 @@ func (m *MockCommentService) UpdateComment(ctx context.Context, commentID uuid.U
 	return nil, nil
 }
 
-func (m *MockCommentService) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+func (m *MockCommentService) DeleteComment(ctx context.Context, commentID, userID uuid.UUID) error {
 	if m.DeleteCommentFunc != nil {
-		return m.DeleteCommentFunc(ctx, commentID)
+		return m.DeleteCommentFunc(ctx, commentID, userID)
 	}
 	return nil
 }
@@ -300,7 +300,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 			name:      "성공: 댓글 삭제",
 			commentID: commentID.String(),
 			mockService: func(m *MockCommentService) {
-				m.DeleteCommentFunc = func(ctx context.Context, id uuid.UUID) error {
+				m.DeleteCommentFunc = func(ctx context.Context, id, userID uuid.UUID) error {
 					return nil
 				}
 			},
@@ -316,7 +316,7 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 			name:      "실패: 댓글이 존재하지 않음",
 			commentID: commentID.String(),
 			mockService: func(m *MockCommentService) {
-				m.DeleteCommentFunc = func(ctx context.Context, id uuid.UUID) error {
+				m.DeleteCommentFunc = func(ctx context.Context, id, userID uuid.UUID) error {
 					return response.NewAppError(response.ErrCodeNotFound, "Comment not found", "")
 				}
 			},
@@ -332,6 +332,10 @@ func TestCommentHandler_DeleteComment(t *testing.T) {
 			handler := NewCommentHandler(mockService)
 
 			router := setupTestRouter()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_id", uuid.New())
+				c.Next()
+			})
 			router.DELETE("/api/comments/:commentId", handler.DeleteComment)
 
 			req := httptest.NewRequest(http.MethodDelete, "/api/comments/"+tt.commentID, nil)
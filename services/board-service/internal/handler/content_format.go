@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
+)
+
+// contentFormatHTML is the contentFormat query value that adds rendered Markdown (contentHtml) to responses
+const contentFormatHTML = "html"
+
+// wantsContentHTML reports whether the client asked for rendered content
+func wantsContentHTML(c *gin.Context) bool {
+	return strings.EqualFold(c.Query("contentFormat"), contentFormatHTML)
+}
+
+// renderBoardContent fills ContentHTML of the boards when requested
+func renderBoardContent(c *gin.Context, boards ...*dto.BoardResponse) {
+	if !wantsContentHTML(c) {
+		return
+	}
+	for _, board := range boards {
+		if board != nil {
+			board.ContentHTML = markdown.Render(board.Content)
+		}
+	}
+}
+
+// renderBoardDetailContent fills ContentHTML of the board and its comments when requested
+func renderBoardDetailContent(c *gin.Context, board *dto.BoardDetailResponse) {
+	if board == nil || !wantsContentHTML(c) {
+		return
+	}
+	renderBoardContent(c, &board.BoardResponse)
+	for i := range board.Comments {
+		board.Comments[i].ContentHTML = markdown.Render(board.Comments[i].Content)
+	}
+}
+
+// renderCommentContent fills ContentHTML of the comments when requested
+func renderCommentContent(c *gin.Context, comments ...*dto.CommentResponse) {
+	if !wantsContentHTML(c) {
+		return
+	}
+	for _, comment := range comments {
+		if comment != nil {
+			comment.ContentHTML = markdown.Render(comment.Content)
+		}
+	}
+}
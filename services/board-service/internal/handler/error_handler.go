@@ -78,8 +78,10 @@ func mapErrorCodeToHTTPStatus(code string) int {
 		return http.StatusUnauthorized
 	case response.ErrCodeForbidden:
 		return http.StatusForbidden
-	case "ALREADY_MEMBER", "PENDING_REQUEST_EXISTS":
+	case "ALREADY_MEMBER", "PENDING_REQUEST_EXISTS", response.ErrCodeProjectArchived, response.ErrCodeSprintState:
 		return http.StatusConflict
+	case response.ErrCodeInviteLinkInvalid:
+		return http.StatusGone
 	default:
 		return http.StatusInternalServerError
 	}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/database"
+	"project-board-api/internal/service"
+)
+
+// outboxBroadcaster delivers relayed board events to WebSocket clients and outgoing webhooks
+type outboxBroadcaster struct{}
+
+// NewOutboxBroadcaster creates the broadcaster the outbox relay delivers board events with
+// With Redis every replica receives the event through its project subscription; without it
+// only the clients of this replica are reached
+func NewOutboxBroadcaster() service.OutboxBroadcaster {
+	return outboxBroadcaster{}
+}
+
+// Broadcast publishes the message on the project channel and forwards it to the webhooks
+// A Redis error is returned so the relay retries; webhooks are only forwarded once published
+func (outboxBroadcaster) Broadcast(ctx context.Context, projectID uuid.UUID, message []byte) error {
+	var event WSEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return fmt.Errorf("invalid board event: %w", err)
+	}
+
+	if rdb := database.GetRedis(); rdb != nil {
+		if err := rdb.Publish(ctx, "kanban:project:"+projectID.String(), message).Err(); err != nil {
+			return fmt.Errorf("failed to publish board event: %w", err)
+		}
+	} else {
+		sendToLocalClients(projectID.String(), event.Type, message)
+	}
+
+	publishWebhookEvent(projectID.String(), event)
+	return nil
+}
@@ -92,6 +92,7 @@ func (h *FieldOptionHandler) CreateFieldOption(c *gin.Context) {
 // @Param        request body dto.UpdateFieldOptionRequest true "필드 옵션 수정 요청"
 // @Success      200 {object} response.SuccessResponse{data=dto.FieldOptionResponse} "필드 옵션 수정 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "필드 옵션 관리 권한 없음 (field_option.manage)"
 // @Failure      404 {object} response.ErrorResponse "필드 옵션을 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /field-options/{optionId} [patch]
@@ -103,13 +104,18 @@ func (h *FieldOptionHandler) UpdateFieldOption(c *gin.Context) {
 		return
 	}
 
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
 	var req dto.UpdateFieldOptionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
 		return
 	}
 
-	option, err := h.fieldOptionService.UpdateFieldOption(c.Request.Context(), optionID, &req)
+	option, err := h.fieldOptionService.UpdateFieldOption(c.Request.Context(), optionID, userID, &req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -126,7 +132,7 @@ func (h *FieldOptionHandler) UpdateFieldOption(c *gin.Context) {
 // @Param        optionId path string true "Option ID (UUID)"
 // @Success      200 {object} response.SuccessResponse "필드 옵션 삭제 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Option ID"
-// @Failure      403 {object} response.ErrorResponse "시스템 기본 옵션은 삭제 불가"
+// @Failure      403 {object} response.ErrorResponse "필드 옵션 관리 권한 없음 (field_option.manage)"
 // @Failure      404 {object} response.ErrorResponse "필드 옵션을 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /field-options/{optionId} [delete]
@@ -138,7 +144,12 @@ func (h *FieldOptionHandler) DeleteFieldOption(c *gin.Context) {
 		return
 	}
 
-	err = h.fieldOptionService.DeleteFieldOption(c.Request.Context(), optionID)
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	err = h.fieldOptionService.DeleteFieldOption(c.Request.Context(), optionID, userID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
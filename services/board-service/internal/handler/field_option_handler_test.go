@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	"project-board-api/internal/domain"
@@ -19,8 +20,8 @@ import (
 type MockFieldOptionService struct {
 	GetFieldOptionsFunc   func(ctx context.Context, fieldType domain.FieldType) ([]*dto.FieldOptionResponse, error)
 	CreateFieldOptionFunc func(ctx context.Context, req *dto.CreateFieldOptionRequest) (*dto.FieldOptionResponse, error)
-	UpdateFieldOptionFunc func(ctx context.Context, optionID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error)
-	DeleteFieldOptionFunc func(ctx context.Context, optionID uuid.UUID) error
+	UpdateFieldOptionFunc func(ctx context.Context, optionID, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error)
+	DeleteFieldOptionFunc func(ctx context.Context, optionID, userID uuid.UUID) error
 }
 
 func (m *MockFieldOptionService) GetFieldOptions(ctx context.Context, fieldType domain.FieldType) ([]*dto.FieldOptionResponse, error) {
@@ -37,16 +38,16 @@ func (m *MockFieldOptionService) CreateFieldOption(ctx context.Context, req *dto
 	return nil, nil
 }
 
-func (m *MockFieldOptionService) UpdateFieldOption(ctx context.Context, optionID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
+func (m *MockFieldOptionService) UpdateFieldOption(ctx context.Context, optionID, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
 	if m.UpdateFieldOptionFunc != nil {
-		return m.UpdateFieldOptionFunc(ctx, optionID, req)
+		return m.UpdateFieldOptionFunc(ctx, optionID, userID, req)
 	}
 	return nil, nil
 }
 
-func (m *MockFieldOptionService) DeleteFieldOption(ctx context.Context, optionID uuid.UUID) error {
+func (m *MockFieldOptionService) DeleteFieldOption(ctx context.Context, optionID, userID uuid.UUID) error {
 	if m.DeleteFieldOptionFunc != nil {
-		return m.DeleteFieldOptionFunc(ctx, optionID)
+		return m.DeleteFieldOptionFunc(ctx, optionID, userID)
 	}
 	return nil
 }
@@ -351,7 +352,7 @@ func TestFieldOptionHandler_UpdateFieldOption(t *testing.T) {
 				DisplayOrder: &newDisplayOrder,
 			},
 			mockService: func(m *MockFieldOptionService) {
-				m.UpdateFieldOptionFunc = func(ctx context.Context, id uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
+				m.UpdateFieldOptionFunc = func(ctx context.Context, id, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
 					return &dto.FieldOptionResponse{
 						OptionID:        id,
 						FieldType:       "stage",
@@ -395,7 +396,7 @@ func TestFieldOptionHandler_UpdateFieldOption(t *testing.T) {
 				Label: &newLabel,
 			},
 			mockService: func(m *MockFieldOptionService) {
-				m.UpdateFieldOptionFunc = func(ctx context.Context, id uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
+				m.UpdateFieldOptionFunc = func(ctx context.Context, id, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
 					return nil, response.NewNotFoundError("Field option not found", "")
 				}
 			},
@@ -411,6 +412,10 @@ func TestFieldOptionHandler_UpdateFieldOption(t *testing.T) {
 			handler := NewFieldOptionHandler(mockService)
 
 			router := setupTestRouter()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_id", uuid.New())
+				c.Next()
+			})
 			router.PATCH("/api/field-options/:optionId", handler.UpdateFieldOption)
 
 			body, _ := json.Marshal(tt.requestBody)
@@ -447,7 +452,7 @@ func TestFieldOptionHandler_DeleteFieldOption(t *testing.T) {
 			name:     "성공: 필드 옵션 삭제",
 			optionID: optionID.String(),
 			mockService: func(m *MockFieldOptionService) {
-				m.DeleteFieldOptionFunc = func(ctx context.Context, id uuid.UUID) error {
+				m.DeleteFieldOptionFunc = func(ctx context.Context, id, userID uuid.UUID) error {
 					return nil
 				}
 			},
@@ -463,7 +468,7 @@ func TestFieldOptionHandler_DeleteFieldOption(t *testing.T) {
 			name:     "실패: 필드 옵션을 찾을 수 없음",
 			optionID: optionID.String(),
 			mockService: func(m *MockFieldOptionService) {
-				m.DeleteFieldOptionFunc = func(ctx context.Context, id uuid.UUID) error {
+				m.DeleteFieldOptionFunc = func(ctx context.Context, id, userID uuid.UUID) error {
 					return response.NewNotFoundError("Field option not found", "")
 				}
 			},
@@ -473,7 +478,7 @@ func TestFieldOptionHandler_DeleteFieldOption(t *testing.T) {
 			name:     "실패: 시스템 기본 옵션 삭제 시도",
 			optionID: optionID.String(),
 			mockService: func(m *MockFieldOptionService) {
-				m.DeleteFieldOptionFunc = func(ctx context.Context, id uuid.UUID) error {
+				m.DeleteFieldOptionFunc = func(ctx context.Context, id, userID uuid.UUID) error {
 					return response.NewValidationError("Cannot delete system default field option", "")
 				}
 			},
@@ -509,6 +514,10 @@ func TestFieldOptionHandler_DeleteFieldOption(t *testing.T) {
 			handler := NewFieldOptionHandler(mockService)
 
 			router := setupTestRouter()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_id", uuid.New())
+				c.Next()
+			})
 			router.DELETE("/api/field-options/:optionId", handler.DeleteFieldOption)
 
 			req := httptest.NewRequest(http.MethodDelete, "/api/field-options/"+tt.optionID, nil)
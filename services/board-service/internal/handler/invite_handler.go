@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type InviteHandler struct {
+	inviteService service.InviteService
+}
+
+func NewInviteHandler(inviteService service.InviteService) *InviteHandler {
+	return &InviteHandler{
+		inviteService: inviteService,
+	}
+}
+
+// CreateInviteLink godoc
+// @Summary      프로젝트 초대 링크 생성
+// @Description  만료 기한과 사용 횟수 제한이 있는 초대 링크를 생성합니다. 링크로 가입한 멤버는 지정한 역할을 받습니다
+// @Description  멤버 초대 권한이 필요하며, ADMIN 역할 링크는 역할 관리 권한도 필요합니다
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateInviteLinkRequest true "초대 링크 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.InviteLinkResponse} "초대 링크 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/invite-links [post]
+func (h *InviteHandler) CreateInviteLink(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateInviteLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.inviteService.CreateInviteLink(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, result)
+}
+
+// GetInviteLinks godoc
+// @Summary      프로젝트 초대 링크 목록 조회
+// @Description  만료되거나 취소된 링크를 포함한 프로젝트의 초대 링크 목록을 최신순으로 조회합니다 (멤버 초대 권한 필요)
+// @Tags         invitations
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.InviteLinkResponse} "초대 링크 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/invite-links [get]
+func (h *InviteHandler) GetInviteLinks(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.inviteService.GetInviteLinks(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// RevokeInviteLink godoc
+// @Summary      프로젝트 초대 링크 취소
+// @Description  초대 링크를 취소하여 더 이상 사용할 수 없게 합니다. 이미 링크로 가입한 멤버는 유지됩니다 (멤버 초대 권한 필요)
+// @Tags         invitations
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        linkId path string true "Invite Link ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "초대 링크 취소 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "초대 링크를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/invite-links/{linkId} [delete]
+func (h *InviteHandler) RevokeInviteLink(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	linkID, err := uuid.Parse(c.Param("linkId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid invite link ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.inviteService.RevokeInviteLink(c.Request.Context(), projectID, userID, linkID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// AcceptInviteLink godoc
+// @Summary      초대 링크로 프로젝트 가입
+// @Description  초대 링크로 가입 승인 없이 프로젝트에 가입합니다. 프로젝트가 속한 워크스페이스의 멤버여야 합니다
+// @Description  대기 중인 가입 요청이 있으면 승인 처리됩니다
+// @Tags         invitations
+// @Produce      json
+// @Param        token path string true "Invite token"
+// @Success      201 {object} response.SuccessResponse{data=dto.ProjectMemberResponse} "프로젝트 가입 성공"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "워크스페이스 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "초대 링크를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 프로젝트 멤버임"
+// @Failure      410 {object} response.ErrorResponse "만료, 취소되었거나 사용 횟수를 초과한 링크"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /invite-links/{token}/accept [post]
+func (h *InviteHandler) AcceptInviteLink(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid invite token")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.inviteService.AcceptInviteLink(c.Request.Context(), token, userID, jwtToken)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, result)
+}
+
+// InviteMembers godoc
+// @Summary      워크스페이스 멤버 직접 초대
+// @Description  워크스페이스 멤버를 사용자 ID로 프로젝트에 바로 추가하고 PROJECT_INVITED 알림을 보냅니다
+// @Description  이미 프로젝트 멤버이거나 워크스페이스 멤버가 아닌 사용자는 건너뛰고 skipped에 사유와 함께 반환합니다
+// @Description  멤버 초대 권한이 필요하며, ADMIN 역할로 초대하려면 역할 관리 권한도 필요합니다
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.InviteMembersRequest true "직접 초대 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.InviteMembersResponse} "직접 초대 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/invitations [post]
+func (h *InviteHandler) InviteMembers(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.InviteMembersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.inviteService.InviteMembers(c.Request.Context(), projectID, userID, &req, jwtToken)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
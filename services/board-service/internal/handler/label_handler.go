@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type LabelHandler struct {
+	labelService service.LabelService
+}
+
+func NewLabelHandler(labelService service.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: labelService,
+	}
+}
+
+// GetLabels godoc
+// @Summary      라벨 목록 조회
+// @Description  프로젝트의 라벨과 라벨별 사용 보드 수를 조회합니다
+// @Tags         labels
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.LabelResponse} "라벨 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/labels [get]
+func (h *LabelHandler) GetLabels(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	labels, err := h.labelService.GetLabels(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, labels)
+}
+
+// CreateLabel godoc
+// @Summary      라벨 생성
+// @Description  프로젝트에 새 라벨을 생성합니다 (이름은 프로젝트 내에서 고유)
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateLabelRequest true "라벨 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.LabelResponse} "라벨 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 존재하는 라벨 이름"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/labels [post]
+func (h *LabelHandler) CreateLabel(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.CreateLabel(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, label)
+}
+
+// UpdateLabel godoc
+// @Summary      라벨 수정
+// @Description  라벨의 이름, 색상, 설명을 수정합니다 (보드 할당은 그대로 유지됩니다)
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        labelId path string true "Label ID (UUID)"
+// @Param        request body dto.UpdateLabelRequest true "라벨 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.LabelResponse} "라벨 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "라벨을 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 존재하는 라벨 이름 (병합을 사용하세요)"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/labels/{labelId} [put]
+func (h *LabelHandler) UpdateLabel(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	labelID, err := uuid.Parse(c.Param("labelId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid label ID")
+		return
+	}
+
+	var req dto.UpdateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.UpdateLabel(c.Request.Context(), projectID, userID, labelID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, label)
+}
+
+// DeleteLabel godoc
+// @Summary      라벨 삭제
+// @Description  라벨을 삭제하고 모든 보드에서 제거합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         labels
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        labelId path string true "Label ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "라벨 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "라벨을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/labels/{labelId} [delete]
+func (h *LabelHandler) DeleteLabel(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	labelID, err := uuid.Parse(c.Param("labelId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid label ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.labelService.DeleteLabel(c.Request.Context(), projectID, userID, labelID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// MergeLabel godoc
+// @Summary      라벨 병합
+// @Description  라벨이 할당된 모든 보드를 대상 라벨로 옮기고 원본 라벨을 삭제합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        labelId path string true "병합될 Label ID (UUID)"
+// @Param        request body dto.MergeLabelRequest true "라벨 병합 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.LabelResponse} "라벨 병합 성공 (대상 라벨 반환)"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "라벨을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/labels/{labelId}/merge [post]
+func (h *LabelHandler) MergeLabel(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	labelID, err := uuid.Parse(c.Param("labelId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid label ID")
+		return
+	}
+
+	var req dto.MergeLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.MergeLabel(c.Request.Context(), projectID, userID, labelID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, label)
+}
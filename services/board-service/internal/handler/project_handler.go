@@ -99,6 +99,7 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 // @Tags         projects
 // @Produce      json
 // @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Param        includeArchived query bool false "보관된 Project 포함 여부" default(false)
 // @Success      200 {object} response.SuccessResponse{data=[]dto.ProjectResponse} "Project 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
@@ -135,7 +136,8 @@ func (h *ProjectHandler) GetProjectsByWorkspace(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, tokenStr)
+	includeArchived := c.Query("includeArchived") == "true"
+	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, includeArchived, tokenStr)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -151,6 +153,7 @@ func (h *ProjectHandler) GetProjectsByWorkspace(c *gin.Context) {
 // @Tags         projects
 // @Produce      json
 // @Param        workspaceId query string true "Workspace ID (UUID)"
+// @Param        includeArchived query bool false "보관된 Project 포함 여부" default(false)
 // @Success      200 {object} response.SuccessResponse{data=dto.PaginatedProjectsResponse} "Project 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
@@ -192,7 +195,8 @@ func (h *ProjectHandler) GetProjectsByWorkspaceQuery(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, tokenStr)
+	includeArchived := c.Query("includeArchived") == "true"
+	projects, err := h.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, userUUID, includeArchived, tokenStr)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+)
+
+// ArchiveProject godoc
+// @Summary      Project 보관
+// @Description  Project를 보관 처리합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  보관된 Project는 기본 목록에서 숨겨지며, 보드/댓글/첨부파일/필드 옵션 변경 시 PROJECT_ARCHIVED (409) 에러가 반환됩니다
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectResponse} "Project 보관 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 기본 Project"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/archive [post]
+func (h *ProjectHandler) ArchiveProject(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.ArchiveProject(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, project)
+}
+
+// UnarchiveProject godoc
+// @Summary      Project 보관 해제
+// @Description  보관된 Project를 다시 수정 가능한 상태로 되돌립니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectResponse} "Project 보관 해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/unarchive [post]
+func (h *ProjectHandler) UnarchiveProject(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.UnarchiveProject(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, project)
+}
@@ -18,13 +18,15 @@ import (
 // MockProjectService is a mock implementation of ProjectService
 type MockProjectService struct {
 	CreateProjectFunc          func(ctx context.Context, req *dto.CreateProjectRequest, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
-	GetProjectsByWorkspaceFunc func(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error)
+	GetProjectsByWorkspaceFunc func(ctx context.Context, workspaceID, userID uuid.UUID, includeArchived bool, token string) ([]*dto.ProjectResponse, error)
 	GetDefaultProjectFunc      func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	GetProjectFunc             func(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	UpdateProjectFunc          func(ctx context.Context, projectID, userID uuid.UUID, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
 	DeleteProjectFunc          func(ctx context.Context, projectID, userID uuid.UUID) error
 	SearchProjectsFunc         func(ctx context.Context, workspaceID, userID uuid.UUID, query string, page, limit int, token string) (*dto.PaginatedProjectsResponse, error)
 	GetProjectInitSettingsFunc func(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectInitSettingsResponse, error)
+	ArchiveProjectFunc         func(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
+	UnarchiveProjectFunc       func(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
 }
 
 func (m *MockProjectService) CreateProject(ctx context.Context, req *dto.CreateProjectRequest, userID uuid.UUID, token string) (*dto.ProjectResponse, error) {
@@ -34,9 +36,9 @@ func (m *MockProjectService) CreateProject(ctx context.Context, req *dto.CreateP
 	return nil, nil
 }
 
-func (m *MockProjectService) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error) {
+func (m *MockProjectService) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, includeArchived bool, token string) ([]*dto.ProjectResponse, error) {
 	if m.GetProjectsByWorkspaceFunc != nil {
-		return m.GetProjectsByWorkspaceFunc(ctx, workspaceID, userID, token)
+		return m.GetProjectsByWorkspaceFunc(ctx, workspaceID, userID, includeArchived, token)
 	}
 	return nil, nil
 }
@@ -83,6 +85,20 @@ func (m *MockProjectService) GetProjectInitSettings(ctx context.Context, project
 	return nil, nil
 }
 
+func (m *MockProjectService) ArchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	if m.ArchiveProjectFunc != nil {
+		return m.ArchiveProjectFunc(ctx, projectID, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectService) UnarchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	if m.UnarchiveProjectFunc != nil {
+		return m.UnarchiveProjectFunc(ctx, projectID, userID)
+	}
+	return nil, nil
+}
+
 func TestProjectHandler_CreateProject(t *testing.T) {
 	workspaceID := uuid.New()
 	projectID := uuid.New()
@@ -213,7 +229,7 @@ func TestProjectHandler_GetProjectsByWorkspace(t *testing.T) {
 			workspaceID: workspaceID.String(),
 			setContext:  true,
 			mockService: func(m *MockProjectService) {
-				m.GetProjectsByWorkspaceFunc = func(ctx context.Context, wID, uID uuid.UUID, t string) ([]*dto.ProjectResponse, error) {
+				m.GetProjectsByWorkspaceFunc = func(ctx context.Context, wID, uID uuid.UUID, includeArchived bool, t string) ([]*dto.ProjectResponse, error) {
 					return []*dto.ProjectResponse{
 						{
 							ID:          uuid.New(),
@@ -253,7 +269,7 @@ func TestProjectHandler_GetProjectsByWorkspace(t *testing.T) {
 			workspaceID: workspaceID.String(),
 			setContext:  true,
 			mockService: func(m *MockProjectService) {
-				m.GetProjectsByWorkspaceFunc = func(ctx context.Context, wID, uID uuid.UUID, t string) ([]*dto.ProjectResponse, error) {
+				m.GetProjectsByWorkspaceFunc = func(ctx context.Context, wID, uID uuid.UUID, includeArchived bool, t string) ([]*dto.ProjectResponse, error) {
 					return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
 				}
 			},
@@ -122,7 +122,7 @@ func (h *ProjectMemberHandler) RemoveMember(c *gin.Context) {
 
 // UpdateMemberRole godoc
 // @Summary      프로젝트 멤버 역할 변경
-// @Description  멤버의 역할을 변경합니다 (OWNER만 가능)
+// @Description  멤버의 역할을 변경합니다 (OWNER만 가능, 자기 자신의 역할은 변경할 수 없음)
 // @Tags         project-members
 // @Accept       json
 // @Produce      json
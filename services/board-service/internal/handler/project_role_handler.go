@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ProjectRoleHandler struct {
+	roleService service.ProjectRoleService
+}
+
+func NewProjectRoleHandler(roleService service.ProjectRoleService) *ProjectRoleHandler {
+	return &ProjectRoleHandler{
+		roleService: roleService,
+	}
+}
+
+// GetRoles godoc
+// @Summary      프로젝트 역할 목록 조회
+// @Description  기본 역할(OWNER/ADMIN/MEMBER)과 프로젝트 커스텀 역할을 권한 목록과 함께 조회합니다
+// @Tags         project-roles
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ProjectRoleResponse} "역할 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/roles [get]
+func (h *ProjectRoleHandler) GetRoles(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	roles, err := h.roleService.GetRoles(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, roles)
+}
+
+// CreateRole godoc
+// @Summary      커스텀 역할 생성
+// @Description  권한 목록으로 구성된 프로젝트 커스텀 역할을 생성합니다. 요청자가 가진 권한만 부여할 수 있습니다 (role.manage 권한 필요)
+// @Tags         project-roles
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateProjectRoleRequest true "역할 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.ProjectRoleResponse} "역할 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 (알 수 없는 권한, 기본 역할 이름)"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 존재하는 역할 이름"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/roles [post]
+func (h *ProjectRoleHandler) CreateRole(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateProjectRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	role, err := h.roleService.CreateRole(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, role)
+}
+
+// UpdateRole godoc
+// @Summary      커스텀 역할 수정
+// @Description  커스텀 역할의 이름, 설명, 권한을 수정합니다. 역할을 가진 멤버에게 즉시 적용됩니다 (role.manage 권한 필요)
+// @Tags         project-roles
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        roleId path string true "Role ID (UUID)"
+// @Param        request body dto.UpdateProjectRoleRequest true "역할 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectRoleResponse} "역할 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "역할을 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 존재하는 역할 이름"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/roles/{roleId} [put]
+func (h *ProjectRoleHandler) UpdateRole(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	roleID, err := uuid.Parse(c.Param("roleId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid role ID")
+		return
+	}
+
+	var req dto.UpdateProjectRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	role, err := h.roleService.UpdateRole(c.Request.Context(), projectID, userID, roleID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, role)
+}
+
+// DeleteRole godoc
+// @Summary      커스텀 역할 삭제
+// @Description  커스텀 역할을 삭제합니다. 역할을 가진 멤버는 기본 역할 권한으로 돌아갑니다 (role.manage 권한 필요)
+// @Tags         project-roles
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        roleId path string true "Role ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "역할 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "역할을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/roles/{roleId} [delete]
+func (h *ProjectRoleHandler) DeleteRole(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	roleID, err := uuid.Parse(c.Param("roleId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid role ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.roleService.DeleteRole(c.Request.Context(), projectID, userID, roleID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// AssignMemberRole godoc
+// @Summary      멤버 커스텀 역할 지정
+// @Description  멤버에게 커스텀 역할을 지정하거나 (customRoleId=null) 해제합니다. 프로젝트 OWNER와 자기 자신에게는 지정할 수 없습니다 (role.manage 권한 필요)
+// @Tags         project-roles
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        memberId path string true "Member User ID (UUID)"
+// @Param        request body dto.AssignProjectRoleRequest true "역할 지정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectMemberResponse} "역할 지정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "멤버 또는 역할을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/members/{memberId}/custom-role [put]
+func (h *ProjectRoleHandler) AssignMemberRole(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid member ID")
+		return
+	}
+
+	var req dto.AssignProjectRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	member, err := h.roleService.AssignMemberRole(c.Request.Context(), projectID, userID, memberID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, member)
+}
+
+// GetMyPermissions godoc
+// @Summary      내 프로젝트 권한 조회
+// @Description  요청자의 역할과 실제 적용되는 권한 목록을 조회합니다 (UI에서 사용할 수 없는 동작을 숨길 때 사용)
+// @Tags         project-roles
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectPermissionsResponse} "권한 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/permissions [get]
+func (h *ProjectRoleHandler) GetMyPermissions(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	permissions, err := h.roleService.GetMyPermissions(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, permissions)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type RecurrenceHandler struct {
+	recurrenceService service.RecurrenceService
+}
+
+func NewRecurrenceHandler(recurrenceService service.RecurrenceService) *RecurrenceHandler {
+	return &RecurrenceHandler{
+		recurrenceService: recurrenceService,
+	}
+}
+
+// GetRecurrence godoc
+// @Summary      보드 반복 규칙 조회
+// @Description  보드가 템플릿이거나 생성된 반복 시리즈와 다음 생성 예정 일시 미리보기를 조회합니다
+// @Tags         recurrences
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.RecurrenceResponse} "반복 규칙 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없거나 반복되지 않는 보드"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/recurrence [get]
+func (h *RecurrenceHandler) GetRecurrence(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.recurrenceService.GetRecurrence(c.Request.Context(), boardID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// SetRecurrence godoc
+// @Summary      보드 반복 규칙 설정
+// @Description  보드를 템플릿으로 반복 규칙(RFC 5545 RRULE 일부: DAILY/WEEKLY/MONTHLY, INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL)을 설정하거나 변경합니다
+// @Description  다음 일시가 되거나 최근 보드가 완료되면 템플릿의 커스텀 필드, 참여자, 담당자, 라벨을 복사한 새 보드가 생성됩니다
+// @Tags         recurrences
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.SetRecurrenceRequest true "반복 규칙 설정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.RecurrenceResponse} "반복 규칙 설정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 규칙, 시작 일시 없음 또는 생성된 보드"
+// @Failure      403 {object} response.ErrorResponse "보드 생성 권한 없음 또는 보관된 프로젝트"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/recurrence [put]
+func (h *RecurrenceHandler) SetRecurrence(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.SetRecurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.recurrenceService.SetRecurrence(c.Request.Context(), boardID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// DeleteRecurrence godoc
+// @Summary      보드 반복 중지
+// @Description  보드가 속한 반복 시리즈를 중지합니다. 이미 생성된 보드는 유지되고 시리즈 연결만 해제됩니다
+// @Tags         recurrences
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "반복 중지 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "보드 생성 권한 없음 또는 보관된 프로젝트"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없거나 반복되지 않는 보드"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/recurrence [delete]
+func (h *RecurrenceHandler) DeleteRecurrence(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.recurrenceService.DeleteRecurrence(c.Request.Context(), boardID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ReminderHandler struct {
+	reminderService service.ReminderService
+}
+
+func NewReminderHandler(reminderService service.ReminderService) *ReminderHandler {
+	return &ReminderHandler{
+		reminderService: reminderService,
+	}
+}
+
+// CreateReminder godoc
+// @Summary      보드 리마인더 생성
+// @Description  보드에 대한 개인 리마인더를 생성합니다 (remindAt 절대 시각 또는 마감일 기준 offsetMinutes 중 하나)
+// @Description  지정한 시각에 noti-service 알림(SSE)으로 전달되며, 보드가 완료되거나 삭제되면 자동으로 취소됩니다
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.CreateReminderRequest true "리마인더 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.ReminderResponse} "리마인더 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청, 과거 시각 또는 마감일 없음"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/reminders [post]
+func (h *ReminderHandler) CreateReminder(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.CreateReminderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reminderService.CreateReminder(c.Request.Context(), boardID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, result)
+}
+
+// GetBoardReminders godoc
+// @Summary      보드 리마인더 목록 조회
+// @Description  요청자가 보드에 설정한 리마인더를 조회합니다
+// @Tags         reminders
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ReminderResponse} "리마인더 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/reminders [get]
+func (h *ReminderHandler) GetBoardReminders(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reminderService.GetBoardReminders(c.Request.Context(), boardID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// GetMyReminders godoc
+// @Summary      내 리마인더 목록 조회
+// @Description  요청자의 모든 보드 리마인더를 조회합니다
+// @Tags         reminders
+// @Produce      json
+// @Param        status query string false "상태 필터 (PENDING, SENT, CANCELLED)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ReminderResponse} "리마인더 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 상태 값"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /reminders [get]
+func (h *ReminderHandler) GetMyReminders(c *gin.Context) {
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reminderService.GetMyReminders(c.Request.Context(), userID, c.Query("status"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// SnoozeReminder godoc
+// @Summary      리마인더 다시 알림(스누즈)
+// @Description  대기 중이거나 이미 전달된 리마인더를 minutes 후 또는 until 시각으로 미룹니다
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        reminderId path string true "Reminder ID (UUID)"
+// @Param        request body dto.SnoozeReminderRequest true "스누즈 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ReminderResponse} "스누즈 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 취소된 리마인더"
+// @Failure      404 {object} response.ErrorResponse "리마인더를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /reminders/{reminderId}/snooze [post]
+func (h *ReminderHandler) SnoozeReminder(c *gin.Context) {
+	reminderID, err := uuid.Parse(c.Param("reminderId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid reminder ID")
+		return
+	}
+
+	var req dto.SnoozeReminderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reminderService.SnoozeReminder(c.Request.Context(), reminderID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// DeleteReminder godoc
+// @Summary      리마인더 삭제
+// @Description  요청자의 리마인더를 삭제합니다
+// @Tags         reminders
+// @Produce      json
+// @Param        reminderId path string true "Reminder ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "리마인더 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Reminder ID"
+// @Failure      404 {object} response.ErrorResponse "리마인더를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /reminders/{reminderId} [delete]
+func (h *ReminderHandler) DeleteReminder(c *gin.Context) {
+	reminderID, err := uuid.Parse(c.Param("reminderId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid reminder ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reminderService.DeleteReminder(c.Request.Context(), reminderID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ReportHandler struct {
+	reportService service.ReportService
+}
+
+func NewReportHandler(reportService service.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// GetProjectReport godoc
+// @Summary      프로젝트 현황 보고서 조회
+// @Description  단계별 보드, 기한이 지난 보드, 기간 내 완료된 보드, 담당자별 보드 수를 담은 프로젝트 현황 보고서를 생성합니다 (프로젝트 멤버)
+// @Description  format=json은 표준 응답으로, markdown과 csv는 워크스페이스 템플릿(없으면 기본 템플릿)으로 렌더링한 본문을 그대로 반환합니다
+// @Description  완료 기간은 from~to (양 끝 포함, UTC 기준 날짜)이며 기본값은 오늘을 포함한 최근 7일입니다 (최대 366일)
+// @Tags         reports
+// @Produce      json
+// @Produce      text/markdown
+// @Produce      text/csv
+// @Param        projectId path  string true  "Project ID (UUID)"
+// @Param        format    query string false "보고서 형식 (json, markdown, csv, 기본값 json)"
+// @Param        from      query string false "완료 기간 시작일 (YYYY-MM-DD)"
+// @Param        to        query string false "완료 기간 종료일 (YYYY-MM-DD, 기본값 오늘)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectReportResponse} "보고서 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 파라미터"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "프로젝트 멤버가 아님"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/report [get]
+func (h *ReportHandler) GetProjectReport(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	query := &dto.ProjectReportQuery{Format: c.DefaultQuery("format", string(domain.ReportFormatJSON))}
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := time.Parse("2006-01-02", fromStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid from date (expected YYYY-MM-DD)")
+			return
+		}
+		query.From = &from
+	}
+	if toStr := c.Query("to"); toStr != "" {
+		to, err := time.Parse("2006-01-02", toStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid to date (expected YYYY-MM-DD)")
+			return
+		}
+		query.To = &to
+	}
+
+	report, err := h.reportService.GetProjectReport(c.Request.Context(), projectID, userID, query, jwtToken)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	format := domain.ReportFormat(query.Format)
+	if !format.IsTemplated() {
+		response.SendSuccess(c, http.StatusOK, report)
+		return
+	}
+
+	body, err := h.reportService.RenderProjectReport(c.Request.Context(), report, format)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	if format == domain.ReportFormatCSV {
+		filename := fmt.Sprintf("%s-report-%s.csv", report.KeyPrefix, report.To.Format("2006-01-02"))
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", body)
+		return
+	}
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", body)
+}
+
+// GetReportTemplates godoc
+// @Summary      워크스페이스 보고서 템플릿 조회
+// @Description  워크스페이스에서 사용하는 Markdown, CSV 보고서 템플릿을 조회합니다 (워크스페이스 멤버)
+// @Description  재정의하지 않은 형식은 기본 템플릿을 isDefault=true로 반환합니다
+// @Tags         reports
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ReportTemplateResponse} "템플릿 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Workspace ID"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "워크스페이스 멤버가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/workspace/{workspaceId}/report-templates [get]
+func (h *ReportHandler) GetReportTemplates(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reportService.GetReportTemplates(c.Request.Context(), workspaceID, userID, jwtToken)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// SetReportTemplate godoc
+// @Summary      워크스페이스 보고서 템플릿 재정의
+// @Description  워크스페이스의 Markdown 또는 CSV 보고서 템플릿을 Go text/template 문법으로 재정의합니다 (워크스페이스 소유자)
+// @Description  템플릿 데이터는 JSON 보고서와 같은 구조이며 Go 필드 이름(.ProjectName, .Stages 등)을 사용합니다
+// @Description  함수: date (YYYY-MM-DD), csv (CSV 필드 이스케이프), cell (Markdown 표 셀 이스케이프). 샘플 보고서로 렌더링되지 않는 템플릿은 거부됩니다
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Param        format      path string true "보고서 형식 (markdown, csv)"
+// @Param        request body dto.SetReportTemplateRequest true "템플릿 재정의 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ReportTemplateResponse} "템플릿 재정의 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 템플릿"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "워크스페이스 소유자가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/workspace/{workspaceId}/report-templates/{format} [put]
+func (h *ReportHandler) SetReportTemplate(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	var req dto.SetReportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reportService.SetReportTemplate(c.Request.Context(), workspaceID, userID, c.Param("format"), &req, jwtToken)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// DeleteReportTemplate godoc
+// @Summary      워크스페이스 보고서 템플릿 초기화
+// @Description  재정의한 보고서 템플릿을 삭제하여 기본 템플릿으로 되돌립니다 (워크스페이스 소유자)
+// @Tags         reports
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID (UUID)"
+// @Param        format      path string true "보고서 형식 (markdown, csv)"
+// @Success      200 {object} response.SuccessResponse "템플릿 초기화 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "워크스페이스 소유자가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/workspace/{workspaceId}/report-templates/{format} [delete]
+func (h *ReportHandler) DeleteReportTemplate(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	jwtToken, ok := getRequestToken(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reportService.DeleteReportTemplate(c.Request.Context(), workspaceID, userID, c.Param("format"), jwtToken); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -0,0 +1,342 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type SprintHandler struct {
+	sprintService service.SprintService
+}
+
+func NewSprintHandler(sprintService service.SprintService) *SprintHandler {
+	return &SprintHandler{
+		sprintService: sprintService,
+	}
+}
+
+// GetSprints godoc
+// @Summary      스프린트 목록 조회
+// @Description  프로젝트의 스프린트를 시작일 순으로 조회합니다 (스프린트별 보드 수 포함)
+// @Tags         sprints
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.SprintResponse} "스프린트 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints [get]
+func (h *SprintHandler) GetSprints(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprints, err := h.sprintService.GetSprints(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, sprints)
+}
+
+// GetSprint godoc
+// @Summary      스프린트 상세 조회
+// @Description  스프린트의 보드 목록과 범위 변경 리포트(진행 중 추가/제거, 종료 시 이월)를 조회합니다
+// @Tags         sprints
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.SprintDetailResponse} "스프린트 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId} [get]
+func (h *SprintHandler) GetSprint(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintService.GetSprint(c.Request.Context(), projectID, userID, sprintID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, sprint)
+}
+
+// CreateSprint godoc
+// @Summary      스프린트 생성
+// @Description  계획(planned) 상태의 스프린트를 생성합니다 (sprint.manage 권한 필요)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateSprintRequest true "스프린트 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.SprintResponse} "스프린트 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 날짜 범위"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      409 {object} response.ErrorResponse "보관된 프로젝트"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints [post]
+func (h *SprintHandler) CreateSprint(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintService.CreateSprint(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, sprint)
+}
+
+// UpdateSprint godoc
+// @Summary      스프린트 수정
+// @Description  스프린트의 이름, 목표, 기간을 수정합니다 (sprint.manage 권한 필요, 종료된 스프린트는 수정 불가)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Param        request body dto.UpdateSprintRequest true "스프린트 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.SprintResponse} "스프린트 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 날짜 범위"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "종료된 스프린트 또는 보관된 프로젝트"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId} [put]
+func (h *SprintHandler) UpdateSprint(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintService.UpdateSprint(c.Request.Context(), projectID, userID, sprintID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, sprint)
+}
+
+// DeleteSprint godoc
+// @Summary      스프린트 삭제
+// @Description  계획 또는 종료 상태의 스프린트를 삭제하고 보드를 백로그로 되돌립니다 (sprint.manage 권한 필요)
+// @Tags         sprints
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "스프린트 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "진행 중인 스프린트"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId} [delete]
+func (h *SprintHandler) DeleteSprint(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.sprintService.DeleteSprint(c.Request.Context(), projectID, userID, sprintID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// StartSprint godoc
+// @Summary      스프린트 시작
+// @Description  계획 상태의 스프린트를 진행(active) 상태로 바꿉니다 (프로젝트당 하나, sprint.manage 권한 필요)
+// @Description  이후 추가·제거되는 보드는 범위 변경으로 기록되며 SPRINT_UPDATED 이벤트가 브로드캐스트됩니다
+// @Tags         sprints
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.SprintResponse} "스프린트 시작 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "계획 상태가 아니거나 이미 진행 중인 스프린트가 있음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId}/start [post]
+func (h *SprintHandler) StartSprint(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintService.StartSprint(c.Request.Context(), projectID, userID, sprintID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, sprint)
+}
+
+// CloseSprint godoc
+// @Summary      스프린트 종료
+// @Description  진행 중인 스프린트를 종료하고 완료되지 않은 보드를 다음 스프린트로 이월합니다 (sprint.manage 권한 필요)
+// @Description  nextSprintId가 없으면 시작일이 가장 빠른 계획 스프린트로, 없거나 toBacklog이면 백로그로 이동합니다
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Param        request body dto.CloseSprintRequest false "스프린트 종료 옵션"
+// @Success      200 {object} response.SuccessResponse{data=dto.CloseSprintResponse} "스프린트 종료 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "진행 중인 스프린트가 아니거나 다음 스프린트가 계획 상태가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId}/close [post]
+func (h *SprintHandler) CloseSprint(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CloseSprintRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+			return
+		}
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.sprintService.CloseSprint(c.Request.Context(), projectID, userID, sprintID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// UpdateSprintBoards godoc
+// @Summary      스프린트 보드 추가/제거
+// @Description  보드를 스프린트에 추가하거나(이전 스프린트에서 이동) 백로그로 제거합니다
+// @Description  진행 중인 스프린트의 변경은 범위 추가/제거로 기록됩니다
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        sprintId path string true "Sprint ID (UUID)"
+// @Param        request body dto.UpdateSprintBoardsRequest true "보드 추가/제거 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.SprintDetailResponse} "보드 변경 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "스프린트 또는 보드를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "종료된 스프린트"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/sprints/{sprintId}/boards [put]
+func (h *SprintHandler) UpdateSprintBoards(c *gin.Context) {
+	projectID, sprintID, ok := parseSprintPath(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSprintBoardsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintService.UpdateSprintBoards(c.Request.Context(), projectID, userID, sprintID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, sprint)
+}
+
+// parseSprintPath parses the project and sprint IDs of a sprint route
+// It writes a 400 response and returns false when either is malformed
+func parseSprintPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	sprintID, err := uuid.Parse(c.Param("sprintId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid sprint ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return projectID, sprintID, true
+}
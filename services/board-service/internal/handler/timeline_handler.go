@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type TimelineHandler struct {
+	timelineService service.TimelineService
+}
+
+func NewTimelineHandler(timelineService service.TimelineService) *TimelineHandler {
+	return &TimelineHandler{
+		timelineService: timelineService,
+	}
+}
+
+// GetTimeline godoc
+// @Summary      프로젝트 타임라인(간트) 조회
+// @Description  startDate/dueDate가 있는 보드를 막대(barStart~barEnd)로 그룹별로 반환합니다
+// @Description  날짜가 하나만 있는 보드는 하루짜리 막대, 날짜가 없는 보드는 unscheduled에 포함됩니다
+// @Description  overlaps는 같은 담당자의 겹치는 막대 쌍, overruns는 프로젝트 마감일을 넘기는 막대입니다
+// @Tags         timeline
+// @Produce      json
+// @Param        projectId path  string true  "Project ID (UUID)"
+// @Param        groupBy   query string false "그룹 기준 (기본값 assignee)" Enums(assignee, stage, role)
+// @Success      200 {object} response.SuccessResponse{data=dto.TimelineResponse} "타임라인 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 groupBy"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/timeline [get]
+func (h *TimelineHandler) GetTimeline(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	timeline, err := h.timelineService.GetTimeline(c.Request.Context(), projectID, userID, c.Query("groupBy"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, timeline)
+}
+
+// RescheduleBoards godoc
+// @Summary      보드 일정 일괄 이동
+// @Description  여러 보드의 날짜를 offsetDays만큼 한 번에 이동합니다 (음수는 앞당김)
+// @Description  shift로 이동할 날짜를 선택합니다: both(기본값), start, due
+// @Description  이동 후 startDate가 dueDate보다 늦어지는 보드가 있으면 전체 요청이 거부됩니다
+// @Description  변경 후 BOARDS_RESCHEDULED WebSocket 이벤트 하나가 브로드캐스트됩니다
+// @Tags         timeline
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.RescheduleBoardsRequest true "일정 이동 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.RescheduleBoardsResponse} "일정 이동 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 날짜 범위"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Project 또는 Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "보관된 프로젝트"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/timeline/reschedule [post]
+func (h *TimelineHandler) RescheduleBoards(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.RescheduleBoardsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.timelineService.RescheduleBoards(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
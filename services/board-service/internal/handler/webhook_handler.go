@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+// webhookPublisher forwards broadcast board events to outgoing webhooks (nil disables forwarding)
+var webhookPublisher service.WebhookDispatcher
+
+// SetWebhookPublisher registers the dispatcher that receives every broadcast board event
+func SetWebhookPublisher(publisher service.WebhookDispatcher) {
+	webhookPublisher = publisher
+}
+
+// publishWebhookEvent hands a broadcast event to the webhook dispatcher (never blocks)
+func publishWebhookEvent(projectID string, event WSEvent) {
+	if webhookPublisher == nil {
+		return
+	}
+	parsedProjectID, err := uuid.Parse(projectID)
+	if err != nil {
+		return
+	}
+	webhookPublisher.Publish(context.Background(), parsedProjectID, event.Type, event.BoardID, event.Payload)
+}
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// GetWebhooks godoc
+// @Summary      웹훅 목록 조회
+// @Description  프로젝트에 등록된 웹훅을 조회합니다 (OWNER 또는 ADMIN만 가능, secret은 포함되지 않음)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.WebhookResponse} "웹훅 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks [get]
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.GetWebhooks(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, webhooks)
+}
+
+// CreateWebhook godoc
+// @Summary      웹훅 생성
+// @Description  보드 이벤트를 외부 URL로 전송하는 웹훅을 추가합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  요청 본문은 HMAC-SHA256으로 서명되어 X-Wealist-Signature 헤더("sha256=<hex>")로 전달됩니다
+// @Description  secret을 생략하면 자동 생성되며 생성 응답에서만 확인할 수 있습니다
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateWebhookRequest true "웹훅 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.WebhookResponse} "웹훅 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary      웹훅 수정
+// @Description  웹훅의 이름, URL, 이벤트 필터, secret, 활성화 여부를 수정합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        webhookId path string true "Webhook ID (UUID)"
+// @Param        request body dto.UpdateWebhookRequest true "웹훅 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.WebhookResponse} "웹훅 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "웹훅을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks/{webhookId} [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid webhook ID")
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(c.Request.Context(), projectID, userID, webhookID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary      웹훅 삭제
+// @Description  웹훅과 전송 로그를 삭제합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        webhookId path string true "Webhook ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "웹훅 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "웹훅을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks/{webhookId} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid webhook ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), projectID, userID, webhookID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// GetDeliveries godoc
+// @Summary      웹훅 전송 로그 조회
+// @Description  웹훅의 최근 전송 내역을 조회합니다 (최신순, OWNER 또는 ADMIN만 가능)
+// @Description  재시도가 모두 실패한 전송은 DEAD 상태로 남으며 재전송할 수 있습니다
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        webhookId path string true "Webhook ID (UUID)"
+// @Param        limit query int false "조회 개수 (기본 50, 최대 200)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.WebhookDeliveryResponse} "전송 로그 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "웹훅을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks/{webhookId}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid webhook ID")
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid limit")
+			return
+		}
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Request.Context(), projectID, userID, webhookID, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, deliveries)
+}
+
+// Redeliver godoc
+// @Summary      웹훅 재전송
+// @Description  저장된 전송 내역을 동일한 payload로 즉시 다시 전송합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  실패하면 재시도 횟수가 초기화된 상태로 다시 재시도 일정에 포함됩니다
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        webhookId path string true "Webhook ID (UUID)"
+// @Param        deliveryId path string true "Delivery ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.WebhookDeliveryResponse} "재전송 결과"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "웹훅 또는 전송 내역을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid webhook ID")
+		return
+	}
+
+	deliveryID, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid delivery ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.Redeliver(c.Request.Context(), projectID, userID, webhookID, deliveryID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, delivery)
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type WorkflowHandler struct {
+	workflowService service.WorkflowService
+}
+
+func NewWorkflowHandler(workflowService service.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		workflowService: workflowService,
+	}
+}
+
+// GetTransitions godoc
+// @Summary      워크플로우 전환 규칙 목록 조회
+// @Description  프로젝트에 정의된 stage 전환 규칙을 조회합니다
+// @Description  규칙이 하나도 없으면 모든 stage 간 이동이 허용됩니다
+// @Tags         workflow
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.WorkflowTransitionResponse} "전환 규칙 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/workflow/transitions [get]
+func (h *WorkflowHandler) GetTransitions(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	transitions, err := h.workflowService.GetTransitions(c.Request.Context(), projectID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, transitions)
+}
+
+// CreateTransition godoc
+// @Summary      워크플로우 전환 규칙 생성
+// @Description  허용되는 stage 전환을 추가합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  fromStage에 "*"를 지정하면 모든 stage에서의 전환에 적용됩니다
+// @Description  requiredFields: assignee, startDate, dueDate, content, participants 또는 custom field 키
+// @Description  allowedRoles: OWNER, ADMIN, MEMBER 또는 프로젝트의 커스텀 역할 이름 (비어 있으면 모든 멤버 허용)
+// @Tags         workflow
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateWorkflowTransitionRequest true "전환 규칙 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.WorkflowTransitionResponse} "전환 규칙 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      409 {object} response.ErrorResponse "이미 존재하는 전환 규칙"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/workflow/transitions [post]
+func (h *WorkflowHandler) CreateTransition(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateWorkflowTransitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	transition, err := h.workflowService.CreateTransition(c.Request.Context(), projectID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, transition)
+}
+
+// UpdateTransition godoc
+// @Summary      워크플로우 전환 규칙 수정
+// @Description  전환 규칙의 필수 필드와 허용 역할을 수정합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         workflow
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        transitionId path string true "Transition ID (UUID)"
+// @Param        request body dto.UpdateWorkflowTransitionRequest true "전환 규칙 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.WorkflowTransitionResponse} "전환 규칙 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "전환 규칙을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/workflow/transitions/{transitionId} [put]
+func (h *WorkflowHandler) UpdateTransition(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	transitionID, err := uuid.Parse(c.Param("transitionId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid transition ID")
+		return
+	}
+
+	var req dto.UpdateWorkflowTransitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	transition, err := h.workflowService.UpdateTransition(c.Request.Context(), projectID, userID, transitionID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, transition)
+}
+
+// DeleteTransition godoc
+// @Summary      워크플로우 전환 규칙 삭제
+// @Description  전환 규칙을 삭제합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         workflow
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        transitionId path string true "Transition ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "전환 규칙 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "전환 규칙을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/workflow/transitions/{transitionId} [delete]
+func (h *WorkflowHandler) DeleteTransition(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	transitionID, err := uuid.Parse(c.Param("transitionId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid transition ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.workflowService.DeleteTransition(c.Request.Context(), projectID, userID, transitionID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
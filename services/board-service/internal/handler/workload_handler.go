@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type WorkloadHandler struct {
+	workloadService service.WorkloadService
+}
+
+func NewWorkloadHandler(workloadService service.WorkloadService) *WorkloadHandler {
+	return &WorkloadHandler{
+		workloadService: workloadService,
+	}
+}
+
+// GetWorkload godoc
+// @Summary      워크스페이스 담당자별 업무량 조회
+// @Description  조회 가능한 모든 프로젝트(공개 프로젝트와 멤버인 프로젝트)의 열린 보드를 담당자별, 주별로 집계합니다
+// @Description  완료(approved)·삭제(deleted) 단계의 보드와 담당자가 없는 보드는 제외됩니다
+// @Description  보드는 startDate~dueDate 기간이 걸친 모든 주에 집계되며, weightField 옵션의 표시 순서로 가중치를 매깁니다
+// @Description  (첫 번째 옵션이 옵션 개수만큼, 마지막 옵션이 1, 값이 없으면 1)
+// @Tags         workload
+// @Produce      json
+// @Param        workspaceId path  string true  "Workspace ID (UUID)"
+// @Param        from        query string false "시작 주 (YYYY-MM-DD, 해당 주 월요일로 맞춤, 기본값 이번 주)"
+// @Param        weeks       query int    false "집계할 주 수 (1~26, 기본값 4)"
+// @Param        weightField query string false "가중치 필드 (기본값 importance)"
+// @Param        assigneeIds query string false "담당자 ID 목록 (쉼표 구분)"
+// @Param        projectIds  query string false "프로젝트 ID 목록 (쉼표 구분)"
+// @Success      200 {object} response.SuccessResponse{data=dto.WorkloadResponse} "업무량 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 파라미터"
+// @Failure      401 {object} response.ErrorResponse "인증 실패"
+// @Failure      403 {object} response.ErrorResponse "워크스페이스 멤버가 아님"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/workspace/{workspaceId}/workload [get]
+func (h *WorkloadHandler) GetWorkload(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	userID, ok := getRequestUserID(c)
+	if !ok {
+		return
+	}
+
+	token, exists := c.Get("jwtToken")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "JWT token not found in context")
+		return
+	}
+	tokenStr, ok := token.(string)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid token format")
+		return
+	}
+
+	query := &dto.WorkloadQuery{WeightField: c.Query("weightField")}
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err := time.Parse("2006-01-02", fromStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid from date (expected YYYY-MM-DD)")
+			return
+		}
+		query.From = &from
+	}
+	if weeksStr := c.Query("weeks"); weeksStr != "" {
+		weeks, err := strconv.Atoi(weeksStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid weeks")
+			return
+		}
+		query.Weeks = weeks
+	}
+	if assigneesStr := c.Query("assigneeIds"); assigneesStr != "" {
+		query.AssigneeIDs, err = parseUUIDList(assigneesStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid assignee ID in assigneeIds")
+			return
+		}
+	}
+	if projectsStr := c.Query("projectIds"); projectsStr != "" {
+		query.ProjectIDs, err = parseUUIDList(projectsStr)
+		if err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID in projectIds")
+			return
+		}
+	}
+
+	workload, err := h.workloadService.GetWorkload(c.Request.Context(), workspaceID, userID, query, tokenStr)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, workload)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"project-board-api/internal/client"
 	"project-board-api/internal/database"
+	"project-board-api/internal/dto"
 	"sync"
 	"time"
 
@@ -35,13 +36,8 @@ const (
 
 // getLogger is defined in error_handler.go with trace context support
 
-type WSEvent struct {
-	Type    string      `json:"type"`
-	Payload interface{} `json:"payload"`
-	BoardID string      `json:"boardId,omitempty"`
-	From    string      `json:"from,omitempty"`
-	To      string      `json:"to,omitempty"`
-}
+// WSEvent is the message sent to WebSocket clients
+type WSEvent = dto.BoardEvent
 
 type Client struct {
 	conn      *websocket.Conn
@@ -464,9 +460,23 @@ func (h *WSHandler) HandleGetOnlineUsers(c *gin.Context) {
 }
 
 // BroadcastEvent broadcasts a WebSocket event to all clients subscribed to the given project.
+// The event is also forwarded to the project's outgoing webhooks.
+// Board changes are broadcast by the services through the event outbox instead.
 func BroadcastEvent(projectID string, event WSEvent) {
-	log := getWSLogger()
 	payload, _ := json.Marshal(event)
+	sendToLocalClients(projectID, event.Type, payload)
+
+	redis := database.GetRedis()
+	if redis != nil {
+		redis.Publish(context.Background(), "kanban:project:"+projectID, payload)
+	}
+
+	publishWebhookEvent(projectID, event)
+}
+
+// sendToLocalClients sends a message to the clients of the project connected to this replica
+func sendToLocalClients(projectID, eventType string, payload []byte) {
+	log := getWSLogger()
 
 	clientsMu.RLock()
 	defer clientsMu.RUnlock()
@@ -474,7 +484,7 @@ func BroadcastEvent(projectID string, event WSEvent) {
 	log.Debug("Broadcasting event",
 		zap.String("projectID", projectID),
 		zap.Int("clientCount", len(clients[projectID])),
-		zap.String("eventType", event.Type))
+		zap.String("eventType", eventType))
 
 	if projectClients, ok := clients[projectID]; ok {
 		for client := range projectClients {
@@ -489,9 +499,4 @@ func BroadcastEvent(projectID string, event WSEvent) {
 	} else {
 		log.Debug("No clients found for project", zap.String("projectID", projectID))
 	}
-
-	redis := database.GetRedis()
-	if redis != nil {
-		redis.Publish(context.Background(), "kanban:project:"+projectID, payload)
-	}
 }
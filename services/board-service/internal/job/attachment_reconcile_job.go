@@ -0,0 +1,238 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/config"
+	"project-board-api/internal/metrics"
+	"project-board-api/internal/repository"
+)
+
+// Reconciliation paging and reporting limits
+const (
+	reconcilePageSize      = 1000
+	reconcileMaxSampleKeys = 20 // orphans listed in the report/log; the counts are always complete
+)
+
+// AttachmentReconcileStorage is the subset of the S3 client used by the reconciliation job
+type AttachmentReconcileStorage interface {
+	ListFiles(ctx context.Context, prefix, token string, limit int) (*client.FileListPage, error)
+	FileExists(ctx context.Context, key string) (bool, error)
+	DeleteFile(ctx context.Context, key string) error
+}
+
+// ReconcileReport summarizes one reconciliation run
+type ReconcileReport struct {
+	ScannedObjects int
+	ScannedRecords int
+
+	// S3 objects under the prefix without an attachment record (original, thumbnail or preview)
+	OrphanObjects       int
+	OrphanObjectSamples []string
+	// CONFIRMED attachment records whose S3 object is gone
+	MissingObjects       int
+	MissingObjectSamples []uuid.UUID
+
+	DeletedObjects int
+	DeletedRecords int
+}
+
+// AttachmentReconcileJob compares the bucket prefix with the attachments table
+// Orphans younger than the grace period are ignored so that in-flight uploads are never reported
+type AttachmentReconcileJob struct {
+	attachmentRepo repository.AttachmentRepository
+	storage        AttachmentReconcileStorage
+	metrics        *metrics.Metrics
+	logger         *zap.Logger
+
+	prefix        string
+	gracePeriod   time.Duration
+	deleteOrphans bool
+	now           func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewAttachmentReconcileJob creates a new AttachmentReconcileJob instance
+func NewAttachmentReconcileJob(
+	attachmentRepo repository.AttachmentRepository,
+	storage AttachmentReconcileStorage,
+	m *metrics.Metrics,
+	cfg config.AttachmentReconcileConfig,
+	logger *zap.Logger,
+) *AttachmentReconcileJob {
+	return &AttachmentReconcileJob{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		metrics:        m,
+		logger:         logger,
+		prefix:         cfg.Prefix,
+		gracePeriod:    cfg.GracePeriod,
+		deleteOrphans:  cfg.DeleteOrphans,
+		now:            time.Now,
+	}
+}
+
+// Run executes the reconciliation job and records the outcome in the logs and metrics
+func (j *AttachmentReconcileJob) Run() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.logger.Info("Starting attachment reconciliation job",
+		zap.String("prefix", j.prefix),
+		zap.Duration("grace_period", j.gracePeriod),
+		zap.Bool("delete_orphans", j.deleteOrphans),
+	)
+
+	report, err := j.Reconcile(context.Background())
+	if err != nil {
+		j.logger.Error("Attachment reconciliation job failed", zap.Error(err))
+		if j.metrics != nil {
+			j.metrics.RecordAttachmentReconcileFailure()
+		}
+		return
+	}
+
+	if j.metrics != nil {
+		j.metrics.RecordAttachmentReconcile(report.OrphanObjects, report.MissingObjects,
+			report.DeletedObjects+report.DeletedRecords, j.now())
+	}
+
+	fields := []zap.Field{
+		zap.Int("scanned_objects", report.ScannedObjects),
+		zap.Int("scanned_records", report.ScannedRecords),
+		zap.Int("orphan_objects", report.OrphanObjects),
+		zap.Int("missing_objects", report.MissingObjects),
+		zap.Int("deleted_objects", report.DeletedObjects),
+		zap.Int("deleted_records", report.DeletedRecords),
+	}
+	if report.OrphanObjects > 0 || report.MissingObjects > 0 {
+		fields = append(fields,
+			zap.Strings("orphan_object_samples", report.OrphanObjectSamples),
+			zap.Stringers("missing_object_samples", report.MissingObjectSamples),
+		)
+		j.logger.Warn("Attachment reconciliation found orphans", fields...)
+		return
+	}
+	j.logger.Info("Attachment reconciliation job completed", fields...)
+}
+
+// Reconcile pages through the bucket prefix and the confirmed attachments and reports orphans in both directions
+// When orphan deletion is enabled, orphan objects are removed from S3 and records are soft deleted
+func (j *AttachmentReconcileJob) Reconcile(ctx context.Context) (*ReconcileReport, error) {
+	report := &ReconcileReport{}
+	cutoff := j.now().Add(-j.gracePeriod)
+
+	if err := j.reconcileObjects(ctx, cutoff, report); err != nil {
+		return nil, err
+	}
+	if err := j.reconcileRecords(ctx, cutoff, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// reconcileObjects finds S3 objects that no attachment references
+func (j *AttachmentReconcileJob) reconcileObjects(ctx context.Context, cutoff time.Time, report *ReconcileReport) error {
+	token := ""
+	for {
+		page, err := j.storage.ListFiles(ctx, j.prefix, token, reconcilePageSize)
+		if err != nil {
+			return err
+		}
+		report.ScannedObjects += len(page.Files)
+
+		keys := make([]string, 0, len(page.Files))
+		for _, file := range page.Files {
+			if file.LastModified.Before(cutoff) {
+				keys = append(keys, file.Key)
+			}
+		}
+		referenced, err := j.attachmentRepo.FindReferencedFileKeys(ctx, keys)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if referenced[key] {
+				continue
+			}
+			report.OrphanObjects++
+			if len(report.OrphanObjectSamples) < reconcileMaxSampleKeys {
+				report.OrphanObjectSamples = append(report.OrphanObjectSamples, key)
+			}
+			if !j.deleteOrphans {
+				continue
+			}
+			if err := j.storage.DeleteFile(ctx, key); err != nil {
+				j.logger.Warn("Failed to delete orphan object from S3",
+					zap.String("file_key", key),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.DeletedObjects++
+		}
+
+		if page.NextToken == "" {
+			return nil
+		}
+		token = page.NextToken
+	}
+}
+
+// reconcileRecords finds confirmed attachments whose original file is missing from S3
+func (j *AttachmentReconcileJob) reconcileRecords(ctx context.Context, cutoff time.Time, report *ReconcileReport) error {
+	afterID := uuid.Nil
+	for {
+		attachments, err := j.attachmentRepo.FindConfirmedAfter(ctx, afterID, reconcilePageSize)
+		if err != nil {
+			return err
+		}
+		if len(attachments) == 0 {
+			return nil
+		}
+		report.ScannedRecords += len(attachments)
+		afterID = attachments[len(attachments)-1].ID
+
+		var missingIDs []uuid.UUID
+		for _, attachment := range attachments {
+			if !attachment.CreatedAt.Before(cutoff) {
+				continue
+			}
+			exists, err := j.storage.FileExists(ctx, attachment.FileURL)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			report.MissingObjects++
+			if len(report.MissingObjectSamples) < reconcileMaxSampleKeys {
+				report.MissingObjectSamples = append(report.MissingObjectSamples, attachment.ID)
+			}
+			missingIDs = append(missingIDs, attachment.ID)
+		}
+
+		if j.deleteOrphans && len(missingIDs) > 0 {
+			if err := j.attachmentRepo.DeleteBatch(ctx, missingIDs); err != nil {
+				j.logger.Warn("Failed to delete attachments without S3 object",
+					zap.Int("count", len(missingIDs)),
+					zap.Error(err),
+				)
+			} else {
+				report.DeletedRecords += len(missingIDs)
+			}
+		}
+
+		if len(attachments) < reconcilePageSize {
+			return nil
+		}
+	}
+}
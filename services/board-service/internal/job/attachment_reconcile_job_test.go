@@ -0,0 +1,134 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/config"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/metrics"
+)
+
+func newReconcileTestJob(repo *MockAttachmentRepository, s3 *client.MockS3Client, deleteOrphans bool) *AttachmentReconcileJob {
+	return NewAttachmentReconcileJob(repo, s3, metrics.NewTestMetrics(), config.AttachmentReconcileConfig{
+		Enabled:       true,
+		Prefix:        "board/",
+		GracePeriod:   24 * time.Hour,
+		DeleteOrphans: deleteOrphans,
+	}, zap.NewNop())
+}
+
+func seedObject(t *testing.T, s3 *client.MockS3Client, key string, lastModified time.Time) {
+	t.Helper()
+	_, err := s3.UploadFile(context.Background(), key, bytes.NewReader([]byte("data")), "image/png")
+	require.NoError(t, err)
+	s3.SetLastModified(key, lastModified)
+}
+
+func TestAttachmentReconcileJob_ReportsOrphansInBothDirections(t *testing.T) {
+	s3 := client.NewMockS3Client()
+	old := time.Now().Add(-48 * time.Hour)
+
+	seedObject(t, s3, "board/boards/ws/2024/01/kept.png", old)
+	seedObject(t, s3, "board/boards/ws/2024/01/kept_thumb.png", old)
+	seedObject(t, s3, "board/boards/ws/2024/01/orphan.png", old)
+	seedObject(t, s3, "board/boards/ws/2024/01/uploading.png", time.Now()) // within the grace period
+	seedObject(t, s3, "other/unrelated.png", old)                          // outside the prefix
+
+	missing := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: old}, FileURL: "board/boards/ws/2024/01/gone.png"}
+	present := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: old}, FileURL: "board/boards/ws/2024/01/kept.png"}
+	recent := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now()}, FileURL: "board/boards/ws/2024/01/new.png"}
+
+	repo := new(MockAttachmentRepository)
+	repo.On("FindReferencedFileKeys", mock.Anything, []string{
+		"board/boards/ws/2024/01/kept.png",
+		"board/boards/ws/2024/01/kept_thumb.png",
+		"board/boards/ws/2024/01/orphan.png",
+	}).Return(map[string]bool{
+		"board/boards/ws/2024/01/kept.png":       true,
+		"board/boards/ws/2024/01/kept_thumb.png": true,
+	}, nil)
+	repo.On("FindConfirmedAfter", mock.Anything, uuid.Nil, reconcilePageSize).
+		Return([]*domain.Attachment{missing, present, recent}, nil)
+
+	report, err := newReconcileTestJob(repo, s3, false).Reconcile(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.ScannedObjects)
+	assert.Equal(t, 3, report.ScannedRecords)
+	assert.Equal(t, 1, report.OrphanObjects)
+	assert.Equal(t, []string{"board/boards/ws/2024/01/orphan.png"}, report.OrphanObjectSamples)
+	assert.Equal(t, 1, report.MissingObjects)
+	assert.Equal(t, []uuid.UUID{missing.ID}, report.MissingObjectSamples)
+	assert.Zero(t, report.DeletedObjects)
+	assert.Zero(t, report.DeletedRecords)
+
+	// Report-only mode leaves the orphan in place
+	exists, _ := s3.FileExists(context.Background(), "board/boards/ws/2024/01/orphan.png")
+	assert.True(t, exists)
+	repo.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything)
+}
+
+func TestAttachmentReconcileJob_DeletesOrphans(t *testing.T) {
+	s3 := client.NewMockS3Client()
+	old := time.Now().Add(-48 * time.Hour)
+	seedObject(t, s3, "board/comments/ws/2024/01/orphan.pdf", old)
+
+	missing := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: old}, FileURL: "board/comments/ws/2024/01/gone.pdf"}
+
+	repo := new(MockAttachmentRepository)
+	repo.On("FindReferencedFileKeys", mock.Anything, mock.Anything).Return(map[string]bool{}, nil)
+	repo.On("FindConfirmedAfter", mock.Anything, uuid.Nil, reconcilePageSize).Return([]*domain.Attachment{missing}, nil)
+	repo.On("DeleteBatch", mock.Anything, []uuid.UUID{missing.ID}).Return(nil)
+
+	report, err := newReconcileTestJob(repo, s3, true).Reconcile(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.DeletedObjects)
+	assert.Equal(t, 1, report.DeletedRecords)
+	exists, _ := s3.FileExists(context.Background(), "board/comments/ws/2024/01/orphan.pdf")
+	assert.False(t, exists)
+	repo.AssertExpectations(t)
+}
+
+func TestAttachmentReconcileJob_PagesThroughBucket(t *testing.T) {
+	s3 := client.NewMockS3Client()
+	old := time.Now().Add(-48 * time.Hour)
+	for i := 0; i < reconcilePageSize+5; i++ {
+		seedObject(t, s3, "board/boards/ws/"+uuid.NewString()+".png", old)
+	}
+
+	repo := new(MockAttachmentRepository)
+	repo.On("FindReferencedFileKeys", mock.Anything, mock.Anything).Return(map[string]bool{}, nil)
+	repo.On("FindConfirmedAfter", mock.Anything, uuid.Nil, reconcilePageSize).Return([]*domain.Attachment{}, nil)
+
+	report, err := newReconcileTestJob(repo, s3, false).Reconcile(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, reconcilePageSize+5, report.ScannedObjects)
+	assert.Equal(t, reconcilePageSize+5, report.OrphanObjects)
+	assert.Len(t, report.OrphanObjectSamples, reconcileMaxSampleKeys)
+	repo.AssertNumberOfCalls(t, "FindReferencedFileKeys", 2)
+}
+
+func TestAttachmentReconcileJob_ListError(t *testing.T) {
+	s3 := client.NewMockS3Client()
+	s3.ListFilesFunc = func(ctx context.Context, prefix, token string, limit int) (*client.FileListPage, error) {
+		return nil, errors.New("access denied")
+	}
+	repo := new(MockAttachmentRepository)
+
+	_, err := newReconcileTestJob(repo, s3, false).Reconcile(context.Background())
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "FindConfirmedAfter", mock.Anything, mock.Anything, mock.Anything)
+}
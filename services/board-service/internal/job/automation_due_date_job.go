@@ -0,0 +1,84 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/service"
+)
+
+const (
+	// dueDateAutomationBatchSize bounds the (rule, board) pairs fired per run; the rest fire on the next run
+	dueDateAutomationBatchSize = 500
+	// dueDateAutomationLookback bounds how far back a run catches up on due dates missed while no replica ran
+	dueDateAutomationLookback = 7 * 24 * time.Hour
+)
+
+// AutomationDueDateJob fires DUE_DATE_REACHED automation rules for boards whose due date has passed
+// Each (rule, board, due date) is claimed in the database before it fires, so it fires once across
+// replicas and restarts, and boards that came due while the job was down fire on the next run
+type AutomationDueDateJob struct {
+	automationRepo repository.AutomationRepository
+	engine         service.AutomationEngine
+	logger         *zap.Logger
+	now            func() time.Time
+}
+
+// NewAutomationDueDateJob creates a new AutomationDueDateJob instance
+func NewAutomationDueDateJob(
+	automationRepo repository.AutomationRepository,
+	engine service.AutomationEngine,
+	logger *zap.Logger,
+) *AutomationDueDateJob {
+	return &AutomationDueDateJob{
+		automationRepo: automationRepo,
+		engine:         engine,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// Run executes the due date automation job
+func (j *AutomationDueDateJob) Run() {
+	ctx := context.Background()
+	now := j.now()
+
+	targets, err := j.automationRepo.FindDueDateTargets(ctx, now.Add(-dueDateAutomationLookback), now, dueDateAutomationBatchSize)
+	if err != nil {
+		j.logger.Error("Failed to find boards due for automation", zap.Error(err))
+		return
+	}
+
+	fired := 0
+	for _, target := range targets {
+		claimed, err := j.automationRepo.ClaimDueDateFiring(ctx, target, now)
+		if err != nil {
+			j.logger.Warn("Failed to claim due date automation",
+				zap.String("rule.id", target.RuleID.String()),
+				zap.String("board.id", target.BoardID.String()),
+				zap.Error(err))
+			continue
+		}
+		if !claimed {
+			// Another replica fired it
+			continue
+		}
+
+		j.engine.Process(ctx, service.AutomationEvent{
+			Trigger:   domain.AutomationTriggerDueDateReached,
+			ProjectID: target.ProjectID,
+			BoardID:   target.BoardID,
+			ActorID:   target.AuthorID,
+			RuleID:    target.RuleID,
+		})
+		fired++
+	}
+
+	if fired > 0 {
+		j.logger.Info("Due date automation job completed", zap.Int("fired", fired))
+	}
+}
@@ -85,6 +85,17 @@ func (j *CleanupJob) Run() {
 			continue
 		}
 
+		// Delete generated thumbnails and previews (best effort)
+		for _, derivedKey := range attachment.DerivedFileKeys() {
+			if err := j.s3Client.DeleteFile(ctx, derivedKey); err != nil {
+				j.logger.Warn("Failed to delete derived image from S3",
+					zap.String("attachment_id", attachment.ID.String()),
+					zap.String("file_key", derivedKey),
+					zap.Error(err),
+				)
+			}
+		}
+
 		successfulDeletionIDs = append(successfulDeletionIDs, attachment.ID)
 		successCount++
 
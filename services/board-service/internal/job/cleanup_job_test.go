@@ -72,6 +72,35 @@ func (m *MockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return args.Error(0)
 }
 
+func (m *MockAttachmentRepository) UpdateThumbnail(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error {
+	args := m.Called(ctx, id, status, thumbnailKey, previewKey)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) FindPendingThumbnails(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, createdBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindReferencedFileKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	args := m.Called(ctx, keys)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindConfirmedAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
 // MockS3Client is a mock implementation of S3ClientInterface
 type MockS3Client struct {
 	mock.Mock
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/service"
+)
+
+// OutboxRelayJob delivers outbox events that were not delivered right after their commit
+// (relay kicks lost on restart, failed attempts whose backoff has elapsed)
+type OutboxRelayJob struct {
+	outbox service.Outbox
+	logger *zap.Logger
+}
+
+// NewOutboxRelayJob creates a new OutboxRelayJob instance
+func NewOutboxRelayJob(outbox service.Outbox, logger *zap.Logger) *OutboxRelayJob {
+	return &OutboxRelayJob{
+		outbox: outbox,
+		logger: logger,
+	}
+}
+
+// Run executes the outbox relay job
+// Passes on this replica are serialized by the outbox itself; other replicas are kept off
+// the same events by the attempt lease
+func (j *OutboxRelayJob) Run() {
+	attempted, err := j.outbox.DeliverDue(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to relay outbox events", zap.Int("attempted", attempted), zap.Error(err))
+		return
+	}
+
+	if attempted > 0 {
+		j.logger.Info("Outbox relay job completed", zap.Int("attempted", attempted))
+	}
+}
+
+// Purge removes delivered outbox events past the retention period
+func (j *OutboxRelayJob) Purge() {
+	removed, err := j.outbox.PurgeDelivered(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to purge delivered outbox events", zap.Error(err))
+		return
+	}
+
+	if removed > 0 {
+		j.logger.Info("Outbox purge completed", zap.Int64("removed", removed))
+	}
+}
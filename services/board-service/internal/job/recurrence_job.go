@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/service"
+)
+
+// RecurrenceJob generates the next occurrences of recurring boards
+type RecurrenceJob struct {
+	recurrenceService service.RecurrenceService
+	logger            *zap.Logger
+
+	mu sync.Mutex
+}
+
+// NewRecurrenceJob creates a new RecurrenceJob instance
+func NewRecurrenceJob(recurrenceService service.RecurrenceService, logger *zap.Logger) *RecurrenceJob {
+	return &RecurrenceJob{
+		recurrenceService: recurrenceService,
+		logger:            logger,
+	}
+}
+
+// Run executes the recurrence job
+func (j *RecurrenceJob) Run() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	generated, err := j.recurrenceService.GenerateDue(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to generate recurring boards", zap.Int("generated", generated), zap.Error(err))
+		return
+	}
+
+	if generated > 0 {
+		j.logger.Info("Recurrence job completed", zap.Int("generated", generated))
+	}
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/service"
+)
+
+// ReminderJob delivers personal board reminders whose time has come
+type ReminderJob struct {
+	reminderService service.ReminderService
+	logger          *zap.Logger
+
+	mu sync.Mutex
+}
+
+// NewReminderJob creates a new ReminderJob instance
+func NewReminderJob(reminderService service.ReminderService, logger *zap.Logger) *ReminderJob {
+	return &ReminderJob{
+		reminderService: reminderService,
+		logger:          logger,
+	}
+}
+
+// Run executes the reminder job
+func (j *ReminderJob) Run() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	delivered, err := j.reminderService.DeliverDue(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to deliver reminders", zap.Int("delivered", delivered), zap.Error(err))
+		return
+	}
+
+	if delivered > 0 {
+		j.logger.Info("Reminder job completed", zap.Int("delivered", delivered))
+	}
+}
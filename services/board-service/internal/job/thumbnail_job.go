@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/service"
+)
+
+// ThumbnailJob generates the thumbnails of image attachments that were left PENDING
+// (e.g. because the replica that received the upload restarted)
+type ThumbnailJob struct {
+	thumbnailer service.AttachmentThumbnailer
+	logger      *zap.Logger
+
+	mu sync.Mutex
+}
+
+// NewThumbnailJob creates a new ThumbnailJob instance
+func NewThumbnailJob(thumbnailer service.AttachmentThumbnailer, logger *zap.Logger) *ThumbnailJob {
+	return &ThumbnailJob{
+		thumbnailer: thumbnailer,
+		logger:      logger,
+	}
+}
+
+// Run executes the thumbnail job
+func (j *ThumbnailJob) Run() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	attempted, err := j.thumbnailer.GeneratePending(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to generate pending thumbnails", zap.Int("attempted", attempted), zap.Error(err))
+		return
+	}
+
+	if attempted > 0 {
+		j.logger.Info("Thumbnail job completed", zap.Int("attempted", attempted))
+	}
+}
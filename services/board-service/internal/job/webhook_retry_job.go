@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/service"
+)
+
+// WebhookRetryJob re-attempts failed webhook deliveries whose backoff has elapsed
+type WebhookRetryJob struct {
+	dispatcher service.WebhookDispatcher
+	logger     *zap.Logger
+
+	mu sync.Mutex
+}
+
+// NewWebhookRetryJob creates a new WebhookRetryJob instance
+func NewWebhookRetryJob(dispatcher service.WebhookDispatcher, logger *zap.Logger) *WebhookRetryJob {
+	return &WebhookRetryJob{
+		dispatcher: dispatcher,
+		logger:     logger,
+	}
+}
+
+// Run executes the webhook retry job
+func (j *WebhookRetryJob) Run() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	attempted, err := j.dispatcher.RetryDue(context.Background())
+	if err != nil {
+		j.logger.Error("Failed to retry webhook deliveries", zap.Int("attempted", attempted), zap.Error(err))
+		return
+	}
+
+	if attempted > 0 {
+		j.logger.Info("Webhook retry job completed", zap.Int("attempted", attempted))
+	}
+}
@@ -0,0 +1,362 @@
+package markdown
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// renderInline renders inline Markdown (emphasis, code spans, links, images, autolinks).
+// Any character that is not part of Markdown syntax is HTML-escaped.
+func renderInline(s string) string {
+	var b strings.Builder
+	sc := &inlineScan{s: s}
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+		case c == '`':
+			i = renderCodeSpan(&b, s, i)
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			if text, dest, title, end, ok := sc.parseLink(i + 1); ok && isSafeURL(dest, false) {
+				b.WriteString(`<img src="` + html.EscapeString(dest) + `" alt="` + html.EscapeString(PlainText(text)) + `"`)
+				if title != "" {
+					b.WriteString(` title="` + html.EscapeString(title) + `"`)
+				}
+				b.WriteString(">")
+				i = end
+			} else {
+				b.WriteString("!")
+				i++
+			}
+		case c == '[':
+			if text, dest, title, end, ok := sc.parseLink(i); ok {
+				if isSafeURL(dest, true) {
+					b.WriteString(`<a href="` + html.EscapeString(dest) + `"`)
+					if title != "" {
+						b.WriteString(` title="` + html.EscapeString(title) + `"`)
+					}
+					b.WriteString(">" + renderInline(text) + "</a>")
+				} else {
+					// Unsafe destinations (javascript:, data:, ...) keep only the link text
+					b.WriteString(renderInline(text))
+				}
+				i = end
+			} else {
+				b.WriteString("[")
+				i++
+			}
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 && isAutolink(s[i+1:i+end]) {
+				dest := s[i+1 : i+end]
+				writeAutolink(&b, dest)
+				i += end + 1
+			} else {
+				b.WriteString("&lt;")
+				i++
+			}
+		case (c == 'h' || c == 'H') && startsBareURL(s, i):
+			end := bareURLEnd(s, i)
+			writeAutolink(&b, s[i:end])
+			i = end
+		case c == '*' || c == '_' || c == '~':
+			if rendered, end, ok := sc.renderEmphasis(i); ok {
+				b.WriteString(rendered)
+				i = end
+			} else {
+				b.WriteString(html.EscapeString(s[i : i+1]))
+				i++
+			}
+		default:
+			_, size := utf8.DecodeRuneInString(s[i:])
+			b.WriteString(html.EscapeString(s[i : i+size]))
+			i += size
+		}
+	}
+	return b.String()
+}
+
+func isASCIIPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) >= 0
+}
+
+// renderCodeSpan renders a code span starting at s[i] (a backtick run)
+func renderCodeSpan(b *strings.Builder, s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == '`' {
+		n++
+	}
+	fence := s[i : i+n]
+	for j := i + n; j < len(s); {
+		k := strings.Index(s[j:], fence)
+		if k < 0 {
+			break
+		}
+		k += j
+		// The closing run must have exactly the same length
+		if k+n < len(s) && s[k+n] == '`' {
+			j = k + n
+			for j < len(s) && s[j] == '`' {
+				j++
+			}
+			continue
+		}
+		code := strings.ReplaceAll(s[i+n:k], "\n", " ")
+		if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+		b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+		return k + n
+	}
+	b.WriteString(fence)
+	return i + n
+}
+
+// inlineScan remembers what is known about the delimiters of a string being rendered,
+// so an unmatched delimiter is not searched for again from every later position
+type inlineScan struct {
+	s        string
+	brackets []int          // index of the bracket closing the '[' at each index (-1: none), built on first use
+	destEnds []int          // end of a link destination starting at each index, built on first use
+	noCloser map[string]int // emphasis delimiter -> start from which it has no closer
+	noQuote  map[byte]int   // title quote -> index from which it is not closed
+}
+
+// closingBracket returns the index of the ']' matching the '[' at s[i], or -1
+// All brackets are matched in one pass instead of scanning to the end for each unmatched '['
+func (sc *inlineScan) closingBracket(i int) int {
+	if sc.brackets == nil {
+		s := sc.s
+		sc.brackets = make([]int, len(s))
+		var open []int
+		for j := 0; j < len(s); j++ {
+			sc.brackets[j] = -1
+			switch s[j] {
+			case '\\':
+				if j+1 < len(s) {
+					j++
+					sc.brackets[j] = -1
+				}
+			case '[':
+				open = append(open, j)
+			case ']':
+				if len(open) > 0 {
+					sc.brackets[open[len(open)-1]] = j
+					open = open[:len(open)-1]
+				}
+			}
+		}
+	}
+	return sc.brackets[i]
+}
+
+// destEnd returns the end of a link destination starting at s[k]: the first space, newline or
+// unbalanced ')', or len(s). Computed right to left once, since a '(' ends where the
+// destination after its matching ')' ends
+func (sc *inlineScan) destEnd(k int) int {
+	if sc.destEnds == nil {
+		s := sc.s
+		sc.destEnds = make([]int, len(s)+1)
+		sc.destEnds[len(s)] = len(s)
+		for j := len(s) - 1; j >= 0; j-- {
+			switch s[j] {
+			case ' ', '\n', ')':
+				sc.destEnds[j] = j
+			case '(':
+				inner := sc.destEnds[j+1]
+				if inner < len(s) && s[inner] == ')' {
+					sc.destEnds[j] = sc.destEnds[inner+1]
+				} else {
+					sc.destEnds[j] = inner
+				}
+			default:
+				sc.destEnds[j] = sc.destEnds[j+1]
+			}
+		}
+	}
+	return sc.destEnds[k]
+}
+
+// parseLink parses [text](dest "title") starting at the opening bracket
+func (sc *inlineScan) parseLink(i int) (text, dest, title string, end int, ok bool) {
+	s := sc.s
+	j := sc.closingBracket(i)
+	if j < 0 || j+1 >= len(s) || s[j+1] != '(' {
+		return "", "", "", 0, false
+	}
+	text = s[i+1 : j]
+
+	k := j + 2
+	for k < len(s) && s[k] == ' ' {
+		k++
+	}
+	destStart := k
+	k = sc.destEnd(k)
+	dest = strings.TrimSuffix(strings.TrimPrefix(s[destStart:k], "<"), ">")
+
+	for k < len(s) && (s[k] == ' ' || s[k] == '\n') {
+		k++
+	}
+	if k < len(s) && (s[k] == '"' || s[k] == '\'') {
+		quote := s[k]
+		if from, ok := sc.noQuote[quote]; ok && k >= from {
+			return "", "", "", 0, false
+		}
+		closing := strings.IndexByte(s[k+1:], quote)
+		if closing < 0 {
+			if sc.noQuote == nil {
+				sc.noQuote = make(map[byte]int)
+			}
+			sc.noQuote[quote] = k
+			return "", "", "", 0, false
+		}
+		title = s[k+1 : k+1+closing]
+		k += closing + 2
+		for k < len(s) && s[k] == ' ' {
+			k++
+		}
+	}
+	if k >= len(s) || s[k] != ')' {
+		return "", "", "", 0, false
+	}
+	return text, dest, title, k + 1, true
+}
+
+// isSafeURL allows http(s), mailto (links only) and relative URLs
+func isSafeURL(dest string, allowMailto bool) bool {
+	dest = strings.TrimSpace(dest)
+	if dest == "" {
+		return false
+	}
+	colon := strings.IndexByte(dest, ':')
+	if colon < 0 {
+		return true
+	}
+	// A colon after the first path, query or fragment delimiter is not a scheme
+	if delim := strings.IndexAny(dest, "/?#"); delim >= 0 && delim < colon {
+		return true
+	}
+	switch strings.ToLower(dest[:colon]) {
+	case "http", "https":
+		return true
+	case "mailto":
+		return allowMailto
+	}
+	return false
+}
+
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " \n<") {
+		return false
+	}
+	lower := strings.ToLower(s)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "mailto:")
+}
+
+func writeAutolink(b *strings.Builder, dest string) {
+	escaped := html.EscapeString(dest)
+	b.WriteString(`<a href="` + escaped + `">` + escaped + "</a>")
+}
+
+// startsBareURL reports whether an http(s) URL starts at s[i] on a word boundary
+func startsBareURL(s string, i int) bool {
+	if i > 0 {
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if unicode.IsLetter(prev) || unicode.IsDigit(prev) || prev == '/' {
+			return false
+		}
+	}
+	lower := strings.ToLower(s[i:min(len(s), i+8)])
+	return strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://")
+}
+
+// bareURLEnd finds the end of a bare URL, dropping trailing punctuation and unbalanced parentheses
+func bareURLEnd(s string, i int) int {
+	end := i
+	for end < len(s) && !unicode.IsSpace(rune(s[end])) && s[end] != '<' {
+		end++
+	}
+	for end > i {
+		last := s[end-1]
+		if strings.IndexByte(".,;:!?'\"*_~", last) >= 0 {
+			end--
+			continue
+		}
+		if last == ')' && strings.Count(s[i:end], "(") < strings.Count(s[i:end], ")") {
+			end--
+			continue
+		}
+		break
+	}
+	return end
+}
+
+// renderEmphasis renders **strong**, __strong__, *em*, _em_ and ~~del~~ starting at s[i]
+// A delimiter without a closer is remembered: a later opener of the same kind cannot have one either
+func (sc *inlineScan) renderEmphasis(i int) (string, int, bool) {
+	s := sc.s
+	c := s[i]
+	double := i+1 < len(s) && s[i+1] == c
+	if c == '~' && !double {
+		return "", 0, false
+	}
+	// Intraword underscores (snake_case) are not emphasis
+	if c == '_' && i > 0 {
+		if prev, _ := utf8.DecodeLastRuneInString(s[:i]); isWordRune(prev) {
+			return "", 0, false
+		}
+	}
+
+	n := 1
+	if double {
+		n = 2
+	}
+	start := i + n
+	if start >= len(s) || unicode.IsSpace(rune(s[start])) {
+		return "", 0, false
+	}
+	delim := s[i : i+n]
+	if from, ok := sc.noCloser[delim]; ok && start >= from {
+		return "", 0, false
+	}
+
+	for j := start + 1; j+n <= len(s); j++ {
+		if s[j] != c || unicode.IsSpace(rune(s[j-1])) {
+			continue
+		}
+		if double {
+			if s[j+1] != c {
+				continue
+			}
+		} else if (j+1 < len(s) && s[j+1] == c) || s[j-1] == c {
+			// Part of a double delimiter; keep looking for a single one
+			continue
+		}
+		if c == '_' && j+n < len(s) {
+			if next, _ := utf8.DecodeRuneInString(s[j+n:]); isWordRune(next) {
+				continue
+			}
+		}
+		inner := renderInline(s[start:j])
+		tag := "em"
+		switch {
+		case c == '~':
+			tag = "del"
+		case double:
+			tag = "strong"
+		}
+		return "<" + tag + ">" + inner + "</" + tag + ">", j + n, true
+	}
+	// Whether s[j] closes the delimiter depends only on j, so no later start finds a closer
+	if sc.noCloser == nil {
+		sc.noCloser = make(map[string]int)
+	}
+	sc.noCloser[delim] = start
+	return "", 0, false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
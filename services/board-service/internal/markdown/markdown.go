@@ -0,0 +1,332 @@
+// Package markdown renders board and comment content (Markdown) to safe HTML and plain text.
+//
+// The renderer supports the subset of CommonMark/GFM used in boards: headings, paragraphs,
+// emphasis, strikethrough, code spans and blocks, block quotes, lists, rules, links, images
+// and bare URLs. Raw HTML in the source is never interpreted; it is shown as text.
+// The rendered HTML always passes through the allow-list sanitizer before it is returned.
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`^(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	rulePattern     = regexp.MustCompile(`^(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	listItemPattern = regexp.MustCompile(`^([-*+]|\d{1,9}[.)])(?:[ \t]+(.*))?$`)
+	setextPattern   = regexp.MustCompile(`^(=+|-+)[ \t]*$`)
+	languagePattern = regexp.MustCompile(`^[A-Za-z0-9_+#.-]{1,32}$`)
+)
+
+// Render converts Markdown to sanitized HTML
+func Render(src string) string {
+	if strings.TrimSpace(src) == "" {
+		return ""
+	}
+	var b strings.Builder
+	renderBlocks(&b, splitLines(src), false)
+	return Sanitize(b.String())
+}
+
+// splitLines normalizes line endings and expands tabs used for indentation
+func splitLines(src string) []string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		lines[i] = expandLeadingTabs(line)
+	}
+	return lines
+}
+
+func expandLeadingTabs(line string) string {
+	if !strings.HasPrefix(line, "\t") && !strings.HasPrefix(line, " \t") {
+		return line
+	}
+	var b strings.Builder
+	col := 0
+	i := 0
+	for ; i < len(line) && (line[i] == ' ' || line[i] == '\t'); i++ {
+		if line[i] == '\t' {
+			n := 4 - col%4
+			b.WriteString(strings.Repeat(" ", n))
+			col += n
+		} else {
+			b.WriteByte(' ')
+			col++
+		}
+	}
+	b.WriteString(line[i:])
+	return b.String()
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// fenceOf returns the fence marker ("```" or "~~~", possibly longer) that opens a code block
+func fenceOf(trimmed string) string {
+	for _, ch := range []byte{'`', '~'} {
+		n := 0
+		for n < len(trimmed) && trimmed[n] == ch {
+			n++
+		}
+		if n >= 3 {
+			if ch == '`' && strings.Contains(trimmed[n:], "`") {
+				return ""
+			}
+			return trimmed[:n]
+		}
+	}
+	return ""
+}
+
+// startsBlock reports whether a line interrupts a paragraph
+func startsBlock(line string) bool {
+	if indentOf(line) >= 4 {
+		return false
+	}
+	trimmed := strings.TrimSpace(line)
+	return fenceOf(trimmed) != "" ||
+		headingPattern.MatchString(trimmed) ||
+		rulePattern.MatchString(trimmed) ||
+		strings.HasPrefix(trimmed, ">") ||
+		listItemPattern.MatchString(trimmed)
+}
+
+// renderBlocks renders block-level Markdown. In tight mode (list items without blank lines)
+// paragraphs are not wrapped in <p>.
+func renderBlocks(b *strings.Builder, lines []string, tight bool) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			i++
+			continue
+		}
+		indent := indentOf(line)
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case indent >= 4:
+			i = renderIndentedCode(b, lines, i)
+		case fenceOf(trimmed) != "":
+			i = renderFencedCode(b, lines, i)
+		case headingPattern.MatchString(trimmed):
+			m := headingPattern.FindStringSubmatch(trimmed)
+			level := strconv.Itoa(len(m[1]))
+			b.WriteString("<h" + level + ">" + renderInline(m[2]) + "</h" + level + ">\n")
+			i++
+		case rulePattern.MatchString(trimmed):
+			b.WriteString("<hr>\n")
+			i++
+		case strings.HasPrefix(trimmed, ">"):
+			i = renderBlockquote(b, lines, i)
+		case listItemPattern.MatchString(trimmed):
+			i = renderList(b, lines, i)
+		default:
+			i = renderParagraph(b, lines, i, tight)
+		}
+	}
+}
+
+func renderIndentedCode(b *strings.Builder, lines []string, i int) int {
+	var code []string
+	for ; i < len(lines) && (isBlank(lines[i]) || indentOf(lines[i]) >= 4); i++ {
+		if isBlank(lines[i]) {
+			code = append(code, "")
+		} else {
+			code = append(code, lines[i][4:])
+		}
+	}
+	for len(code) > 0 && code[len(code)-1] == "" {
+		code = code[:len(code)-1]
+	}
+	b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "\n</code></pre>\n")
+	return i
+}
+
+func renderFencedCode(b *strings.Builder, lines []string, i int) int {
+	indent := indentOf(lines[i])
+	trimmed := strings.TrimSpace(lines[i])
+	fence := fenceOf(trimmed)
+	info := strings.Fields(strings.TrimSpace(trimmed[len(fence):]))
+
+	var code []string
+	for i++; i < len(lines); i++ {
+		t := strings.TrimSpace(lines[i])
+		if indentOf(lines[i]) < 4 && strings.HasPrefix(t, fence) && strings.Trim(t, fence[:1]) == "" {
+			i++
+			break
+		}
+		line := lines[i]
+		if strip := min(indent, indentOf(line)); strip > 0 {
+			line = line[strip:]
+		}
+		code = append(code, line)
+	}
+
+	b.WriteString("<pre><code")
+	if len(info) > 0 && languagePattern.MatchString(info[0]) {
+		b.WriteString(` class="language-` + html.EscapeString(info[0]) + `"`)
+	}
+	b.WriteString(">")
+	if len(code) > 0 {
+		b.WriteString(html.EscapeString(strings.Join(code, "\n")) + "\n")
+	}
+	b.WriteString("</code></pre>\n")
+	return i
+}
+
+func renderBlockquote(b *strings.Builder, lines []string, i int) int {
+	var inner []string
+	for ; i < len(lines); i++ {
+		t := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(t, ">") {
+			t = strings.TrimPrefix(t, ">")
+			t = strings.TrimPrefix(t, " ")
+			inner = append(inner, t)
+			continue
+		}
+		// Lazy continuation of a paragraph inside the quote
+		if !isBlank(lines[i]) && len(inner) > 0 && !isBlank(inner[len(inner)-1]) && !startsBlock(lines[i]) {
+			inner = append(inner, t)
+			continue
+		}
+		break
+	}
+	b.WriteString("<blockquote>\n")
+	renderBlocks(b, inner, false)
+	b.WriteString("</blockquote>\n")
+	return i
+}
+
+// listMarker parses a list item line and returns its kind, start number, content and content indent
+func listMarker(line string) (ordered bool, start int, content string, contentIndent int, ok bool) {
+	indent := indentOf(line)
+	if indent >= 4 {
+		return false, 0, "", 0, false
+	}
+	trimmed := strings.TrimSpace(line)
+	m := listItemPattern.FindStringSubmatch(trimmed)
+	if m == nil {
+		return false, 0, "", 0, false
+	}
+	marker := m[1]
+	ordered = marker[len(marker)-1] == '.' || marker[len(marker)-1] == ')'
+	if ordered {
+		start, _ = strconv.Atoi(marker[:len(marker)-1])
+	}
+	rest := line[indent+len(marker):]
+	spaces := len(rest) - len(strings.TrimLeft(rest, " "))
+	if spaces == 0 || spaces > 4 {
+		spaces = 1
+	}
+	return ordered, start, m[2], indent + len(marker) + spaces, true
+}
+
+func renderList(b *strings.Builder, lines []string, i int) int {
+	ordered, start, _, _, _ := listMarker(lines[i])
+
+	var items [][]string
+	tight := true
+	for i < len(lines) {
+		itemOrdered, _, content, contentIndent, ok := listMarker(lines[i])
+		if !ok || itemOrdered != ordered {
+			break
+		}
+		if len(items) > 0 && isBlank(lines[i-1]) {
+			tight = false
+		}
+		item := []string{content}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if isBlank(line) {
+				// A blank line continues the item only when indented content follows
+				next := i + 1
+				for next < len(lines) && isBlank(lines[next]) {
+					next++
+				}
+				if next < len(lines) && indentOf(lines[next]) >= contentIndent {
+					item = append(item, "")
+					tight = false
+					i = next - 1
+					continue
+				}
+				i = next
+				break
+			}
+			if indentOf(line) >= contentIndent {
+				item = append(item, line[contentIndent:])
+				continue
+			}
+			// Lazy paragraph continuation
+			if !startsBlock(line) && !isBlank(item[len(item)-1]) {
+				item = append(item, strings.TrimSpace(line))
+				continue
+			}
+			break
+		}
+		items = append(items, item)
+	}
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+	b.WriteString("<" + tag)
+	if ordered && start != 1 {
+		b.WriteString(` start="` + strconv.Itoa(start) + `"`)
+	}
+	b.WriteString(">\n")
+	for _, item := range items {
+		b.WriteString("<li>")
+		var inner strings.Builder
+		renderBlocks(&inner, item, tight)
+		b.WriteString(strings.TrimSuffix(inner.String(), "\n"))
+		b.WriteString("</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+	return i
+}
+
+func renderParagraph(b *strings.Builder, lines []string, i int, tight bool) int {
+	var para []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlank(line) {
+			break
+		}
+		if len(para) > 0 && indentOf(line) < 4 {
+			// Setext heading underline turns the paragraph into a heading
+			if m := setextPattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				level := "2"
+				if m[1][0] == '=' {
+					level = "1"
+				}
+				b.WriteString("<h" + level + ">" + renderInline(strings.Join(para, "\n")) + "</h" + level + ">\n")
+				return i + 1
+			}
+			if startsBlock(line) {
+				break
+			}
+		}
+		para = append(para, strings.TrimSpace(line))
+	}
+
+	// Every line break inside a paragraph is kept, as users of the board editor expect
+	text := renderInline(strings.Join(para, "\n"))
+	text = strings.ReplaceAll(text, "\n", "<br>\n")
+	if tight {
+		b.WriteString(text + "\n")
+	} else {
+		b.WriteString("<p>" + text + "</p>\n")
+	}
+	return i
+}
@@ -0,0 +1,187 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "빈 내용",
+			src:  "  \n ",
+			want: "",
+		},
+		{
+			name: "제목과 문단",
+			src:  "# 제목\n\n본문 **굵게** _기울임_ ~~취소~~",
+			want: "<h1>제목</h1>\n<p>본문 <strong>굵게</strong> <em>기울임</em> <del>취소</del></p>\n",
+		},
+		{
+			name: "문단 내 줄바꿈 유지",
+			src:  "첫 줄\n둘째 줄",
+			want: "<p>첫 줄<br>\n둘째 줄</p>\n",
+		},
+		{
+			name: "목록",
+			src:  "- 하나\n- 둘\n\n3. 셋\n4. 넷",
+			want: "<ul>\n<li>하나</li>\n<li>둘</li>\n</ul>\n<ol start=\"3\">\n<li>셋</li>\n<li>넷</li>\n</ol>\n",
+		},
+		{
+			name: "코드 블록과 코드 스팬",
+			src:  "```go\nif a < b {}\n```\n\n`<b>`",
+			want: "<pre><code class=\"language-go\">if a &lt; b {}\n</code></pre>\n<p><code>&lt;b&gt;</code></p>\n",
+		},
+		{
+			name: "인용",
+			src:  "> 인용문",
+			want: "<blockquote>\n<p>인용문</p>\n</blockquote>\n",
+		},
+		{
+			name: "링크와 이미지",
+			src:  "[문서](https://example.com/doc \"설명\") ![로고](/img/logo.png)",
+			want: "<p><a href=\"https://example.com/doc\" title=\"설명\" rel=\"nofollow noopener noreferrer\">문서</a> <img src=\"/img/logo.png\" alt=\"로고\"></p>\n",
+		},
+		{
+			name: "URL 자동 링크",
+			src:  "참고: https://example.com/a_b.",
+			want: "<p>참고: <a href=\"https://example.com/a_b\" rel=\"nofollow noopener noreferrer\">https://example.com/a_b</a>.</p>\n",
+		},
+		{
+			name: "snake_case는 강조가 아님",
+			src:  "user_id_value",
+			want: "<p>user_id_value</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Render(tt.src))
+		})
+	}
+}
+
+func TestRender_XSS(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "script 태그", src: "<script>alert(1)</script>"},
+		{name: "이벤트 핸들러 속성", src: `<img src="x" onerror="alert(1)">`},
+		{name: "javascript 링크", src: "[클릭](javascript:alert(1))"},
+		{name: "제어 문자가 섞인 javascript 링크", src: "[클릭](java\tscript:alert(1))"},
+		{name: "javascript 이미지", src: "![x](javascript:alert(1))"},
+		{name: "data URL 이미지", src: "![x](data:text/html;base64,PHNjcmlwdD4=)"},
+		{name: "javascript 자동 링크", src: "<javascript:alert(1)>"},
+		{name: "속성 탈출 시도", src: `[a](https://example.com/"onmouseover="alert(1))`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := Render(tt.src)
+			assert.NotContains(t, rendered, "<script")
+			assert.NotContains(t, rendered, "<img src=\"x\"")
+			assert.NotContains(t, strings.ToLower(rendered), "href=\"javascript")
+			assert.NotContains(t, strings.ToLower(rendered), "src=\"javascript")
+			assert.NotContains(t, rendered, "src=\"data:")
+			assert.NotContains(t, rendered, "\" onmouseover")
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "허용되지 않은 태그는 텍스트만 유지",
+			in:   `<div class="x"><span>안녕</span></div>`,
+			want: "안녕",
+		},
+		{
+			name: "script 내용 제거",
+			in:   `<p>a<script>alert(1)</script>b</p>`,
+			want: "<p>ab</p>",
+		},
+		{
+			name: "허용되지 않은 속성 제거",
+			in:   `<a href="https://example.com" onclick="x()" style="color:red">a</a>`,
+			want: `<a href="https://example.com" rel="nofollow noopener noreferrer">a</a>`,
+		},
+		{
+			name: "닫히지 않은 태그 닫기",
+			in:   `<p><strong>a`,
+			want: "<p><strong>a</strong></p>",
+		},
+		{
+			name: "잘못된 code class 제거",
+			in:   `<code class="x onload">a</code>`,
+			want: "<code>a</code>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sanitize(tt.in))
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	src := "# 회의록\n\n- **결정**: [문서](https://example.com) 참고\n- ![다이어그램](/d.png)\n\n<script>alert(1)</script>"
+	assert.Equal(t, "회의록 결정: 문서 참고 다이어그램 <script>alert(1)</script>", PlainText(src))
+}
+
+func TestPreview(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		maxRunes int
+		want     string
+	}{
+		{name: "짧은 내용은 그대로", src: "**안녕하세요**", maxRunes: 10, want: "안녕하세요"},
+		{name: "룬 단위로 자르기", src: "가나다라마바사", maxRunes: 3, want: "가나다..."},
+		{name: "빈 내용", src: "", maxRunes: 3, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Preview(tt.src, tt.maxRunes))
+		})
+	}
+}
+
+func TestRender_UnmatchedDelimitersRenderInLinearTime(t *testing.T) {
+	const n = 50000
+	inputs := map[string]string{
+		"여는 대괄호":       strings.Repeat("[", n),
+		"닫히지 않은 링크 주소": strings.Repeat("[a](", n),
+		"닫히지 않은 링크 제목": strings.Repeat(`[a](b "`, n),
+		"닫히지 않은 강조":    strings.Repeat("*a ", n),
+		"닫히지 않은 굵게":    strings.Repeat("**a ", n),
+		"닫히지 않은 밑줄":    strings.Repeat("_a ", n),
+		"닫히지 않은 취소선":   strings.Repeat("~~a ", n),
+	}
+
+	for name, src := range inputs {
+		t.Run(name, func(t *testing.T) {
+			start := time.Now()
+			Render(src)
+			assert.Less(t, time.Since(start), 2*time.Second)
+		})
+	}
+}
+
+func TestRender_UnmatchedDelimiterBeforeMatchedOne(t *testing.T) {
+	want := "<p>[<a href=\"https://example.com\" rel=\"nofollow noopener noreferrer\">a</a> [b](c <em>d</em> <del>e</del></p>\n"
+	assert.Equal(t, want, Render("[[a](https://example.com) [b](c *d* ~~e~~"))
+}
@@ -0,0 +1,53 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// PlainText extracts the text of Markdown content, e.g. for previews, search and notifications.
+// Markup is removed, image alt texts are kept and all whitespace is collapsed to single spaces.
+func PlainText(src string) string {
+	if strings.TrimSpace(src) == "" {
+		return ""
+	}
+	var b strings.Builder
+	renderBlocks(&b, splitLines(src), false)
+
+	var text strings.Builder
+	z := nethtml.NewTokenizer(strings.NewReader(Sanitize(b.String())))
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		tok := z.Token()
+		switch tt {
+		case nethtml.TextToken:
+			text.WriteString(tok.Data)
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken, nethtml.EndTagToken:
+			if tok.Data == "img" {
+				for _, attr := range tok.Attr {
+					if attr.Key == "alt" {
+						text.WriteString(" " + attr.Val + " ")
+					}
+				}
+			} else if blockTags[tok.Data] {
+				text.WriteString(" ")
+			}
+		}
+	}
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+// Preview returns the plain text of Markdown content truncated to maxRunes characters
+func Preview(src string, maxRunes int) string {
+	text := PlainText(src)
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+	return strings.TrimRightFunc(string(runes[:maxRunes]), unicode.IsSpace) + "..."
+}
@@ -0,0 +1,144 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// allowedTags lists the elements kept by Sanitize with their allowed attributes
+var allowedTags = map[string]map[string]bool{
+	"p": {}, "br": {}, "hr": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+	"strong": {}, "em": {}, "del": {}, "code": {"class": true}, "pre": {},
+	"blockquote": {}, "ul": {}, "ol": {"start": true}, "li": {},
+	"a":   {"href": true, "title": true},
+	"img": {"src": true, "alt": true, "title": true},
+}
+
+// voidTags never have content or an end tag
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// droppedTags are removed together with their content
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"template": true, "noscript": true, "textarea": true, "title": true, "svg": true, "math": true,
+}
+
+// blockTags separate words when the HTML is converted to plain text
+var blockTags = map[string]bool{
+	"p": true, "br": true, "hr": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"pre": true, "blockquote": true, "ul": true, "ol": true, "li": true,
+}
+
+var (
+	codeClassPattern = regexp.MustCompile(`^language-[A-Za-z0-9_+#.-]{1,32}$`)
+	digitsPattern    = regexp.MustCompile(`^\d{1,9}$`)
+)
+
+// Sanitize filters HTML through an allow-list of tags and attributes.
+// Disallowed tags are removed (their text is kept, except for script-like elements),
+// URLs are limited to http(s), mailto and relative links, links get rel="nofollow noopener noreferrer"
+// and unclosed tags are closed so the fragment can be embedded safely.
+func Sanitize(fragment string) string {
+	var b strings.Builder
+	var open []string
+	dropDepth := 0
+
+	z := nethtml.NewTokenizer(strings.NewReader(fragment))
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			// io.EOF or a malformed document; everything read so far has been filtered
+			break
+		}
+		tok := z.Token()
+
+		switch tt {
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if droppedTags[tok.Data] {
+				if tt == nethtml.StartTagToken {
+					dropDepth++
+				}
+				continue
+			}
+			attrs, ok := allowedTags[tok.Data]
+			if dropDepth > 0 || !ok {
+				continue
+			}
+			b.WriteString("<" + tok.Data)
+			for _, attr := range tok.Attr {
+				if value, keep := sanitizeAttr(tok.Data, attr, attrs); keep {
+					b.WriteString(" " + attr.Key + `="` + html.EscapeString(value) + `"`)
+				}
+			}
+			if tok.Data == "a" {
+				b.WriteString(` rel="nofollow noopener noreferrer"`)
+			}
+			b.WriteString(">")
+			if !voidTags[tok.Data] && tt == nethtml.StartTagToken {
+				open = append(open, tok.Data)
+			}
+		case nethtml.EndTagToken:
+			if droppedTags[tok.Data] {
+				if dropDepth > 0 {
+					dropDepth--
+				}
+				continue
+			}
+			if dropDepth > 0 {
+				continue
+			}
+			// Close only tags that are open, closing any unclosed children first
+			for idx := len(open) - 1; idx >= 0; idx-- {
+				if open[idx] == tok.Data {
+					for len(open) > idx {
+						b.WriteString("</" + open[len(open)-1] + ">")
+						open = open[:len(open)-1]
+					}
+					break
+				}
+			}
+		case nethtml.TextToken:
+			if dropDepth == 0 {
+				b.WriteString(html.EscapeString(tok.Data))
+			}
+		}
+	}
+	for len(open) > 0 {
+		b.WriteString("</" + open[len(open)-1] + ">")
+		open = open[:len(open)-1]
+	}
+	return b.String()
+}
+
+// sanitizeAttr validates an attribute of an allowed tag
+func sanitizeAttr(tag string, attr nethtml.Attribute, allowed map[string]bool) (string, bool) {
+	if attr.Namespace != "" || !allowed[attr.Key] {
+		return "", false
+	}
+	switch {
+	case attr.Key == "href":
+		return attr.Val, isSafeURL(stripControl(attr.Val), true)
+	case attr.Key == "src":
+		return attr.Val, isSafeURL(stripControl(attr.Val), false)
+	case tag == "code" && attr.Key == "class":
+		return attr.Val, codeClassPattern.MatchString(attr.Val)
+	case tag == "ol" && attr.Key == "start":
+		return attr.Val, digitsPattern.MatchString(attr.Val)
+	}
+	return attr.Val, true
+}
+
+// stripControl removes whitespace and control characters browsers ignore inside URL schemes (e.g. "java\tscript:")
+func stripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -1,6 +1,8 @@
 // Package metrics는 애플리케이션의 Prometheus 메트릭을 제공합니다.
 package metrics
 
+import "time"
+
 // IncrementProjectCreated는 프로젝트 생성 카운터를 증가시킵니다.
 func (m *Metrics) IncrementProjectCreated() {
 	if m.ProjectCreatedTotal != nil {
@@ -28,3 +30,59 @@ func (m *Metrics) SetBoardsTotal(count int64) {
 		m.BoardsTotal.Set(float64(count))
 	}
 }
+
+// RecordAttachmentReconcile는 성공한 첨부파일 정합성 점검 결과를 기록합니다.
+func (m *Metrics) RecordAttachmentReconcile(orphanObjects, missingObjects, deleted int, finishedAt time.Time) {
+	if m.AttachmentReconcileRunsTotal != nil {
+		m.AttachmentReconcileRunsTotal.Inc()
+	}
+	if m.AttachmentOrphanObjects != nil {
+		m.AttachmentOrphanObjects.Set(float64(orphanObjects))
+	}
+	if m.AttachmentMissingObjects != nil {
+		m.AttachmentMissingObjects.Set(float64(missingObjects))
+	}
+	if m.AttachmentOrphansDeletedTotal != nil {
+		m.AttachmentOrphansDeletedTotal.Add(float64(deleted))
+	}
+	if m.AttachmentReconcileLastSuccess != nil {
+		m.AttachmentReconcileLastSuccess.Set(float64(finishedAt.Unix()))
+	}
+}
+
+// RecordAttachmentReconcileFailure는 실패한 첨부파일 정합성 점검을 기록합니다.
+func (m *Metrics) RecordAttachmentReconcileFailure() {
+	if m.AttachmentReconcileRunsTotal != nil {
+		m.AttachmentReconcileRunsTotal.Inc()
+	}
+	if m.AttachmentReconcileFailuresTotal != nil {
+		m.AttachmentReconcileFailuresTotal.Inc()
+	}
+}
+
+// RecordOutboxDelivered는 전달 완료된 outbox 이벤트를 기록합니다.
+func (m *Metrics) RecordOutboxDelivered() {
+	if m.OutboxEventsDeliveredTotal != nil {
+		m.OutboxEventsDeliveredTotal.Inc()
+	}
+}
+
+// RecordOutboxFailure는 실패한 outbox 전달 시도를 기록합니다. dead이면 재시도 소진도 함께 기록합니다.
+func (m *Metrics) RecordOutboxFailure(dead bool) {
+	if m.OutboxDeliveryFailuresTotal != nil {
+		m.OutboxDeliveryFailuresTotal.Inc()
+	}
+	if dead && m.OutboxEventsDeadTotal != nil {
+		m.OutboxEventsDeadTotal.Inc()
+	}
+}
+
+// SetOutboxBacklog는 대기 중인 outbox 이벤트 수와 가장 오래된 이벤트의 지연 시간을 설정합니다.
+func (m *Metrics) SetOutboxBacklog(pending int64, lag time.Duration) {
+	if m.OutboxPendingEvents != nil {
+		m.OutboxPendingEvents.Set(float64(pending))
+	}
+	if m.OutboxLagSeconds != nil {
+		m.OutboxLagSeconds.Set(lag.Seconds())
+	}
+}
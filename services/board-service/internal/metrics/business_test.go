@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
@@ -131,6 +132,67 @@ func TestBusinessMetricsIntegration(t *testing.T) {
 	}
 }
 
+func TestRecordAttachmentReconcile(t *testing.T) {
+	m := getTestMetrics()
+
+	initialRuns := getCounterValue(t, m.AttachmentReconcileRunsTotal)
+	initialDeleted := getCounterValue(t, m.AttachmentOrphansDeletedTotal)
+	finishedAt := time.Unix(1700000000, 0)
+
+	m.RecordAttachmentReconcile(3, 2, 4, finishedAt)
+
+	if getGaugeValue(t, m.AttachmentOrphanObjects) != 3 {
+		t.Error("Expected AttachmentOrphanObjects to be 3")
+	}
+	if getGaugeValue(t, m.AttachmentMissingObjects) != 2 {
+		t.Error("Expected AttachmentMissingObjects to be 2")
+	}
+	if getCounterValue(t, m.AttachmentOrphansDeletedTotal) != initialDeleted+4 {
+		t.Error("Expected AttachmentOrphansDeletedTotal to increase by 4")
+	}
+	if getCounterValue(t, m.AttachmentReconcileRunsTotal) != initialRuns+1 {
+		t.Error("Expected AttachmentReconcileRunsTotal to increment")
+	}
+	if getGaugeValue(t, m.AttachmentReconcileLastSuccess) != float64(finishedAt.Unix()) {
+		t.Error("Expected AttachmentReconcileLastSuccess to be the finish time")
+	}
+
+	initialFailures := getCounterValue(t, m.AttachmentReconcileFailuresTotal)
+	m.RecordAttachmentReconcileFailure()
+	if getCounterValue(t, m.AttachmentReconcileFailuresTotal) != initialFailures+1 {
+		t.Error("Expected AttachmentReconcileFailuresTotal to increment")
+	}
+}
+
+func TestRecordOutbox(t *testing.T) {
+	m := getTestMetrics()
+
+	initialDelivered := getCounterValue(t, m.OutboxEventsDeliveredTotal)
+	initialFailures := getCounterValue(t, m.OutboxDeliveryFailuresTotal)
+	initialDead := getCounterValue(t, m.OutboxEventsDeadTotal)
+
+	m.RecordOutboxDelivered()
+	m.RecordOutboxFailure(false)
+	m.RecordOutboxFailure(true)
+	m.SetOutboxBacklog(7, 90*time.Second)
+
+	if getCounterValue(t, m.OutboxEventsDeliveredTotal) != initialDelivered+1 {
+		t.Error("Expected OutboxEventsDeliveredTotal to increment")
+	}
+	if getCounterValue(t, m.OutboxDeliveryFailuresTotal) != initialFailures+2 {
+		t.Error("Expected OutboxDeliveryFailuresTotal to increase by 2")
+	}
+	if getCounterValue(t, m.OutboxEventsDeadTotal) != initialDead+1 {
+		t.Error("Expected OutboxEventsDeadTotal to increment")
+	}
+	if getGaugeValue(t, m.OutboxPendingEvents) != 7 {
+		t.Error("Expected OutboxPendingEvents to be 7")
+	}
+	if getGaugeValue(t, m.OutboxLagSeconds) != 90 {
+		t.Error("Expected OutboxLagSeconds to be 90")
+	}
+}
+
 // Helper function to get counter value
 func getCounterValue(t *testing.T, counter prometheus.Counter) float64 {
 	t.Helper()
@@ -22,6 +22,21 @@ type Metrics struct {
 	BoardsTotal         prometheus.Gauge   // 보드 총 개수
 	ProjectCreatedTotal prometheus.Counter // 프로젝트 생성 이벤트 총 횟수
 	BoardCreatedTotal   prometheus.Counter // 보드 생성 이벤트 총 횟수
+
+	// 첨부파일 S3 ↔ DB 정합성 점검 메트릭
+	AttachmentOrphanObjects          prometheus.Gauge   // 마지막 점검에서 DB 레코드가 없는 S3 객체 수
+	AttachmentMissingObjects         prometheus.Gauge   // 마지막 점검에서 S3 객체가 없는 CONFIRMED 레코드 수
+	AttachmentOrphansDeletedTotal    prometheus.Counter // 정합성 점검으로 삭제된 객체/레코드 총 개수
+	AttachmentReconcileRunsTotal     prometheus.Counter // 정합성 점검 실행 총 횟수
+	AttachmentReconcileFailuresTotal prometheus.Counter // 정합성 점검 실패 총 횟수
+	AttachmentReconcileLastSuccess   prometheus.Gauge   // 마지막 성공 시각 (unix seconds)
+
+	// Outbox relay 메트릭
+	OutboxEventsDeliveredTotal  prometheus.Counter // 전달 완료된 outbox 이벤트 총 개수
+	OutboxDeliveryFailuresTotal prometheus.Counter // 실패한 전달 시도 총 횟수
+	OutboxEventsDeadTotal       prometheus.Counter // 재시도 소진으로 DEAD 처리된 이벤트 총 개수
+	OutboxPendingEvents         prometheus.Gauge   // 전달 대기 중인 이벤트 수
+	OutboxLagSeconds            prometheus.Gauge   // 가장 오래된 대기 이벤트의 경과 시간 (초)
 }
 
 // New는 기본 레지스트리에 모든 메트릭을 생성하고 등록합니다.
@@ -66,5 +81,18 @@ func newWithCommon(common *commonmetrics.Metrics) *Metrics {
 		BoardsTotal:         common.RegisterGauge("boards_total", "Total number of boards"),
 		ProjectCreatedTotal: common.RegisterCounter("project_created_total", "Total number of project creation events"),
 		BoardCreatedTotal:   common.RegisterCounter("board_created_total", "Total number of board creation events"),
+
+		AttachmentOrphanObjects:          common.RegisterGauge("attachment_orphan_objects", "S3 objects without an attachment record found by the last reconciliation"),
+		AttachmentMissingObjects:         common.RegisterGauge("attachment_missing_objects", "Confirmed attachment records without an S3 object found by the last reconciliation"),
+		AttachmentOrphansDeletedTotal:    common.RegisterCounter("attachment_orphans_deleted_total", "Total number of orphan objects and records deleted by reconciliation"),
+		AttachmentReconcileRunsTotal:     common.RegisterCounter("attachment_reconcile_runs_total", "Total number of attachment reconciliation runs"),
+		AttachmentReconcileFailuresTotal: common.RegisterCounter("attachment_reconcile_failures_total", "Total number of failed attachment reconciliation runs"),
+		AttachmentReconcileLastSuccess:   common.RegisterGauge("attachment_reconcile_last_success_timestamp_seconds", "Unix time of the last successful attachment reconciliation"),
+
+		OutboxEventsDeliveredTotal:  common.RegisterCounter("outbox_events_delivered_total", "Total number of outbox events delivered by the relay"),
+		OutboxDeliveryFailuresTotal: common.RegisterCounter("outbox_delivery_failures_total", "Total number of failed outbox delivery attempts"),
+		OutboxEventsDeadTotal:       common.RegisterCounter("outbox_events_dead_total", "Total number of outbox events that exhausted their retries"),
+		OutboxPendingEvents:         common.RegisterGauge("outbox_pending_events", "Number of outbox events waiting for delivery"),
+		OutboxLagSeconds:            common.RegisterGauge("outbox_lag_seconds", "Age of the oldest outbox event waiting for delivery"),
 	}
 }
@@ -0,0 +1,393 @@
+// Package recurrence parses and expands the subset of RFC 5545 recurrence rules (RRULE) used by recurring boards.
+//
+// Supported parts are FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL.
+// BYDAY takes plain weekdays (MO, TU, ...) with DAILY and WEEKLY, and optionally an ordinal
+// (1MO, -1FR) with MONTHLY; BYMONTHDAY is MONTHLY only and counts from the end when negative.
+// Weeks start on Monday. As in RFC 5545, DTSTART is always the first occurrence, occurrences keep
+// its time of day, and days that do not exist in a month (e.g. the 31st) are skipped.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the base period of a rule
+type Frequency string
+
+// Supported frequencies
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+const (
+	// MaxInterval bounds INTERVAL
+	MaxInterval = 365
+	// MaxCount bounds COUNT
+	MaxCount = 1000
+	// maxPeriods stops the expansion of rules that never or rarely match (about 30 years of days)
+	maxPeriods = 11000
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// Weekday is a BYDAY entry; N is the ordinal within the month (1: first, -1: last), 0 for every such day
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+// String formats the weekday as in RFC 5545 (e.g. MO, 2TU, -1FR)
+func (w Weekday) String() string {
+	code := strings.ToUpper(w.Day.String()[:2])
+	if w.N == 0 {
+		return code
+	}
+	return strconv.Itoa(w.N) + code
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []Weekday
+	ByMonthDay []int
+	Count      int        // 0: unbounded
+	Until      *time.Time // inclusive
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10"
+// A leading "RRULE:" is accepted
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "RRULE:"), "rrule:")
+	if s == "" {
+		return nil, errors.New("rule is empty")
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s is given more than once", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			switch f := Frequency(value); f {
+			case Daily, Weekly, Monthly:
+				rule.Freq = f
+			default:
+				return nil, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY")
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > MaxInterval {
+				return nil, fmt.Errorf("INTERVAL must be between 1 and %d", MaxInterval)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > MaxCount {
+				return nil, fmt.Errorf("COUNT must be between 1 and %d", MaxCount)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				day, err := parseWeekday(v)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY %q", v)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		default:
+			return nil, fmt.Errorf("%s is not supported", name)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("COUNT and UNTIL cannot be combined")
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq != Monthly {
+		return nil, errors.New("BYMONTHDAY requires FREQ=MONTHLY")
+	}
+	if len(rule.ByMonthDay) > 0 && len(rule.ByDay) > 0 {
+		return nil, errors.New("BYDAY and BYMONTHDAY cannot be combined")
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != Monthly {
+			return nil, errors.New("BYDAY ordinals require FREQ=MONTHLY")
+		}
+	}
+	return rule, nil
+}
+
+// parseWeekday parses a BYDAY entry with an optional ordinal between -5 and 5
+func parseWeekday(v string) (Weekday, error) {
+	if len(v) < 2 {
+		return Weekday{}, fmt.Errorf("invalid BYDAY %q", v)
+	}
+	day, ok := weekdayCodes[v[len(v)-2:]]
+	if !ok {
+		return Weekday{}, fmt.Errorf("invalid BYDAY %q", v)
+	}
+	n := 0
+	if prefix := v[:len(v)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return Weekday{}, fmt.Errorf("invalid BYDAY %q", v)
+		}
+	}
+	return Weekday{Day: day, N: n}, nil
+}
+
+// parseUntil parses UNTIL as a UTC date-time (20240131T090000Z) or a date (20240131, end of that day in UTC)
+func parseUntil(v string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", v); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", v)
+}
+
+// String formats the rule in canonical form
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, day := range r.ByMonthDay {
+			days[i] = strconv.Itoa(day)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Each calls fn with every occurrence from dtstart in order, with its 1-based index, until fn returns false
+// or the rule ends
+func (r *Rule) Each(dtstart time.Time, fn func(t time.Time, index int) bool) {
+	index := 0
+	emit := func(t time.Time) bool {
+		if r.Until != nil && t.After(*r.Until) {
+			return false
+		}
+		index++
+		if !fn(t, index) {
+			return false
+		}
+		return r.Count == 0 || index < r.Count
+	}
+
+	if !emit(dtstart) {
+		return
+	}
+	for period := 0; period < maxPeriods; period++ {
+		for _, t := range r.candidates(dtstart, period) {
+			if !t.After(dtstart) {
+				continue
+			}
+			if !emit(t) {
+				return
+			}
+		}
+		if r.Until != nil && r.periodStart(dtstart, period).After(*r.Until) {
+			return
+		}
+	}
+}
+
+// After returns the first occurrence strictly after t and its index; ok is false when the rule has ended
+func (r *Rule) After(dtstart, t time.Time) (next time.Time, index int, ok bool) {
+	r.Each(dtstart, func(occ time.Time, i int) bool {
+		if occ.After(t) {
+			next, index, ok = occ, i, true
+			return false
+		}
+		return true
+	})
+	return next, index, ok
+}
+
+// Latest returns the last occurrence at or before t and its index; ok is false when none is
+func (r *Rule) Latest(dtstart, t time.Time) (latest time.Time, index int, ok bool) {
+	r.Each(dtstart, func(occ time.Time, i int) bool {
+		if occ.After(t) {
+			return false
+		}
+		latest, index, ok = occ, i, true
+		return true
+	})
+	return latest, index, ok
+}
+
+// Upcoming returns at most n occurrences strictly after t
+func (r *Rule) Upcoming(dtstart, t time.Time, n int) []time.Time {
+	occurrences := make([]time.Time, 0, n)
+	if n <= 0 {
+		return occurrences
+	}
+	r.Each(dtstart, func(occ time.Time, _ int) bool {
+		if occ.After(t) {
+			occurrences = append(occurrences, occ)
+		}
+		return len(occurrences) < n
+	})
+	return occurrences
+}
+
+// periodStart returns the first day of a period, at dtstart's time of day
+func (r *Rule) periodStart(dtstart time.Time, period int) time.Time {
+	y, m, d := dtstart.Date()
+	h, mi, s := dtstart.Clock()
+	loc := dtstart.Location()
+	switch r.Freq {
+	case Weekly:
+		offset := (int(dtstart.Weekday()) + 6) % 7 // days since Monday
+		return time.Date(y, m, d-offset+7*r.Interval*period, h, mi, s, 0, loc)
+	case Monthly:
+		return time.Date(y, m+time.Month(r.Interval*period), 1, h, mi, s, 0, loc)
+	default:
+		return time.Date(y, m, d+r.Interval*period, h, mi, s, 0, loc)
+	}
+}
+
+// candidates returns the occurrences of one period in order
+func (r *Rule) candidates(dtstart time.Time, period int) []time.Time {
+	start := r.periodStart(dtstart, period)
+	switch r.Freq {
+	case Weekly:
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []Weekday{{Day: dtstart.Weekday()}}
+		}
+		out := make([]time.Time, 0, len(days))
+		for _, day := range days {
+			out = append(out, start.AddDate(0, 0, (int(day.Day)+6)%7))
+		}
+		return sortUnique(out)
+	case Monthly:
+		return r.monthCandidates(dtstart, start)
+	default:
+		if len(r.ByDay) == 0 || containsWeekday(r.ByDay, start.Weekday()) {
+			return []time.Time{start}
+		}
+		return nil
+	}
+}
+
+// monthCandidates returns the occurrences within the month that starts at first
+func (r *Rule) monthCandidates(dtstart, first time.Time) []time.Time {
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+	var days []int
+	switch {
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			firstMatch := 1 + (int(wd.Day)-int(first.Weekday())+7)%7
+			var matches []int
+			for d := firstMatch; d <= daysInMonth; d += 7 {
+				matches = append(matches, d)
+			}
+			switch {
+			case wd.N == 0:
+				days = append(days, matches...)
+			case wd.N > 0 && wd.N <= len(matches):
+				days = append(days, matches[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(matches):
+				days = append(days, matches[len(matches)+wd.N])
+			}
+		}
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d >= 1 && d <= daysInMonth {
+				days = append(days, d)
+			}
+		}
+	default:
+		if d := dtstart.Day(); d <= daysInMonth {
+			days = append(days, d)
+		}
+	}
+
+	out := make([]time.Time, 0, len(days))
+	for _, d := range days {
+		out = append(out, first.AddDate(0, 0, d-1))
+	}
+	return sortUnique(out)
+}
+
+// containsWeekday reports whether days contains day
+func containsWeekday(days []Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d.Day == day {
+			return true
+		}
+	}
+	return false
+}
+
+// sortUnique sorts times and removes duplicates
+func sortUnique(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	out := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d, h int) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{name: "매일", rule: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "RRULE 접두사와 소문자", rule: "RRULE:freq=weekly;byday=mo,th;interval=2", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{name: "매월 마지막 금요일", rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6", want: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6"},
+		{name: "UNTIL 날짜", rule: "FREQ=MONTHLY;BYMONTHDAY=1,-1;UNTIL=20240630", want: "FREQ=MONTHLY;BYMONTHDAY=1,-1;UNTIL=20240630T235959Z"},
+		{name: "실패: 빈 규칙", rule: " ", wantErr: true},
+		{name: "실패: FREQ 없음", rule: "INTERVAL=2", wantErr: true},
+		{name: "실패: 지원하지 않는 FREQ", rule: "FREQ=YEARLY", wantErr: true},
+		{name: "실패: 지원하지 않는 항목", rule: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+		{name: "실패: 중복 항목", rule: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "실패: COUNT와 UNTIL 동시 사용", rule: "FREQ=DAILY;COUNT=3;UNTIL=20240101", wantErr: true},
+		{name: "실패: 주간 규칙의 서수 요일", rule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "실패: 월간이 아닌 BYMONTHDAY", rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "실패: 잘못된 INTERVAL", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "실패: 잘못된 요일", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.String())
+		})
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		after   time.Time
+		n       int
+		want    []time.Time
+	}{
+		{
+			name:    "격일",
+			rule:    "FREQ=DAILY;INTERVAL=2",
+			dtstart: date(2024, 1, 1, 9),
+			after:   date(2024, 1, 1, 9),
+			n:       3,
+			want:    []time.Time{date(2024, 1, 3, 9), date(2024, 1, 5, 9), date(2024, 1, 7, 9)},
+		},
+		{
+			name:    "평일만",
+			rule:    "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			dtstart: date(2024, 1, 5, 9), // 금요일
+			after:   date(2024, 1, 5, 9),
+			n:       2,
+			want:    []time.Time{date(2024, 1, 8, 9), date(2024, 1, 9, 9)},
+		},
+		{
+			name:    "격주 월요일과 목요일",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=TH,MO",
+			dtstart: date(2024, 1, 3, 9), // 수요일
+			after:   date(2024, 1, 3, 9),
+			n:       4,
+			want:    []time.Time{date(2024, 1, 4, 9), date(2024, 1, 15, 9), date(2024, 1, 18, 9), date(2024, 1, 29, 9)},
+		},
+		{
+			name:    "매월 31일은 없는 달을 건너뜀",
+			rule:    "FREQ=MONTHLY",
+			dtstart: date(2024, 1, 31, 9),
+			after:   date(2024, 1, 31, 9),
+			n:       2,
+			want:    []time.Time{date(2024, 3, 31, 9), date(2024, 5, 31, 9)},
+		},
+		{
+			name:    "매월 말일",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: date(2024, 1, 31, 9),
+			after:   date(2024, 1, 31, 9),
+			n:       2,
+			want:    []time.Time{date(2024, 2, 29, 9), date(2024, 3, 31, 9)},
+		},
+		{
+			name:    "매월 첫째 월요일",
+			rule:    "FREQ=MONTHLY;BYDAY=1MO",
+			dtstart: date(2024, 1, 1, 9),
+			after:   date(2024, 1, 1, 9),
+			n:       2,
+			want:    []time.Time{date(2024, 2, 5, 9), date(2024, 3, 4, 9)},
+		},
+		{
+			name:    "COUNT는 시작 일시를 포함",
+			rule:    "FREQ=WEEKLY;COUNT=3",
+			dtstart: date(2024, 1, 1, 9),
+			after:   date(2023, 12, 31, 0),
+			n:       5,
+			want:    []time.Time{date(2024, 1, 1, 9), date(2024, 1, 8, 9), date(2024, 1, 15, 9)},
+		},
+		{
+			name:    "UNTIL 이후는 없음",
+			rule:    "FREQ=DAILY;UNTIL=20240103",
+			dtstart: date(2024, 1, 1, 9),
+			after:   date(2024, 1, 1, 9),
+			n:       5,
+			want:    []time.Time{date(2024, 1, 2, 9), date(2024, 1, 3, 9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.Upcoming(tt.dtstart, tt.after, tt.n))
+		})
+	}
+}
+
+func TestAfterAndLatest(t *testing.T) {
+	rule, err := Parse("FREQ=WEEKLY;BYDAY=MO;COUNT=4")
+	require.NoError(t, err)
+	dtstart := date(2024, 1, 1, 9)
+
+	next, index, ok := rule.After(dtstart, date(2024, 1, 10, 0))
+	assert.True(t, ok)
+	assert.Equal(t, date(2024, 1, 15, 9), next)
+	assert.Equal(t, 3, index)
+
+	latest, index, ok := rule.Latest(dtstart, date(2024, 1, 20, 0))
+	assert.True(t, ok)
+	assert.Equal(t, date(2024, 1, 15, 9), latest)
+	assert.Equal(t, 3, index)
+
+	_, _, ok = rule.After(dtstart, date(2024, 1, 22, 9))
+	assert.False(t, ok, "COUNT=4 ends with the occurrence of Jan 22")
+
+	_, _, ok = rule.Latest(dtstart, date(2023, 12, 31, 0))
+	assert.False(t, ok)
+}
+
+func TestEachStopsForRulesThatNeverMatch(t *testing.T) {
+	rule, err := Parse("FREQ=MONTHLY;INTERVAL=12;BYMONTHDAY=30")
+	require.NoError(t, err)
+
+	// February never has a 30th, so only DTSTART itself occurs
+	_, _, ok := rule.After(date(2024, 2, 1, 9), date(2024, 2, 1, 9))
+	assert.False(t, ok)
+}
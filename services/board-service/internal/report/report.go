@@ -0,0 +1,123 @@
+// Package report renders project reports from text templates.
+//
+// Markdown and CSV reports are produced by Go text/template templates executed against
+// dto.ProjectReportResponse. Built-in templates are embedded; workspaces may replace them.
+// Besides the text/template builtins, templates can call:
+//
+//	date  formats a time.Time or *time.Time as YYYY-MM-DD ("" for nil)
+//	csv   quotes a value as a CSV field, neutralising spreadsheet formulas
+//	cell  escapes a value for a Markdown table cell
+package report
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"project-board-api/internal/domain"
+)
+
+// MaxTemplateSize is the largest template body accepted, in bytes
+const MaxTemplateSize = 64 * 1024
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// funcs are the functions available to report templates
+var funcs = template.FuncMap{
+	"date": formatDate,
+	"csv":  escapeCSV,
+	"cell": escapeCell,
+}
+
+// DefaultTemplate returns the built-in template body of a format
+func DefaultTemplate(format domain.ReportFormat) (string, bool) {
+	if !format.IsTemplated() {
+		return "", false
+	}
+	body, err := defaultTemplates.ReadFile("templates/" + string(format) + ".tmpl")
+	if err != nil {
+		return "", false
+	}
+	return string(body), true
+}
+
+// Parse compiles a template body with the report functions
+func Parse(body string) (*template.Template, error) {
+	if len(body) > MaxTemplateSize {
+		return nil, fmt.Errorf("template exceeds %d bytes", MaxTemplateSize)
+	}
+	return template.New("report").Funcs(funcs).Option("missingkey=error").Parse(body)
+}
+
+// Render executes a template body against the report data
+func Render(body string, data interface{}) ([]byte, error) {
+	tmpl, err := Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// formatDate formats a date as YYYY-MM-DD (UTC)
+func formatDate(value interface{}) (string, error) {
+	switch t := value.(type) {
+	case time.Time:
+		return t.UTC().Format("2006-01-02"), nil
+	case *time.Time:
+		if t == nil {
+			return "", nil
+		}
+		return t.UTC().Format("2006-01-02"), nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("date: unsupported type %T", value)
+	}
+}
+
+// escapeCSV quotes a value as a CSV field (RFC 4180)
+// Values starting like a spreadsheet formula are prefixed with ' so they are shown as text
+func escapeCSV(value interface{}) string {
+	s := toText(value)
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		s = "'" + s
+	}
+	if strings.ContainsAny(s, ",\"\r\n") {
+		s = `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return s
+}
+
+// escapeCell escapes a value for a Markdown table cell
+func escapeCell(value interface{}) string {
+	s := toText(value)
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// toText formats a template value as text: nil pointers are empty and times use RFC 3339
+func toText(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		value = v.Elem().Interface()
+	}
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,85 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestEscapeCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"일반 문자열", "login page", "login page"},
+		{"쉼표 포함", "a, b", `"a, b"`},
+		{"따옴표 포함", `say "hi"`, `"say ""hi"""`},
+		{"줄바꿈 포함", "line1\nline2", "\"line1\nline2\""},
+		{"수식으로 시작", "=SUM(A1:A9)", "'=SUM(A1:A9)"},
+		{"수식과 쉼표", "+1,2", `"'+1,2"`},
+		{"nil 포인터", (*string)(nil), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeCSV(tt.value); got != tt.want {
+				t.Errorf("escapeCSV(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeCell(t *testing.T) {
+	if got := escapeCell("a | b\n  c\\d"); got != `a \| b c\\d` {
+		t.Errorf("escapeCell() = %q", got)
+	}
+}
+
+func TestDefaultTemplates(t *testing.T) {
+	due := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	board := dto.ReportBoardResponse{Key: "WEB-1", Title: "Login, page", Stage: "in_progress", DueDate: &due, Overdue: true, OverdueDays: 4}
+	data := &dto.ProjectReportResponse{
+		ProjectName: "Web",
+		GeneratedAt: due,
+		Stages:      []dto.ReportStageResponse{{Value: "in_progress", Label: "진행중", Count: 1, Boards: []dto.ReportBoardResponse{board}}},
+		Overdue:     []dto.ReportBoardResponse{board},
+		Completed:   []dto.ReportBoardResponse{},
+		Assignees:   []dto.ReportAssigneeResponse{{Open: 1, Overdue: 1}},
+	}
+
+	tests := []struct {
+		format domain.ReportFormat
+		want   string
+	}{
+		{domain.ReportFormatMarkdown, "- **WEB-1** Login, page (마감 2024-01-15, 4일 지남)\n"},
+		{domain.ReportFormatCSV, "key,title,stage,assignee,due_date,overdue,completed_at\nWEB-1,\"Login, page\",진행중,,2024-01-15,true,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			body, ok := DefaultTemplate(tt.format)
+			if !ok {
+				t.Fatalf("DefaultTemplate(%s) not found", tt.format)
+			}
+			output, err := Render(body, data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if !strings.Contains(string(output), tt.want) {
+				t.Errorf("Render() = %q, want to contain %q", output, tt.want)
+			}
+		})
+	}
+
+	if _, ok := DefaultTemplate(domain.ReportFormatJSON); ok {
+		t.Error("DefaultTemplate(json) should not exist")
+	}
+}
+
+func TestParse_RejectsOversizedTemplate(t *testing.T) {
+	if _, err := Parse(strings.Repeat("a", MaxTemplateSize+1)); err == nil {
+		t.Error("Parse() should reject templates over MaxTemplateSize")
+	}
+}
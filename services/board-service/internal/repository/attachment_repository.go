@@ -22,6 +22,10 @@ type AttachmentRepository interface {
 	FindExpiredTempAttachments(ctx context.Context) ([]*domain.Attachment, error)
 	ConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error
 	DeleteBatch(ctx context.Context, attachmentIDs []uuid.UUID) error
+	UpdateThumbnail(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error
+	FindPendingThumbnails(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error)
+	FindReferencedFileKeys(ctx context.Context, keys []string) (map[string]bool, error)
+	FindConfirmedAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error)
 }
 
 // attachmentRepositoryImpl is the GORM implementation of AttachmentRepository
@@ -36,7 +40,7 @@ func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
 
 // Create creates a new attachment
 func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *domain.Attachment) error {
-	if err := r.db.WithContext(ctx).Create(attachment).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(attachment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -45,7 +49,7 @@ func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *domai
 // FindByID finds an attachment by its ID
 func (r *attachmentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
 	var attachment domain.Attachment
-	if err := r.db.WithContext(ctx).First(&attachment, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).First(&attachment, id).Error; err != nil {
 		return nil, err
 	}
 	return &attachment, nil
@@ -54,7 +58,7 @@ func (r *attachmentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (
 // FindByEntityID finds all attachments by entity type and entity ID
 func (r *attachmentRepositoryImpl) FindByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
 		Order("created_at DESC").
 		Find(&attachments).Error; err != nil {
@@ -70,7 +74,7 @@ func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUI
 	}
 
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("id IN ?", ids).
 		Find(&attachments).Error; err != nil {
 		return nil, err
@@ -80,7 +84,7 @@ func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUI
 
 // Delete soft deletes an attachment by ID
 func (r *attachmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Attachment{}, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Delete(&domain.Attachment{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -89,7 +93,7 @@ func (r *attachmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) err
 // FindExpiredTempAttachments finds all temporary attachments that have exceeded their expiration time
 func (r *attachmentRepositoryImpl) FindExpiredTempAttachments(ctx context.Context) ([]*domain.Attachment, error) {
 	var attachments []*domain.Attachment
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("status = ? AND expires_at < ?", domain.AttachmentStatusTemp, time.Now()).
 		Find(&attachments).Error; err != nil {
 		return nil, err
@@ -103,7 +107,7 @@ func (r *attachmentRepositoryImpl) ConfirmAttachments(ctx context.Context, attac
 	}
 
 	// ✅ TEMP 상태만 업데이트, 결과 검증
-	result := r.db.WithContext(ctx).
+	result := dbWithContext(ctx, r.db).
 		Model(&domain.Attachment{}).
 		Where("id IN ? AND status = ?", attachmentIDs, domain.AttachmentStatusTemp). // ✅
 		Updates(map[string]interface{}{
@@ -135,10 +139,73 @@ func (r *attachmentRepositoryImpl) DeleteBatch(ctx context.Context, attachmentID
 		return nil
 	}
 
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("id IN ?", attachmentIDs).
 		Delete(&domain.Attachment{}).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// UpdateThumbnail stores the thumbnail generation result of an attachment
+func (r *attachmentRepositoryImpl) UpdateThumbnail(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error {
+	return dbWithContext(ctx, r.db).
+		Model(&domain.Attachment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"thumbnail_status": status,
+			"thumbnail_key":    thumbnailKey,
+			"preview_key":      previewKey,
+		}).Error
+}
+
+// FindPendingThumbnails finds attachments created before the given time whose thumbnails are still pending
+func (r *attachmentRepositoryImpl) FindPendingThumbnails(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	if err := dbWithContext(ctx, r.db).
+		Where("thumbnail_status = ? AND created_at < ?", domain.ThumbnailStatusPending, createdBefore).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// FindReferencedFileKeys returns which of the given S3 keys belong to an attachment
+// A key is referenced when it is the original file, the thumbnail or the preview of a live attachment
+func (r *attachmentRepositoryImpl) FindReferencedFileKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	referenced := make(map[string]bool, len(keys))
+	if len(keys) == 0 {
+		return referenced, nil
+	}
+
+	var attachments []*domain.Attachment
+	if err := dbWithContext(ctx, r.db).
+		Select("file_url", "thumbnail_key", "preview_key").
+		Where("file_url IN ? OR thumbnail_key IN ? OR preview_key IN ?", keys, keys, keys).
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range attachments {
+		referenced[attachment.FileURL] = true
+		for _, key := range attachment.DerivedFileKeys() {
+			referenced[key] = true
+		}
+	}
+	return referenced, nil
+}
+
+// FindConfirmedAfter pages through confirmed attachments ordered by ID, starting after afterID
+func (r *attachmentRepositoryImpl) FindConfirmedAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	if err := dbWithContext(ctx, r.db).
+		Where("status = ? AND id > ?", domain.AttachmentStatusConfirmed, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
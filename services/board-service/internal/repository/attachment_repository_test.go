@@ -34,7 +34,10 @@ func setupAttachmentTestDB(t *testing.T) *gorm.DB {
 		file_size INTEGER NOT NULL,
 		content_type TEXT NOT NULL,
 		uploaded_by TEXT NOT NULL,
-		expires_at DATETIME
+		expires_at DATETIME,
+		thumbnail_status TEXT,
+		thumbnail_key TEXT,
+		preview_key TEXT
 	)`)
 
 	return db
@@ -319,3 +322,174 @@ func TestAttachmentRepository_FindByID_NotFound(t *testing.T) {
 		t.Error("FindByID() expected error for non-existent ID, got nil")
 	}
 }
+
+func TestAttachmentRepository_FindPendingThumbnails(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	newImage := func(status domain.ThumbnailStatus) *domain.Attachment {
+		attachment := &domain.Attachment{
+			BaseModel:       domain.BaseModel{ID: uuid.New()},
+			EntityType:      domain.EntityTypeBoard,
+			Status:          domain.AttachmentStatusTemp,
+			FileName:        "photo.png",
+			FileURL:         "board/boards/ws/2024/01/photo.png",
+			FileSize:        1024,
+			ContentType:     "image/png",
+			UploadedBy:      uuid.New(),
+			ThumbnailStatus: status,
+		}
+		db.Create(attachment)
+		return attachment
+	}
+
+	pending := newImage(domain.ThumbnailStatusPending)
+	newImage(domain.ThumbnailStatusReady)
+	newImage("")
+
+	// Test: only pending attachments created before the cutoff are returned
+	found, err := repo.FindPendingThumbnails(ctx, time.Now().Add(time.Minute), 10)
+	if err != nil {
+		t.Fatalf("FindPendingThumbnails() error = %v", err)
+	}
+	if len(found) != 1 || found[0].ID != pending.ID {
+		t.Fatalf("FindPendingThumbnails() = %v, want only %v", found, pending.ID)
+	}
+
+	found, err = repo.FindPendingThumbnails(ctx, time.Now().Add(-time.Hour), 10)
+	if err != nil {
+		t.Fatalf("FindPendingThumbnails() error = %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("FindPendingThumbnails() returned %d attachments created after the cutoff", len(found))
+	}
+
+	// Test: UpdateThumbnail stores the result and removes the attachment from the pending list
+	if err := repo.UpdateThumbnail(ctx, pending.ID, domain.ThumbnailStatusReady, "thumb.jpg", "preview.jpg"); err != nil {
+		t.Fatalf("UpdateThumbnail() error = %v", err)
+	}
+	updated, err := repo.FindByID(ctx, pending.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if updated.ThumbnailStatus != domain.ThumbnailStatusReady || updated.ThumbnailKey != "thumb.jpg" || updated.PreviewKey != "preview.jpg" {
+		t.Errorf("UpdateThumbnail() stored %q/%q/%q", updated.ThumbnailStatus, updated.ThumbnailKey, updated.PreviewKey)
+	}
+	found, err = repo.FindPendingThumbnails(ctx, time.Now().Add(time.Minute), 10)
+	if err != nil {
+		t.Fatalf("FindPendingThumbnails() error = %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("FindPendingThumbnails() returned %d attachments after update", len(found))
+	}
+}
+
+func TestAttachmentRepository_FindReferencedFileKeys(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	attachment := &domain.Attachment{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		EntityType:   domain.EntityTypeBoard,
+		Status:       domain.AttachmentStatusConfirmed,
+		FileName:     "photo.png",
+		FileURL:      "board/boards/ws/photo.png",
+		FileSize:     1024,
+		ContentType:  "image/png",
+		UploadedBy:   uuid.New(),
+		ThumbnailKey: "board/boards/ws/photo_thumb.png",
+		PreviewKey:   "board/boards/ws/photo_preview.png",
+	}
+	db.Create(attachment)
+	deleted := &domain.Attachment{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		EntityType:  domain.EntityTypeBoard,
+		Status:      domain.AttachmentStatusConfirmed,
+		FileName:    "deleted.png",
+		FileURL:     "board/boards/ws/deleted.png",
+		FileSize:    1024,
+		ContentType: "image/png",
+		UploadedBy:  uuid.New(),
+	}
+	db.Create(deleted)
+	db.Delete(deleted)
+
+	// Test: originals and derived images of live attachments are referenced; soft-deleted ones are not
+	referenced, err := repo.FindReferencedFileKeys(ctx, []string{
+		"board/boards/ws/photo.png",
+		"board/boards/ws/photo_thumb.png",
+		"board/boards/ws/photo_preview.png",
+		"board/boards/ws/deleted.png",
+		"board/boards/ws/unknown.png",
+	})
+	if err != nil {
+		t.Fatalf("FindReferencedFileKeys() error = %v", err)
+	}
+	for _, key := range []string{"board/boards/ws/photo.png", "board/boards/ws/photo_thumb.png", "board/boards/ws/photo_preview.png"} {
+		if !referenced[key] {
+			t.Errorf("FindReferencedFileKeys() missing %s", key)
+		}
+	}
+	for _, key := range []string{"board/boards/ws/deleted.png", "board/boards/ws/unknown.png"} {
+		if referenced[key] {
+			t.Errorf("FindReferencedFileKeys() unexpectedly referenced %s", key)
+		}
+	}
+}
+
+func TestAttachmentRepository_FindConfirmedAfter(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	var confirmedIDs []string
+	for i := 0; i < 3; i++ {
+		attachment := &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New()},
+			EntityType:  domain.EntityTypeBoard,
+			Status:      domain.AttachmentStatusConfirmed,
+			FileName:    "file.pdf",
+			FileURL:     "board/boards/ws/file.pdf",
+			FileSize:    1024,
+			ContentType: "application/pdf",
+			UploadedBy:  uuid.New(),
+		}
+		db.Create(attachment)
+		confirmedIDs = append(confirmedIDs, attachment.ID.String())
+	}
+	db.Create(&domain.Attachment{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		EntityType:  domain.EntityTypeBoard,
+		Status:      domain.AttachmentStatusTemp,
+		FileName:    "temp.pdf",
+		FileURL:     "board/boards/ws/temp.pdf",
+		FileSize:    1024,
+		ContentType: "application/pdf",
+		UploadedBy:  uuid.New(),
+	})
+
+	// Test: pages of two cover every confirmed attachment exactly once
+	var seen []string
+	afterID := uuid.Nil
+	for {
+		page, err := repo.FindConfirmedAfter(ctx, afterID, 2)
+		if err != nil {
+			t.Fatalf("FindConfirmedAfter() error = %v", err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, attachment := range page {
+			if attachment.Status != domain.AttachmentStatusConfirmed {
+				t.Errorf("FindConfirmedAfter() returned %s attachment", attachment.Status)
+			}
+			seen = append(seen, attachment.ID.String())
+		}
+		afterID = page[len(page)-1].ID
+	}
+	if len(seen) != len(confirmedIDs) {
+		t.Errorf("FindConfirmedAfter() paged %d attachments, want %d", len(seen), len(confirmedIDs))
+	}
+}
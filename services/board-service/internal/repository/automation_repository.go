@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// AutomationRepository defines the interface for automation rule and execution log data access
+type AutomationRepository interface {
+	CreateRule(ctx context.Context, rule *domain.AutomationRule) error
+	FindRuleByID(ctx context.Context, id uuid.UUID) (*domain.AutomationRule, error)
+	FindRulesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.AutomationRule, error)
+	FindEnabledRules(ctx context.Context, projectID uuid.UUID, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error)
+	UpdateRule(ctx context.Context, rule *domain.AutomationRule) error
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	CreateExecution(ctx context.Context, execution *domain.AutomationExecution) error
+	FindExecutions(ctx context.Context, projectID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*domain.AutomationExecution, error)
+	FindDueDateTargets(ctx context.Context, since, now time.Time, limit int) ([]*DueDateAutomationTarget, error)
+	ClaimDueDateFiring(ctx context.Context, target *DueDateAutomationTarget, firedAt time.Time) (bool, error)
+}
+
+// DueDateAutomationTarget is a board whose due date was reached for an enabled DUE_DATE_REACHED rule
+type DueDateAutomationTarget struct {
+	RuleID    uuid.UUID
+	BoardID   uuid.UUID
+	ProjectID uuid.UUID
+	AuthorID  uuid.UUID
+	DueDate   time.Time
+}
+
+// automationRepositoryImpl is the GORM implementation of AutomationRepository
+type automationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAutomationRepository creates a new instance of AutomationRepository
+func NewAutomationRepository(db *gorm.DB) AutomationRepository {
+	return &automationRepositoryImpl{db: db}
+}
+
+// CreateRule creates a new automation rule
+func (r *automationRepositoryImpl) CreateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	return dbWithContext(ctx, r.db).Create(rule).Error
+}
+
+// FindRuleByID finds an automation rule by ID
+func (r *automationRepositoryImpl) FindRuleByID(ctx context.Context, id uuid.UUID) (*domain.AutomationRule, error) {
+	var rule domain.AutomationRule
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FindRulesByProjectID finds all automation rules of a project
+func (r *automationRepositoryImpl) FindRulesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.AutomationRule, error) {
+	rules := make([]*domain.AutomationRule, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// FindEnabledRules finds the enabled rules of a project for a trigger, oldest first
+func (r *automationRepositoryImpl) FindEnabledRules(ctx context.Context, projectID uuid.UUID, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error) {
+	rules := make([]*domain.AutomationRule, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND trigger = ? AND enabled = ?", projectID, trigger, true).
+		Order("created_at ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// UpdateRule updates an automation rule
+func (r *automationRepositoryImpl) UpdateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	return dbWithContext(ctx, r.db).Save(rule).Error
+}
+
+// DeleteRule deletes an automation rule (its execution log is removed by cascade)
+func (r *automationRepositoryImpl) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Delete(&domain.AutomationRule{}, id).Error
+}
+
+// CreateExecution records an automation execution
+func (r *automationRepositoryImpl) CreateExecution(ctx context.Context, execution *domain.AutomationExecution) error {
+	return dbWithContext(ctx, r.db).Create(execution).Error
+}
+
+// FindExecutions finds the most recent executions of a project, optionally filtered by rule
+func (r *automationRepositoryImpl) FindExecutions(ctx context.Context, projectID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*domain.AutomationExecution, error) {
+	executions := make([]*domain.AutomationExecution, 0)
+	query := dbWithContext(ctx, r.db).Where("project_id = ?", projectID)
+	if ruleID != nil {
+		query = query.Where("rule_id = ?", *ruleID)
+	}
+	if err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&executions).Error; err != nil {
+		return nil, err
+	}
+	return executions, nil
+}
+
+// FindDueDateTargets finds (rule, board) pairs that have not fired yet for the board's current due date
+// A board qualifies when its due date is in (since, now], after the rule was created, and its project is not archived
+func (r *automationRepositoryImpl) FindDueDateTargets(ctx context.Context, since, now time.Time, limit int) ([]*DueDateAutomationTarget, error) {
+	targets := make([]*DueDateAutomationTarget, 0)
+	if err := dbWithContext(ctx, r.db).
+		Table("boards AS b").
+		Select("r.id AS rule_id, b.id AS board_id, b.project_id, b.author_id, b.due_date").
+		Joins("JOIN automation_rules AS r ON r.project_id = b.project_id AND r.trigger = ? AND r.enabled = ?",
+			domain.AutomationTriggerDueDateReached, true).
+		Joins("JOIN projects AS p ON p.id = b.project_id AND p.archived_at IS NULL").
+		Where("b.due_date > ? AND b.due_date <= ? AND b.due_date > r.created_at", since, now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM automation_due_date_firings AS f
+			WHERE f.rule_id = r.id AND f.board_id = b.id AND f.due_date = b.due_date
+		)`).
+		Order("b.due_date ASC").
+		Limit(limit).
+		Scan(&targets).Error; err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// ClaimDueDateFiring records the firing of a rule for a board's due date
+// It returns false when another worker already claimed it
+func (r *automationRepositoryImpl) ClaimDueDateFiring(ctx context.Context, target *DueDateAutomationTarget, firedAt time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.AutomationDueDateFiring{
+			RuleID:  target.RuleID,
+			BoardID: target.BoardID,
+			DueDate: target.DueDate,
+			FiredAt: firedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+)
+
+func TestAutomationRepository_DueDateTargets(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewAutomationRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Automation"}
+	archived := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: project.WorkspaceID, OwnerID: project.OwnerID, Name: "Archived", ArchivedAt: at(-time.Hour)}
+	db.Create(project)
+	db.Create(archived)
+
+	newRule := func(projectID uuid.UUID, trigger domain.AutomationTrigger, createdAt time.Time) *domain.AutomationRule {
+		rule := &domain.AutomationRule{
+			BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt, UpdatedAt: createdAt},
+			ProjectID: projectID,
+			Name:      "Rule",
+			Enabled:   true,
+			Trigger:   trigger,
+			Actions:   datatypes.JSON(`[]`),
+			CreatedBy: project.OwnerID,
+		}
+		if err := repo.CreateRule(ctx, rule); err != nil {
+			t.Fatalf("CreateRule() error = %v", err)
+		}
+		return rule
+	}
+	newBoard := func(projectID uuid.UUID, dueDate *time.Time) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: project.OwnerID, Title: "Board", DueDate: dueDate}
+		db.Create(board)
+		return board
+	}
+
+	rule := newRule(project.ID, domain.AutomationTriggerDueDateReached, now.Add(-30*24*time.Hour))
+	newRule(project.ID, domain.AutomationTriggerBoardCreated, now.Add(-30*24*time.Hour))
+	newRule(archived.ID, domain.AutomationTriggerDueDateReached, now.Add(-30*24*time.Hour))
+
+	due := newBoard(project.ID, at(-time.Hour))
+	missed := newBoard(project.ID, at(-3*24*time.Hour)) // came due while no replica ran
+	newBoard(project.ID, at(time.Hour))                 // not yet due
+	newBoard(project.ID, at(-10*24*time.Hour))          // beyond the lookback
+	newBoard(project.ID, nil)                           // no due date
+	newBoard(archived.ID, at(-time.Hour))               // archived project
+
+	since := now.Add(-7 * 24 * time.Hour)
+	targets, err := repo.FindDueDateTargets(ctx, since, now, 100)
+	if err != nil {
+		t.Fatalf("FindDueDateTargets() error = %v", err)
+	}
+	if len(targets) != 2 || targets[0].BoardID != missed.ID || targets[1].BoardID != due.ID {
+		t.Fatalf("FindDueDateTargets() = %+v, want the missed and due boards oldest first", targets)
+	}
+	if targets[1].RuleID != rule.ID || targets[1].ProjectID != project.ID || targets[1].AuthorID != project.OwnerID {
+		t.Errorf("FindDueDateTargets() target = %+v, want rule %s of project %s", targets[1], rule.ID, project.ID)
+	}
+
+	// Only the first replica claims a firing
+	claimed, err := repo.ClaimDueDateFiring(ctx, targets[1], now)
+	if err != nil || !claimed {
+		t.Fatalf("ClaimDueDateFiring() = %v, %v, want claimed", claimed, err)
+	}
+	claimed, err = repo.ClaimDueDateFiring(ctx, targets[1], now)
+	if err != nil || claimed {
+		t.Fatalf("second ClaimDueDateFiring() = %v, %v, want not claimed", claimed, err)
+	}
+
+	targets, err = repo.FindDueDateTargets(ctx, since, now, 100)
+	if err != nil {
+		t.Fatalf("FindDueDateTargets() error = %v", err)
+	}
+	if len(targets) != 1 || targets[0].BoardID != missed.ID {
+		t.Fatalf("FindDueDateTargets() after claim = %+v, want only the missed board", targets)
+	}
+
+	// Moving the due date makes the board fire again
+	db.Model(&domain.Board{}).Where("id = ?", due.ID).Update("due_date", now.Add(-time.Minute))
+	targets, err = repo.FindDueDateTargets(ctx, since, now, 100)
+	if err != nil {
+		t.Fatalf("FindDueDateTargets() error = %v", err)
+	}
+	if len(targets) != 2 {
+		t.Errorf("FindDueDateTargets() after moving the due date = %d targets, want 2", len(targets))
+	}
+
+	// A rule does not fire for boards that came due before it existed
+	newRule(project.ID, domain.AutomationTriggerDueDateReached, now.Add(-30*time.Minute))
+	targets, err = repo.FindDueDateTargets(ctx, since, now, 100)
+	if err != nil {
+		t.Fatalf("FindDueDateTargets() error = %v", err)
+	}
+	if len(targets) != 3 {
+		t.Errorf("FindDueDateTargets() with a new rule = %d targets, want 3", len(targets))
+	}
+}
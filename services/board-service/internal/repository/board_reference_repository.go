@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// BoardReferenceRepository defines the interface for board cross-reference data access
+type BoardReferenceRepository interface {
+	ReplaceBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID, sourceBoardID uuid.UUID, targetBoardIDs []uuid.UUID) error
+	DeleteBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID uuid.UUID) error
+	FindByTargetBoardID(ctx context.Context, targetBoardID, viewerID uuid.UUID) ([]*domain.BoardReference, error)
+	FindBoardIDsInSameWorkspace(ctx context.Context, boardID uuid.UUID, candidateIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+// boardReferenceRepositoryImpl is the GORM implementation of BoardReferenceRepository
+type boardReferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBoardReferenceRepository creates a new instance of BoardReferenceRepository
+func NewBoardReferenceRepository(db *gorm.DB) BoardReferenceRepository {
+	return &boardReferenceRepositoryImpl{db: db}
+}
+
+// ReplaceBySource replaces the references parsed from one board or comment with the given targets
+func (r *boardReferenceRepositoryImpl) ReplaceBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID, sourceBoardID uuid.UUID, targetBoardIDs []uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("source_type = ? AND source_id = ?", sourceType, sourceID).
+			Delete(&domain.BoardReference{}).Error; err != nil {
+			return err
+		}
+		if len(targetBoardIDs) == 0 {
+			return nil
+		}
+
+		references := make([]*domain.BoardReference, 0, len(targetBoardIDs))
+		for _, targetID := range targetBoardIDs {
+			references = append(references, &domain.BoardReference{
+				BaseModel:     domain.BaseModel{ID: uuid.New()},
+				SourceType:    sourceType,
+				SourceID:      sourceID,
+				SourceBoardID: sourceBoardID,
+				TargetBoardID: targetID,
+			})
+		}
+		return tx.Create(&references).Error
+	})
+}
+
+// DeleteBySource removes the references parsed from one board or comment
+func (r *boardReferenceRepositoryImpl) DeleteBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID uuid.UUID) error {
+	return dbWithContext(ctx, r.db).
+		Where("source_type = ? AND source_id = ?", sourceType, sourceID).
+		Delete(&domain.BoardReference{}).Error
+}
+
+// FindByTargetBoardID finds the references pointing at a board, newest first, with source boards preloaded
+// Only references whose source board is in a public project or a project the viewer is a member of are returned
+func (r *boardReferenceRepositoryImpl) FindByTargetBoardID(ctx context.Context, targetBoardID, viewerID uuid.UUID) ([]*domain.BoardReference, error) {
+	references := make([]*domain.BoardReference, 0)
+	if err := dbWithContext(ctx, r.db).
+		Preload("SourceBoard").
+		Preload("SourceBoard.Project").
+		Joins("JOIN boards ON boards.id = board_references.source_board_id").
+		Joins("JOIN projects ON projects.id = boards.project_id").
+		Where("board_references.target_board_id = ?", targetBoardID).
+		Where("projects.is_public = ? OR EXISTS (SELECT 1 FROM project_members WHERE project_members.project_id = projects.id AND project_members.user_id = ?)", true, viewerID).
+		Order("board_references.created_at DESC").
+		Find(&references).Error; err != nil {
+		return nil, err
+	}
+	return references, nil
+}
+
+// FindBoardIDsInSameWorkspace returns the candidate board IDs that exist in the workspace of the given board
+func (r *boardReferenceRepositoryImpl) FindBoardIDsInSameWorkspace(ctx context.Context, boardID uuid.UUID, candidateIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(candidateIDs))
+	if len(candidateIDs) == 0 {
+		return ids, nil
+	}
+
+	workspaceOf := r.db.Table("boards").
+		Select("projects.workspace_id").
+		Joins("JOIN projects ON projects.id = boards.project_id").
+		Where("boards.id = ?", boardID)
+
+	if err := dbWithContext(ctx, r.db).
+		Table("boards").
+		Joins("JOIN projects ON projects.id = boards.project_id").
+		Where("boards.id IN ?", candidateIDs).
+		Where("projects.workspace_id = (?)", workspaceOf).
+		Pluck("boards.id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestBoardReferenceRepository_ReplaceAndWorkspaceScope(t *testing.T) {
+	db := setupBoardTestDB(t)
+	db.Exec(`CREATE TABLE board_references (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		source_type TEXT NOT NULL,
+		source_id TEXT NOT NULL,
+		source_board_id TEXT NOT NULL,
+		target_board_id TEXT NOT NULL,
+		UNIQUE(source_type, source_id, target_board_id)
+	)`)
+	db.Exec(`CREATE TABLE project_members (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		role_name TEXT NOT NULL,
+		joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		custom_role_id TEXT,
+		UNIQUE(project_id, user_id)
+	)`)
+	repo := NewBoardReferenceRepository(db)
+	ctx := context.Background()
+
+	// Two projects in one workspace, one project elsewhere
+	workspaceID := uuid.New()
+	projectA := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "A"}
+	projectB := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "B"}
+	foreign := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "C"}
+	db.Create(projectA)
+	db.Create(projectB)
+	db.Create(foreign)
+	viewerID := uuid.New()
+	db.Create(&domain.ProjectMember{ID: uuid.New(), ProjectID: projectA.ID, UserID: viewerID, RoleName: domain.ProjectRoleMember})
+
+	newBoard := func(projectID uuid.UUID) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Board"}
+		db.Create(board)
+		return board
+	}
+	source := newBoard(projectA.ID)
+	sameProject := newBoard(projectA.ID)
+	sameWorkspace := newBoard(projectB.ID)
+	otherWorkspace := newBoard(foreign.ID)
+
+	// Only boards of the source workspace are resolved
+	ids, err := repo.FindBoardIDsInSameWorkspace(ctx, source.ID, []uuid.UUID{sameProject.ID, sameWorkspace.ID, otherWorkspace.ID, uuid.New()})
+	if err != nil {
+		t.Fatalf("FindBoardIDsInSameWorkspace() error = %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 boards in workspace, got %v", ids)
+	}
+
+	// Replacing keeps only the latest targets of a source
+	commentID := uuid.New()
+	if err := repo.ReplaceBySource(ctx, domain.BoardReferenceSourceComment, commentID, source.ID, ids); err != nil {
+		t.Fatalf("ReplaceBySource() error = %v", err)
+	}
+	if err := repo.ReplaceBySource(ctx, domain.BoardReferenceSourceComment, commentID, source.ID, []uuid.UUID{sameWorkspace.ID}); err != nil {
+		t.Fatalf("ReplaceBySource() error = %v", err)
+	}
+
+	refs, err := repo.FindByTargetBoardID(ctx, sameProject.ID, viewerID)
+	if err != nil {
+		t.Fatalf("FindByTargetBoardID() error = %v", err)
+	}
+	if len(refs) != 0 {
+		t.Errorf("expected stale reference to be removed, got %d", len(refs))
+	}
+
+	refs, err = repo.FindByTargetBoardID(ctx, sameWorkspace.ID, viewerID)
+	if err != nil {
+		t.Fatalf("FindByTargetBoardID() error = %v", err)
+	}
+	if len(refs) != 1 || refs[0].SourceBoard.ID != source.ID || refs[0].SourceBoard.Project.ID != projectA.ID {
+		t.Fatalf("expected one reference with preloaded source board, got %+v", refs)
+	}
+
+	// Sources in private projects are hidden from non-members until the project is public
+	outsiderID := uuid.New()
+	refs, err = repo.FindByTargetBoardID(ctx, sameWorkspace.ID, outsiderID)
+	if err != nil {
+		t.Fatalf("FindByTargetBoardID() error = %v", err)
+	}
+	if len(refs) != 0 {
+		t.Errorf("expected private source to be hidden from non-member, got %d", len(refs))
+	}
+	db.Model(projectA).Update("is_public", true)
+	refs, _ = repo.FindByTargetBoardID(ctx, sameWorkspace.ID, outsiderID)
+	if len(refs) != 1 {
+		t.Errorf("expected public source to be visible to non-member, got %d", len(refs))
+	}
+
+	// Deleting the comment removes its backlinks
+	if err := repo.DeleteBySource(ctx, domain.BoardReferenceSourceComment, commentID); err != nil {
+		t.Fatalf("DeleteBySource() error = %v", err)
+	}
+	refs, _ = repo.FindByTargetBoardID(ctx, sameWorkspace.ID, viewerID)
+	if len(refs) != 0 {
+		t.Errorf("expected no references after delete, got %d", len(refs))
+	}
+}
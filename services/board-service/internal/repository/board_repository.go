@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -14,8 +15,12 @@ import (
 type BoardRepository interface {
 	Create(ctx context.Context, board *domain.Board) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindByProjectAndNumber(ctx context.Context, projectID uuid.UUID, number int64) (*domain.Board, error)
 	FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	FindByIDsInProject(ctx context.Context, projectID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error)
+	FindAssignedByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.Board, error)
 	Update(ctx context.Context, board *domain.Board) error
+	UpdateDates(ctx context.Context, boardID uuid.UUID, startDate, dueDate *time.Time) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
@@ -29,21 +34,42 @@ func NewBoardRepository(db *gorm.DB) BoardRepository {
 	return &boardRepositoryImpl{db: db}
 }
 
-// Create creates a new board
+// Create creates a new board and assigns it the next number of its project
+// The project counter is incremented in the same transaction, so the row lock taken by the
+// UPDATE serializes concurrent creations and a failed insert does not leave a gap
 func (r *boardRepositoryImpl) Create(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Create(board).Error; err != nil {
-		return err
-	}
-	return nil
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Project{}).
+			Where("id = ?", board.ProjectID).
+			UpdateColumn("board_sequence", gorm.Expr("board_sequence + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var sequence int64
+		if err := tx.Model(&domain.Project{}).
+			Where("id = ?", board.ProjectID).
+			Select("board_sequence").
+			Scan(&sequence).Error; err != nil {
+			return err
+		}
+		board.Number = sequence
+
+		return tx.Create(board).Error
+	})
 }
 
 // FindByID finds a board by ID with preloaded participants and comments
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *boardRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
 	var board domain.Board
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Preload("Participants").
 		Preload("Comments").
+		Preload("Labels", orderLabelsByName).
 		// Preload("Attachments"). // ✅ 제거
 		Where("id = ?", id).
 		First(&board).Error; err != nil {
@@ -55,29 +81,66 @@ func (r *boardRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*doma
 	return &board, nil
 }
 
+// FindByProjectAndNumber finds a board by its per-project number
+func (r *boardRepositoryImpl) FindByProjectAndNumber(ctx context.Context, projectID uuid.UUID, number int64) (*domain.Board, error) {
+	var board domain.Board
+	if err := dbWithContext(ctx, r.db).
+		Preload("Participants").
+		Preload("Comments").
+		Preload("Labels", orderLabelsByName).
+		Where("project_id = ? AND number = ?", projectID, number).
+		First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// orderLabelsByName keeps preloaded board labels in a stable order
+func orderLabelsByName(db *gorm.DB) *gorm.DB {
+	return db.Order("labels.name ASC")
+}
+
+// BoardListFilter narrows the boards returned by FindByProjectID
+type BoardListFilter struct {
+	CustomFields   map[string]interface{}
+	LabelIDs       []uuid.UUID
+	MatchAllLabels bool   // true: board must carry every label, false: any of them
+	Query          string // matches the title or the plain text of the content
+}
+
 // FindByProjectID finds all boards by project ID with optional filters
+// filters may be a custom field map (map[string]interface{}) or a BoardListFilter
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
 	var boards []*domain.Board
 
 	// Start building the query with Participants preload
-	query := r.db.WithContext(ctx).
+	query := dbWithContext(ctx, r.db).
 		Preload("Participants").
+		Preload("Labels", orderLabelsByName).
 		// Preload("Attachments"). // ✅ 제거
 		Where("project_id = ?", projectID)
 
 	// Apply filters if provided
-	if filters != nil {
-		// Type assertion to get customFields map
-		if customFields, ok := filters.(map[string]interface{}); ok {
-			// Apply JSONB filtering for each custom field
-			for key, value := range customFields {
-				// Use JSONB operator ->> to extract text value and compare
-				query = query.Where("custom_fields->>? = ?", key, value)
-			}
+	var customFields map[string]interface{}
+	switch f := filters.(type) {
+	case map[string]interface{}:
+		customFields = f
+	case BoardListFilter:
+		customFields = f.CustomFields
+		query = applyLabelFilter(query, f.LabelIDs, f.MatchAllLabels)
+		if f.Query != "" {
+			searchPattern := "%" + f.Query + "%"
+			query = query.Where("(title ILIKE ? OR content_text ILIKE ?)", searchPattern, searchPattern)
 		}
 	}
 
+	// Apply JSONB filtering for each custom field
+	for key, value := range customFields {
+		// Use JSONB operator ->> to extract text value and compare
+		query = query.Where("custom_fields->>? = ?", key, value)
+	}
+
 	// Execute the query
 	if err := query.Find(&boards).Error; err != nil {
 		return nil, err
@@ -86,17 +149,75 @@ func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uui
 	return boards, nil
 }
 
+// applyLabelFilter restricts a board query to boards carrying any (or all) of the labels
+func applyLabelFilter(query *gorm.DB, labelIDs []uuid.UUID, matchAll bool) *gorm.DB {
+	if len(labelIDs) == 0 {
+		return query
+	}
+	if !matchAll {
+		return query.Where("id IN (SELECT board_id FROM board_labels WHERE label_id IN ?)", labelIDs)
+	}
+	return query.Where(
+		"id IN (SELECT board_id FROM board_labels WHERE label_id IN ? GROUP BY board_id HAVING COUNT(DISTINCT label_id) = ?)",
+		labelIDs, len(labelIDs))
+}
+
+// FindByIDsInProject finds the boards of a project among the given IDs (IDs of other projects are ignored)
+func (r *boardRepositoryImpl) FindByIDsInProject(ctx context.Context, projectID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if len(ids) == 0 {
+		return boards, nil
+	}
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND id IN ?", projectID, ids).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindAssignedByProjectIDs finds the boards with an assignee across several projects
+func (r *boardRepositoryImpl) FindAssignedByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if len(projectIDs) == 0 {
+		return boards, nil
+	}
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id IN ? AND assignee_id IS NOT NULL", projectIDs).
+		Order("created_at ASC").
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
 // Update updates a board
+// Labels are managed through LabelRepository.ReplaceBoardLabels and never saved from a loaded copy
 func (r *boardRepositoryImpl) Update(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Save(board).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Omit("Labels").Save(board).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
+// UpdateDates sets the start and due date of a board without touching its other columns
+func (r *boardRepositoryImpl) UpdateDates(ctx context.Context, boardID uuid.UUID, startDate, dueDate *time.Time) error {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Where("id = ?", boardID).
+		Updates(map[string]interface{}{"start_date": startDate, "due_date": dueDate})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // Delete soft deletes a board
 func (r *boardRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Board{}, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Delete(&domain.Board{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/driver/sqlite"
@@ -32,7 +34,11 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		start_date DATETIME,
 		due_date DATETIME,
 		is_default INTEGER DEFAULT 0,
-		is_public INTEGER DEFAULT 0
+		is_public INTEGER DEFAULT 0,
+		key_prefix TEXT NOT NULL DEFAULT '',
+		board_sequence INTEGER NOT NULL DEFAULT 0,
+		archived_at DATETIME,
+		archived_by TEXT
 	)`)
 
 	db.Exec(`CREATE TABLE boards (
@@ -43,11 +49,16 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		project_id TEXT NOT NULL,
 		author_id TEXT NOT NULL,
 		assignee_id TEXT,
+		sprint_id TEXT,
+		recurrence_id TEXT,
 		title TEXT NOT NULL,
 		content TEXT,
+		content_text TEXT,
 		custom_fields TEXT,
 		start_date DATETIME,
-		due_date DATETIME
+		due_date DATETIME,
+		completed_at DATETIME,
+		number INTEGER NOT NULL DEFAULT 0
 	)`)
 
 	db.Exec(`CREATE TABLE participants (
@@ -60,6 +71,35 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		UNIQUE(board_id, user_id)
 	)`)
 
+	db.Exec(`CREATE TABLE comments (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		content TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE labels (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		color TEXT NOT NULL,
+		description TEXT,
+		created_by TEXT NOT NULL,
+		UNIQUE(project_id, name)
+	)`)
+
+	db.Exec(`CREATE TABLE board_labels (
+		board_id TEXT NOT NULL,
+		label_id TEXT NOT NULL,
+		PRIMARY KEY(board_id, label_id)
+	)`)
+
 	db.Exec(`CREATE TABLE attachments (
 		id TEXT PRIMARY KEY,
 		created_at DATETIME NOT NULL,
@@ -74,6 +114,117 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		uploaded_by TEXT NOT NULL
 	)`)
 
+	db.Exec(`CREATE TABLE sprints (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		goal TEXT,
+		state TEXT NOT NULL DEFAULT 'planned',
+		start_date DATETIME NOT NULL,
+		end_date DATETIME NOT NULL,
+		started_at DATETIME,
+		closed_at DATETIME,
+		created_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE sprint_scope_changes (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		sprint_id TEXT NOT NULL,
+		board_id TEXT NOT NULL,
+		change_type TEXT NOT NULL,
+		changed_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE automation_rules (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		enabled INTEGER NOT NULL DEFAULT 1,
+		trigger TEXT NOT NULL,
+		trigger_field TEXT,
+		trigger_value TEXT,
+		conditions TEXT,
+		actions TEXT NOT NULL,
+		created_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE automation_due_date_firings (
+		rule_id TEXT NOT NULL,
+		board_id TEXT NOT NULL,
+		due_date DATETIME NOT NULL,
+		fired_at DATETIME NOT NULL,
+		PRIMARY KEY (rule_id, board_id, due_date)
+	)`)
+
+	db.Exec(`CREATE TABLE board_reminders (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		remind_at DATETIME,
+		offset_minutes INTEGER,
+		note TEXT,
+		status TEXT NOT NULL DEFAULT 'PENDING',
+		sent_at DATETIME,
+		cancelled_at DATETIME,
+		cancel_reason TEXT
+	)`)
+
+	db.Exec(`CREATE TABLE board_recurrences (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		template_board_id TEXT NOT NULL UNIQUE,
+		rrule TEXT NOT NULL,
+		dtstart DATETIME NOT NULL,
+		latest_board_id TEXT NOT NULL,
+		latest_at DATETIME NOT NULL,
+		occurrence_count INTEGER NOT NULL DEFAULT 1,
+		next_at DATETIME,
+		created_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE project_invite_links (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		token TEXT NOT NULL UNIQUE,
+		role TEXT NOT NULL,
+		max_uses INTEGER,
+		use_count INTEGER NOT NULL DEFAULT 0,
+		expires_at DATETIME NOT NULL,
+		created_by TEXT NOT NULL,
+		revoked_at DATETIME,
+		revoked_by TEXT
+	)`)
+
+	db.Exec(`CREATE TABLE report_templates (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		workspace_id TEXT NOT NULL,
+		format TEXT NOT NULL,
+		body TEXT NOT NULL,
+		updated_by TEXT NOT NULL,
+		UNIQUE (workspace_id, format)
+	)`)
+
 	return db
 }
 
@@ -304,3 +455,164 @@ func TestBoardRepository_FindByProjectID_WithFilters(t *testing.T) {
 		t.Errorf("expected 1 participant, got %d", len(boards[0].Participants))
 	}
 }
+
+func TestBoardRepository_Create_AssignsSequentialNumbers(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	for _, id := range []uuid.UUID{projectID, otherProjectID} {
+		db.Create(&domain.Project{
+			BaseModel:   domain.BaseModel{ID: id},
+			WorkspaceID: uuid.New(),
+			OwnerID:     uuid.New(),
+			Name:        "Test Project",
+			KeyPrefix:   "WEB",
+		})
+	}
+
+	// Numbers are per project and start at 1
+	for i, pid := range []uuid.UUID{projectID, projectID, otherProjectID, projectID} {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: pid,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("Create() #%d error = %v", i, err)
+		}
+	}
+
+	var numbers []int64
+	db.Model(&domain.Board{}).Where("project_id = ?", projectID).Order("number ASC").Pluck("number", &numbers)
+	if len(numbers) != 3 || numbers[0] != 1 || numbers[1] != 2 || numbers[2] != 3 {
+		t.Errorf("expected numbers [1 2 3], got %v", numbers)
+	}
+
+	var sequence int64
+	db.Model(&domain.Project{}).Where("id = ?", otherProjectID).Pluck("board_sequence", &sequence)
+	if sequence != 1 {
+		t.Errorf("expected other project sequence 1, got %d", sequence)
+	}
+
+	// Lookup by number
+	board, err := repo.FindByProjectAndNumber(ctx, projectID, 2)
+	if err != nil {
+		t.Fatalf("FindByProjectAndNumber() error = %v", err)
+	}
+	if board.Number != 2 || board.ProjectID != projectID {
+		t.Errorf("unexpected board %d in project %s", board.Number, board.ProjectID)
+	}
+
+	// Unknown project creates nothing and does not consume a number
+	err = repo.Create(ctx, &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Orphan",
+	})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+	var count int64
+	db.Model(&domain.Board{}).Count(&count)
+	if count != 4 {
+		t.Errorf("expected 4 boards, got %d", count)
+	}
+}
+
+func TestBoardRepository_FindByIDsInProject_AndUpdateDates(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		AuthorID:  uuid.New(),
+		Title:     "Scheduled",
+		Content:   "Content",
+		StartDate: &start,
+		DueDate:   &due,
+	}
+	otherBoard := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: otherProjectID,
+		AuthorID:  uuid.New(),
+		Title:     "Other project",
+	}
+	db.Create(board)
+	db.Create(otherBoard)
+
+	// Boards of other projects are ignored
+	boards, err := repo.FindByIDsInProject(ctx, projectID, []uuid.UUID{board.ID, otherBoard.ID})
+	if err != nil {
+		t.Fatalf("FindByIDsInProject() error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != board.ID {
+		t.Fatalf("expected only the board of the project, got %d boards", len(boards))
+	}
+
+	// Only the dates change
+	newStart := start.AddDate(0, 0, 7)
+	if err := repo.UpdateDates(ctx, board.ID, &newStart, nil); err != nil {
+		t.Fatalf("UpdateDates() error = %v", err)
+	}
+	var updated domain.Board
+	db.First(&updated, "id = ?", board.ID)
+	if updated.StartDate == nil || !updated.StartDate.Equal(newStart) || updated.DueDate != nil {
+		t.Errorf("unexpected dates %v ~ %v", updated.StartDate, updated.DueDate)
+	}
+	if updated.Title != "Scheduled" || updated.Content != "Content" {
+		t.Errorf("expected other columns to be unchanged, got %q / %q", updated.Title, updated.Content)
+	}
+
+	if err := repo.UpdateDates(ctx, uuid.New(), &newStart, nil); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound for an unknown board, got %v", err)
+	}
+}
+
+func TestBoardRepository_FindAssignedByProjectIDs(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	hiddenProjectID := uuid.New()
+	assigneeID := uuid.New()
+
+	assigned := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Assigned", AssigneeID: &assigneeID}
+	unassigned := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Unassigned"}
+	otherAssigned := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: otherProjectID, AuthorID: uuid.New(), Title: "Other", AssigneeID: &assigneeID}
+	hidden := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: hiddenProjectID, AuthorID: uuid.New(), Title: "Hidden", AssigneeID: &assigneeID}
+	for _, board := range []*domain.Board{assigned, unassigned, otherAssigned, hidden} {
+		db.Create(board)
+	}
+
+	boards, err := repo.FindAssignedByProjectIDs(ctx, []uuid.UUID{projectID, otherProjectID})
+	if err != nil {
+		t.Fatalf("FindAssignedByProjectIDs() error = %v", err)
+	}
+	if len(boards) != 2 {
+		t.Fatalf("expected 2 assigned boards, got %d", len(boards))
+	}
+	for _, board := range boards {
+		if board.ID != assigned.ID && board.ID != otherAssigned.ID {
+			t.Errorf("unexpected board %s", board.Title)
+		}
+	}
+
+	boards, err = repo.FindAssignedByProjectIDs(ctx, nil)
+	if err != nil || len(boards) != 0 {
+		t.Errorf("expected no boards without projects, got %d (err %v)", len(boards), err)
+	}
+}
@@ -31,7 +31,7 @@ func NewCommentRepository(db *gorm.DB) CommentRepository {
 
 // Create creates a new comment
 func (r *commentRepositoryImpl) Create(ctx context.Context, comment *domain.Comment) error {
-	if err := r.db.WithContext(ctx).Create(comment).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(comment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -41,7 +41,7 @@ func (r *commentRepositoryImpl) Create(ctx context.Context, comment *domain.Comm
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *commentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
 	var comment domain.Comment
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		// Preload("Attachments"). // ✅ 제거
 		Where("id = ?", id).
 		First(&comment).Error; err != nil {
@@ -57,7 +57,7 @@ func (r *commentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*do
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *commentRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Comment, error) {
 	var comments []*domain.Comment
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		// Preload("Attachments"). // ✅ 제거
 		Where("board_id = ?", boardID).
 		Order("created_at ASC").
@@ -69,7 +69,7 @@ func (r *commentRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.
 
 // Update updates a comment
 func (r *commentRepositoryImpl) Update(ctx context.Context, comment *domain.Comment) error {
-	if err := r.db.WithContext(ctx).Save(comment).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Save(comment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -77,7 +77,7 @@ func (r *commentRepositoryImpl) Update(ctx context.Context, comment *domain.Comm
 
 // Delete soft deletes a comment
 func (r *commentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Comment{}, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Delete(&domain.Comment{}, id).Error; err != nil {
 		return err
 	}
 	return nil
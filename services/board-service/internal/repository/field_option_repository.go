@@ -37,7 +37,7 @@ func NewFieldOptionRepository(db *gorm.DB) FieldOptionRepository {
 
 // Create creates a new field option
 func (r *fieldOptionRepositoryImpl) Create(ctx context.Context, fieldOption *domain.FieldOption) error {
-	if err := r.db.WithContext(ctx).Create(fieldOption).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(fieldOption).Error; err != nil {
 		return err
 	}
 	return nil
@@ -46,7 +46,7 @@ func (r *fieldOptionRepositoryImpl) Create(ctx context.Context, fieldOption *dom
 // FindByID finds a field option by ID
 func (r *fieldOptionRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.FieldOption, error) {
 	var fieldOption domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("id = ?", id).
 		First(&fieldOption).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -60,7 +60,7 @@ func (r *fieldOptionRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID)
 // FindByFieldType finds all field options by field type, ordered by display_order
 func (r *fieldOptionRepositoryImpl) FindByFieldType(ctx context.Context, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
 	var fieldOptions []*domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("field_type = ?", fieldType).
 		Order("display_order ASC").
 		Find(&fieldOptions).Error; err != nil {
@@ -72,7 +72,7 @@ func (r *fieldOptionRepositoryImpl) FindByFieldType(ctx context.Context, fieldTy
 // FindByFieldTypeAndValue finds a field option by field type and value
 func (r *fieldOptionRepositoryImpl) FindByFieldTypeAndValue(ctx context.Context, fieldType, value string) (*domain.FieldOption, error) {
 	var fieldOption domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("field_type = ? AND value = ?", fieldType, value).
 		First(&fieldOption).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -85,7 +85,7 @@ func (r *fieldOptionRepositoryImpl) FindByFieldTypeAndValue(ctx context.Context,
 
 // Update updates a field option
 func (r *fieldOptionRepositoryImpl) Update(ctx context.Context, fieldOption *domain.FieldOption) error {
-	if err := r.db.WithContext(ctx).Save(fieldOption).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Save(fieldOption).Error; err != nil {
 		return err
 	}
 	return nil
@@ -93,7 +93,7 @@ func (r *fieldOptionRepositoryImpl) Update(ctx context.Context, fieldOption *dom
 
 // Delete soft deletes a field option
 func (r *fieldOptionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.FieldOption{}, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Delete(&domain.FieldOption{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -102,7 +102,7 @@ func (r *fieldOptionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) er
 // FindByProjectAndFieldType finds all field options for a specific project and field type
 func (r *fieldOptionRepositoryImpl) FindByProjectAndFieldType(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
 	var fieldOptions []*domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id = ? AND field_type = ?", projectID, fieldType).
 		Order("display_order ASC").
 		Find(&fieldOptions).Error; err != nil {
@@ -114,7 +114,7 @@ func (r *fieldOptionRepositoryImpl) FindByProjectAndFieldType(ctx context.Contex
 // FindSystemDefaults finds all system default field options (project_id is NULL)
 func (r *fieldOptionRepositoryImpl) FindSystemDefaults(ctx context.Context) ([]*domain.FieldOption, error) {
 	var fieldOptions []*domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id IS NULL AND is_system_default = ?", true).
 		Order("field_type ASC, display_order ASC").
 		Find(&fieldOptions).Error; err != nil {
@@ -128,7 +128,7 @@ func (r *fieldOptionRepositoryImpl) CreateBatch(ctx context.Context, fieldOption
 	if len(fieldOptions) == 0 {
 		return nil
 	}
-	if err := r.db.WithContext(ctx).Create(&fieldOptions).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(&fieldOptions).Error; err != nil {
 		return err
 	}
 	return nil
@@ -141,7 +141,7 @@ func (r *fieldOptionRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UU
 	}
 
 	var fieldOptions []*domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("id IN ?", ids).
 		Find(&fieldOptions).Error; err != nil {
 		return nil, err
@@ -152,7 +152,7 @@ func (r *fieldOptionRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UU
 // FindByProjectAndFieldTypeAndValue finds a field option by project ID, field type, and value
 func (r *fieldOptionRepositoryImpl) FindByProjectAndFieldTypeAndValue(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
 	var fieldOption domain.FieldOption
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id = ? AND field_type = ? AND value = ?", projectID, fieldType, value).
 		First(&fieldOption).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// InviteLinkRepository defines the interface for project invite link data access
+type InviteLinkRepository interface {
+	Create(ctx context.Context, link *domain.ProjectInviteLink) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectInviteLink, error)
+	FindByToken(ctx context.Context, token string) (*domain.ProjectInviteLink, error)
+	FindByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectInviteLink, error)
+	Revoke(ctx context.Context, id, revokedBy uuid.UUID, revokedAt time.Time) error
+	ConsumeUse(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+}
+
+// inviteLinkRepositoryImpl is the GORM implementation of InviteLinkRepository
+type inviteLinkRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewInviteLinkRepository creates a new instance of InviteLinkRepository
+func NewInviteLinkRepository(db *gorm.DB) InviteLinkRepository {
+	return &inviteLinkRepositoryImpl{db: db}
+}
+
+// Create creates a new invite link
+func (r *inviteLinkRepositoryImpl) Create(ctx context.Context, link *domain.ProjectInviteLink) error {
+	if link.ID == uuid.Nil {
+		link.ID = uuid.New()
+	}
+	return dbWithContext(ctx, r.db).Omit("Project").Create(link).Error
+}
+
+// FindByID finds an invite link by ID
+func (r *inviteLinkRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectInviteLink, error) {
+	var link domain.ProjectInviteLink
+	if err := dbWithContext(ctx, r.db).Where("id = ?", id).First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// FindByToken finds an invite link by its token with its project
+func (r *inviteLinkRepositoryImpl) FindByToken(ctx context.Context, token string) (*domain.ProjectInviteLink, error) {
+	var link domain.ProjectInviteLink
+	if err := dbWithContext(ctx, r.db).
+		Preload("Project").
+		Where("token = ?", token).
+		First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// FindByProject finds all invite links of a project, newest first
+func (r *inviteLinkRepositoryImpl) FindByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectInviteLink, error) {
+	links := make([]*domain.ProjectInviteLink, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// Revoke marks an invite link as revoked
+func (r *inviteLinkRepositoryImpl) Revoke(ctx context.Context, id, revokedBy uuid.UUID, revokedAt time.Time) error {
+	return dbWithContext(ctx, r.db).
+		Model(&domain.ProjectInviteLink{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"revoked_by": revokedBy,
+		}).Error
+}
+
+// ConsumeUse atomically counts one use of a link that is still usable at now
+// It reports false when the link has expired, was revoked or has no uses left
+func (r *inviteLinkRepositoryImpl) ConsumeUse(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.ProjectInviteLink{}).
+		Where("id = ? AND revoked_at IS NULL AND expires_at > ?", id, now).
+		Where("max_uses IS NULL OR use_count < max_uses").
+		Update("use_count", gorm.Expr("use_count + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestInviteLinkRepository_ConsumeUse(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewInviteLinkRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Invites"}
+	db.Create(project)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newLink := func(token string, maxUses *int, expiresAt time.Time) *domain.ProjectInviteLink {
+		link := &domain.ProjectInviteLink{
+			ProjectID: project.ID,
+			Token:     token,
+			Role:      domain.ProjectRoleMember,
+			MaxUses:   maxUses,
+			ExpiresAt: expiresAt,
+			CreatedBy: project.OwnerID,
+		}
+		if err := repo.Create(ctx, link); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		return link
+	}
+	two := 2
+	limited := newLink("limited", &two, now.Add(time.Hour))
+	expired := newLink("expired", nil, now.Add(-time.Minute))
+	revoked := newLink("revoked", nil, now.Add(time.Hour))
+	if err := repo.Revoke(ctx, revoked.ID, project.OwnerID, now); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+
+	for i, want := range []bool{true, true, false} {
+		ok, err := repo.ConsumeUse(ctx, limited.ID, now)
+		if err != nil {
+			t.Fatalf("ConsumeUse() error = %v", err)
+		}
+		if ok != want {
+			t.Errorf("ConsumeUse() #%d = %v, want %v", i+1, ok, want)
+		}
+	}
+	for _, link := range []*domain.ProjectInviteLink{expired, revoked} {
+		if ok, err := repo.ConsumeUse(ctx, link.ID, now); err != nil || ok {
+			t.Errorf("ConsumeUse(%s) = %v, %v, want false", link.Token, ok, err)
+		}
+	}
+
+	found, err := repo.FindByToken(ctx, "limited")
+	if err != nil {
+		t.Fatalf("FindByToken() error = %v", err)
+	}
+	if found.UseCount != 2 || found.Project.ID != project.ID {
+		t.Errorf("FindByToken() = use count %d, project %s; want 2, %s", found.UseCount, found.Project.ID, project.ID)
+	}
+	links, err := repo.FindByProject(ctx, project.ID)
+	if err != nil || len(links) != 3 {
+		t.Fatalf("FindByProject() = %d links, %v; want 3", len(links), err)
+	}
+}
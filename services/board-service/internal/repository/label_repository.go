@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// boardLabelsTable is the many-to-many join table between boards and labels
+const boardLabelsTable = "board_labels"
+
+// LabelRepository defines the interface for label data access
+type LabelRepository interface {
+	Create(ctx context.Context, label *domain.Label) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error)
+	FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error)
+	Update(ctx context.Context, label *domain.Label) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	CountUsageByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.LabelUsage, error)
+	ReplaceBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) error
+}
+
+// labelRepositoryImpl is the GORM implementation of LabelRepository
+type labelRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewLabelRepository creates a new instance of LabelRepository
+func NewLabelRepository(db *gorm.DB) LabelRepository {
+	return &labelRepositoryImpl{db: db}
+}
+
+// Create creates a new label
+func (r *labelRepositoryImpl) Create(ctx context.Context, label *domain.Label) error {
+	return dbWithContext(ctx, r.db).Create(label).Error
+}
+
+// FindByID finds a label by ID
+func (r *labelRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	var label domain.Label
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&label).Error; err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// FindByIDs finds labels by IDs
+func (r *labelRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+	labels := make([]*domain.Label, 0)
+	if len(ids) == 0 {
+		return labels, nil
+	}
+	if err := dbWithContext(ctx, r.db).
+		Where("id IN ?", ids).
+		Find(&labels).Error; err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// FindByProjectID finds all labels of a project ordered by name
+func (r *labelRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	labels := make([]*domain.Label, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Find(&labels).Error; err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// FindByProjectAndName finds a label of a project by its exact name
+func (r *labelRepositoryImpl) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error) {
+	var label domain.Label
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND name = ?", projectID, name).
+		First(&label).Error; err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// Update updates a label
+func (r *labelRepositoryImpl) Update(ctx context.Context, label *domain.Label) error {
+	return dbWithContext(ctx, r.db).Save(label).Error
+}
+
+// Delete deletes a label together with its board assignments
+func (r *labelRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM board_labels WHERE label_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Label{}, id).Error
+	})
+}
+
+// CountUsageByProjectID counts the boards attached to each label of a project
+// Labels without boards are not included
+func (r *labelRepositoryImpl) CountUsageByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.LabelUsage, error) {
+	usages := make([]domain.LabelUsage, 0)
+	if err := dbWithContext(ctx, r.db).
+		Table(boardLabelsTable).
+		Select("board_labels.label_id AS label_id, COUNT(*) AS board_count").
+		Joins("JOIN labels ON labels.id = board_labels.label_id").
+		Where("labels.project_id = ?", projectID).
+		Group("board_labels.label_id").
+		Scan(&usages).Error; err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
+
+// ReplaceBoardLabels sets the labels of a board to exactly labelIDs
+func (r *labelRepositoryImpl) ReplaceBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM board_labels WHERE board_id = ?", boardID).Error; err != nil {
+			return err
+		}
+		if len(labelIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]map[string]interface{}, 0, len(labelIDs))
+		for _, labelID := range labelIDs {
+			rows = append(rows, map[string]interface{}{"board_id": boardID, "label_id": labelID})
+		}
+		return tx.Table(boardLabelsTable).Create(rows).Error
+	})
+}
+
+// Merge moves every board assignment of the source label to the target label and deletes the source
+// Boards that already carry both labels keep a single assignment
+func (r *labelRepositoryImpl) Merge(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO board_labels (board_id, label_id)
+			SELECT board_id, ? FROM board_labels
+			WHERE label_id = ?
+			AND board_id NOT IN (SELECT board_id FROM board_labels WHERE label_id = ?)`,
+			targetID, sourceID, targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM board_labels WHERE label_id = ?", sourceID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Label{}, sourceID).Error
+	})
+}
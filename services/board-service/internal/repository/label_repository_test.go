@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestLabelRepository_FilterUsageAndMerge(t *testing.T) {
+	db := setupBoardTestDB(t)
+	labelRepo := NewLabelRepository(db)
+	boardRepo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Labels"}
+	db.Create(project)
+
+	newLabel := func(name string) *domain.Label {
+		label := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, Name: name, Color: domain.DefaultLabelColor, CreatedBy: project.OwnerID}
+		if err := labelRepo.Create(ctx, label); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		return label
+	}
+	newBoard := func(labels ...*domain.Label) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Board"}
+		db.Create(board)
+		ids := make([]uuid.UUID, len(labels))
+		for i, label := range labels {
+			ids[i] = label.ID
+		}
+		if err := labelRepo.ReplaceBoardLabels(ctx, board.ID, ids); err != nil {
+			t.Fatalf("ReplaceBoardLabels() error = %v", err)
+		}
+		return board
+	}
+
+	frontend := newLabel("frontend")
+	web := newLabel("web")
+	bug := newLabel("bug")
+
+	both := newBoard(frontend, bug)
+	frontendOnly := newBoard(frontend)
+	webOnly := newBoard(web)
+	newBoard()
+
+	// any: boards carrying at least one of the labels
+	boards, err := boardRepo.FindByProjectID(ctx, project.ID, BoardListFilter{LabelIDs: []uuid.UUID{frontend.ID, web.ID}})
+	if err != nil {
+		t.Fatalf("FindByProjectID(any) error = %v", err)
+	}
+	if len(boards) != 3 {
+		t.Errorf("any filter returned %d boards, want 3", len(boards))
+	}
+
+	// all: only boards carrying every label
+	boards, err = boardRepo.FindByProjectID(ctx, project.ID, BoardListFilter{LabelIDs: []uuid.UUID{frontend.ID, bug.ID}, MatchAllLabels: true})
+	if err != nil {
+		t.Fatalf("FindByProjectID(all) error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != both.ID {
+		t.Fatalf("all filter returned %v, want only %s", boards, both.ID)
+	}
+	if len(boards[0].Labels) != 2 || boards[0].Labels[0].Name != "bug" {
+		t.Errorf("expected preloaded labels ordered by name, got %+v", boards[0].Labels)
+	}
+
+	// Merging web into frontend moves its boards and deletes web
+	if err := labelRepo.Merge(ctx, web.ID, frontend.ID); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if _, err := labelRepo.FindByID(ctx, web.ID); err == nil {
+		t.Error("merged label should be deleted")
+	}
+
+	usages, err := labelRepo.CountUsageByProjectID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("CountUsageByProjectID() error = %v", err)
+	}
+	counts := make(map[uuid.UUID]int64)
+	for _, u := range usages {
+		counts[u.LabelID] = u.BoardCount
+	}
+	if counts[frontend.ID] != 3 || counts[bug.ID] != 1 || counts[web.ID] != 0 {
+		t.Errorf("unexpected usage counts after merge: %v", counts)
+	}
+
+	// Merging into a label the board already carries keeps a single assignment
+	if err := labelRepo.Merge(ctx, bug.ID, frontend.ID); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	for _, board := range []*domain.Board{both, frontendOnly, webOnly} {
+		reloaded, err := boardRepo.FindByID(ctx, board.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if len(reloaded.Labels) != 1 || reloaded.Labels[0].ID != frontend.ID {
+			t.Errorf("board %s labels = %+v, want only frontend", board.ID, reloaded.Labels)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// OutboxRepository defines the interface for outbox event data access
+type OutboxRepository interface {
+	Create(ctx context.Context, events []*domain.OutboxEvent) error
+	FindDeliverable(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxEvent, error)
+	ClaimDue(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error)
+	Update(ctx context.Context, event *domain.OutboxEvent) error
+	FindOldestPendingCreatedAt(ctx context.Context) (*time.Time, error)
+	CountPending(ctx context.Context) (int64, error)
+	DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// outboxRepositoryImpl is the GORM implementation of OutboxRepository
+type outboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepositoryImpl{db: db}
+}
+
+// Create inserts outbox events; called with a transaction context, the events commit or roll back
+// together with the change that produced them
+func (r *outboxRepositoryImpl) Create(ctx context.Context, events []*domain.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return dbWithContext(ctx, r.db).Create(&events).Error
+}
+
+// FindDeliverable finds due pending events that are the oldest pending event of their aggregate and topic
+// Later events of an aggregate wait until the earlier ones are delivered or dead, which keeps delivery
+// in order even while an earlier event is leased by another worker or waiting for a retry
+func (r *outboxRepositoryImpl) FindDeliverable(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	events := make([]*domain.OutboxEvent, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("status = ?", domain.OutboxStatusPending).
+		Where("next_attempt_at <= ?", now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox_events AS earlier
+			WHERE earlier.aggregate_type = outbox_events.aggregate_type
+			  AND earlier.aggregate_id = outbox_events.aggregate_id
+			  AND earlier.topic = outbox_events.topic
+			  AND earlier.status = ?
+			  AND earlier.id < outbox_events.id
+		)`, domain.OutboxStatusPending).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ClaimDue moves the next attempt of a due pending event to leaseUntil
+// It returns false when another worker already claimed the event or it is no longer due
+func (r *outboxRepositoryImpl) ClaimDue(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.OutboxEvent{}).
+		Where("id = ?", id).
+		Where("status = ?", domain.OutboxStatusPending).
+		Where("next_attempt_at <= ?", now).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Update updates an outbox event
+func (r *outboxRepositoryImpl) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	return dbWithContext(ctx, r.db).Save(event).Error
+}
+
+// FindOldestPendingCreatedAt returns the creation time of the oldest pending event (nil when none is pending)
+func (r *outboxRepositoryImpl) FindOldestPendingCreatedAt(ctx context.Context) (*time.Time, error) {
+	var event domain.OutboxEvent
+	err := dbWithContext(ctx, r.db).
+		Select("created_at").
+		Where("status = ?", domain.OutboxStatusPending).
+		Order("id ASC").
+		Limit(1).
+		Find(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatedAt.IsZero() {
+		return nil, nil
+	}
+	return &event.CreatedAt, nil
+}
+
+// CountPending counts the events waiting for delivery
+func (r *outboxRepositoryImpl) CountPending(ctx context.Context) (int64, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.OutboxEvent{}).
+		Where("status = ?", domain.OutboxStatusPending).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteDeliveredBefore removes delivered events older than the given time and returns how many were removed
+// Dead events are kept for inspection
+func (r *outboxRepositoryImpl) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := dbWithContext(ctx, r.db).
+		Where("status = ? AND delivered_at < ?", domain.OutboxStatusDelivered, before).
+		Delete(&domain.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+func setupOutboxTestDB(t *testing.T) *gorm.DB {
+	db := setupBoardTestDB(t)
+	db.Exec(`CREATE TABLE outbox_events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		idempotency_key TEXT NOT NULL UNIQUE,
+		aggregate_type TEXT NOT NULL,
+		aggregate_id TEXT NOT NULL,
+		topic TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		project_id TEXT,
+		payload TEXT NOT NULL,
+		status TEXT NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at DATETIME NOT NULL,
+		last_error TEXT,
+		created_at DATETIME NOT NULL,
+		delivered_at DATETIME
+	)`)
+	return db
+}
+
+func newTestOutboxEvent(aggregateID uuid.UUID, topic domain.OutboxTopic, now time.Time) *domain.OutboxEvent {
+	return &domain.OutboxEvent{
+		IdempotencyKey: uuid.New(),
+		AggregateType:  domain.OutboxAggregateBoard,
+		AggregateID:    aggregateID,
+		Topic:          topic,
+		EventType:      "BOARD_UPDATED",
+		Payload:        []byte(`{}`),
+		Status:         domain.OutboxStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+func TestOutboxRepository_FindDeliverableKeepsAggregateOrder(t *testing.T) {
+	db := setupOutboxTestDB(t)
+	repo := NewOutboxRepository(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	boardA := uuid.New()
+	boardB := uuid.New()
+	events := []*domain.OutboxEvent{
+		newTestOutboxEvent(boardA, domain.OutboxTopicNotification, now),
+		newTestOutboxEvent(boardA, domain.OutboxTopicNotification, now),
+		newTestOutboxEvent(boardA, domain.OutboxTopicBroadcast, now),
+		newTestOutboxEvent(boardB, domain.OutboxTopicNotification, now),
+	}
+	if err := repo.Create(ctx, events); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Only the oldest pending event per aggregate and topic is deliverable
+	deliverable, err := repo.FindDeliverable(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("FindDeliverable() error = %v", err)
+	}
+	if len(deliverable) != 3 || deliverable[0].ID != events[0].ID || deliverable[1].ID != events[2].ID || deliverable[2].ID != events[3].ID {
+		t.Fatalf("unexpected deliverable events: %+v", deliverable)
+	}
+
+	// A claimed event is leased: it cannot be claimed twice and still holds back its successor
+	claimed, err := repo.ClaimDue(ctx, events[0].ID, now, now.Add(time.Minute))
+	if err != nil || !claimed {
+		t.Fatalf("ClaimDue() claimed = %v, error = %v", claimed, err)
+	}
+	claimed, err = repo.ClaimDue(ctx, events[0].ID, now, now.Add(time.Minute))
+	if err != nil || claimed {
+		t.Fatalf("second ClaimDue() claimed = %v, error = %v", claimed, err)
+	}
+	deliverable, _ = repo.FindDeliverable(ctx, now, 10)
+	if len(deliverable) != 2 {
+		t.Fatalf("expected 2 deliverable events while the first one is leased, got %d", len(deliverable))
+	}
+
+	// Delivering the first event releases the second one
+	deliveredAt := now
+	events[0].Status = domain.OutboxStatusDelivered
+	events[0].DeliveredAt = &deliveredAt
+	if err := repo.Update(ctx, events[0]); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	deliverable, _ = repo.FindDeliverable(ctx, now, 10)
+	if len(deliverable) != 3 || deliverable[0].ID != events[1].ID {
+		t.Fatalf("expected the second event to become deliverable, got %+v", deliverable)
+	}
+
+	pending, err := repo.CountPending(ctx)
+	if err != nil || pending != 3 {
+		t.Errorf("CountPending() = %d, error = %v", pending, err)
+	}
+	removed, err := repo.DeleteDeliveredBefore(ctx, now.Add(time.Second))
+	if err != nil || removed != 1 {
+		t.Errorf("DeleteDeliveredBefore() = %d, error = %v", removed, err)
+	}
+}
+
+func TestTxManager_RollsBackOutboxEvents(t *testing.T) {
+	db := setupOutboxTestDB(t)
+	repo := NewOutboxRepository(db)
+	txManager := NewTxManager(db)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	errAbort := errors.New("abort")
+	err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if !InTx(ctx) {
+			t.Error("expected the context to carry the transaction")
+		}
+		if err := repo.Create(ctx, []*domain.OutboxEvent{newTestOutboxEvent(uuid.New(), domain.OutboxTopicNotification, now)}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("WithinTx() error = %v", err)
+	}
+	if pending, _ := repo.CountPending(ctx); pending != 0 {
+		t.Errorf("expected the event to be rolled back, got %d pending", pending)
+	}
+
+	err = txManager.WithinTx(ctx, func(ctx context.Context) error {
+		return repo.Create(ctx, []*domain.OutboxEvent{newTestOutboxEvent(uuid.New(), domain.OutboxTopicNotification, now)})
+	})
+	if err != nil {
+		t.Fatalf("WithinTx() error = %v", err)
+	}
+	if pending, _ := repo.CountPending(ctx); pending != 1 {
+		t.Errorf("expected the event to be committed, got %d pending", pending)
+	}
+}
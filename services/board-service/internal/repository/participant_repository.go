@@ -30,7 +30,7 @@ func NewParticipantRepository(db *gorm.DB) ParticipantRepository {
 
 // Create creates a new participant
 func (r *participantRepositoryImpl) Create(ctx context.Context, participant *domain.Participant) error {
-	if err := r.db.WithContext(ctx).Create(participant).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(participant).Error; err != nil {
 		return err
 	}
 	return nil
@@ -39,7 +39,7 @@ func (r *participantRepositoryImpl) Create(ctx context.Context, participant *dom
 // FindByBoardID finds all participants by board ID
 func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Participant, error) {
 	var participants []*domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("board_id = ?", boardID).
 		Find(&participants).Error; err != nil {
 		return nil, err
@@ -50,7 +50,7 @@ func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID u
 // FindByBoardAndUser finds a participant by board ID and user ID
 func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (*domain.Participant, error) {
 	var participant domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		First(&participant).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -63,7 +63,7 @@ func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boar
 
 // Delete soft deletes a participant by board ID and user ID
 func (r *participantRepositoryImpl) Delete(ctx context.Context, boardID, userID uuid.UUID) error {
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		Delete(&domain.Participant{}).Error; err != nil {
 		return err
@@ -31,13 +31,13 @@ func NewProjectJoinRequestRepository(db *gorm.DB) ProjectJoinRequestRepository {
 
 // Create creates a new project join request
 func (r *projectJoinRequestRepositoryImpl) Create(ctx context.Context, request *domain.ProjectJoinRequest) error {
-	return r.db.WithContext(ctx).Create(request).Error
+	return dbWithContext(ctx, r.db).Create(request).Error
 }
 
 // FindByID finds a join request by its ID
 func (r *projectJoinRequestRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectJoinRequest, error) {
 	var request domain.ProjectJoinRequest
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("id = ?", id).
 		First(&request).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -51,7 +51,7 @@ func (r *projectJoinRequestRepositoryImpl) FindByID(ctx context.Context, id uuid
 // FindByProjectID finds all join requests for a project, optionally filtered by status
 func (r *projectJoinRequestRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID, status *domain.ProjectJoinRequestStatus) ([]*domain.ProjectJoinRequest, error) {
 	var requests []*domain.ProjectJoinRequest
-	query := r.db.WithContext(ctx).Where("project_id = ?", projectID)
+	query := dbWithContext(ctx, r.db).Where("project_id = ?", projectID)
 
 	if status != nil {
 		query = query.Where("status = ?", *status)
@@ -66,7 +66,7 @@ func (r *projectJoinRequestRepositoryImpl) FindByProjectID(ctx context.Context,
 // FindPendingByProjectAndUser finds a pending join request for a specific user and project
 func (r *projectJoinRequestRepositoryImpl) FindPendingByProjectAndUser(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectJoinRequest, error) {
 	var request domain.ProjectJoinRequest
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id = ? AND user_id = ? AND status = ?", projectID, userID, domain.JoinRequestPending).
 		First(&request).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -79,7 +79,7 @@ func (r *projectJoinRequestRepositoryImpl) FindPendingByProjectAndUser(ctx conte
 
 // UpdateStatus updates the status of a join request
 func (r *projectJoinRequestRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ProjectJoinRequestStatus) error {
-	return r.db.WithContext(ctx).
+	return dbWithContext(ctx, r.db).
 		Model(&domain.ProjectJoinRequest{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
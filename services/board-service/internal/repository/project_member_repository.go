@@ -32,13 +32,13 @@ func NewProjectMemberRepository(db *gorm.DB) ProjectMemberRepository {
 
 // Create creates a new project member
 func (r *projectMemberRepositoryImpl) Create(ctx context.Context, member *domain.ProjectMember) error {
-	return r.db.WithContext(ctx).Create(member).Error
+	return dbWithContext(ctx, r.db).Create(member).Error
 }
 
 // FindByProjectID finds all members of a project
 func (r *projectMemberRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectMember, error) {
 	var members []*domain.ProjectMember
-	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&members).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Where("project_id = ?", projectID).Find(&members).Error; err != nil {
 		return nil, err
 	}
 	return members, nil
@@ -47,7 +47,7 @@ func (r *projectMemberRepositoryImpl) FindByProjectID(ctx context.Context, proje
 // FindByProjectAndUser finds a specific member by project and user ID
 func (r *projectMemberRepositoryImpl) FindByProjectAndUser(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
 	var member domain.ProjectMember
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		First(&member).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -60,7 +60,7 @@ func (r *projectMemberRepositoryImpl) FindByProjectAndUser(ctx context.Context,
 
 // UpdateRole updates a member's role
 func (r *projectMemberRepositoryImpl) UpdateRole(ctx context.Context, projectID, userID uuid.UUID, role domain.ProjectRole) error {
-	return r.db.WithContext(ctx).
+	return dbWithContext(ctx, r.db).
 		Model(&domain.ProjectMember{}).
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		Update("role_name", role).Error
@@ -68,7 +68,7 @@ func (r *projectMemberRepositoryImpl) UpdateRole(ctx context.Context, projectID,
 
 // Delete removes a member from a project
 func (r *projectMemberRepositoryImpl) Delete(ctx context.Context, projectID, userID uuid.UUID) error {
-	return r.db.WithContext(ctx).
+	return dbWithContext(ctx, r.db).
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		Delete(&domain.ProjectMember{}).Error
 }
@@ -76,7 +76,7 @@ func (r *projectMemberRepositoryImpl) Delete(ctx context.Context, projectID, use
 // IsProjectMember checks if a user is a member of a project
 func (r *projectMemberRepositoryImpl) IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Model(&domain.ProjectMember{}).
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		Count(&count).Error; err != nil {
@@ -32,7 +32,11 @@ func setupTestDB(t *testing.T) *gorm.DB {
 		start_date DATETIME,
 		due_date DATETIME,
 		is_default INTEGER DEFAULT 0,
-		is_public INTEGER DEFAULT 0
+		is_public INTEGER DEFAULT 0,
+		key_prefix TEXT NOT NULL DEFAULT '',
+		board_sequence INTEGER NOT NULL DEFAULT 0,
+		archived_at DATETIME,
+		archived_by TEXT
 	)`)
 
 	db.Exec(`CREATE TABLE project_members (
@@ -41,9 +45,23 @@ func setupTestDB(t *testing.T) *gorm.DB {
 		user_id TEXT NOT NULL,
 		role_name TEXT NOT NULL,
 		joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		custom_role_id TEXT,
 		UNIQUE(project_id, user_id)
 	)`)
 
+	db.Exec(`CREATE TABLE project_custom_roles (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		description TEXT,
+		permissions TEXT,
+		created_by TEXT NOT NULL,
+		UNIQUE(project_id, name)
+	)`)
+
 	db.Exec(`CREATE TABLE project_join_requests (
 		id TEXT PRIMARY KEY,
 		project_id TEXT NOT NULL,
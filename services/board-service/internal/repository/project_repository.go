@@ -14,8 +14,9 @@ import (
 type ProjectRepository interface {
 	Create(ctx context.Context, project *domain.Project) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
-	FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
+	FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, includeArchived bool) ([]*domain.Project, error)
 	FindDefaultByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
+	FindByWorkspaceAndKeyPrefix(ctx context.Context, workspaceID uuid.UUID, keyPrefix string) (*domain.Project, error)
 	Search(ctx context.Context, workspaceID uuid.UUID, query string, page, limit int) ([]*domain.Project, int64, error)
 	Update(ctx context.Context, project *domain.Project) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -27,6 +28,8 @@ type ProjectRepository interface {
 	UpdateMemberRole(ctx context.Context, memberID uuid.UUID, role domain.ProjectRole) error
 	RemoveMember(ctx context.Context, memberID uuid.UUID) error
 	IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
+	FindMemberProjectIDs(ctx context.Context, userID uuid.UUID, projectIDs []uuid.UUID) ([]uuid.UUID, error)
+	FindMemberUserIDs(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error)
 
 	// Join request management
 	CreateJoinRequest(ctx context.Context, request *domain.ProjectJoinRequest) error
@@ -48,7 +51,7 @@ func NewProjectRepository(db *gorm.DB) ProjectRepository {
 
 // Create creates a new project
 func (r *projectRepositoryImpl) Create(ctx context.Context, project *domain.Project) error {
-	if err := r.db.WithContext(ctx).Create(project).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Create(project).Error; err != nil {
 		return err
 	}
 	return nil
@@ -58,7 +61,7 @@ func (r *projectRepositoryImpl) Create(ctx context.Context, project *domain.Proj
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *projectRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 	var project domain.Project
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		// Preload("Attachments"). // ✅ 제거
 		Where("id = ?", id).
 		First(&project).Error; err != nil {
@@ -71,14 +74,18 @@ func (r *projectRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*do
 }
 
 // FindByWorkspaceID finds all projects by workspace ID
+// Archived projects are only included when includeArchived is set
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
-func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
+func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, includeArchived bool) ([]*domain.Project, error) {
 	// Explicitly initialize empty array to prevent nil return
 	projects := make([]*domain.Project, 0)
-	if err := r.db.WithContext(ctx).
+	query := dbWithContext(ctx, r.db).
 		// Preload("Attachments"). // ✅ 제거
-		Where("workspace_id = ?", workspaceID).
-		Find(&projects).Error; err != nil {
+		Where("workspace_id = ?", workspaceID)
+	if !includeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+	if err := query.Find(&projects).Error; err != nil {
 		return nil, err
 	}
 	return projects, nil
@@ -87,7 +94,7 @@ func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspace
 // FindDefaultByWorkspaceID finds the default project by workspace ID
 func (r *projectRepositoryImpl) FindDefaultByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error) {
 	var project domain.Project
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("workspace_id = ? AND is_default = ?", workspaceID, true).
 		First(&project).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -98,9 +105,21 @@ func (r *projectRepositoryImpl) FindDefaultByWorkspaceID(ctx context.Context, wo
 	return &project, nil
 }
 
+// FindByWorkspaceAndKeyPrefix finds the project of a workspace that owns a board key prefix
+func (r *projectRepositoryImpl) FindByWorkspaceAndKeyPrefix(ctx context.Context, workspaceID uuid.UUID, keyPrefix string) (*domain.Project, error) {
+	var project domain.Project
+	if err := dbWithContext(ctx, r.db).
+		Where("workspace_id = ? AND key_prefix = ?", workspaceID, keyPrefix).
+		First(&project).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
 // Update updates a project
+// board_sequence is owned by BoardRepository.Create and never written from a loaded copy
 func (r *projectRepositoryImpl) Update(ctx context.Context, project *domain.Project) error {
-	if err := r.db.WithContext(ctx).Save(project).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Omit("board_sequence").Save(project).Error; err != nil {
 		return err
 	}
 	return nil
@@ -108,7 +127,7 @@ func (r *projectRepositoryImpl) Update(ctx context.Context, project *domain.Proj
 
 // Delete soft deletes a project
 func (r *projectRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Project{}, id).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Delete(&domain.Project{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -119,7 +138,7 @@ func (r *projectRepositoryImpl) Search(ctx context.Context, workspaceID uuid.UUI
 	var projects []*domain.Project
 	var total int64
 
-	db := r.db.WithContext(ctx).Where("workspace_id = ?", workspaceID)
+	db := dbWithContext(ctx, r.db).Where("workspace_id = ?", workspaceID)
 
 	if query != "" {
 		searchPattern := "%" + query + "%"
@@ -142,13 +161,13 @@ func (r *projectRepositoryImpl) Search(ctx context.Context, workspaceID uuid.UUI
 
 // AddMember adds a member to a project
 func (r *projectRepositoryImpl) AddMember(ctx context.Context, member *domain.ProjectMember) error {
-	return r.db.WithContext(ctx).Create(member).Error
+	return dbWithContext(ctx, r.db).Create(member).Error
 }
 
 // FindMembersByProjectID finds all members of a project
 func (r *projectRepositoryImpl) FindMembersByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectMember, error) {
 	var members []*domain.ProjectMember
-	if err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&members).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Preload("CustomRole").Where("project_id = ?", projectID).Find(&members).Error; err != nil {
 		return nil, err
 	}
 	return members, nil
@@ -157,7 +176,8 @@ func (r *projectRepositoryImpl) FindMembersByProjectID(ctx context.Context, proj
 // FindMemberByProjectAndUser finds a specific member by project and user ID
 func (r *projectRepositoryImpl) FindMemberByProjectAndUser(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
 	var member domain.ProjectMember
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
+		Preload("CustomRole").
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		First(&member).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -168,23 +188,45 @@ func (r *projectRepositoryImpl) FindMemberByProjectAndUser(ctx context.Context,
 	return &member, nil
 }
 
-// UpdateMemberRole updates a member's role
+// UpdateMemberRole updates a member's built-in role and clears its custom role,
+// which would otherwise keep replacing the permissions of the new role
 func (r *projectRepositoryImpl) UpdateMemberRole(ctx context.Context, memberID uuid.UUID, role domain.ProjectRole) error {
-	return r.db.WithContext(ctx).
+	return dbWithContext(ctx, r.db).
 		Model(&domain.ProjectMember{}).
 		Where("id = ?", memberID).
-		Update("role_name", role).Error
+		Updates(map[string]interface{}{"role_name": role, "custom_role_id": nil}).Error
 }
 
-// RemoveMember removes a member from a project
+// RemoveMember removes a member from a project together with their project watch and
+// their watches and mutes on the project's boards, so a former member stops receiving board activity
 func (r *projectRepositoryImpl) RemoveMember(ctx context.Context, memberID uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.ProjectMember{}, memberID).Error
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var member domain.ProjectMember
+		if err := tx.Where("id = ?", memberID).First(&member).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		projectBoards := tx.Model(&domain.Board{}).Select("id").Where("project_id = ?", member.ProjectID)
+		if err := tx.Where("user_id = ? AND ((target_type = ? AND target_id = ?) OR (target_type = ? AND target_id IN (?)))",
+			member.UserID, domain.WatchTargetProject, member.ProjectID, domain.WatchTargetBoard, projectBoards).
+			Delete(&domain.Watcher{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ? AND board_id IN (?)", member.UserID, projectBoards).
+			Delete(&domain.BoardMute{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.ProjectMember{}, memberID).Error
+	})
 }
 
 // IsProjectMember checks if a user is a member of a project
 func (r *projectRepositoryImpl) IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Model(&domain.ProjectMember{}).
 		Where("project_id = ? AND user_id = ?", projectID, userID).
 		Count(&count).Error; err != nil {
@@ -193,15 +235,46 @@ func (r *projectRepositoryImpl) IsProjectMember(ctx context.Context, projectID,
 	return count > 0, nil
 }
 
+// FindMemberProjectIDs returns the projects among projectIDs that the user is a member of
+func (r *projectRepositoryImpl) FindMemberProjectIDs(ctx context.Context, userID uuid.UUID, projectIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if len(projectIDs) == 0 {
+		return ids, nil
+	}
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.ProjectMember{}).
+		Where("user_id = ? AND project_id IN ?", userID, projectIDs).
+		Distinct("project_id").
+		Pluck("project_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FindMemberUserIDs returns the users among userIDs that are members of the project
+func (r *projectRepositoryImpl) FindMemberUserIDs(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if len(userIDs) == 0 {
+		return ids, nil
+	}
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.ProjectMember{}).
+		Where("project_id = ? AND user_id IN ?", projectID, userIDs).
+		Pluck("user_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // CreateJoinRequest creates a new join request
 func (r *projectRepositoryImpl) CreateJoinRequest(ctx context.Context, request *domain.ProjectJoinRequest) error {
-	return r.db.WithContext(ctx).Create(request).Error
+	return dbWithContext(ctx, r.db).Create(request).Error
 }
 
 // FindJoinRequestsByProjectID finds all join requests for a project
 func (r *projectRepositoryImpl) FindJoinRequestsByProjectID(ctx context.Context, projectID uuid.UUID, status *domain.ProjectJoinRequestStatus) ([]*domain.ProjectJoinRequest, error) {
 	var requests []*domain.ProjectJoinRequest
-	db := r.db.WithContext(ctx).Where("project_id = ?", projectID)
+	db := dbWithContext(ctx, r.db).Where("project_id = ?", projectID)
 
 	if status != nil {
 		db = db.Where("status = ?", *status)
@@ -216,7 +289,7 @@ func (r *projectRepositoryImpl) FindJoinRequestsByProjectID(ctx context.Context,
 // FindJoinRequestByID finds a join request by ID
 func (r *projectRepositoryImpl) FindJoinRequestByID(ctx context.Context, requestID uuid.UUID) (*domain.ProjectJoinRequest, error) {
 	var request domain.ProjectJoinRequest
-	if err := r.db.WithContext(ctx).Where("id = ?", requestID).First(&request).Error; err != nil {
+	if err := dbWithContext(ctx, r.db).Where("id = ?", requestID).First(&request).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
@@ -228,7 +301,7 @@ func (r *projectRepositoryImpl) FindJoinRequestByID(ctx context.Context, request
 // FindPendingByProjectAndUser finds a pending join request for a specific user and project
 func (r *projectRepositoryImpl) FindPendingByProjectAndUser(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectJoinRequest, error) {
 	var request domain.ProjectJoinRequest
-	if err := r.db.WithContext(ctx).
+	if err := dbWithContext(ctx, r.db).
 		Where("project_id = ? AND user_id = ? AND status = ?", projectID, userID, domain.JoinRequestPending).
 		First(&request).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -241,7 +314,7 @@ func (r *projectRepositoryImpl) FindPendingByProjectAndUser(ctx context.Context,
 
 // UpdateJoinRequestStatus updates the status of a join request
 func (r *projectRepositoryImpl) UpdateJoinRequestStatus(ctx context.Context, requestID uuid.UUID, status domain.ProjectJoinRequestStatus) error {
-	return r.db.WithContext(ctx).
+	return dbWithContext(ctx, r.db).
 		Model(&domain.ProjectJoinRequest{}).
 		Where("id = ?", requestID).
 		Updates(map[string]interface{}{
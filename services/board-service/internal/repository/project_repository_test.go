@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -133,6 +134,37 @@ func TestProjectRepository_Update(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_FindByWorkspaceID_Archived(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	workspaceID := uuid.New()
+	archivedAt := time.Now()
+	active := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "Active"}
+	archived := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "Archived", ArchivedAt: &archivedAt}
+	repo.Create(ctx, active)
+	repo.Create(ctx, archived)
+
+	// Archived projects are hidden by default
+	projects, err := repo.FindByWorkspaceID(ctx, workspaceID, false)
+	if err != nil {
+		t.Fatalf("FindByWorkspaceID() error = %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != active.ID {
+		t.Errorf("expected only the active project, got %d projects", len(projects))
+	}
+
+	// includeArchived returns both
+	projects, err = repo.FindByWorkspaceID(ctx, workspaceID, true)
+	if err != nil {
+		t.Fatalf("FindByWorkspaceID() error = %v", err)
+	}
+	if len(projects) != 2 {
+		t.Errorf("expected 2 projects with includeArchived, got %d", len(projects))
+	}
+}
+
 func TestProjectRepository_Delete(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewProjectRepository(db)
@@ -224,6 +256,43 @@ func TestProjectRepository_FindMembersByProjectID(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_UpdateMemberRole_ClearsCustomRole(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		WorkspaceID: uuid.New(),
+		OwnerID:     uuid.New(),
+		Name:        "Test Project",
+	}
+	db.Create(project)
+
+	customRoleID := uuid.New()
+	member := &domain.ProjectMember{
+		ID:           uuid.New(),
+		ProjectID:    project.ID,
+		UserID:       uuid.New(),
+		RoleName:     domain.ProjectRoleMember,
+		CustomRoleID: &customRoleID,
+	}
+	repo.AddMember(ctx, member)
+
+	if err := repo.UpdateMemberRole(ctx, member.ID, domain.ProjectRoleAdmin); err != nil {
+		t.Fatalf("UpdateMemberRole() error = %v", err)
+	}
+
+	var updated domain.ProjectMember
+	db.First(&updated, "id = ?", member.ID)
+	if updated.RoleName != domain.ProjectRoleAdmin {
+		t.Errorf("expected role ADMIN, got %s", updated.RoleName)
+	}
+	if updated.CustomRoleID != nil {
+		t.Errorf("expected custom role to be cleared, got %s", updated.CustomRoleID)
+	}
+}
+
 func TestProjectRepository_IsProjectMember(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewProjectRepository(db)
@@ -254,6 +323,30 @@ func TestProjectRepository_IsProjectMember(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_FindMemberProjectIDs(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	workspaceID := uuid.New()
+	joined := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "Joined"}
+	other := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, OwnerID: uuid.New(), Name: "Other"}
+	db.Create(joined)
+	db.Create(other)
+
+	userID := uuid.New()
+	repo.AddMember(ctx, &domain.ProjectMember{ProjectID: joined.ID, UserID: userID, RoleName: domain.ProjectRoleMember})
+	repo.AddMember(ctx, &domain.ProjectMember{ProjectID: other.ID, UserID: uuid.New(), RoleName: domain.ProjectRoleMember})
+
+	ids, err := repo.FindMemberProjectIDs(ctx, userID, []uuid.UUID{joined.ID, other.ID})
+	if err != nil {
+		t.Fatalf("FindMemberProjectIDs() error = %v", err)
+	}
+	if len(ids) != 1 || ids[0] != joined.ID {
+		t.Errorf("expected only the joined project, got %v", ids)
+	}
+}
+
 func TestProjectRepository_CreateJoinRequest(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewProjectRepository(db)
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ProjectRoleRepository defines the interface for project custom role data access
+type ProjectRoleRepository interface {
+	Create(ctx context.Context, role *domain.ProjectCustomRole) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectCustomRole, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectCustomRole, error)
+	FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.ProjectCustomRole, error)
+	Update(ctx context.Context, role *domain.ProjectCustomRole) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	AssignToMember(ctx context.Context, memberID uuid.UUID, roleID *uuid.UUID) error
+}
+
+// projectRoleRepositoryImpl is the GORM implementation of ProjectRoleRepository
+type projectRoleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewProjectRoleRepository creates a new instance of ProjectRoleRepository
+func NewProjectRoleRepository(db *gorm.DB) ProjectRoleRepository {
+	return &projectRoleRepositoryImpl{db: db}
+}
+
+// Create creates a new custom role
+func (r *projectRoleRepositoryImpl) Create(ctx context.Context, role *domain.ProjectCustomRole) error {
+	return dbWithContext(ctx, r.db).Create(role).Error
+}
+
+// FindByID finds a custom role by ID
+func (r *projectRoleRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectCustomRole, error) {
+	var role domain.ProjectCustomRole
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&role).Error; err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// FindByProjectID finds all custom roles of a project ordered by name
+func (r *projectRoleRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectCustomRole, error) {
+	roles := make([]*domain.ProjectCustomRole, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// FindByProjectAndName finds a custom role of a project by its exact name
+func (r *projectRoleRepositoryImpl) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.ProjectCustomRole, error) {
+	var role domain.ProjectCustomRole
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND name = ?", projectID, name).
+		First(&role).Error; err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// Update updates a custom role
+func (r *projectRoleRepositoryImpl) Update(ctx context.Context, role *domain.ProjectCustomRole) error {
+	return dbWithContext(ctx, r.db).Save(role).Error
+}
+
+// Delete deletes a custom role; members holding it fall back to their built-in role
+func (r *projectRoleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.ProjectMember{}).
+			Where("custom_role_id = ?", id).
+			Update("custom_role_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.ProjectCustomRole{}, id).Error
+	})
+}
+
+// AssignToMember sets or clears (nil) the custom role of a member
+func (r *projectRoleRepositoryImpl) AssignToMember(ctx context.Context, memberID uuid.UUID, roleID *uuid.UUID) error {
+	return dbWithContext(ctx, r.db).
+		Model(&domain.ProjectMember{}).
+		Where("id = ?", memberID).
+		Update("custom_role_id", roleID).Error
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+)
+
+func TestProjectRoleRepository_AssignAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	roleRepo := NewProjectRoleRepository(db)
+	projectRepo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	userID := uuid.New()
+	member := &domain.ProjectMember{ID: uuid.New(), ProjectID: projectID, UserID: userID, RoleName: domain.ProjectRoleMember}
+	if err := db.Create(member).Error; err != nil {
+		t.Fatalf("failed to create member: %v", err)
+	}
+
+	role := &domain.ProjectCustomRole{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		ProjectID:   projectID,
+		Name:        "Reviewer",
+		Permissions: datatypes.JSON(`["comment.create","comment.delete.any"]`),
+		CreatedBy:   userID,
+	}
+	if err := roleRepo.Create(ctx, role); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Assigned roles are preloaded with the membership used for permission checks
+	if err := roleRepo.AssignToMember(ctx, member.ID, &role.ID); err != nil {
+		t.Fatalf("AssignToMember() error = %v", err)
+	}
+	found, err := projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		t.Fatalf("FindMemberByProjectAndUser() error = %v", err)
+	}
+	if found.CustomRole == nil || found.CustomRole.ID != role.ID {
+		t.Fatalf("expected custom role %s to be preloaded, got %+v", role.ID, found.CustomRole)
+	}
+	if !found.Can(domain.PermissionCommentDeleteAny) || found.Can(domain.PermissionBoardCreate) {
+		t.Errorf("custom role permissions not applied: %v", found.Permissions())
+	}
+
+	// Deleting the role returns its members to their built-in role
+	if err := roleRepo.Delete(ctx, role.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	found, err = projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		t.Fatalf("FindMemberByProjectAndUser() error = %v", err)
+	}
+	if found.CustomRoleID != nil || found.CustomRole != nil {
+		t.Errorf("expected custom role to be cleared, got %v", found.CustomRoleID)
+	}
+	if !found.Can(domain.PermissionBoardCreate) {
+		t.Error("member should fall back to MEMBER permissions")
+	}
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// RecurrenceRepository defines the interface for recurring board series data access
+type RecurrenceRepository interface {
+	Create(ctx context.Context, recurrence *domain.BoardRecurrence) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardRecurrence, error)
+	FindActive(ctx context.Context, limit int) ([]*domain.BoardRecurrence, error)
+	Update(ctx context.Context, recurrence *domain.BoardRecurrence) error
+	Advance(ctx context.Context, recurrence *domain.BoardRecurrence, fromLatestBoardID uuid.UUID, fromNextAt *time.Time) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	LinkBoard(ctx context.Context, boardID uuid.UUID, recurrenceID *uuid.UUID) error
+}
+
+// recurrenceRepositoryImpl is the GORM implementation of RecurrenceRepository
+type recurrenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRecurrenceRepository creates a new instance of RecurrenceRepository
+func NewRecurrenceRepository(db *gorm.DB) RecurrenceRepository {
+	return &recurrenceRepositoryImpl{db: db}
+}
+
+// Create creates a new series
+func (r *recurrenceRepositoryImpl) Create(ctx context.Context, recurrence *domain.BoardRecurrence) error {
+	if recurrence.ID == uuid.Nil {
+		recurrence.ID = uuid.New()
+	}
+	return dbWithContext(ctx, r.db).Omit("Project").Create(recurrence).Error
+}
+
+// FindByID finds a series by ID
+func (r *recurrenceRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardRecurrence, error) {
+	var recurrence domain.BoardRecurrence
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&recurrence).Error; err != nil {
+		return nil, err
+	}
+	return &recurrence, nil
+}
+
+// FindActive finds the series that have not ended, the earliest next occurrence first
+func (r *recurrenceRepositoryImpl) FindActive(ctx context.Context, limit int) ([]*domain.BoardRecurrence, error) {
+	recurrences := make([]*domain.BoardRecurrence, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("next_at IS NOT NULL").
+		Order("next_at ASC").
+		Limit(limit).
+		Find(&recurrences).Error; err != nil {
+		return nil, err
+	}
+	return recurrences, nil
+}
+
+// Update updates a series
+func (r *recurrenceRepositoryImpl) Update(ctx context.Context, recurrence *domain.BoardRecurrence) error {
+	return dbWithContext(ctx, r.db).Omit("Project").Save(recurrence).Error
+}
+
+// Advance saves the latest occurrence and the next date of a series unless it changed since it was read
+// It returns false when another worker generated the occurrence first or the series was rescheduled
+func (r *recurrenceRepositoryImpl) Advance(ctx context.Context, recurrence *domain.BoardRecurrence, fromLatestBoardID uuid.UUID, fromNextAt *time.Time) (bool, error) {
+	query := dbWithContext(ctx, r.db).
+		Model(&domain.BoardRecurrence{}).
+		Where("id = ?", recurrence.ID).
+		Where("latest_board_id = ?", fromLatestBoardID)
+	if fromNextAt != nil {
+		query = query.Where("next_at = ?", *fromNextAt)
+	} else {
+		query = query.Where("next_at IS NULL")
+	}
+	result := query.Updates(map[string]interface{}{
+		"latest_board_id":  recurrence.LatestBoardID,
+		"latest_at":        recurrence.LatestAt,
+		"occurrence_count": recurrence.OccurrenceCount,
+		"next_at":          recurrence.NextAt,
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Delete deletes a series; its boards stay and lose their link to it
+func (r *recurrenceRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Board{}).
+			Where("recurrence_id = ?", id).
+			UpdateColumn("recurrence_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.BoardRecurrence{}, id).Error
+	})
+}
+
+// LinkBoard links a board to a series, or unlinks it when recurrenceID is nil
+func (r *recurrenceRepositoryImpl) LinkBoard(ctx context.Context, boardID uuid.UUID, recurrenceID *uuid.UUID) error {
+	return dbWithContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Where("id = ?", boardID).
+		UpdateColumn("recurrence_id", recurrenceID).Error
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestRecurrenceRepository_FindActiveAndDelete(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Recurring"}
+	db.Create(project)
+	dtstart := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	newSeries := func(nextAt *time.Time) (*domain.BoardRecurrence, *domain.Board) {
+		template := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Checklist"}
+		db.Create(template)
+		series := &domain.BoardRecurrence{
+			ProjectID:       project.ID,
+			TemplateBoardID: template.ID,
+			RRule:           "FREQ=WEEKLY",
+			DTStart:         dtstart,
+			LatestBoardID:   template.ID,
+			LatestAt:        dtstart,
+			OccurrenceCount: 1,
+			NextAt:          nextAt,
+			CreatedBy:       project.OwnerID,
+		}
+		if err := repo.Create(ctx, series); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if err := repo.LinkBoard(ctx, template.ID, &series.ID); err != nil {
+			t.Fatalf("LinkBoard() error = %v", err)
+		}
+		return series, template
+	}
+	later := dtstart.Add(14 * 24 * time.Hour)
+	sooner := dtstart.Add(7 * 24 * time.Hour)
+	laterSeries, _ := newSeries(&later)
+	soonerSeries, template := newSeries(&sooner)
+	newSeries(nil) // ended
+
+	active, err := repo.FindActive(ctx, 10)
+	if err != nil {
+		t.Fatalf("FindActive() error = %v", err)
+	}
+	if len(active) != 2 || active[0].ID != soonerSeries.ID || active[1].ID != laterSeries.ID {
+		t.Fatalf("FindActive() = %d series, want the two active ones, earliest next date first", len(active))
+	}
+
+	occurrence := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Checklist", RecurrenceID: &soonerSeries.ID}
+	db.Create(occurrence)
+
+	if err := repo.Delete(ctx, soonerSeries.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.FindByID(ctx, soonerSeries.ID); err == nil {
+		t.Error("series still exists after Delete()")
+	}
+	for _, id := range []uuid.UUID{template.ID, occurrence.ID} {
+		var board domain.Board
+		if err := db.First(&board, "id = ?", id).Error; err != nil {
+			t.Fatalf("board %s was deleted with the series: %v", id, err)
+		}
+		if board.RecurrenceID != nil {
+			t.Errorf("board %s still links to the deleted series", id)
+		}
+	}
+}
+
+func TestRecurrenceRepository_Advance(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Recurring"}
+	db.Create(project)
+	dtstart := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	nextAt := dtstart.Add(7 * 24 * time.Hour)
+	templateID := uuid.New()
+	series := &domain.BoardRecurrence{
+		ProjectID:       project.ID,
+		TemplateBoardID: templateID,
+		RRule:           "FREQ=WEEKLY",
+		DTStart:         dtstart,
+		LatestBoardID:   templateID,
+		LatestAt:        dtstart,
+		OccurrenceCount: 1,
+		NextAt:          &nextAt,
+		CreatedBy:       project.OwnerID,
+	}
+	if err := repo.Create(ctx, series); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Two replicas generate the same occurrence from the series they read
+	read := func() *domain.BoardRecurrence {
+		stale, err := repo.FindByID(ctx, series.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		return stale
+	}
+	first, second := read(), read()
+	following := nextAt.Add(7 * 24 * time.Hour)
+	advanced := func(stale *domain.BoardRecurrence) *domain.BoardRecurrence {
+		next := *stale
+		next.LatestBoardID, next.LatestAt, next.OccurrenceCount, next.NextAt = uuid.New(), nextAt, 2, &following
+		return &next
+	}
+
+	firstNext := advanced(first)
+	if ok, err := repo.Advance(ctx, firstNext, first.LatestBoardID, first.NextAt); err != nil || !ok {
+		t.Fatalf("first Advance() = %v, %v; want true", ok, err)
+	}
+	if ok, err := repo.Advance(ctx, advanced(second), second.LatestBoardID, second.NextAt); err != nil || ok {
+		t.Errorf("second Advance() = %v, %v; want false", ok, err)
+	}
+
+	stored, err := repo.FindByID(ctx, series.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if stored.LatestBoardID != firstNext.LatestBoardID || stored.OccurrenceCount != 2 || stored.NextAt == nil || !stored.NextAt.Equal(following) {
+		t.Errorf("stored series latest = %s (#%d) next %v, want the first replica's occurrence", stored.LatestBoardID, stored.OccurrenceCount, stored.NextAt)
+	}
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ReminderRepository defines the interface for board reminder data access
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder *domain.BoardReminder) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardReminder, error)
+	FindByUser(ctx context.Context, userID uuid.UUID, status *domain.ReminderStatus) ([]*domain.BoardReminder, error)
+	FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) ([]*domain.BoardReminder, error)
+	CountPendingByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (int64, error)
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.BoardReminder, error)
+	ClaimDue(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error)
+	CancelPending(ctx context.Context, id uuid.UUID, reason domain.ReminderCancelReason, cancelledAt time.Time) (bool, error)
+	Update(ctx context.Context, reminder *domain.BoardReminder) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// reminderRepositoryImpl is the GORM implementation of ReminderRepository
+type reminderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewReminderRepository creates a new instance of ReminderRepository
+func NewReminderRepository(db *gorm.DB) ReminderRepository {
+	return &reminderRepositoryImpl{db: db}
+}
+
+// Create creates a new reminder
+func (r *reminderRepositoryImpl) Create(ctx context.Context, reminder *domain.BoardReminder) error {
+	if reminder.ID == uuid.Nil {
+		reminder.ID = uuid.New()
+	}
+	return dbWithContext(ctx, r.db).Omit("Board").Create(reminder).Error
+}
+
+// FindByID finds a reminder by ID with its board
+func (r *reminderRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardReminder, error) {
+	var reminder domain.BoardReminder
+	if err := dbWithContext(ctx, r.db).
+		Preload("Board").
+		Where("id = ?", id).
+		First(&reminder).Error; err != nil {
+		return nil, err
+	}
+	return &reminder, nil
+}
+
+// FindByUser finds the reminders of a user with their boards, optionally filtered by status
+func (r *reminderRepositoryImpl) FindByUser(ctx context.Context, userID uuid.UUID, status *domain.ReminderStatus) ([]*domain.BoardReminder, error) {
+	reminders := make([]*domain.BoardReminder, 0)
+	query := dbWithContext(ctx, r.db).
+		Preload("Board").
+		Where("user_id = ?", userID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	if err := query.Order("created_at ASC").Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// FindByBoardAndUser finds the reminders of a user on a board
+func (r *reminderRepositoryImpl) FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) ([]*domain.BoardReminder, error) {
+	reminders := make([]*domain.BoardReminder, 0)
+	if err := dbWithContext(ctx, r.db).
+		Preload("Board").
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		Order("created_at ASC").
+		Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// CountPendingByBoardAndUser counts the pending reminders of a user on a board
+func (r *reminderRepositoryImpl) CountPendingByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (int64, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.BoardReminder{}).
+		Where("board_id = ? AND user_id = ? AND status = ?", boardID, userID, domain.ReminderStatusPending).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindDue finds due pending reminders with their boards
+// Absolute reminders are due at remind_at, relative ones at their board's due date plus offset_minutes.
+// Relative reminders of deleted boards are returned too so the caller cancels them
+func (r *reminderRepositoryImpl) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.BoardReminder, error) {
+	db := dbWithContext(ctx, r.db)
+	reminders := make([]*domain.BoardReminder, 0)
+	if err := db.
+		Preload("Board").
+		Where("status = ?", domain.ReminderStatusPending).
+		Where(db.
+			Where("offset_minutes IS NULL AND remind_at <= ?", now).
+			Or("offset_minutes IS NOT NULL AND EXISTS (SELECT 1 FROM boards WHERE boards.id = board_reminders.board_id AND boards.deleted_at IS NULL AND boards.due_date IS NOT NULL AND "+relativeFireAtBefore(db)+")", now).
+			Or("offset_minutes IS NOT NULL AND NOT EXISTS (SELECT 1 FROM boards WHERE boards.id = board_reminders.board_id AND boards.deleted_at IS NULL)")).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// relativeFireAtBefore compares the fire time of a relative reminder with a time parameter
+// SQLite (used in tests) has no interval type and compares times through datetime()
+func relativeFireAtBefore(db *gorm.DB) string {
+	if db.Dialector.Name() == "sqlite" {
+		return "datetime(boards.due_date, board_reminders.offset_minutes || ' minutes') <= datetime(?)"
+	}
+	return "boards.due_date + board_reminders.offset_minutes * interval '1 minute' <= ?"
+}
+
+// ClaimDue marks a pending reminder as sent
+// It returns false when another worker already sent or cancelled the reminder
+func (r *reminderRepositoryImpl) ClaimDue(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.BoardReminder{}).
+		Where("id = ?", id).
+		Where("status = ?", domain.ReminderStatusPending).
+		Updates(map[string]interface{}{"status": domain.ReminderStatusSent, "sent_at": sentAt})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CancelPending cancels a pending reminder
+// It returns false when the reminder is no longer pending
+func (r *reminderRepositoryImpl) CancelPending(ctx context.Context, id uuid.UUID, reason domain.ReminderCancelReason, cancelledAt time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.BoardReminder{}).
+		Where("id = ?", id).
+		Where("status = ?", domain.ReminderStatusPending).
+		Updates(map[string]interface{}{"status": domain.ReminderStatusCancelled, "cancel_reason": reason, "cancelled_at": cancelledAt})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Update updates a reminder
+func (r *reminderRepositoryImpl) Update(ctx context.Context, reminder *domain.BoardReminder) error {
+	return dbWithContext(ctx, r.db).Omit("Board").Save(reminder).Error
+}
+
+// Delete deletes a reminder
+func (r *reminderRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Delete(&domain.BoardReminder{}, id).Error
+}
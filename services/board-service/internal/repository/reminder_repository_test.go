@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestReminderRepository_FindDue(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewReminderRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Reminders"}
+	db.Create(project)
+	userID := uuid.New()
+	now := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	newBoard := func(dueDate *time.Time) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Board", DueDate: dueDate}
+		db.Create(board)
+		return board
+	}
+	newReminder := func(board *domain.Board, remindAt *time.Time, offset *int, status domain.ReminderStatus) *domain.BoardReminder {
+		reminder := &domain.BoardReminder{BoardID: board.ID, UserID: userID, RemindAt: remindAt, OffsetMinutes: offset, Status: status}
+		if err := repo.Create(ctx, reminder); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		return reminder
+	}
+	offset := -60
+
+	board := newBoard(nil)
+	dueSoon := newBoard(at(30 * time.Minute))
+	dueLater := newBoard(at(90 * time.Minute))
+	deletedBoard := newBoard(at(90 * 24 * time.Hour))
+
+	// Reminders that are not due yet come first so they would take the batch if they were returned
+	newReminder(dueLater, nil, &offset, domain.ReminderStatusPending)    // fires in 30 minutes
+	newReminder(board, at(time.Hour), nil, domain.ReminderStatusPending) // not yet
+	newReminder(board, at(-time.Hour), nil, domain.ReminderStatusSent)   // already sent
+	newReminder(board, nil, &offset, domain.ReminderStatusPending)       // board without due date
+	due := newReminder(board, at(-time.Minute), nil, domain.ReminderStatusPending)
+	relative := newReminder(dueSoon, nil, &offset, domain.ReminderStatusPending) // fired 30 minutes ago
+	orphaned := newReminder(deletedBoard, nil, &offset, domain.ReminderStatusPending)
+	db.Delete(deletedBoard)
+
+	reminders, err := repo.FindDue(ctx, now, 3)
+	if err != nil {
+		t.Fatalf("FindDue() error = %v", err)
+	}
+	found := make(map[uuid.UUID]bool)
+	for _, r := range reminders {
+		found[r.ID] = true
+		if r.ID != orphaned.ID && r.Board.ID != r.BoardID {
+			t.Errorf("reminder %s board not preloaded", r.ID)
+		}
+	}
+	if len(reminders) != 3 || !found[due.ID] || !found[relative.ID] || !found[orphaned.ID] {
+		t.Errorf("FindDue() returned %d reminders, want the due absolute and relative ones and the one of the deleted board", len(reminders))
+	}
+
+	count, err := repo.CountPendingByBoardAndUser(ctx, board.ID, userID)
+	if err != nil || count != 3 {
+		t.Errorf("CountPendingByBoardAndUser() = %d, %v; want 3", count, err)
+	}
+
+	pending := domain.ReminderStatusPending
+	mine, err := repo.FindByUser(ctx, userID, &pending)
+	if err != nil || len(mine) != 6 {
+		t.Errorf("FindByUser(PENDING) = %d, %v; want 6", len(mine), err)
+	}
+}
+
+func TestReminderRepository_ClaimAndCancelArePending(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewReminderRepository(db)
+	ctx := context.Background()
+	now := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	reminder := &domain.BoardReminder{BoardID: uuid.New(), UserID: uuid.New(), RemindAt: &now, Status: domain.ReminderStatusPending}
+	if err := repo.Create(ctx, reminder); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Only the first of two replicas claims the reminder
+	if claimed, err := repo.ClaimDue(ctx, reminder.ID, now); err != nil || !claimed {
+		t.Fatalf("first ClaimDue() = %v, %v; want true", claimed, err)
+	}
+	if claimed, err := repo.ClaimDue(ctx, reminder.ID, now); err != nil || claimed {
+		t.Errorf("second ClaimDue() = %v, %v; want false", claimed, err)
+	}
+	// A sent reminder is not cancelled afterwards
+	if cancelled, err := repo.CancelPending(ctx, reminder.ID, domain.ReminderCancelBoardDeleted, now); err != nil || cancelled {
+		t.Errorf("CancelPending() of a sent reminder = %v, %v; want false", cancelled, err)
+	}
+
+	stored, err := repo.FindByID(ctx, reminder.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if stored.Status != domain.ReminderStatusSent || stored.SentAt == nil || stored.CancelReason != "" {
+		t.Errorf("stored reminder = %s (sent %v, cancel reason %q), want SENT", stored.Status, stored.SentAt, stored.CancelReason)
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ReportTemplateRepository defines the interface for workspace report template data access
+type ReportTemplateRepository interface {
+	FindByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ReportTemplate, error)
+	FindByWorkspaceAndFormat(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) (*domain.ReportTemplate, error)
+	Save(ctx context.Context, template *domain.ReportTemplate) error
+	Delete(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) error
+}
+
+// reportTemplateRepositoryImpl is the GORM implementation of ReportTemplateRepository
+type reportTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewReportTemplateRepository creates a new instance of ReportTemplateRepository
+func NewReportTemplateRepository(db *gorm.DB) ReportTemplateRepository {
+	return &reportTemplateRepositoryImpl{db: db}
+}
+
+// FindByWorkspace finds the template overrides of a workspace
+func (r *reportTemplateRepositoryImpl) FindByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ReportTemplate, error) {
+	templates := make([]*domain.ReportTemplate, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("workspace_id = ?", workspaceID).
+		Order("format ASC").
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// FindByWorkspaceAndFormat finds the template override of a workspace for a format
+func (r *reportTemplateRepositoryImpl) FindByWorkspaceAndFormat(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) (*domain.ReportTemplate, error) {
+	var template domain.ReportTemplate
+	if err := dbWithContext(ctx, r.db).
+		Where("workspace_id = ? AND format = ?", workspaceID, format).
+		First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Save creates a template override, or updates it when the template has an ID
+func (r *reportTemplateRepositoryImpl) Save(ctx context.Context, template *domain.ReportTemplate) error {
+	if template.ID == uuid.Nil {
+		template.ID = uuid.New()
+		return dbWithContext(ctx, r.db).Create(template).Error
+	}
+	return dbWithContext(ctx, r.db).Save(template).Error
+}
+
+// Delete deletes the template override of a workspace for a format
+func (r *reportTemplateRepositoryImpl) Delete(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) error {
+	return dbWithContext(ctx, r.db).
+		Where("workspace_id = ? AND format = ?", workspaceID, format).
+		Delete(&domain.ReportTemplate{}).Error
+}
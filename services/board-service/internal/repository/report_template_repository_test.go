@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+func TestReportTemplateRepository_SaveAndDelete(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewReportTemplateRepository(db)
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	ownerID := uuid.New()
+
+	markdown := &domain.ReportTemplate{WorkspaceID: workspaceID, Format: domain.ReportFormatMarkdown, Body: "# {{.ProjectName}}", UpdatedBy: ownerID}
+	if err := repo.Save(ctx, markdown); err != nil {
+		t.Fatalf("Save() create error = %v", err)
+	}
+	csv := &domain.ReportTemplate{WorkspaceID: workspaceID, Format: domain.ReportFormatCSV, Body: "key", UpdatedBy: ownerID}
+	if err := repo.Save(ctx, csv); err != nil {
+		t.Fatalf("Save() create error = %v", err)
+	}
+	other := &domain.ReportTemplate{WorkspaceID: uuid.New(), Format: domain.ReportFormatMarkdown, Body: "other", UpdatedBy: ownerID}
+	if err := repo.Save(ctx, other); err != nil {
+		t.Fatalf("Save() create error = %v", err)
+	}
+
+	markdown.Body = "## {{.ProjectName}}"
+	if err := repo.Save(ctx, markdown); err != nil {
+		t.Fatalf("Save() update error = %v", err)
+	}
+	found, err := repo.FindByWorkspaceAndFormat(ctx, workspaceID, domain.ReportFormatMarkdown)
+	if err != nil {
+		t.Fatalf("FindByWorkspaceAndFormat() error = %v", err)
+	}
+	if found.ID != markdown.ID || found.Body != "## {{.ProjectName}}" {
+		t.Errorf("Found template = %+v, want updated markdown template", found)
+	}
+
+	templates, err := repo.FindByWorkspace(ctx, workspaceID)
+	if err != nil {
+		t.Fatalf("FindByWorkspace() error = %v", err)
+	}
+	if len(templates) != 2 {
+		t.Errorf("len(templates) = %d, want 2", len(templates))
+	}
+
+	if err := repo.Delete(ctx, workspaceID, domain.ReportFormatMarkdown); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.FindByWorkspaceAndFormat(ctx, workspaceID, domain.ReportFormatMarkdown); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByWorkspaceAndFormat() after delete error = %v, want record not found", err)
+	}
+	if _, err := repo.FindByWorkspaceAndFormat(ctx, other.WorkspaceID, domain.ReportFormatMarkdown); err != nil {
+		t.Errorf("Other workspace template should remain, error = %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// SprintRepository defines the interface for sprint data access
+type SprintRepository interface {
+	Create(ctx context.Context, sprint *domain.Sprint) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Sprint, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Sprint, error)
+	FindActiveByProjectID(ctx context.Context, projectID uuid.UUID) (*domain.Sprint, error)
+	Update(ctx context.Context, sprint *domain.Sprint) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindBoards(ctx context.Context, sprintID uuid.UUID) ([]*domain.Board, error)
+	CountBoardsByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.SprintBoardCount, error)
+	AssignBoards(ctx context.Context, sprintID *uuid.UUID, boardIDs []uuid.UUID) error
+	AddScopeChanges(ctx context.Context, changes []*domain.SprintScopeChange) error
+	FindScopeChanges(ctx context.Context, sprintID uuid.UUID) ([]*domain.SprintScopeChange, error)
+}
+
+// sprintRepositoryImpl is the GORM implementation of SprintRepository
+type sprintRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSprintRepository creates a new instance of SprintRepository
+func NewSprintRepository(db *gorm.DB) SprintRepository {
+	return &sprintRepositoryImpl{db: db}
+}
+
+// Create creates a new sprint
+func (r *sprintRepositoryImpl) Create(ctx context.Context, sprint *domain.Sprint) error {
+	return dbWithContext(ctx, r.db).Create(sprint).Error
+}
+
+// FindByID finds a sprint by ID
+func (r *sprintRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Sprint, error) {
+	var sprint domain.Sprint
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&sprint).Error; err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// FindByProjectID finds all sprints of a project ordered by start date
+func (r *sprintRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Sprint, error) {
+	sprints := make([]*domain.Sprint, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("start_date ASC").
+		Order("created_at ASC").
+		Find(&sprints).Error; err != nil {
+		return nil, err
+	}
+	return sprints, nil
+}
+
+// FindActiveByProjectID finds the active sprint of a project
+// It returns gorm.ErrRecordNotFound when no sprint is running
+func (r *sprintRepositoryImpl) FindActiveByProjectID(ctx context.Context, projectID uuid.UUID) (*domain.Sprint, error) {
+	var sprint domain.Sprint
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND state = ?", projectID, domain.SprintStateActive).
+		First(&sprint).Error; err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// Update updates a sprint
+func (r *sprintRepositoryImpl) Update(ctx context.Context, sprint *domain.Sprint) error {
+	return dbWithContext(ctx, r.db).Omit("Project").Save(sprint).Error
+}
+
+// Delete deletes a sprint and its scope history; its boards go back to the backlog
+func (r *sprintRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Board{}).
+			Where("sprint_id = ?", id).
+			UpdateColumn("sprint_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("sprint_id = ?", id).Delete(&domain.SprintScopeChange{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Sprint{}, id).Error
+	})
+}
+
+// FindBoards finds the boards assigned to a sprint
+func (r *sprintRepositoryImpl) FindBoards(ctx context.Context, sprintID uuid.UUID) ([]*domain.Board, error) {
+	boards := make([]*domain.Board, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("sprint_id = ?", sprintID).
+		Order("number ASC").
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// CountBoardsByProjectID counts the boards of each sprint of a project
+// Sprints without boards are not included
+func (r *sprintRepositoryImpl) CountBoardsByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.SprintBoardCount, error) {
+	counts := make([]domain.SprintBoardCount, 0)
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Select("sprint_id, COUNT(*) AS board_count").
+		Where("project_id = ? AND sprint_id IS NOT NULL", projectID).
+		Group("sprint_id").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// AssignBoards moves boards to a sprint, or to the backlog when sprintID is nil
+func (r *sprintRepositoryImpl) AssignBoards(ctx context.Context, sprintID *uuid.UUID, boardIDs []uuid.UUID) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+	return dbWithContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Where("id IN ?", boardIDs).
+		UpdateColumn("sprint_id", sprintID).Error
+}
+
+// AddScopeChanges records boards added to or removed from active sprints
+func (r *sprintRepositoryImpl) AddScopeChanges(ctx context.Context, changes []*domain.SprintScopeChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return dbWithContext(ctx, r.db).Omit("Sprint").Create(changes).Error
+}
+
+// FindScopeChanges finds the scope changes of a sprint in chronological order
+func (r *sprintRepositoryImpl) FindScopeChanges(ctx context.Context, sprintID uuid.UUID) ([]*domain.SprintScopeChange, error) {
+	changes := make([]*domain.SprintScopeChange, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("sprint_id = ?", sprintID).
+		Order("created_at ASC").
+		Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
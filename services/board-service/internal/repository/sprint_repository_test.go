@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestSprintRepository_AssignCountAndDelete(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewSprintRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Sprints"}
+	db.Create(project)
+
+	start := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	newSprint := func(name string, state domain.SprintState, offsetDays int) *domain.Sprint {
+		sprint := &domain.Sprint{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: project.ID,
+			Name:      name,
+			State:     state,
+			StartDate: start.AddDate(0, 0, offsetDays),
+			EndDate:   start.AddDate(0, 0, offsetDays+13),
+			CreatedBy: project.OwnerID,
+		}
+		if err := repo.Create(ctx, sprint); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		return sprint
+	}
+	newBoard := func(number int64) *domain.Board {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Board", Number: number}
+		db.Create(board)
+		return board
+	}
+
+	next := newSprint("Sprint 2", domain.SprintStatePlanned, 14)
+	current := newSprint("Sprint 1", domain.SprintStateActive, 0)
+	first, second, third := newBoard(1), newBoard(2), newBoard(3)
+
+	sprints, err := repo.FindByProjectID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(sprints) != 2 || sprints[0].ID != current.ID {
+		t.Fatalf("expected sprints ordered by start date, got %+v", sprints)
+	}
+	active, err := repo.FindActiveByProjectID(ctx, project.ID)
+	if err != nil || active.ID != current.ID {
+		t.Fatalf("FindActiveByProjectID() = %v, %v; want %s", active, err, current.ID)
+	}
+
+	if err := repo.AssignBoards(ctx, &current.ID, []uuid.UUID{first.ID, second.ID}); err != nil {
+		t.Fatalf("AssignBoards() error = %v", err)
+	}
+	if err := repo.AssignBoards(ctx, &next.ID, []uuid.UUID{third.ID}); err != nil {
+		t.Fatalf("AssignBoards() error = %v", err)
+	}
+
+	boards, err := repo.FindBoards(ctx, current.ID)
+	if err != nil {
+		t.Fatalf("FindBoards() error = %v", err)
+	}
+	if len(boards) != 2 || boards[0].ID != first.ID {
+		t.Errorf("expected sprint boards ordered by number, got %+v", boards)
+	}
+
+	counts, err := repo.CountBoardsByProjectID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("CountBoardsByProjectID() error = %v", err)
+	}
+	got := make(map[uuid.UUID]int64)
+	for _, c := range counts {
+		got[c.SprintID] = c.BoardCount
+	}
+	if got[current.ID] != 2 || got[next.ID] != 1 {
+		t.Errorf("board counts = %v, want 2 and 1", got)
+	}
+
+	// A nil sprint moves boards back to the backlog
+	if err := repo.AssignBoards(ctx, nil, []uuid.UUID{second.ID}); err != nil {
+		t.Fatalf("AssignBoards(nil) error = %v", err)
+	}
+	if err := repo.AddScopeChanges(ctx, []*domain.SprintScopeChange{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, SprintID: current.ID, BoardID: second.ID, ChangeType: domain.SprintScopeRemoved, ChangedBy: project.OwnerID},
+	}); err != nil {
+		t.Fatalf("AddScopeChanges() error = %v", err)
+	}
+	changes, err := repo.FindScopeChanges(ctx, current.ID)
+	if err != nil || len(changes) != 1 || changes[0].ChangeType != domain.SprintScopeRemoved {
+		t.Fatalf("FindScopeChanges() = %+v, %v; want one removal", changes, err)
+	}
+
+	// Deleting a sprint returns its boards to the backlog and drops its scope history
+	if err := repo.Delete(ctx, current.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.FindByID(ctx, current.ID); err == nil {
+		t.Error("deleted sprint should not be found")
+	}
+	var reloaded domain.Board
+	db.First(&reloaded, "id = ?", first.ID)
+	if reloaded.SprintID != nil {
+		t.Errorf("board sprint = %v after delete, want backlog", reloaded.SprintID)
+	}
+	if changes, _ := repo.FindScopeChanges(ctx, current.ID); len(changes) != 0 {
+		t.Errorf("scope changes = %d after delete, want 0", len(changes))
+	}
+	if boards, _ := repo.FindBoards(ctx, next.ID); len(boards) != 1 {
+		t.Errorf("other sprint boards = %d, want 1", len(boards))
+	}
+}
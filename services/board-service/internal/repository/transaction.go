@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txContextKey is the context key of the transaction opened by TxManager
+type txContextKey struct{}
+
+// TxManager runs a function in a database transaction
+// The transaction travels in the context, so every repository called with that context
+// joins it; nested calls run in a savepoint, so a failing nested step can be rolled back
+// without aborting the outer transaction
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txManagerImpl is the GORM implementation of TxManager
+type txManagerImpl struct {
+	db *gorm.DB
+}
+
+// NewTxManager creates a new instance of TxManager
+func NewTxManager(db *gorm.DB) TxManager {
+	return &txManagerImpl{db: db}
+}
+
+// WithinTx commits when fn returns nil and rolls back otherwise
+func (m *txManagerImpl) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return dbWithContext(ctx, m.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbWithContext returns the transaction carried by ctx, or db bound to ctx when there is none
+func dbWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// InTx reports whether ctx carries a transaction opened by TxManager
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txContextKey{}).(*gorm.DB)
+	return ok
+}
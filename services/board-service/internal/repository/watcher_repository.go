@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// WatcherRepository defines the interface for board/project watcher and board mute data access
+type WatcherRepository interface {
+	Watch(ctx context.Context, watcher *domain.Watcher) (bool, error)
+	Unwatch(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) error
+	FindWatcher(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) (*domain.Watcher, error)
+	FindByTarget(ctx context.Context, targetType domain.WatchTargetType, targetID uuid.UUID) ([]*domain.Watcher, error)
+	FindBoardWatcherUserIDs(ctx context.Context, boardID, projectID uuid.UUID) ([]uuid.UUID, error)
+	Mute(ctx context.Context, boardID, userID uuid.UUID) error
+	Unmute(ctx context.Context, boardID, userID uuid.UUID) error
+	IsMuted(ctx context.Context, boardID, userID uuid.UUID) (bool, error)
+	FindMutedUserIDs(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// watcherRepositoryImpl is the GORM implementation of WatcherRepository
+type watcherRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWatcherRepository creates a new instance of WatcherRepository
+func NewWatcherRepository(db *gorm.DB) WatcherRepository {
+	return &watcherRepositoryImpl{db: db}
+}
+
+// Watch subscribes a user to a board or project and reports whether a new subscription was created
+// An existing subscription is kept as is, so automatic watches never overwrite a manual one
+func (r *watcherRepositoryImpl) Watch(ctx context.Context, watcher *domain.Watcher) (bool, error) {
+	if watcher.ID == uuid.Nil {
+		watcher.ID = uuid.New()
+	}
+	result := dbWithContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(watcher)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Unwatch removes the subscription of a user to a board or project
+func (r *watcherRepositoryImpl) Unwatch(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) error {
+	return dbWithContext(ctx, r.db).
+		Where("target_type = ? AND target_id = ? AND user_id = ?", targetType, targetID, userID).
+		Delete(&domain.Watcher{}).Error
+}
+
+// FindWatcher finds the subscription of a user to a board or project
+func (r *watcherRepositoryImpl) FindWatcher(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) (*domain.Watcher, error) {
+	var watcher domain.Watcher
+	if err := dbWithContext(ctx, r.db).
+		Where("target_type = ? AND target_id = ? AND user_id = ?", targetType, targetID, userID).
+		First(&watcher).Error; err != nil {
+		return nil, err
+	}
+	return &watcher, nil
+}
+
+// FindByTarget finds the watchers of a board or project, oldest first
+func (r *watcherRepositoryImpl) FindByTarget(ctx context.Context, targetType domain.WatchTargetType, targetID uuid.UUID) ([]*domain.Watcher, error) {
+	watchers := make([]*domain.Watcher, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at ASC").
+		Find(&watchers).Error; err != nil {
+		return nil, err
+	}
+	return watchers, nil
+}
+
+// FindBoardWatcherUserIDs returns the distinct users watching a board directly or through its project
+func (r *watcherRepositoryImpl) FindBoardWatcherUserIDs(ctx context.Context, boardID, projectID uuid.UUID) ([]uuid.UUID, error) {
+	userIDs := make([]uuid.UUID, 0)
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.Watcher{}).
+		Distinct("user_id").
+		Where("(target_type = ? AND target_id = ?) OR (target_type = ? AND target_id = ?)",
+			domain.WatchTargetBoard, boardID, domain.WatchTargetProject, projectID).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// Mute silences a board for a user; muting twice is a no-op
+func (r *watcherRepositoryImpl) Mute(ctx context.Context, boardID, userID uuid.UUID) error {
+	mute := &domain.BoardMute{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   boardID,
+		UserID:    userID,
+	}
+	return dbWithContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Omit("Board").
+		Create(mute).Error
+}
+
+// Unmute restores the notifications of a board for a user
+func (r *watcherRepositoryImpl) Unmute(ctx context.Context, boardID, userID uuid.UUID) error {
+	return dbWithContext(ctx, r.db).
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		Delete(&domain.BoardMute{}).Error
+}
+
+// IsMuted reports whether a user muted a board
+func (r *watcherRepositoryImpl) IsMuted(ctx context.Context, boardID, userID uuid.UUID) (bool, error) {
+	var count int64
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.BoardMute{}).
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindMutedUserIDs returns the users who muted a board
+func (r *watcherRepositoryImpl) FindMutedUserIDs(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error) {
+	userIDs := make([]uuid.UUID, 0)
+	if err := dbWithContext(ctx, r.db).
+		Model(&domain.BoardMute{}).
+		Where("board_id = ?", boardID).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// createWatcherTables creates the watcher and board mute tables on a board test database
+func createWatcherTables(db *gorm.DB) {
+	db.Exec(`CREATE TABLE watchers (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		target_type TEXT NOT NULL,
+		target_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		reason TEXT NOT NULL DEFAULT 'MANUAL',
+		UNIQUE(target_type, target_id, user_id)
+	)`)
+	db.Exec(`CREATE TABLE board_mutes (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		UNIQUE(board_id, user_id)
+	)`)
+}
+
+func TestWatcherRepository_WatchAndMute(t *testing.T) {
+	db := setupBoardTestDB(t)
+	createWatcherTables(db)
+	repo := NewWatcherRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	boardID := uuid.New()
+	boardWatcher := uuid.New()
+	projectWatcher := uuid.New()
+
+	// A manual watch is not replaced by a later automatic one
+	created, err := repo.Watch(ctx, &domain.Watcher{TargetType: domain.WatchTargetBoard, TargetID: boardID, UserID: boardWatcher, Reason: domain.WatchReasonManual})
+	if err != nil || !created {
+		t.Fatalf("Watch() created = %v, error = %v", created, err)
+	}
+	created, err = repo.Watch(ctx, &domain.Watcher{TargetType: domain.WatchTargetBoard, TargetID: boardID, UserID: boardWatcher, Reason: domain.WatchReasonCommented})
+	if err != nil || created {
+		t.Fatalf("second Watch() created = %v, error = %v", created, err)
+	}
+	watcher, err := repo.FindWatcher(ctx, domain.WatchTargetBoard, boardID, boardWatcher)
+	if err != nil {
+		t.Fatalf("FindWatcher() error = %v", err)
+	}
+	if watcher.Reason != domain.WatchReasonManual {
+		t.Errorf("expected reason MANUAL, got %s", watcher.Reason)
+	}
+
+	// Board and project watchers are both resolved for the board, without duplicates
+	if _, err := repo.Watch(ctx, &domain.Watcher{TargetType: domain.WatchTargetProject, TargetID: projectID, UserID: projectWatcher}); err != nil {
+		t.Fatalf("Watch(project) error = %v", err)
+	}
+	if _, err := repo.Watch(ctx, &domain.Watcher{TargetType: domain.WatchTargetProject, TargetID: projectID, UserID: boardWatcher}); err != nil {
+		t.Fatalf("Watch(project) error = %v", err)
+	}
+	if _, err := repo.Watch(ctx, &domain.Watcher{TargetType: domain.WatchTargetProject, TargetID: uuid.New(), UserID: uuid.New()}); err != nil {
+		t.Fatalf("Watch(other project) error = %v", err)
+	}
+	userIDs, err := repo.FindBoardWatcherUserIDs(ctx, boardID, projectID)
+	if err != nil {
+		t.Fatalf("FindBoardWatcherUserIDs() error = %v", err)
+	}
+	if len(userIDs) != 2 {
+		t.Errorf("expected 2 watchers, got %v", userIDs)
+	}
+
+	// Muting is idempotent and reversible
+	if err := repo.Mute(ctx, boardID, projectWatcher); err != nil {
+		t.Fatalf("Mute() error = %v", err)
+	}
+	if err := repo.Mute(ctx, boardID, projectWatcher); err != nil {
+		t.Fatalf("second Mute() error = %v", err)
+	}
+	muted, err := repo.FindMutedUserIDs(ctx, boardID)
+	if err != nil || len(muted) != 1 || muted[0] != projectWatcher {
+		t.Fatalf("FindMutedUserIDs() = %v, error = %v", muted, err)
+	}
+	if err := repo.Unmute(ctx, boardID, projectWatcher); err != nil {
+		t.Fatalf("Unmute() error = %v", err)
+	}
+	if isMuted, err := repo.IsMuted(ctx, boardID, projectWatcher); err != nil || isMuted {
+		t.Errorf("IsMuted() = %v, error = %v after unmute", isMuted, err)
+	}
+
+	// Unwatching removes only the given subscription
+	if err := repo.Unwatch(ctx, domain.WatchTargetBoard, boardID, boardWatcher); err != nil {
+		t.Fatalf("Unwatch() error = %v", err)
+	}
+	watchers, err := repo.FindByTarget(ctx, domain.WatchTargetBoard, boardID)
+	if err != nil || len(watchers) != 0 {
+		t.Errorf("FindByTarget() = %v, error = %v after unwatch", watchers, err)
+	}
+}
+
+func TestProjectRepository_RemoveMemberDropsWatches(t *testing.T) {
+	db := setupBoardTestDB(t)
+	createWatcherTables(db)
+	db.Exec(`CREATE TABLE project_members (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		role_name TEXT NOT NULL,
+		joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		custom_role_id TEXT,
+		UNIQUE(project_id, user_id)
+	)`)
+	projectRepo := NewProjectRepository(db)
+	watcherRepo := NewWatcherRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Watched"}
+	other := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: project.WorkspaceID, OwnerID: project.OwnerID, Name: "Other"}
+	db.Create(project)
+	db.Create(other)
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: project.OwnerID, Title: "Board"}
+	otherBoard := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: other.ID, AuthorID: project.OwnerID, Title: "Other board"}
+	db.Create(board)
+	db.Create(otherBoard)
+
+	leaverID := uuid.New()
+	stayerID := uuid.New()
+	leaver := &domain.ProjectMember{ID: uuid.New(), ProjectID: project.ID, UserID: leaverID, RoleName: domain.ProjectRoleMember}
+	db.Create(leaver)
+	db.Create(&domain.ProjectMember{ID: uuid.New(), ProjectID: project.ID, UserID: stayerID, RoleName: domain.ProjectRoleMember})
+	for _, w := range []*domain.Watcher{
+		{TargetType: domain.WatchTargetProject, TargetID: project.ID, UserID: leaverID},
+		{TargetType: domain.WatchTargetBoard, TargetID: board.ID, UserID: leaverID},
+		{TargetType: domain.WatchTargetBoard, TargetID: otherBoard.ID, UserID: leaverID},
+		{TargetType: domain.WatchTargetBoard, TargetID: board.ID, UserID: stayerID},
+	} {
+		if _, err := watcherRepo.Watch(ctx, w); err != nil {
+			t.Fatalf("Watch() error = %v", err)
+		}
+	}
+	if err := watcherRepo.Mute(ctx, board.ID, leaverID); err != nil {
+		t.Fatalf("Mute() error = %v", err)
+	}
+
+	// Only members of the project are kept
+	members, err := projectRepo.FindMemberUserIDs(ctx, project.ID, []uuid.UUID{leaverID, stayerID, uuid.New()})
+	if err != nil || len(members) != 2 {
+		t.Fatalf("FindMemberUserIDs() = %v, error = %v; want 2 members", members, err)
+	}
+
+	if err := projectRepo.RemoveMember(ctx, leaver.ID); err != nil {
+		t.Fatalf("RemoveMember() error = %v", err)
+	}
+
+	// The removed member's watches and mutes in the project are gone; other projects and members are untouched
+	userIDs, err := watcherRepo.FindBoardWatcherUserIDs(ctx, board.ID, project.ID)
+	if err != nil || len(userIDs) != 1 || userIDs[0] != stayerID {
+		t.Errorf("FindBoardWatcherUserIDs() = %v, error = %v; want only the remaining member", userIDs, err)
+	}
+	if muted, _ := watcherRepo.IsMuted(ctx, board.ID, leaverID); muted {
+		t.Error("mute of the removed member should be deleted")
+	}
+	if _, err := watcherRepo.FindWatcher(ctx, domain.WatchTargetBoard, otherBoard.ID, leaverID); err != nil {
+		t.Errorf("watch on another project's board should be kept, FindWatcher() error = %v", err)
+	}
+	if isMember, _ := projectRepo.IsProjectMember(ctx, project.ID, leaverID); isMember {
+		t.Error("member should be removed")
+	}
+}
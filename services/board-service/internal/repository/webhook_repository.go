@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// WebhookRepository defines the interface for webhook subscription and delivery data access
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+	FindSubscriptionsByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	FindEnabledSubscriptions(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+	CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	FindDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)
+	FindDeliveriesBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error)
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+	ClaimDueDelivery(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error)
+	UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+// webhookRepositoryImpl is the GORM implementation of WebhookRepository
+type webhookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepositoryImpl{db: db}
+}
+
+// CreateSubscription creates a new webhook subscription
+func (r *webhookRepositoryImpl) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	return dbWithContext(ctx, r.db).Create(subscription).Error
+}
+
+// FindSubscriptionByID finds a webhook subscription by ID
+func (r *webhookRepositoryImpl) FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&subscription).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// FindSubscriptionsByProjectID finds all webhook subscriptions of a project
+func (r *webhookRepositoryImpl) FindSubscriptionsByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	subscriptions := make([]*domain.WebhookSubscription, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// FindEnabledSubscriptions finds the enabled webhook subscriptions of a project
+func (r *webhookRepositoryImpl) FindEnabledSubscriptions(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	subscriptions := make([]*domain.WebhookSubscription, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ? AND enabled = ?", projectID, true).
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription updates a webhook subscription
+func (r *webhookRepositoryImpl) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	return dbWithContext(ctx, r.db).Save(subscription).Error
+}
+
+// DeleteSubscription deletes a webhook subscription (its deliveries are removed by cascade)
+func (r *webhookRepositoryImpl) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Delete(&domain.WebhookSubscription{}, id).Error
+}
+
+// CreateDelivery creates a new webhook delivery
+func (r *webhookRepositoryImpl) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return dbWithContext(ctx, r.db).Create(delivery).Error
+}
+
+// FindDeliveryByID finds a webhook delivery by ID
+func (r *webhookRepositoryImpl) FindDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveriesBySubscriptionID finds the most recent deliveries of a subscription
+func (r *webhookRepositoryImpl) FindDeliveriesBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error) {
+	deliveries := make([]*domain.WebhookDelivery, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// FindDueDeliveries finds pending or retrying deliveries whose next attempt is due
+func (r *webhookRepositoryImpl) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	deliveries := make([]*domain.WebhookDelivery, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("status IN ?", []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryRetrying}).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// ClaimDueDelivery moves the next attempt of a due delivery to leaseUntil
+// It returns false when another worker already claimed the delivery or it is no longer due
+func (r *webhookRepositoryImpl) ClaimDueDelivery(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error) {
+	result := dbWithContext(ctx, r.db).
+		Model(&domain.WebhookDelivery{}).
+		Where("id = ?", id).
+		Where("status IN ?", []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryRetrying}).
+		Where("next_attempt_at <= ?", now).
+		Update("next_attempt_at", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateDelivery updates a webhook delivery
+func (r *webhookRepositoryImpl) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return dbWithContext(ctx, r.db).Save(delivery).Error
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// WorkflowRepository defines the interface for workflow transition data access
+type WorkflowRepository interface {
+	Create(ctx context.Context, transition *domain.WorkflowTransition) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.WorkflowTransition, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WorkflowTransition, error)
+	Update(ctx context.Context, transition *domain.WorkflowTransition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// workflowRepositoryImpl is the GORM implementation of WorkflowRepository
+type workflowRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWorkflowRepository creates a new instance of WorkflowRepository
+func NewWorkflowRepository(db *gorm.DB) WorkflowRepository {
+	return &workflowRepositoryImpl{db: db}
+}
+
+// Create creates a new workflow transition
+func (r *workflowRepositoryImpl) Create(ctx context.Context, transition *domain.WorkflowTransition) error {
+	return dbWithContext(ctx, r.db).Create(transition).Error
+}
+
+// FindByID finds a workflow transition by ID
+func (r *workflowRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.WorkflowTransition, error) {
+	var transition domain.WorkflowTransition
+	if err := dbWithContext(ctx, r.db).
+		Where("id = ?", id).
+		First(&transition).Error; err != nil {
+		return nil, err
+	}
+	return &transition, nil
+}
+
+// FindByProjectID finds all workflow transitions of a project
+func (r *workflowRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WorkflowTransition, error) {
+	transitions := make([]*domain.WorkflowTransition, 0)
+	if err := dbWithContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("from_stage ASC, to_stage ASC").
+		Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// Update updates a workflow transition
+func (r *workflowRepositoryImpl) Update(ctx context.Context, transition *domain.WorkflowTransition) error {
+	return dbWithContext(ctx, r.db).Save(transition).Error
+}
+
+// Delete deletes a workflow transition
+func (r *workflowRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbWithContext(ctx, r.db).Delete(&domain.WorkflowTransition{}, id).Error
+}
@@ -13,6 +13,15 @@ const (
 	ErrCodeInternal      = apperrors.ErrCodeInternal
 	ErrCodeUnauthorized  = apperrors.ErrCodeUnauthorized
 	ErrCodeForbidden     = apperrors.ErrCodeForbidden
+
+	// ErrCodeProjectArchived is returned for mutations on an archived (read-only) project
+	ErrCodeProjectArchived = "PROJECT_ARCHIVED"
+
+	// ErrCodeSprintState is returned when a sprint action does not fit the current sprint state
+	ErrCodeSprintState = "INVALID_SPRINT_STATE"
+
+	// ErrCodeInviteLinkInvalid is returned when an invite link has expired, was revoked or has no uses left
+	ErrCodeInviteLinkInvalid = "INVITE_LINK_INVALID"
 )
 
 // AppError is an alias for the common module's AppError
@@ -49,6 +58,21 @@ func NewForbiddenError(message string, details string) *AppError {
 	return apperrors.Forbidden(message, details)
 }
 
+// NewProjectArchivedError creates a new project archived error
+func NewProjectArchivedError(details string) *AppError {
+	return apperrors.New(ErrCodeProjectArchived, "Project is archived and read-only", details)
+}
+
+// NewSprintStateError creates a new invalid sprint state error
+func NewSprintStateError(message string, details string) *AppError {
+	return apperrors.New(ErrCodeSprintState, message, details)
+}
+
+// NewInviteLinkInvalidError creates an error for an invite link that can no longer be accepted
+func NewInviteLinkInvalidError(message string, details string) *AppError {
+	return apperrors.New(ErrCodeInviteLinkInvalid, message, details)
+}
+
 // NewAppError creates a new application error with the given code, message, and details
 func NewAppError(code string, message string, details string) *AppError {
 	return apperrors.New(code, message, details)
@@ -39,6 +39,14 @@ type Config struct {
 	RedisClient        *redis.Client
 	RateLimitConfig    config.RateLimitConfig
 	ServiceName        string // Service name for tracing (default: "board-service")
+	// AutomationEngine is shared with background jobs; built from the repositories when nil
+	AutomationEngine service.AutomationEngine
+	// WebhookDispatcher is shared with the retry job; built from the repositories when nil
+	WebhookDispatcher service.WebhookDispatcher
+	// AttachmentThumbnailer is shared with the thumbnail job; built from the S3 client when nil
+	AttachmentThumbnailer service.AttachmentThumbnailer
+	// Outbox is shared with the relay job; built from the repositories when nil
+	Outbox service.Outbox
 }
 
 // Setup initializes the router with all dependencies and routes.
@@ -88,28 +96,97 @@ func Setup(cfg Config) *gin.Engine {
 	commentRepo := repository.NewCommentRepository(cfg.DB)
 	fieldOptionRepo := repository.NewFieldOptionRepository(cfg.DB)
 	attachmentRepo := repository.NewAttachmentRepository(cfg.DB)
+	workflowRepo := repository.NewWorkflowRepository(cfg.DB)
+	automationRepo := repository.NewAutomationRepository(cfg.DB)
+	webhookRepo := repository.NewWebhookRepository(cfg.DB)
+	boardReferenceRepo := repository.NewBoardReferenceRepository(cfg.DB)
+	labelRepo := repository.NewLabelRepository(cfg.DB)
+	sprintRepo := repository.NewSprintRepository(cfg.DB)
+	reminderRepo := repository.NewReminderRepository(cfg.DB)
+	recurrenceRepo := repository.NewRecurrenceRepository(cfg.DB)
+	inviteLinkRepo := repository.NewInviteLinkRepository(cfg.DB)
+	reportTemplateRepo := repository.NewReportTemplateRepository(cfg.DB)
+	projectRoleRepo := repository.NewProjectRoleRepository(cfg.DB)
+	watcherRepo := repository.NewWatcherRepository(cfg.DB)
 
 	// Initialize converters
 	fieldOptionConverter := converter.NewFieldOptionConverter(fieldOptionRepo)
 
+	// Initialize watcher resolution (notification audience and auto-watch)
+	boardWatchers := service.NewBoardWatchers(watcherRepo, projectRepo, cfg.Logger)
+
+	// Initialize the outbox that relays notifications and broadcast board events (shared with the relay job when provided)
+	outbox := cfg.Outbox
+	if outbox == nil {
+		outbox = service.NewOutbox(repository.NewOutboxRepository(cfg.DB), repository.NewTxManager(cfg.DB), cfg.NotiClient, handler.NewOutboxBroadcaster(), cfg.Metrics, cfg.Logger)
+	}
+
+	// Initialize automation engine (shared with the due date job when provided)
+	automationEngine := cfg.AutomationEngine
+	if automationEngine == nil {
+		automationEngine = service.NewAutomationEngine(automationRepo, boardRepo, projectRepo, participantRepo, commentRepo, fieldOptionConverter, cfg.NotiClient, outbox, boardWatchers, cfg.Logger)
+	}
+
+	// Initialize webhook dispatcher and forward broadcast board events to it
+	webhookDispatcher := cfg.WebhookDispatcher
+	if webhookDispatcher == nil {
+		webhookDispatcher = service.NewWebhookDispatcher(webhookRepo, nil, cfg.Logger)
+	}
+	handler.SetWebhookPublisher(webhookDispatcher)
+
+	// Initialize cross-reference indexer (board links in content and comments)
+	boardReferenceIndexer := service.NewBoardReferenceIndexer(boardReferenceRepo, cfg.Logger)
+
 	// Initialize services with repository dependencies
 	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, cfg.UserClient, cfg.Metrics, cfg.Logger)
-	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, cfg.Metrics, cfg.Logger)
-	participantService := service.NewParticipantService(participantRepo, boardRepo)
-	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, cfg.S3Client, cfg.NotiClient, cfg.Logger)
-	fieldOptionService := service.NewFieldOptionService(fieldOptionRepo)
+	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, workflowRepo, labelRepo, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, outbox, automationEngine, boardReferenceIndexer, boardWatchers, cfg.Metrics, cfg.Logger)
+	participantService := service.NewParticipantService(participantRepo, boardRepo, projectRepo)
+	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, cfg.S3Client, cfg.NotiClient, outbox, automationEngine, boardReferenceIndexer, boardWatchers, cfg.Logger)
+	fieldOptionService := service.NewFieldOptionService(fieldOptionRepo, projectRepo)
 	projectMemberService := service.NewProjectMemberService(projectRepo, cfg.UserClient)
 	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, cfg.UserClient)
+	workflowService := service.NewWorkflowService(workflowRepo, projectRepo, fieldOptionRepo, projectRoleRepo)
+	automationService := service.NewAutomationService(automationRepo, projectRepo, fieldOptionRepo)
+	webhookService := service.NewWebhookService(webhookRepo, projectRepo, webhookDispatcher)
+	labelService := service.NewLabelService(labelRepo, projectRepo)
+	projectRoleService := service.NewProjectRoleService(projectRoleRepo, projectRepo)
+	watcherService := service.NewWatcherService(watcherRepo, boardRepo, projectRepo)
+	timelineService := service.NewTimelineService(boardRepo, projectRepo, fieldOptionRepo, fieldOptionConverter, outbox, cfg.Logger)
+	sprintService := service.NewSprintService(sprintRepo, boardRepo, projectRepo, fieldOptionConverter, outbox, cfg.Logger)
+	reminderService := service.NewReminderService(reminderRepo, boardRepo, projectRepo, fieldOptionConverter, cfg.NotiClient, outbox, cfg.Logger)
+	recurrenceService := service.NewRecurrenceService(recurrenceRepo, boardRepo, projectRepo, participantRepo, labelRepo, fieldOptionRepo, fieldOptionConverter, cfg.NotiClient, outbox, cfg.Logger)
+	inviteService := service.NewInviteService(inviteLinkRepo, projectRepo, cfg.UserClient, cfg.NotiClient, outbox, cfg.Logger)
+	workloadService := service.NewWorkloadService(boardRepo, projectRepo, fieldOptionRepo, fieldOptionConverter, cfg.UserClient, cfg.Logger)
+	reportService := service.NewReportService(reportTemplateRepo, boardRepo, projectRepo, fieldOptionRepo, fieldOptionConverter, cfg.UserClient, cfg.Logger)
 
 	// Initialize handlers with service dependencies
 	projectHandler := handler.NewProjectHandler(projectService)
-	boardHandler := handler.NewBoardHandler(boardService, cfg.NotiClient)
+	boardHandler := handler.NewBoardHandler(boardService)
 	participantHandler := handler.NewParticipantHandler(participantService)
 	commentHandler := handler.NewCommentHandler(commentService)
 	fieldOptionHandler := handler.NewFieldOptionHandler(fieldOptionService)
 	projectMemberHandler := handler.NewProjectMemberHandler(projectMemberService)
 	projectJoinRequestHandler := handler.NewProjectJoinRequestHandler(projectJoinRequestService)
 	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo)
+	attachmentHandler.SetAttachmentGuard(service.NewAttachmentGuard(projectRepo, boardRepo, commentRepo))
+	if cfg.AttachmentThumbnailer != nil {
+		attachmentHandler.SetThumbnailer(cfg.AttachmentThumbnailer)
+	} else if cfg.S3Client != nil {
+		attachmentHandler.SetThumbnailer(service.NewAttachmentThumbnailer(attachmentRepo, cfg.S3Client, cfg.Logger))
+	}
+	workflowHandler := handler.NewWorkflowHandler(workflowService)
+	automationHandler := handler.NewAutomationHandler(automationService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	labelHandler := handler.NewLabelHandler(labelService)
+	projectRoleHandler := handler.NewProjectRoleHandler(projectRoleService)
+	watcherHandler := handler.NewWatcherHandler(watcherService)
+	timelineHandler := handler.NewTimelineHandler(timelineService)
+	workloadHandler := handler.NewWorkloadHandler(workloadService)
+	sprintHandler := handler.NewSprintHandler(sprintService)
+	reminderHandler := handler.NewReminderHandler(reminderService)
+	recurrenceHandler := handler.NewRecurrenceHandler(recurrenceService)
+	inviteHandler := handler.NewInviteHandler(inviteService)
+	reportHandler := handler.NewReportHandler(reportService)
 
 	// 💡 WebSocket Handler 초기화
 	wsHandler := handler.NewWSHandler(cfg.Logger, cfg.UserClient)
@@ -166,7 +243,7 @@ func Setup(cfg Config) *gin.Engine {
 	}
 
 	// Setup API routes
-	setupRoutes(baseGroup, authMiddleware, projectHandler, boardHandler, participantHandler, commentHandler, fieldOptionHandler, projectMemberHandler, projectJoinRequestHandler, attachmentHandler, wsHandler)
+	setupRoutes(baseGroup, authMiddleware, projectHandler, boardHandler, participantHandler, commentHandler, fieldOptionHandler, projectMemberHandler, projectJoinRequestHandler, attachmentHandler, workflowHandler, automationHandler, webhookHandler, labelHandler, projectRoleHandler, watcherHandler, timelineHandler, workloadHandler, sprintHandler, reminderHandler, recurrenceHandler, inviteHandler, reportHandler, wsHandler)
 
 	// 🔥 [중요] WebSocket은 baseGroup에 직접 등록 (chat-service와 동일한 패턴)
 	// basePath가 /api/boards일 때: /api/boards/ws/project/:projectId
@@ -187,6 +264,19 @@ func setupRoutes(
 	projectMemberHandler *handler.ProjectMemberHandler,
 	projectJoinRequestHandler *handler.ProjectJoinRequestHandler,
 	attachmentHandler *handler.AttachmentHandler,
+	workflowHandler *handler.WorkflowHandler,
+	automationHandler *handler.AutomationHandler,
+	webhookHandler *handler.WebhookHandler,
+	labelHandler *handler.LabelHandler,
+	projectRoleHandler *handler.ProjectRoleHandler,
+	watcherHandler *handler.WatcherHandler,
+	timelineHandler *handler.TimelineHandler,
+	workloadHandler *handler.WorkloadHandler,
+	sprintHandler *handler.SprintHandler,
+	reminderHandler *handler.ReminderHandler,
+	recurrenceHandler *handler.RecurrenceHandler,
+	inviteHandler *handler.InviteHandler,
+	reportHandler *handler.ReportHandler,
 	wsHandler *handler.WSHandler, // 🔥 온라인 사용자 조회용
 ) {
 	// API group with authentication
@@ -205,6 +295,10 @@ func setupRoutes(
 			projects.POST("", projectHandler.CreateProject)
 			projects.GET("/workspace/:workspaceId", projectHandler.GetProjectsByWorkspace)
 			projects.GET("/workspace/:workspaceId/default", projectHandler.GetDefaultProject)
+			projects.GET("/workspace/:workspaceId/workload", workloadHandler.GetWorkload)
+			projects.GET("/workspace/:workspaceId/report-templates", reportHandler.GetReportTemplates)
+			projects.PUT("/workspace/:workspaceId/report-templates/:format", reportHandler.SetReportTemplate)
+			projects.DELETE("/workspace/:workspaceId/report-templates/:format", reportHandler.DeleteReportTemplate)
 
 			// New project management extension routes
 			projects.GET("/search", projectHandler.SearchProjects)
@@ -212,15 +306,81 @@ func setupRoutes(
 			projects.PUT("/:projectId", projectHandler.UpdateProject)
 			projects.DELETE("/:projectId", projectHandler.DeleteProject)
 			projects.GET("/:projectId/init-settings", projectHandler.GetProjectInitSettings)
+			projects.POST("/:projectId/archive", projectHandler.ArchiveProject)
+			projects.POST("/:projectId/unarchive", projectHandler.UnarchiveProject)
 
 			// Project member routes
 			projects.GET("/:projectId/members", projectMemberHandler.GetMembers)
 			projects.DELETE("/:projectId/members/:memberId", projectMemberHandler.RemoveMember)
 			projects.PUT("/:projectId/members/:memberId/role", projectMemberHandler.UpdateMemberRole)
+			projects.PUT("/:projectId/members/:memberId/custom-role", projectRoleHandler.AssignMemberRole)
+
+			// Permission matrix and custom role routes
+			projects.GET("/:projectId/permissions", projectRoleHandler.GetMyPermissions)
+			projects.GET("/:projectId/roles", projectRoleHandler.GetRoles)
+			projects.POST("/:projectId/roles", projectRoleHandler.CreateRole)
+			projects.PUT("/:projectId/roles/:roleId", projectRoleHandler.UpdateRole)
+			projects.DELETE("/:projectId/roles/:roleId", projectRoleHandler.DeleteRole)
 
 			// Project join request routes
 			projects.GET("/:projectId/join-requests", projectJoinRequestHandler.GetJoinRequests)
 
+			// Invitation routes (members join without a join request)
+			projects.GET("/:projectId/invite-links", inviteHandler.GetInviteLinks)
+			projects.POST("/:projectId/invite-links", inviteHandler.CreateInviteLink)
+			projects.DELETE("/:projectId/invite-links/:linkId", inviteHandler.RevokeInviteLink)
+			projects.POST("/:projectId/invitations", inviteHandler.InviteMembers)
+
+			// Workflow transition routes
+			projects.GET("/:projectId/workflow/transitions", workflowHandler.GetTransitions)
+			projects.POST("/:projectId/workflow/transitions", workflowHandler.CreateTransition)
+			projects.PUT("/:projectId/workflow/transitions/:transitionId", workflowHandler.UpdateTransition)
+			projects.DELETE("/:projectId/workflow/transitions/:transitionId", workflowHandler.DeleteTransition)
+
+			// Automation rule routes
+			projects.GET("/:projectId/automation/rules", automationHandler.GetRules)
+			projects.POST("/:projectId/automation/rules", automationHandler.CreateRule)
+			projects.PUT("/:projectId/automation/rules/:ruleId", automationHandler.UpdateRule)
+			projects.DELETE("/:projectId/automation/rules/:ruleId", automationHandler.DeleteRule)
+			projects.GET("/:projectId/automation/executions", automationHandler.GetExecutions)
+
+			// Outgoing webhook routes
+			projects.GET("/:projectId/webhooks", webhookHandler.GetWebhooks)
+			projects.POST("/:projectId/webhooks", webhookHandler.CreateWebhook)
+			projects.PUT("/:projectId/webhooks/:webhookId", webhookHandler.UpdateWebhook)
+			projects.DELETE("/:projectId/webhooks/:webhookId", webhookHandler.DeleteWebhook)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries", webhookHandler.GetDeliveries)
+			projects.POST("/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+
+			// Label routes
+			projects.GET("/:projectId/labels", labelHandler.GetLabels)
+			projects.POST("/:projectId/labels", labelHandler.CreateLabel)
+			projects.PUT("/:projectId/labels/:labelId", labelHandler.UpdateLabel)
+			projects.DELETE("/:projectId/labels/:labelId", labelHandler.DeleteLabel)
+			projects.POST("/:projectId/labels/:labelId/merge", labelHandler.MergeLabel)
+
+			// Watch routes (subscribe to every board of the project)
+			projects.GET("/:projectId/watch", watcherHandler.GetProjectWatchStatus)
+			projects.POST("/:projectId/watch", watcherHandler.WatchProject)
+			projects.DELETE("/:projectId/watch", watcherHandler.UnwatchProject)
+
+			// Timeline (Gantt) routes
+			projects.GET("/:projectId/timeline", timelineHandler.GetTimeline)
+			projects.POST("/:projectId/timeline/reschedule", timelineHandler.RescheduleBoards)
+
+			// Status report routes
+			projects.GET("/:projectId/report", reportHandler.GetProjectReport)
+
+			// Sprint (milestone) routes
+			projects.GET("/:projectId/sprints", sprintHandler.GetSprints)
+			projects.POST("/:projectId/sprints", sprintHandler.CreateSprint)
+			projects.GET("/:projectId/sprints/:sprintId", sprintHandler.GetSprint)
+			projects.PUT("/:projectId/sprints/:sprintId", sprintHandler.UpdateSprint)
+			projects.DELETE("/:projectId/sprints/:sprintId", sprintHandler.DeleteSprint)
+			projects.POST("/:projectId/sprints/:sprintId/start", sprintHandler.StartSprint)
+			projects.POST("/:projectId/sprints/:sprintId/close", sprintHandler.CloseSprint)
+			projects.PUT("/:projectId/sprints/:sprintId/boards", sprintHandler.UpdateSprintBoards)
+
 			// Attachment routes for projects
 			projects.GET("/:projectId/attachments", attachmentHandler.GetProjectAttachments)
 
@@ -235,6 +395,12 @@ func setupRoutes(
 			joinRequests.PUT("/:joinRequestId", projectJoinRequestHandler.UpdateJoinRequest)
 		}
 
+		// Invite link routes (not nested under project, the token identifies it)
+		inviteLinks := api.Group("/invite-links")
+		{
+			inviteLinks.POST("/:token/accept", inviteHandler.AcceptInviteLink)
+		}
+
 		// Board routes
 		boards := api.Group("/boards")
 		{
@@ -244,14 +410,40 @@ func setupRoutes(
 			boards.POST("", boardHandler.CreateBoard)
 			boards.GET("/:boardId", boardHandler.GetBoard)
 			boards.GET("/project/:projectId", boardHandler.GetBoardsByProject)
+			boards.GET("/by-key/:key", boardHandler.GetBoardByKey)
 			boards.PUT("/:boardId", boardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", boardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", boardHandler.MoveBoard) // ✅ 이 라인 추가
 
+			// Watch and mute routes
+			boards.GET("/:boardId/watch", watcherHandler.GetBoardWatchStatus)
+			boards.POST("/:boardId/watch", watcherHandler.WatchBoard)
+			boards.DELETE("/:boardId/watch", watcherHandler.UnwatchBoard)
+			boards.POST("/:boardId/mute", watcherHandler.MuteBoard)
+			boards.DELETE("/:boardId/mute", watcherHandler.UnmuteBoard)
+			boards.GET("/:boardId/watchers", watcherHandler.GetBoardWatchers)
+
+			// Personal reminder routes
+			boards.GET("/:boardId/reminders", reminderHandler.GetBoardReminders)
+			boards.POST("/:boardId/reminders", reminderHandler.CreateReminder)
+
+			// Recurrence routes (the board is the template of the series)
+			boards.GET("/:boardId/recurrence", recurrenceHandler.GetRecurrence)
+			boards.PUT("/:boardId/recurrence", recurrenceHandler.SetRecurrence)
+			boards.DELETE("/:boardId/recurrence", recurrenceHandler.DeleteRecurrence)
+
 			// Attachment routes for boards
 			boards.GET("/:boardId/attachments", attachmentHandler.GetBoardAttachments)
 		}
 
+		// Reminder routes (the requester's own reminders)
+		reminders := api.Group("/reminders")
+		{
+			reminders.GET("", reminderHandler.GetMyReminders)
+			reminders.POST("/:reminderId/snooze", reminderHandler.SnoozeReminder)
+			reminders.DELETE("/:reminderId", reminderHandler.DeleteReminder)
+		}
+
 		// Participant routes
 		participants := api.Group("/participants")
 		{
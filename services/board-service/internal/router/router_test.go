@@ -57,6 +57,10 @@ func (m *mockUserClient) GetWorkspaceProfile(ctx context.Context, workspaceID, u
 	return nil, nil
 }
 
+func (m *mockUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+	return nil, nil
+}
+
 func (m *mockUserClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error) {
 	return nil, nil
 }
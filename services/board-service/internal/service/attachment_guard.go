@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// AttachmentGuard authorizes attachment changes made outside the service layer
+// It resolves the project an attachment belongs to through its board or comment
+type AttachmentGuard interface {
+	EnsureAttachmentWritable(ctx context.Context, attachment *domain.Attachment) error
+	EnsureAttachmentDeletable(ctx context.Context, attachment *domain.Attachment, userID uuid.UUID) error
+}
+
+// attachmentGuardImpl is the implementation of AttachmentGuard
+type attachmentGuardImpl struct {
+	projectRepo repository.ProjectRepository
+	boardRepo   repository.BoardRepository
+	commentRepo repository.CommentRepository
+}
+
+// NewAttachmentGuard creates a new instance of AttachmentGuard
+func NewAttachmentGuard(projectRepo repository.ProjectRepository, boardRepo repository.BoardRepository, commentRepo repository.CommentRepository) AttachmentGuard {
+	return &attachmentGuardImpl{
+		projectRepo: projectRepo,
+		boardRepo:   boardRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// EnsureAttachmentWritable rejects changes to an attachment whose board, comment or project is archived
+// Attachments that are not yet linked to an entity are always writable
+func (g *attachmentGuardImpl) EnsureAttachmentWritable(ctx context.Context, attachment *domain.Attachment) error {
+	projectID, ok := g.resolveProjectID(ctx, attachment)
+	if !ok {
+		return nil
+	}
+	return ensureProjectWritable(ctx, g.projectRepo, projectID)
+}
+
+// EnsureAttachmentDeletable lets uploaders delete their own attachments and otherwise requires
+// attachment.delete in the owning project; archived projects reject both
+func (g *attachmentGuardImpl) EnsureAttachmentDeletable(ctx context.Context, attachment *domain.Attachment, userID uuid.UUID) error {
+	projectID, ok := g.resolveProjectID(ctx, attachment)
+	if !ok {
+		if attachment.UploadedBy != userID {
+			return response.NewForbiddenError("You do not have permission to delete this attachment", "")
+		}
+		return nil
+	}
+
+	if attachment.UploadedBy != userID {
+		if err := requirePermission(ctx, g.projectRepo, projectID, userID, domain.PermissionAttachmentDelete, "You do not have permission to delete this attachment"); err != nil {
+			return err
+		}
+	}
+	return ensureProjectWritable(ctx, g.projectRepo, projectID)
+}
+
+// resolveProjectID finds the project of the entity an attachment is linked to
+func (g *attachmentGuardImpl) resolveProjectID(ctx context.Context, attachment *domain.Attachment) (uuid.UUID, bool) {
+	if attachment.EntityID == nil {
+		return uuid.Nil, false
+	}
+
+	switch attachment.EntityType {
+	case domain.EntityTypeBoard:
+		board, err := g.boardRepo.FindByID(ctx, *attachment.EntityID)
+		if err != nil || board == nil {
+			return uuid.Nil, false
+		}
+		return board.ProjectID, true
+	case domain.EntityTypeComment:
+		comment, err := g.commentRepo.FindByID(ctx, *attachment.EntityID)
+		if err != nil || comment == nil {
+			return uuid.Nil, false
+		}
+		board, err := g.boardRepo.FindByID(ctx, comment.BoardID)
+		if err != nil || board == nil {
+			return uuid.Nil, false
+		}
+		return board.ProjectID, true
+	}
+	return *attachment.EntityID, true
+}
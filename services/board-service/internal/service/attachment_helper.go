@@ -2,10 +2,12 @@
 package service
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/response"
@@ -27,6 +29,31 @@ func toDomainAttachments(attachments []*domain.Attachment) []domain.Attachment {
 	return result
 }
 
+// attachmentImageURLs returns the download URLs of the generated thumbnail and preview (empty until READY)
+func attachmentImageURLs(s3Client S3Client, attachment *domain.Attachment) (string, string) {
+	var thumbnailURL, previewURL string
+	if attachment.ThumbnailKey != "" {
+		thumbnailURL = s3Client.GetFileURL(attachment.ThumbnailKey)
+	}
+	if attachment.PreviewKey != "" {
+		previewURL = s3Client.GetFileURL(attachment.PreviewKey)
+	}
+	return thumbnailURL, previewURL
+}
+
+// deleteDerivedFiles removes the generated thumbnail and preview of an attachment from S3
+// Failures are only logged, like the deletion of the original file
+func deleteDerivedFiles(ctx context.Context, s3Client S3Client, logger *zap.Logger, attachment *domain.Attachment) {
+	for _, key := range attachment.DerivedFileKeys() {
+		if err := s3Client.DeleteFile(ctx, key); err != nil {
+			logger.Warn("Failed to delete derived image from S3",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.String("file_key", key),
+				zap.Error(err))
+		}
+	}
+}
+
 // removeDuplicateUUIDs removes duplicate UUIDs from a slice
 func removeDuplicateUUIDs(uuids []uuid.UUID) []uuid.UUID {
 	seen := make(map[uuid.UUID]bool)
@@ -52,6 +79,11 @@ func validateDateRange(startDate, dueDate *time.Time) error {
 	return nil
 }
 
+// isUniqueViolation checks whether a repository error was caused by a unique constraint
+func isUniqueViolation(err error) bool {
+	return err != nil && (strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") || strings.Contains(err.Error(), "UNIQUE"))
+}
+
 // extractS3KeyFromURL extracts the S3 key from a full S3 URL
 // Example: https://bucket.s3.region.amazonaws.com/board/boards/workspace/2024/01/file.jpg -> board/boards/workspace/2024/01/file.jpg
 func extractS3KeyFromURL(fileURL string) string {
@@ -30,6 +30,7 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 		}
 
 		mockProjectRepo := &MockProjectRepository{
+			FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
 			},
@@ -69,9 +70,14 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil, // workflowRepo
+			nil, // labelRepo
 			mockS3Client,
 			mockFieldOptionConverter,
-			nil, // notiClient
+			nil, nil, // notiClient
+			nil, // automation
+			nil, // references
+			nil, // watchers
 			nil, // metrics
 			logger,
 		)
@@ -99,6 +105,7 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 
 		mockBoardRepo := &MockBoardRepository{}
 		mockProjectRepo := &MockProjectRepository{
+			FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
 			},
@@ -122,9 +129,14 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil, // workflowRepo
+			nil, // labelRepo
 			mockS3Client,
 			mockFieldOptionConverter,
-			nil, // notiClient
+			nil, nil, // notiClient
+			nil, // automation
+			nil, // references
+			nil, // watchers
 			nil, // metrics
 			logger,
 		)
@@ -157,6 +169,7 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 
 		mockBoardRepo := &MockBoardRepository{}
 		mockProjectRepo := &MockProjectRepository{
+			FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
 			},
@@ -185,9 +198,14 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil, // workflowRepo
+			nil, // labelRepo
 			mockS3Client,
 			mockFieldOptionConverter,
-			nil, // notiClient
+			nil, nil, // notiClient
+			nil, // automation
+			nil, // references
+			nil, // watchers
 			nil, // metrics
 			logger,
 		)
@@ -220,6 +238,7 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 
 		mockBoardRepo := &MockBoardRepository{}
 		mockProjectRepo := &MockProjectRepository{
+			FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
 			},
@@ -249,9 +268,14 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil, // workflowRepo
+			nil, // labelRepo
 			mockS3Client,
 			mockFieldOptionConverter,
-			nil, // notiClient
+			nil, nil, // notiClient
+			nil, // automation
+			nil, // references
+			nil, // watchers
 			nil, // metrics
 			logger,
 		)
@@ -320,8 +344,8 @@ func TestCreateCommentWithAttachments(t *testing.T) {
 		}
 
 		mockS3Client := &MockS3Client{}
-		mockProjectRepo := &MockProjectRepository{}
-		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, logger)
+		mockProjectRepo := &MockProjectRepository{FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember)}
+		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, nil, nil, nil, nil, logger)
 
 		req := &dto.CreateCommentRequest{
 			BoardID:       boardID,
@@ -359,7 +383,7 @@ func TestCreateCommentWithAttachments(t *testing.T) {
 
 		mockS3Client := &MockS3Client{}
 		mockProjectRepo := &MockProjectRepository{}
-		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, logger)
+		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, nil, nil, nil, nil, logger)
 
 		req := &dto.CreateCommentRequest{
 			BoardID:       boardID,
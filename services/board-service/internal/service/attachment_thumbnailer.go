@@ -0,0 +1,306 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register the WebP decoder
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// Thumbnail rendering settings
+// The thumbnail is a square center crop used on board cards; the preview keeps the aspect ratio
+// Images are never upscaled
+const (
+	thumbnailSize        = 320
+	previewMaxSize       = 1280
+	thumbnailJPEGQuality = 85
+)
+
+// Thumbnail worker limits
+const (
+	thumbnailMaxSourceBytes  = 50 * 1024 * 1024 // same as the upload limit
+	thumbnailMaxSourcePixels = 50_000_000       // refuse decompression bombs before decoding
+	thumbnailWorkers         = 2                // concurrent renders per replica (decoding is memory heavy)
+	thumbnailPendingGrace    = 10 * time.Minute // pending attachments younger than this are still in a worker
+	thumbnailRetryWindow     = 24 * time.Hour   // pending attachments older than this are marked FAILED
+	thumbnailBatchSize       = 50
+)
+
+// Derived S3 key suffixes (appended to the original key without its extension)
+const (
+	thumbnailKeySuffix = "_thumb"
+	previewKeySuffix   = "_preview"
+)
+
+// thumbnailContentTypes lists the image types thumbnails are generated for
+// SVG and HEIC are served as-is
+var thumbnailContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/jpg":  true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// SupportsThumbnail reports whether thumbnails are generated for the content type
+func SupportsThumbnail(contentType string) bool {
+	return thumbnailContentTypes[strings.ToLower(contentType)]
+}
+
+// ThumbnailStorage is the subset of the S3 client used by the thumbnail worker
+type ThumbnailStorage interface {
+	DownloadFile(ctx context.Context, key string) (io.ReadCloser, error)
+	UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
+}
+
+// AttachmentThumbnailer renders thumbnails and previews of image attachments
+type AttachmentThumbnailer interface {
+	// Enqueue generates the images of the attachment in the background without blocking the caller
+	Enqueue(attachment *domain.Attachment)
+	// Generate renders and stores the thumbnail and preview of an attachment and records the outcome
+	Generate(ctx context.Context, attachment *domain.Attachment) error
+	// GeneratePending processes attachments left PENDING (e.g. by a restart) and returns how many were attempted
+	GeneratePending(ctx context.Context) (int, error)
+}
+
+// attachmentThumbnailerImpl is the implementation of AttachmentThumbnailer
+type attachmentThumbnailerImpl struct {
+	attachmentRepo repository.AttachmentRepository
+	storage        ThumbnailStorage
+	logger         *zap.Logger
+	slots          chan struct{}
+	now            func() time.Time
+}
+
+// NewAttachmentThumbnailer creates a new instance of AttachmentThumbnailer
+func NewAttachmentThumbnailer(attachmentRepo repository.AttachmentRepository, storage ThumbnailStorage, logger *zap.Logger) AttachmentThumbnailer {
+	return &attachmentThumbnailerImpl{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		logger:         logger,
+		slots:          make(chan struct{}, thumbnailWorkers),
+		now:            time.Now,
+	}
+}
+
+// ThumbnailKey returns the S3 key of the thumbnail derived from the original key
+func ThumbnailKey(fileKey, ext string) string {
+	return strings.TrimSuffix(fileKey, path.Ext(fileKey)) + thumbnailKeySuffix + ext
+}
+
+// PreviewKey returns the S3 key of the preview derived from the original key
+func PreviewKey(fileKey, ext string) string {
+	return strings.TrimSuffix(fileKey, path.Ext(fileKey)) + previewKeySuffix + ext
+}
+
+// Enqueue renders the images in a goroutine; at most thumbnailWorkers renders run at once
+func (t *attachmentThumbnailerImpl) Enqueue(attachment *domain.Attachment) {
+	if !SupportsThumbnail(attachment.ContentType) {
+		return
+	}
+	target := *attachment
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				t.logger.Error("Thumbnail generation panicked",
+					zap.String("attachment_id", target.ID.String()),
+					zap.Any("panic", r))
+			}
+		}()
+		t.slots <- struct{}{}
+		defer func() { <-t.slots }()
+
+		if err := t.Generate(context.Background(), &target); err != nil {
+			t.logger.Warn("Failed to generate attachment thumbnail",
+				zap.String("attachment_id", target.ID.String()),
+				zap.String("file_key", target.FileURL),
+				zap.Error(err))
+		}
+	}()
+}
+
+// Generate downloads the original image, stores the derived images and marks the attachment READY
+// Images that cannot be decoded are marked FAILED; storage errors leave the attachment PENDING
+// so that GeneratePending retries it
+func (t *attachmentThumbnailerImpl) Generate(ctx context.Context, attachment *domain.Attachment) error {
+	if !SupportsThumbnail(attachment.ContentType) {
+		return nil
+	}
+
+	data, err := t.download(ctx, attachment.FileURL)
+	if err != nil {
+		return err
+	}
+
+	src, format, err := decodeThumbnailSource(data)
+	if err != nil {
+		if updateErr := t.attachmentRepo.UpdateThumbnail(ctx, attachment.ID, domain.ThumbnailStatusFailed, "", ""); updateErr != nil {
+			return updateErr
+		}
+		attachment.ThumbnailStatus = domain.ThumbnailStatusFailed
+		return err
+	}
+
+	// Formats that may carry transparency are rendered as PNG, everything else as JPEG
+	ext, contentType := ".jpg", "image/jpeg"
+	if format == "png" || format == "gif" {
+		ext, contentType = ".png", "image/png"
+	}
+
+	thumbnailKey := ThumbnailKey(attachment.FileURL, ext)
+	if err := t.upload(ctx, thumbnailKey, renderThumbnail(src, contentType), contentType); err != nil {
+		return err
+	}
+	previewKey := PreviewKey(attachment.FileURL, ext)
+	if err := t.upload(ctx, previewKey, renderPreview(src, contentType), contentType); err != nil {
+		return err
+	}
+
+	if err := t.attachmentRepo.UpdateThumbnail(ctx, attachment.ID, domain.ThumbnailStatusReady, thumbnailKey, previewKey); err != nil {
+		return err
+	}
+	attachment.ThumbnailStatus = domain.ThumbnailStatusReady
+	attachment.ThumbnailKey = thumbnailKey
+	attachment.PreviewKey = previewKey
+	return nil
+}
+
+// GeneratePending renders attachments that stayed PENDING longer than thumbnailPendingGrace
+// Attachments still failing after thumbnailRetryWindow are marked FAILED
+func (t *attachmentThumbnailerImpl) GeneratePending(ctx context.Context) (int, error) {
+	now := t.now()
+	attachments, err := t.attachmentRepo.FindPendingThumbnails(ctx, now.Add(-thumbnailPendingGrace), thumbnailBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, attachment := range attachments {
+		attempted++
+		err := t.Generate(ctx, attachment)
+		if err == nil || attachment.ThumbnailStatus == domain.ThumbnailStatusFailed {
+			continue
+		}
+		if now.Sub(attachment.CreatedAt) < thumbnailRetryWindow {
+			t.logger.Warn("Failed to generate pending attachment thumbnail",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.Error(err))
+			continue
+		}
+		t.logger.Warn("Giving up on attachment thumbnail",
+			zap.String("attachment_id", attachment.ID.String()),
+			zap.Error(err))
+		if err := t.attachmentRepo.UpdateThumbnail(ctx, attachment.ID, domain.ThumbnailStatusFailed, "", ""); err != nil {
+			return attempted, err
+		}
+	}
+	return attempted, nil
+}
+
+// download reads the original file, refusing files larger than the upload limit
+func (t *attachmentThumbnailerImpl) download(ctx context.Context, key string) ([]byte, error) {
+	body, err := t.storage.DownloadFile(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, thumbnailMaxSourceBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read original image: %w", err)
+	}
+	if len(data) > thumbnailMaxSourceBytes {
+		return nil, fmt.Errorf("original image exceeds %d bytes", thumbnailMaxSourceBytes)
+	}
+	return data, nil
+}
+
+// upload stores one derived image
+func (t *attachmentThumbnailerImpl) upload(ctx context.Context, key string, data []byte, contentType string) error {
+	if data == nil {
+		return fmt.Errorf("failed to encode %s", key)
+	}
+	if _, err := t.storage.UploadFile(ctx, key, bytes.NewReader(data), contentType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// decodeThumbnailSource decodes an image after checking its dimensions
+func decodeThumbnailSource(data []byte) (image.Image, string, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("unsupported image: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > thumbnailMaxSourcePixels {
+		return nil, "", fmt.Errorf("image dimensions %dx%d are not supported", cfg.Width, cfg.Height)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, format, nil
+}
+
+// renderThumbnail center-crops the image to a square and scales it down to thumbnailSize
+func renderThumbnail(src image.Image, contentType string) []byte {
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2))
+	size := min(side, thumbnailSize)
+	return scaleAndEncode(src, crop, size, size, contentType)
+}
+
+// renderPreview scales the image down to fit in previewMaxSize while keeping its aspect ratio
+func renderPreview(src image.Image, contentType string) []byte {
+	b := src.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width > previewMaxSize || height > previewMaxSize {
+		if width >= height {
+			height = max(1, height*previewMaxSize/width)
+			width = previewMaxSize
+		} else {
+			width = max(1, width*previewMaxSize/height)
+			height = previewMaxSize
+		}
+	}
+	return scaleAndEncode(src, b, width, height, contentType)
+}
+
+// scaleAndEncode draws the source rectangle into a width x height canvas and encodes it
+// JPEG output is flattened onto a white background; nil is returned when encoding fails
+func scaleAndEncode(src image.Image, srcRect image.Rectangle, width, height int, contentType string) []byte {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if contentType == "image/jpeg" {
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	}
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, srcRect, draw.Over, nil)
+
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buf, dst)
+	} else {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailJPEGQuality})
+	}
+	if err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
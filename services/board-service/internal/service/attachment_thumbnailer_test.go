@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+)
+
+// thumbnailUpdate records one UpdateThumbnail call
+type thumbnailUpdate struct {
+	status       domain.ThumbnailStatus
+	thumbnailKey string
+	previewKey   string
+}
+
+func newThumbnailTestRepo(updates map[uuid.UUID]thumbnailUpdate) *MockAttachmentRepository {
+	return &MockAttachmentRepository{
+		UpdateThumbnailFunc: func(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error {
+			updates[id] = thumbnailUpdate{status: status, thumbnailKey: thumbnailKey, previewKey: previewKey}
+			return nil
+		},
+	}
+}
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode test image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func storedImageSize(t *testing.T, s3 *client.MockS3Client, key string) (int, int) {
+	t.Helper()
+	body, err := s3.DownloadFile(context.Background(), key)
+	if err != nil {
+		t.Fatalf("derived image %s was not stored: %v", key, err)
+	}
+	defer body.Close()
+	cfg, _, err := image.DecodeConfig(body)
+	if err != nil {
+		t.Fatalf("derived image %s is not decodable: %v", key, err)
+	}
+	return cfg.Width, cfg.Height
+}
+
+func TestAttachmentThumbnailer_Generate(t *testing.T) {
+	ctx := context.Background()
+	s3 := client.NewMockS3Client()
+	updates := make(map[uuid.UUID]thumbnailUpdate)
+	thumbnailer := NewAttachmentThumbnailer(newThumbnailTestRepo(updates), s3, zap.NewNop())
+
+	fileKey := "board/boards/ws/2024/01/photo_1.png"
+	if _, err := s3.UploadFile(ctx, fileKey, bytes.NewReader(encodeTestPNG(t, 2000, 1000)), "image/png"); err != nil {
+		t.Fatalf("failed to seed original: %v", err)
+	}
+
+	attachment := &domain.Attachment{
+		BaseModel:       domain.BaseModel{ID: uuid.New()},
+		FileURL:         fileKey,
+		ContentType:     "image/png",
+		ThumbnailStatus: domain.ThumbnailStatusPending,
+	}
+	if err := thumbnailer.Generate(ctx, attachment); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	want := thumbnailUpdate{
+		status:       domain.ThumbnailStatusReady,
+		thumbnailKey: "board/boards/ws/2024/01/photo_1_thumb.png",
+		previewKey:   "board/boards/ws/2024/01/photo_1_preview.png",
+	}
+	if got := updates[attachment.ID]; got != want {
+		t.Fatalf("UpdateThumbnail() = %+v, want %+v", got, want)
+	}
+	if attachment.ThumbnailKey != want.thumbnailKey || attachment.PreviewKey != want.previewKey {
+		t.Errorf("attachment keys = %q/%q", attachment.ThumbnailKey, attachment.PreviewKey)
+	}
+
+	if w, h := storedImageSize(t, s3, want.thumbnailKey); w != thumbnailSize || h != thumbnailSize {
+		t.Errorf("thumbnail size = %dx%d, want %dx%d", w, h, thumbnailSize, thumbnailSize)
+	}
+	if w, h := storedImageSize(t, s3, want.previewKey); w != previewMaxSize || h != previewMaxSize/2 {
+		t.Errorf("preview size = %dx%d, want %dx%d", w, h, previewMaxSize, previewMaxSize/2)
+	}
+	if contentType, _ := s3.StoredContentType(want.thumbnailKey); contentType != "image/png" {
+		t.Errorf("thumbnail content type = %q, want image/png", contentType)
+	}
+}
+
+func TestAttachmentThumbnailer_Generate_SmallImageIsNotUpscaled(t *testing.T) {
+	ctx := context.Background()
+	s3 := client.NewMockS3Client()
+	updates := make(map[uuid.UUID]thumbnailUpdate)
+	thumbnailer := NewAttachmentThumbnailer(newThumbnailTestRepo(updates), s3, zap.NewNop())
+
+	fileKey := "board/comments/ws/2024/01/icon.png"
+	_, _ = s3.UploadFile(ctx, fileKey, bytes.NewReader(encodeTestPNG(t, 100, 60)), "image/png")
+
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: fileKey, ContentType: "image/png"}
+	if err := thumbnailer.Generate(ctx, attachment); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if w, h := storedImageSize(t, s3, attachment.ThumbnailKey); w != 60 || h != 60 {
+		t.Errorf("thumbnail size = %dx%d, want 60x60", w, h)
+	}
+	if w, h := storedImageSize(t, s3, attachment.PreviewKey); w != 100 || h != 60 {
+		t.Errorf("preview size = %dx%d, want 100x60", w, h)
+	}
+}
+
+func TestAttachmentThumbnailer_Generate_UndecodableImageFails(t *testing.T) {
+	ctx := context.Background()
+	s3 := client.NewMockS3Client()
+	updates := make(map[uuid.UUID]thumbnailUpdate)
+	thumbnailer := NewAttachmentThumbnailer(newThumbnailTestRepo(updates), s3, zap.NewNop())
+
+	fileKey := "board/boards/ws/2024/01/broken.jpg"
+	_, _ = s3.UploadFile(ctx, fileKey, bytes.NewReader([]byte("not an image")), "image/jpeg")
+
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: fileKey, ContentType: "image/jpeg"}
+	if err := thumbnailer.Generate(ctx, attachment); err == nil {
+		t.Fatal("Generate() expected error for undecodable image")
+	}
+	if got := updates[attachment.ID].status; got != domain.ThumbnailStatusFailed {
+		t.Errorf("status = %q, want FAILED", got)
+	}
+}
+
+func TestAttachmentThumbnailer_Generate_SkipsUnsupportedTypes(t *testing.T) {
+	s3 := client.NewMockS3Client()
+	s3.DownloadFileFunc = func(ctx context.Context, key string) (io.ReadCloser, error) {
+		t.Fatal("unsupported attachments must not be downloaded")
+		return nil, nil
+	}
+	thumbnailer := NewAttachmentThumbnailer(newThumbnailTestRepo(make(map[uuid.UUID]thumbnailUpdate)), s3, zap.NewNop())
+
+	for _, contentType := range []string{"image/svg+xml", "image/heic", "application/pdf"} {
+		attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "board/boards/ws/file", ContentType: contentType}
+		if err := thumbnailer.Generate(context.Background(), attachment); err != nil {
+			t.Errorf("Generate(%s) error = %v", contentType, err)
+		}
+	}
+}
+
+func TestAttachmentThumbnailer_GeneratePending(t *testing.T) {
+	ctx := context.Background()
+	s3 := client.NewMockS3Client()
+	updates := make(map[uuid.UUID]thumbnailUpdate)
+	now := time.Now()
+
+	ready := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: now.Add(-time.Hour)}, FileURL: "board/boards/ws/a.png", ContentType: "image/png"}
+	_, _ = s3.UploadFile(ctx, ready.FileURL, bytes.NewReader(encodeTestPNG(t, 10, 10)), "image/png")
+	// Missing originals stay pending within the retry window and fail afterwards
+	recent := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: now.Add(-time.Hour)}, FileURL: "board/boards/ws/b.png", ContentType: "image/png"}
+	stale := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: now.Add(-2 * thumbnailRetryWindow)}, FileURL: "board/boards/ws/c.png", ContentType: "image/png"}
+
+	repo := newThumbnailTestRepo(updates)
+	var cutoff time.Time
+	repo.FindPendingThumbnailsFunc = func(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+		cutoff = createdBefore
+		return []*domain.Attachment{ready, recent, stale}, nil
+	}
+	thumbnailer := NewAttachmentThumbnailer(repo, s3, zap.NewNop()).(*attachmentThumbnailerImpl)
+	thumbnailer.now = func() time.Time { return now }
+
+	attempted, err := thumbnailer.GeneratePending(ctx)
+	if err != nil {
+		t.Fatalf("GeneratePending() error = %v", err)
+	}
+	if attempted != 3 {
+		t.Errorf("attempted = %d, want 3", attempted)
+	}
+	if !cutoff.Equal(now.Add(-thumbnailPendingGrace)) {
+		t.Errorf("cutoff = %v, want %v", cutoff, now.Add(-thumbnailPendingGrace))
+	}
+	if updates[ready.ID].status != domain.ThumbnailStatusReady {
+		t.Errorf("ready status = %q, want READY", updates[ready.ID].status)
+	}
+	if _, ok := updates[recent.ID]; ok {
+		t.Errorf("recent attachment was updated to %q, want it to stay PENDING", updates[recent.ID].status)
+	}
+	if updates[stale.ID].status != domain.ThumbnailStatusFailed {
+		t.Errorf("stale status = %q, want FAILED", updates[stale.ID].status)
+	}
+}
+
+func TestAttachmentThumbnailer_GeneratePending_RepositoryError(t *testing.T) {
+	repo := &MockAttachmentRepository{
+		FindPendingThumbnailsFunc: func(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+			return nil, errors.New("db down")
+		},
+	}
+	thumbnailer := NewAttachmentThumbnailer(repo, client.NewMockS3Client(), zap.NewNop())
+
+	if _, err := thumbnailer.GeneratePending(context.Background()); err == nil {
+		t.Error("GeneratePending() expected repository error")
+	}
+}
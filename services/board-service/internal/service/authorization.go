@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// requirePermission verifies that the requester is a project member holding the permission
+// It is the single authorization check for role-restricted actions; deniedMessage is returned
+// as a forbidden error to members lacking the permission
+func requirePermission(ctx context.Context, projectRepo repository.ProjectRepository, projectID, requesterID uuid.UUID, permission domain.Permission, deniedMessage string) error {
+	member, err := findRequesterMember(ctx, projectRepo, projectID, requesterID)
+	if err != nil {
+		return err
+	}
+	if !member.Can(permission) {
+		return response.NewForbiddenError(deniedMessage, "Missing permission: "+string(permission))
+	}
+	return nil
+}
+
+// requireOwnOrPermission lets the author of a resource through and otherwise requires the permission
+// Authors must still be members of the project
+func requireOwnOrPermission(ctx context.Context, projectRepo repository.ProjectRepository, projectID, requesterID, authorID uuid.UUID, permission domain.Permission, deniedMessage string) error {
+	member, err := findRequesterMember(ctx, projectRepo, projectID, requesterID)
+	if err != nil {
+		return err
+	}
+	if requesterID != authorID && !member.Can(permission) {
+		return response.NewForbiddenError(deniedMessage, "Missing permission: "+string(permission))
+	}
+	return nil
+}
+
+// requireBuiltinAdmin verifies that the requester is the owner or an admin without a custom role
+// It guards actions that custom roles can never be granted
+func requireBuiltinAdmin(ctx context.Context, projectRepo repository.ProjectRepository, projectID, requesterID uuid.UUID, deniedMessage string) error {
+	member, err := findRequesterMember(ctx, projectRepo, projectID, requesterID)
+	if err != nil {
+		return err
+	}
+	if !member.IsBuiltinAdmin() {
+		return response.NewForbiddenError(deniedMessage, "Requires the OWNER or ADMIN role")
+	}
+	return nil
+}
+
+// requireHeldPermissions rejects granting permissions that the requester does not hold itself
+func requireHeldPermissions(requester *domain.ProjectMember, permissions []domain.Permission) error {
+	for _, p := range permissions {
+		if !requester.Can(p) {
+			return response.NewForbiddenError("You cannot grant a permission you do not hold", "Missing permission: "+string(p))
+		}
+	}
+	return nil
+}
+
+// findRequesterMember fetches the requester's membership with its custom role
+func findRequesterMember(ctx context.Context, projectRepo repository.ProjectRepository, projectID, requesterID uuid.UUID) (*domain.ProjectMember, error) {
+	member, err := projectRepo.FindMemberByProjectAndUser(ctx, projectID, requesterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	}
+	if member == nil {
+		return nil, response.NewForbiddenError("You are not a member of this project", "")
+	}
+	return member, nil
+}
@@ -0,0 +1,508 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// maxAutomationDepth bounds how many rule executions may chain off a single user action
+const maxAutomationDepth = 5
+
+// AutomationEvent describes a board event that may fire automation rules
+type AutomationEvent struct {
+	Trigger   domain.AutomationTrigger
+	ProjectID uuid.UUID
+	BoardID   uuid.UUID
+	ActorID   uuid.UUID
+	// ChangedFields maps changed field names to their new values (FIELD_CHANGED only)
+	// Custom fields use option values, assignee uses the user ID ("" when cleared)
+	ChangedFields map[string]string
+	// RuleID limits processing to a single rule (DUE_DATE_REACHED firings are claimed per rule); uuid.Nil means every rule
+	RuleID uuid.UUID
+	// Depth is the number of rule executions that led to this event (0 = user action)
+	Depth int
+	// firedRules holds the rules already executed in this chain (loop protection)
+	firedRules map[uuid.UUID]bool
+}
+
+// AutomationEngine evaluates automation rules against board events
+type AutomationEngine interface {
+	// Dispatch processes the event in the background; it never blocks the caller
+	Dispatch(ctx context.Context, event AutomationEvent)
+	// Process evaluates and executes matching rules synchronously
+	Process(ctx context.Context, event AutomationEvent)
+}
+
+// automationBoardUpdater applies board changes made by automation rules
+// It is the board service, so rule changes record the same events and notifications as user updates
+type automationBoardUpdater interface {
+	applyAutomationUpdate(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
+}
+
+// bindAutomationBoardUpdater lets the engine apply board changes through the board service
+func bindAutomationBoardUpdater(engine AutomationEngine, boards automationBoardUpdater) {
+	if impl, ok := engine.(*automationEngineImpl); ok {
+		impl.boards = boards
+	}
+}
+
+// automationEngineImpl is the implementation of AutomationEngine
+// Board changes go through the board service without dispatching automation,
+// so that only the engine itself decides which follow-up events are raised
+type automationEngineImpl struct {
+	automationRepo       repository.AutomationRepository
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	participantRepo      repository.ParticipantRepository
+	commentRepo          repository.CommentRepository
+	fieldOptionConverter FieldOptionConverter
+	notiClient           client.NotiClient
+	outbox               Outbox                 // optional, nil sends notifications directly
+	watchers             BoardWatchers          // optional, nil skips mutes and auto-watch
+	boards               automationBoardUpdater // bound by NewBoardService
+	logger               *zap.Logger
+}
+
+// NewAutomationEngine creates a new instance of AutomationEngine
+func NewAutomationEngine(
+	automationRepo repository.AutomationRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	participantRepo repository.ParticipantRepository,
+	commentRepo repository.CommentRepository,
+	fieldOptionConverter FieldOptionConverter,
+	notiClient client.NotiClient,
+	outbox Outbox,
+	watchers BoardWatchers,
+	logger *zap.Logger,
+) AutomationEngine {
+	return &automationEngineImpl{
+		automationRepo:       automationRepo,
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		participantRepo:      participantRepo,
+		commentRepo:          commentRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		notiClient:           notiClient,
+		outbox:               outbox,
+		watchers:             watchers,
+		logger:               logger,
+	}
+}
+
+// Dispatch processes the event asynchronously
+func (e *automationEngineImpl) Dispatch(ctx context.Context, event AutomationEvent) {
+	// Detach from the request context so rules keep running after the response is sent
+	bgCtx := context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				e.logger.Error("Automation panicked",
+					zap.String("board.id", event.BoardID.String()),
+					zap.String("trigger", string(event.Trigger)),
+					zap.Any("panic", r))
+			}
+		}()
+		e.Process(bgCtx, event)
+	}()
+}
+
+// Process evaluates the enabled rules of the project for the event and executes matching ones
+// Follow-up events raised by actions are processed in the same goroutine with an increased depth
+func (e *automationEngineImpl) Process(ctx context.Context, event AutomationEvent) {
+	// Archived projects are read-only, so their rules do not run
+	if err := ensureProjectWritable(ctx, e.projectRepo, event.ProjectID); err != nil {
+		e.logger.Debug("Skipping automation for read-only project",
+			zap.String("project.id", event.ProjectID.String()),
+			zap.String("trigger", string(event.Trigger)),
+			zap.Error(err))
+		return
+	}
+
+	rules, err := e.automationRepo.FindEnabledRules(ctx, event.ProjectID, event.Trigger)
+	if err != nil {
+		e.logger.Warn("Failed to fetch automation rules",
+			zap.String("project.id", event.ProjectID.String()),
+			zap.String("trigger", string(event.Trigger)),
+			zap.Error(err))
+		return
+	}
+
+	for _, rule := range rules {
+		if event.RuleID != uuid.Nil && rule.ID != event.RuleID {
+			continue
+		}
+		if !automationTriggerMatches(rule, event) {
+			continue
+		}
+		if event.Depth >= maxAutomationDepth {
+			e.recordExecution(ctx, rule, event, domain.AutomationExecutionSkipped, "Maximum automation chain depth reached")
+			continue
+		}
+		if event.firedRules[rule.ID] {
+			e.recordExecution(ctx, rule, event, domain.AutomationExecutionSkipped, "Rule already executed in this chain")
+			continue
+		}
+
+		// Reload the board for every rule since previous rules may have changed it
+		board, err := e.boardRepo.FindByID(ctx, event.BoardID)
+		if err != nil || board == nil {
+			e.logger.Warn("Failed to load board for automation",
+				zap.String("board.id", event.BoardID.String()),
+				zap.Error(err))
+			return
+		}
+
+		if !e.conditionsMet(ctx, board, decodeAutomationConditions(rule.Conditions)) {
+			continue
+		}
+
+		followUps, err := e.executeActions(ctx, rule, board)
+		if err != nil {
+			e.recordExecution(ctx, rule, event, domain.AutomationExecutionFailed, err.Error())
+		} else {
+			e.recordExecution(ctx, rule, event, domain.AutomationExecutionSuccess, "")
+		}
+
+		firedRules := make(map[uuid.UUID]bool, len(event.firedRules)+1)
+		for id := range event.firedRules {
+			firedRules[id] = true
+		}
+		firedRules[rule.ID] = true
+
+		for _, next := range followUps {
+			next.Depth = event.Depth + 1
+			next.firedRules = firedRules
+			e.Process(ctx, next)
+		}
+	}
+}
+
+// automationTriggerMatches checks the FIELD_CHANGED field/value filter of a rule
+func automationTriggerMatches(rule *domain.AutomationRule, event AutomationEvent) bool {
+	if rule.Trigger != event.Trigger {
+		return false
+	}
+	if event.Trigger != domain.AutomationTriggerFieldChanged {
+		return true
+	}
+	if rule.TriggerField == "" {
+		return len(event.ChangedFields) > 0
+	}
+	newValue, changed := event.ChangedFields[rule.TriggerField]
+	if !changed {
+		return false
+	}
+	return rule.TriggerValue == "" || rule.TriggerValue == newValue
+}
+
+// conditionsMet checks that the board satisfies every condition of a rule
+func (e *automationEngineImpl) conditionsMet(ctx context.Context, board *domain.Board, conditions []domain.AutomationCondition) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+
+	values := make(map[string]interface{})
+	if len(board.CustomFields) > 0 {
+		var idFields map[string]interface{}
+		if err := json.Unmarshal(board.CustomFields, &idFields); err == nil {
+			if converted, err := e.fieldOptionConverter.ConvertIDsToValues(ctx, idFields); err == nil {
+				values = converted
+			}
+		}
+	}
+
+	for _, c := range conditions {
+		var actual string
+		if c.Field == WorkflowFieldAssignee {
+			actual = formatUUIDPtr(board.AssigneeID)
+		} else {
+			actual = formatInterface(values[c.Field])
+		}
+
+		switch c.Operator {
+		case domain.AutomationOperatorEquals:
+			if actual != c.Value {
+				return false
+			}
+		case domain.AutomationOperatorNotEquals:
+			if actual == c.Value {
+				return false
+			}
+		case domain.AutomationOperatorIsEmpty:
+			if actual != "" {
+				return false
+			}
+		case domain.AutomationOperatorIsNotEmpty:
+			if actual == "" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// executeActions applies the actions of a rule in order and returns the follow-up events they raise
+// Execution stops at the first failing action
+func (e *automationEngineImpl) executeActions(ctx context.Context, rule *domain.AutomationRule, board *domain.Board) ([]AutomationEvent, error) {
+	changedFields := make(map[string]string)
+	var followUps []AutomationEvent
+
+	for _, action := range decodeAutomationActions(rule.Actions) {
+		switch action.Type {
+		case domain.AutomationActionSetField:
+			changed, err := e.setField(ctx, rule, board, action.Field, action.Value)
+			if err != nil {
+				return followUps, err
+			}
+			if changed {
+				changedFields[action.Field] = action.Value
+			}
+		case domain.AutomationActionAssign:
+			if uuidPtrEqual(board.AssigneeID, action.UserID) {
+				continue
+			}
+			// uuid.Nil clears the assignee
+			assigneeID := uuid.Nil
+			if action.UserID != nil {
+				assigneeID = *action.UserID
+			}
+			if err := e.updateBoard(ctx, rule, board, &dto.UpdateBoardRequest{AssigneeID: &assigneeID}); err != nil {
+				return followUps, fmt.Errorf("failed to assign board: %w", err)
+			}
+			changedFields[WorkflowFieldAssignee] = formatUUIDPtr(board.AssigneeID)
+		case domain.AutomationActionAddParticipants:
+			added, err := e.addParticipants(ctx, board.ID, action.UserIDs)
+			if err != nil {
+				return followUps, err
+			}
+			if len(added) > 0 {
+				e.notify(ctx, rule, board, added, client.NotificationTypeBoardParticipantAdded, "")
+			}
+		case domain.AutomationActionPostComment:
+			comment := &domain.Comment{
+				BoardID: board.ID,
+				UserID:  rule.CreatedBy,
+				Content: action.Message,
+			}
+			if err := e.commentRepo.Create(ctx, comment); err != nil {
+				return followUps, fmt.Errorf("failed to post comment: %w", err)
+			}
+			followUps = append(followUps, AutomationEvent{
+				Trigger:   domain.AutomationTriggerCommentAdded,
+				ProjectID: board.ProjectID,
+				BoardID:   board.ID,
+				ActorID:   rule.CreatedBy,
+			})
+		case domain.AutomationActionNotify:
+			targets := automationNotifyTargets(board, action)
+			if len(targets) > 0 {
+				e.notify(ctx, rule, board, targets, client.NotificationTypeBoardUpdated, action.Message)
+			}
+		default:
+			return followUps, fmt.Errorf("unsupported action type: %s", action.Type)
+		}
+	}
+
+	if len(changedFields) > 0 {
+		followUps = append(followUps, AutomationEvent{
+			Trigger:       domain.AutomationTriggerFieldChanged,
+			ProjectID:     board.ProjectID,
+			BoardID:       board.ID,
+			ActorID:       rule.CreatedBy,
+			ChangedFields: changedFields,
+		})
+	}
+	return followUps, nil
+}
+
+// setField sets a custom field of the board to an option value
+// Workflow transition rules are not enforced for automation, which acts on behalf of an admin
+func (e *automationEngineImpl) setField(ctx context.Context, rule *domain.AutomationRule, board *domain.Board, field, value string) (bool, error) {
+	// The update replaces every custom field, so start from the current values
+	values := make(map[string]interface{})
+	if len(board.CustomFields) > 0 {
+		var idFields map[string]interface{}
+		if err := json.Unmarshal(board.CustomFields, &idFields); err == nil {
+			converted, err := e.fieldOptionConverter.ConvertIDsToValues(ctx, idFields)
+			if err != nil {
+				return false, fmt.Errorf("failed to read custom fields: %w", err)
+			}
+			values = converted
+		}
+	}
+	if formatInterface(values[field]) == value {
+		return false, nil
+	}
+	values[field] = value
+
+	if err := e.updateBoard(ctx, rule, board, &dto.UpdateBoardRequest{CustomFields: &values}); err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", field, err)
+	}
+	return true, nil
+}
+
+// updateBoard applies a change through the board service on behalf of the rule creator
+// and reloads the board so later actions see the change
+func (e *automationEngineImpl) updateBoard(ctx context.Context, rule *domain.AutomationRule, board *domain.Board, req *dto.UpdateBoardRequest) error {
+	if e.boards == nil {
+		return errors.New("automation board updater is not configured")
+	}
+	ctx = context.WithValue(ctx, "user_id", rule.CreatedBy)
+	if _, err := e.boards.applyAutomationUpdate(ctx, board.ID, req); err != nil {
+		return err
+	}
+	updated, err := e.boardRepo.FindByID(ctx, board.ID)
+	if err != nil {
+		return fmt.Errorf("failed to reload board: %w", err)
+	}
+	*board = *updated
+	return nil
+}
+
+// addParticipants adds the users that are not yet participants and returns the ones added
+func (e *automationEngineImpl) addParticipants(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	added := make([]uuid.UUID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		existing, err := e.participantRepo.FindByBoardAndUser(ctx, boardID, userID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return added, fmt.Errorf("failed to check participant: %w", err)
+		}
+		if existing != nil {
+			continue
+		}
+		if err := e.participantRepo.Create(ctx, &domain.Participant{BoardID: boardID, UserID: userID}); err != nil {
+			if isUniqueViolation(err) {
+				continue
+			}
+			return added, fmt.Errorf("failed to add participant: %w", err)
+		}
+		added = append(added, userID)
+	}
+	return added, nil
+}
+
+// automationNotifyTargets resolves the recipients of a notify action
+func automationNotifyTargets(board *domain.Board, action domain.AutomationAction) []uuid.UUID {
+	targets := append([]uuid.UUID{}, action.UserIDs...)
+	switch action.Target {
+	case domain.AutomationTargetAssignee:
+		if board.AssigneeID != nil {
+			targets = append(targets, *board.AssigneeID)
+		}
+	case domain.AutomationTargetParticipants:
+		for _, p := range board.Participants {
+			targets = append(targets, p.UserID)
+		}
+	case domain.AutomationTargetAuthor:
+		targets = append(targets, board.AuthorID)
+	}
+	return removeDuplicateUUIDs(targets)
+}
+
+// notify sends a notification on behalf of the rule creator to every target that has not muted the board
+// Notification failures are logged and do not fail the rule
+func (e *automationEngineImpl) notify(ctx context.Context, rule *domain.AutomationRule, board *domain.Board, targets []uuid.UUID, notificationType client.NotificationType, message string) {
+	if e.notiClient == nil {
+		return
+	}
+	targets = unmutedRecipients(ctx, e.watchers, board.ID, targets)
+	if len(targets) == 0 {
+		return
+	}
+
+	project, err := e.projectRepo.FindByID(ctx, board.ProjectID)
+	if err != nil {
+		e.logger.Warn("Failed to get project for automation notification",
+			zap.String("board.id", board.ID.String()),
+			zap.Error(err))
+		return
+	}
+
+	events := make([]*client.NotificationEvent, 0, len(targets))
+	for _, targetID := range targets {
+		metadata := map[string]interface{}{
+			"projectId":      board.ProjectID.String(),
+			"projectName":    project.Name,
+			"boardKey":       domain.FormatBoardKey(project.KeyPrefix, board.Number),
+			"automationRule": rule.Name,
+		}
+		if message != "" {
+			metadata["message"] = message
+		}
+		events = append(events, &client.NotificationEvent{
+			Type:         notificationType,
+			ActorID:      rule.CreatedBy,
+			TargetUserID: targetID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata:     metadata,
+		})
+	}
+
+	// Rules run after the triggering change has committed, so the notifications are recorded
+	// on their own rather than in a transaction with the rule's actions
+	if e.outbox != nil {
+		if err := e.outbox.AddNotifications(ctx, domain.OutboxAggregateBoard, board.ID, events); err != nil {
+			e.logger.Warn("Failed to record automation notifications",
+				zap.String("rule.id", rule.ID.String()),
+				zap.String("board.id", board.ID.String()),
+				zap.Error(err))
+			return
+		}
+		e.outbox.Kick()
+		return
+	}
+
+	for _, event := range events {
+		if err := e.notiClient.SendNotification(ctx, event); err != nil {
+			e.logger.Warn("Failed to send automation notification",
+				zap.String("rule.id", rule.ID.String()),
+				zap.String("board.id", board.ID.String()),
+				zap.String("target.user.id", event.TargetUserID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// recordExecution writes an execution log entry
+func (e *automationEngineImpl) recordExecution(ctx context.Context, rule *domain.AutomationRule, event AutomationEvent, status domain.AutomationExecutionStatus, message string) {
+	execution := &domain.AutomationExecution{
+		RuleID:    rule.ID,
+		ProjectID: rule.ProjectID,
+		BoardID:   event.BoardID,
+		Trigger:   event.Trigger,
+		Status:    status,
+		Depth:     event.Depth,
+		Message:   message,
+	}
+	if err := e.automationRepo.CreateExecution(ctx, execution); err != nil {
+		e.logger.Warn("Failed to record automation execution",
+			zap.String("rule.id", rule.ID.String()),
+			zap.String("board.id", event.BoardID.String()),
+			zap.Error(err))
+	}
+}
+
+// uuidPtrEqual compares two optional UUIDs
+func uuidPtrEqual(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// Execution log page size limits
+const (
+	defaultAutomationExecutionLimit = 50
+	maxAutomationExecutionLimit     = 200
+)
+
+// Board fields that can be used as a FIELD_CHANGED trigger (besides custom field keys)
+const (
+	AutomationFieldTitle = "title"
+)
+
+// AutomationService defines the interface for automation rule management
+type AutomationService interface {
+	GetRules(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.AutomationRuleResponse, error)
+	CreateRule(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateAutomationRuleRequest) (*dto.AutomationRuleResponse, error)
+	UpdateRule(ctx context.Context, projectID, requesterID, ruleID uuid.UUID, req *dto.UpdateAutomationRuleRequest) (*dto.AutomationRuleResponse, error)
+	DeleteRule(ctx context.Context, projectID, requesterID, ruleID uuid.UUID) error
+	GetExecutions(ctx context.Context, projectID, requesterID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*dto.AutomationExecutionResponse, error)
+}
+
+// automationServiceImpl is the implementation of AutomationService
+type automationServiceImpl struct {
+	automationRepo  repository.AutomationRepository
+	projectRepo     repository.ProjectRepository
+	fieldOptionRepo repository.FieldOptionRepository
+}
+
+// NewAutomationService creates a new instance of AutomationService
+func NewAutomationService(automationRepo repository.AutomationRepository, projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository) AutomationService {
+	return &automationServiceImpl{
+		automationRepo:  automationRepo,
+		projectRepo:     projectRepo,
+		fieldOptionRepo: fieldOptionRepo,
+	}
+}
+
+// GetRules retrieves all automation rules of a project (any project member)
+func (s *automationServiceImpl) GetRules(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.AutomationRuleResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.automationRepo.FindRulesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch automation rules", err.Error())
+	}
+
+	responses := make([]*dto.AutomationRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = toAutomationRuleResponse(rule)
+	}
+	return responses, nil
+}
+
+// CreateRule creates a new automation rule (requires automation.manage)
+func (s *automationServiceImpl) CreateRule(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateAutomationRuleRequest) (*dto.AutomationRuleResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	trigger := domain.AutomationTrigger(req.Trigger)
+	if !isValidAutomationTrigger(trigger) {
+		return nil, response.NewValidationError(fmt.Sprintf("Invalid trigger: %s", req.Trigger), "")
+	}
+	if err := validateAutomationTriggerField(trigger, req.TriggerField, req.TriggerValue); err != nil {
+		return nil, err
+	}
+	conditions, err := toAutomationConditions(req.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := s.toAutomationActions(ctx, projectID, req.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	rule := &domain.AutomationRule{
+		ProjectID:    projectID,
+		Name:         req.Name,
+		Enabled:      enabled,
+		Trigger:      trigger,
+		TriggerField: req.TriggerField,
+		TriggerValue: req.TriggerValue,
+		Conditions:   encodeAutomationConditions(conditions),
+		Actions:      encodeAutomationActions(actions),
+		CreatedBy:    requesterID,
+	}
+
+	if err := s.automationRepo.CreateRule(ctx, rule); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create automation rule", err.Error())
+	}
+
+	return toAutomationRuleResponse(rule), nil
+}
+
+// UpdateRule updates an automation rule (requires automation.manage)
+func (s *automationServiceImpl) UpdateRule(ctx context.Context, projectID, requesterID, ruleID uuid.UUID, req *dto.UpdateAutomationRuleRequest) (*dto.AutomationRuleResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.findRule(ctx, projectID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	triggerField, triggerValue := rule.TriggerField, rule.TriggerValue
+	if req.TriggerField != nil {
+		triggerField = *req.TriggerField
+	}
+	if req.TriggerValue != nil {
+		triggerValue = *req.TriggerValue
+	}
+	if err := validateAutomationTriggerField(rule.Trigger, triggerField, triggerValue); err != nil {
+		return nil, err
+	}
+	rule.TriggerField, rule.TriggerValue = triggerField, triggerValue
+
+	if req.Conditions != nil {
+		conditions, err := toAutomationConditions(*req.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		rule.Conditions = encodeAutomationConditions(conditions)
+	}
+	if req.Actions != nil {
+		actions, err := s.toAutomationActions(ctx, projectID, *req.Actions)
+		if err != nil {
+			return nil, err
+		}
+		rule.Actions = encodeAutomationActions(actions)
+	}
+
+	if err := s.automationRepo.UpdateRule(ctx, rule); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update automation rule", err.Error())
+	}
+
+	return toAutomationRuleResponse(rule), nil
+}
+
+// DeleteRule deletes an automation rule and its execution log (requires automation.manage)
+func (s *automationServiceImpl) DeleteRule(ctx context.Context, projectID, requesterID, ruleID uuid.UUID) error {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	if _, err := s.findRule(ctx, projectID, ruleID); err != nil {
+		return err
+	}
+
+	if err := s.automationRepo.DeleteRule(ctx, ruleID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete automation rule", err.Error())
+	}
+	return nil
+}
+
+// GetExecutions retrieves the most recent execution log entries of a project (any project member)
+func (s *automationServiceImpl) GetExecutions(ctx context.Context, projectID, requesterID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*dto.AutomationExecutionResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultAutomationExecutionLimit
+	}
+	if limit > maxAutomationExecutionLimit {
+		limit = maxAutomationExecutionLimit
+	}
+
+	executions, err := s.automationRepo.FindExecutions(ctx, projectID, ruleID, limit)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch automation executions", err.Error())
+	}
+
+	responses := make([]*dto.AutomationExecutionResponse, len(executions))
+	for i, e := range executions {
+		responses[i] = &dto.AutomationExecutionResponse{
+			ExecutionID: e.ID,
+			RuleID:      e.RuleID,
+			BoardID:     e.BoardID,
+			Trigger:     string(e.Trigger),
+			Status:      string(e.Status),
+			Depth:       e.Depth,
+			Message:     e.Message,
+			ExecutedAt:  e.CreatedAt,
+		}
+	}
+	return responses, nil
+}
+
+// checkManage verifies that the requester holds the automation.manage permission
+func (s *automationServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionAutomationManage, "You do not have permission to manage automation rules")
+}
+
+// findRule fetches a rule and ensures it belongs to the project
+func (s *automationServiceImpl) findRule(ctx context.Context, projectID, ruleID uuid.UUID) (*domain.AutomationRule, error) {
+	rule, err := s.automationRepo.FindRuleByID(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Automation rule not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch automation rule", err.Error())
+	}
+	if rule.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Automation rule not found", "")
+	}
+	return rule, nil
+}
+
+// toAutomationActions validates action requests and converts them to domain actions
+func (s *automationServiceImpl) toAutomationActions(ctx context.Context, projectID uuid.UUID, reqs []dto.AutomationActionRequest) ([]domain.AutomationAction, error) {
+	if len(reqs) == 0 {
+		return nil, response.NewValidationError("At least one action is required", "")
+	}
+
+	actions := make([]domain.AutomationAction, 0, len(reqs))
+	for _, r := range reqs {
+		action := domain.AutomationAction{Type: domain.AutomationActionType(r.Type)}
+		switch action.Type {
+		case domain.AutomationActionSetField:
+			if !isValidFieldType(domain.FieldType(r.Field)) {
+				return nil, response.NewValidationError(fmt.Sprintf("Invalid field for set_field: %s", r.Field), "")
+			}
+			option, err := s.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(ctx, projectID, domain.FieldType(r.Field), r.Value)
+			if err != nil {
+				return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify field value", err.Error())
+			}
+			if option == nil {
+				return nil, response.NewValidationError(fmt.Sprintf("Invalid value for %s: %s", r.Field, r.Value), "")
+			}
+			action.Field, action.Value = r.Field, r.Value
+		case domain.AutomationActionAssign:
+			if r.UserID != nil && *r.UserID != uuid.Nil {
+				id := *r.UserID
+				action.UserID = &id
+			}
+		case domain.AutomationActionAddParticipants:
+			action.UserIDs = removeDuplicateUUIDs(filterValidUUIDs(r.UserIDs))
+			if len(action.UserIDs) == 0 {
+				return nil, response.NewValidationError("add_participants requires userIds", "")
+			}
+		case domain.AutomationActionPostComment:
+			if r.Message == "" {
+				return nil, response.NewValidationError("post_comment requires a message", "")
+			}
+			action.Message = r.Message
+		case domain.AutomationActionNotify:
+			if r.Message == "" {
+				return nil, response.NewValidationError("notify requires a message", "")
+			}
+			action.UserIDs = removeDuplicateUUIDs(filterValidUUIDs(r.UserIDs))
+			switch r.Target {
+			case "":
+				if len(action.UserIDs) == 0 {
+					return nil, response.NewValidationError("notify requires a target or userIds", "")
+				}
+			case domain.AutomationTargetAssignee, domain.AutomationTargetParticipants, domain.AutomationTargetAuthor:
+				action.Target = r.Target
+			default:
+				return nil, response.NewValidationError(fmt.Sprintf("Invalid notify target: %s", r.Target), "")
+			}
+			action.Message = r.Message
+		default:
+			return nil, response.NewValidationError(fmt.Sprintf("Invalid action type: %s", r.Type), "")
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// toAutomationConditions validates condition requests and converts them to domain conditions
+func toAutomationConditions(reqs []dto.AutomationConditionRequest) ([]domain.AutomationCondition, error) {
+	conditions := make([]domain.AutomationCondition, 0, len(reqs))
+	for _, r := range reqs {
+		if r.Field != WorkflowFieldAssignee && !isValidFieldType(domain.FieldType(r.Field)) {
+			return nil, response.NewValidationError(fmt.Sprintf("Invalid condition field: %s", r.Field), "")
+		}
+		operator := domain.AutomationConditionOperator(r.Operator)
+		switch operator {
+		case domain.AutomationOperatorEquals, domain.AutomationOperatorNotEquals:
+			if r.Value == "" {
+				return nil, response.NewValidationError(fmt.Sprintf("Operator %s requires a value", r.Operator), "")
+			}
+		case domain.AutomationOperatorIsEmpty, domain.AutomationOperatorIsNotEmpty:
+		default:
+			return nil, response.NewValidationError(fmt.Sprintf("Invalid condition operator: %s", r.Operator), "")
+		}
+		conditions = append(conditions, domain.AutomationCondition{Field: r.Field, Operator: operator, Value: r.Value})
+	}
+	return conditions, nil
+}
+
+// isValidAutomationTrigger checks if the trigger is supported
+func isValidAutomationTrigger(trigger domain.AutomationTrigger) bool {
+	switch trigger {
+	case domain.AutomationTriggerBoardCreated, domain.AutomationTriggerFieldChanged,
+		domain.AutomationTriggerDueDateReached, domain.AutomationTriggerCommentAdded:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateAutomationTriggerField checks the FIELD_CHANGED filter (only allowed for that trigger)
+func validateAutomationTriggerField(trigger domain.AutomationTrigger, field, value string) error {
+	if trigger != domain.AutomationTriggerFieldChanged {
+		if field != "" || value != "" {
+			return response.NewValidationError("triggerField and triggerValue are only allowed for FIELD_CHANGED", "")
+		}
+		return nil
+	}
+	if field == "" {
+		if value != "" {
+			return response.NewValidationError("triggerValue requires triggerField", "")
+		}
+		return nil
+	}
+	switch field {
+	case AutomationFieldTitle, WorkflowFieldContent, WorkflowFieldAssignee, WorkflowFieldStartDate, WorkflowFieldDueDate:
+		return nil
+	}
+	if !isValidFieldType(domain.FieldType(field)) {
+		return response.NewValidationError(fmt.Sprintf("Invalid trigger field: %s", field), "")
+	}
+	return nil
+}
+
+// encodeAutomationConditions converts conditions to datatypes.JSON (nil when empty)
+func encodeAutomationConditions(conditions []domain.AutomationCondition) datatypes.JSON {
+	if len(conditions) == 0 {
+		return nil
+	}
+	jsonBytes, _ := json.Marshal(conditions)
+	return jsonBytes
+}
+
+// encodeAutomationActions converts actions to datatypes.JSON
+func encodeAutomationActions(actions []domain.AutomationAction) datatypes.JSON {
+	jsonBytes, _ := json.Marshal(actions)
+	return jsonBytes
+}
+
+// decodeAutomationConditions converts datatypes.JSON to conditions (empty slice on invalid data)
+func decodeAutomationConditions(data datatypes.JSON) []domain.AutomationCondition {
+	conditions := make([]domain.AutomationCondition, 0)
+	if len(data) == 0 {
+		return conditions
+	}
+	_ = json.Unmarshal(data, &conditions)
+	return conditions
+}
+
+// decodeAutomationActions converts datatypes.JSON to actions (empty slice on invalid data)
+func decodeAutomationActions(data datatypes.JSON) []domain.AutomationAction {
+	actions := make([]domain.AutomationAction, 0)
+	if len(data) == 0 {
+		return actions
+	}
+	_ = json.Unmarshal(data, &actions)
+	return actions
+}
+
+// toAutomationRuleResponse converts domain.AutomationRule to dto.AutomationRuleResponse
+func toAutomationRuleResponse(rule *domain.AutomationRule) *dto.AutomationRuleResponse {
+	conditions := decodeAutomationConditions(rule.Conditions)
+	conditionResponses := make([]dto.AutomationConditionRequest, len(conditions))
+	for i, c := range conditions {
+		conditionResponses[i] = dto.AutomationConditionRequest{Field: c.Field, Operator: string(c.Operator), Value: c.Value}
+	}
+
+	actions := decodeAutomationActions(rule.Actions)
+	actionResponses := make([]dto.AutomationActionRequest, len(actions))
+	for i, a := range actions {
+		actionResponses[i] = dto.AutomationActionRequest{
+			Type:    string(a.Type),
+			Field:   a.Field,
+			Value:   a.Value,
+			UserID:  a.UserID,
+			UserIDs: a.UserIDs,
+			Target:  a.Target,
+			Message: a.Message,
+		}
+	}
+
+	return &dto.AutomationRuleResponse{
+		RuleID:       rule.ID,
+		ProjectID:    rule.ProjectID,
+		Name:         rule.Name,
+		Enabled:      rule.Enabled,
+		Trigger:      string(rule.Trigger),
+		TriggerField: rule.TriggerField,
+		TriggerValue: rule.TriggerValue,
+		Conditions:   conditionResponses,
+		Actions:      actionResponses,
+		CreatedBy:    rule.CreatedBy,
+		CreatedAt:    rule.CreatedAt,
+		UpdatedAt:    rule.UpdatedAt,
+	}
+}
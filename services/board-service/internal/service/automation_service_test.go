@@ -0,0 +1,494 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestAutomationService_CreateRule(t *testing.T) {
+	projectID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+	reviewerID := uuid.New()
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		req         *dto.CreateAutomationRuleRequest
+		wantErrCode string
+	}{
+		{
+			name:        "성공: review 단계 진입 시 리뷰어 지정",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:         "리뷰어 지정",
+				Trigger:      "FIELD_CHANGED",
+				TriggerField: "stage",
+				TriggerValue: "review",
+				Actions:      []dto.AutomationActionRequest{{Type: "assign", UserID: &reviewerID}},
+			},
+		},
+		{
+			name:        "성공: 조건과 여러 액션",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:       "새 보드 안내",
+				Trigger:    "BOARD_CREATED",
+				Conditions: []dto.AutomationConditionRequest{{Field: "importance", Operator: "equals", Value: "urgent"}},
+				Actions: []dto.AutomationActionRequest{
+					{Type: "add_participants", UserIDs: []uuid.UUID{reviewerID}},
+					{Type: "notify", Target: "assignee", Message: "긴급 보드가 생성되었습니다"},
+				},
+			},
+		},
+		{
+			name:        "실패: MEMBER는 규칙을 만들 수 없음",
+			requesterID: memberID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:    "규칙",
+				Trigger: "COMMENT_ADDED",
+				Actions: []dto.AutomationActionRequest{{Type: "post_comment", Message: "확인했습니다"}},
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 알 수 없는 트리거",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:    "규칙",
+				Trigger: "BOARD_DELETED",
+				Actions: []dto.AutomationActionRequest{{Type: "assign"}},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: FIELD_CHANGED가 아닌 트리거에 triggerField 지정",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:         "규칙",
+				Trigger:      "BOARD_CREATED",
+				TriggerField: "stage",
+				Actions:      []dto.AutomationActionRequest{{Type: "assign"}},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 존재하지 않는 필드 값으로 set_field",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:    "규칙",
+				Trigger: "DUE_DATE_REACHED",
+				Actions: []dto.AutomationActionRequest{{Type: "set_field", Field: "stage", Value: "unknown"}},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 대상 없는 notify",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:    "규칙",
+				Trigger: "DUE_DATE_REACHED",
+				Actions: []dto.AutomationActionRequest{{Type: "notify", Message: "마감일입니다"}},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 잘못된 조건 연산자",
+			requesterID: adminID,
+			req: &dto.CreateAutomationRuleRequest{
+				Name:       "규칙",
+				Trigger:    "COMMENT_ADDED",
+				Conditions: []dto.AutomationConditionRequest{{Field: "assignee", Operator: "contains", Value: "x"}},
+				Actions:    []dto.AutomationActionRequest{{Type: "post_comment", Message: "확인했습니다"}},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == adminID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+				},
+			}
+			mockFieldOptionRepo := &MockFieldOptionRepository{
+				FindByProjectAndFieldTypeAndValueFunc: func(ctx context.Context, pID uuid.UUID, fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
+					if value == "unknown" {
+						return nil, nil
+					}
+					return &domain.FieldOption{FieldType: fieldType, Value: value}, nil
+				},
+			}
+			var created *domain.AutomationRule
+			mockAutomationRepo := &MockAutomationRepository{
+				CreateRuleFunc: func(ctx context.Context, rule *domain.AutomationRule) error {
+					rule.ID = uuid.New()
+					created = rule
+					return nil
+				},
+			}
+			service := NewAutomationService(mockAutomationRepo, mockProjectRepo, mockFieldOptionRepo)
+
+			// When
+			got, err := service.CreateRule(context.Background(), projectID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("CreateRule() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("CreateRule() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateRule() unexpected error = %v", err)
+			}
+			if created == nil || got.RuleID != created.ID {
+				t.Fatal("CreateRule() did not persist the rule")
+			}
+			if !got.Enabled {
+				t.Error("CreateRule() rule should be enabled by default")
+			}
+			if created.CreatedBy != tt.requesterID {
+				t.Errorf("CreateRule() CreatedBy = %v, want %v", created.CreatedBy, tt.requesterID)
+			}
+			if len(got.Actions) != len(tt.req.Actions) || len(got.Conditions) != len(tt.req.Conditions) {
+				t.Errorf("CreateRule() actions/conditions = %d/%d, want %d/%d", len(got.Actions), len(got.Conditions), len(tt.req.Actions), len(tt.req.Conditions))
+			}
+		})
+	}
+}
+
+func automationTestRule(projectID uuid.UUID, trigger domain.AutomationTrigger, triggerField, triggerValue string, conditions []domain.AutomationCondition, actions ...domain.AutomationAction) *domain.AutomationRule {
+	return &domain.AutomationRule{
+		BaseModel:    domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now()},
+		ProjectID:    projectID,
+		Name:         "test rule",
+		Enabled:      true,
+		Trigger:      trigger,
+		TriggerField: triggerField,
+		TriggerValue: triggerValue,
+		Conditions:   encodeAutomationConditions(conditions),
+		Actions:      encodeAutomationActions(actions),
+		CreatedBy:    uuid.New(),
+	}
+}
+
+func TestAutomationEngine_Process(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+
+	t.Run("성공: review 단계 진입 시 리뷰어를 담당자로 지정", func(t *testing.T) {
+		// Given
+		env := &automationTestEnv{
+			board: domain.Board{
+				BaseModel:    domain.BaseModel{ID: boardID},
+				ProjectID:    projectID,
+				AuthorID:     authorID,
+				AssigneeID:   &authorID,
+				CustomFields: testCustomFields(map[string]interface{}{"stage": "review"}),
+			},
+		}
+		env.rules = []*domain.AutomationRule{
+			automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "review", nil,
+				domain.AutomationAction{Type: domain.AutomationActionAssign, UserID: &reviewerID},
+				domain.AutomationAction{Type: domain.AutomationActionPostComment, Message: "리뷰를 요청합니다"}),
+		}
+
+		// When
+		env.engine().Process(context.Background(), AutomationEvent{
+			Trigger:       domain.AutomationTriggerFieldChanged,
+			ProjectID:     projectID,
+			BoardID:       boardID,
+			ChangedFields: map[string]string{"stage": "review"},
+		})
+
+		// Then
+		if env.board.AssigneeID == nil || *env.board.AssigneeID != reviewerID {
+			t.Errorf("AssigneeID = %v, want %v", env.board.AssigneeID, reviewerID)
+		}
+		if len(env.comments) != 1 || env.comments[0].UserID != env.rules[0].CreatedBy {
+			t.Errorf("comments = %v, want one comment by the rule creator", env.comments)
+		}
+		if len(env.executions) != 1 || env.executions[0].Status != domain.AutomationExecutionSuccess {
+			t.Fatalf("executions = %v, want one SUCCESS", env.executions)
+		}
+	})
+
+	t.Run("성공: done 단계에서 담당자 해제 (조건 충족 시에만)", func(t *testing.T) {
+		// Given
+		env := &automationTestEnv{
+			board: domain.Board{
+				BaseModel:    domain.BaseModel{ID: boardID},
+				ProjectID:    projectID,
+				AuthorID:     authorID,
+				AssigneeID:   &reviewerID,
+				CustomFields: testCustomFields(map[string]interface{}{"stage": "done", "importance": "low"}),
+			},
+		}
+		clearRule := automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "done",
+			[]domain.AutomationCondition{{Field: "importance", Operator: domain.AutomationOperatorNotEquals, Value: "urgent"}},
+			domain.AutomationAction{Type: domain.AutomationActionAssign})
+		urgentRule := automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "done",
+			[]domain.AutomationCondition{{Field: "importance", Operator: domain.AutomationOperatorEquals, Value: "urgent"}},
+			domain.AutomationAction{Type: domain.AutomationActionPostComment, Message: "긴급 보드 완료"})
+		env.rules = []*domain.AutomationRule{clearRule, urgentRule}
+
+		// When
+		env.engine().Process(context.Background(), AutomationEvent{
+			Trigger:       domain.AutomationTriggerFieldChanged,
+			ProjectID:     projectID,
+			BoardID:       boardID,
+			ChangedFields: map[string]string{"stage": "done"},
+		})
+
+		// Then
+		if env.board.AssigneeID != nil {
+			t.Errorf("AssigneeID = %v, want nil", env.board.AssigneeID)
+		}
+		if len(env.comments) != 0 {
+			t.Errorf("comments = %d, want 0 (condition not met)", len(env.comments))
+		}
+		if len(env.executions) != 1 || env.executions[0].RuleID != clearRule.ID {
+			t.Errorf("executions = %v, want only the clear rule", env.executions)
+		}
+	})
+
+	t.Run("성공: 다른 필드 변경에는 반응하지 않음", func(t *testing.T) {
+		// Given
+		env := &automationTestEnv{
+			board: domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, AuthorID: authorID},
+		}
+		env.rules = []*domain.AutomationRule{
+			automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "review", nil,
+				domain.AutomationAction{Type: domain.AutomationActionAssign, UserID: &reviewerID}),
+		}
+
+		// When
+		env.engine().Process(context.Background(), AutomationEvent{
+			Trigger:       domain.AutomationTriggerFieldChanged,
+			ProjectID:     projectID,
+			BoardID:       boardID,
+			ChangedFields: map[string]string{"importance": "high"},
+		})
+
+		// Then
+		if env.board.AssigneeID != nil || len(env.executions) != 0 {
+			t.Errorf("rule should not fire: assignee = %v, executions = %d", env.board.AssigneeID, len(env.executions))
+		}
+	})
+
+	t.Run("성공: DUE_DATE_REACHED는 점유한 규칙만 실행", func(t *testing.T) {
+		// Given: two due date rules, the job claimed only one of them for this board
+		env := &automationTestEnv{
+			board: domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID, AuthorID: authorID},
+		}
+		claimed := automationTestRule(projectID, domain.AutomationTriggerDueDateReached, "", "", nil,
+			domain.AutomationAction{Type: domain.AutomationActionPostComment, Message: "기한이 지났습니다"})
+		other := automationTestRule(projectID, domain.AutomationTriggerDueDateReached, "", "", nil,
+			domain.AutomationAction{Type: domain.AutomationActionPostComment, Message: "다른 복제본이 실행"})
+		env.rules = []*domain.AutomationRule{claimed, other}
+
+		// When
+		env.engine().Process(context.Background(), AutomationEvent{
+			Trigger:   domain.AutomationTriggerDueDateReached,
+			ProjectID: projectID,
+			BoardID:   boardID,
+			ActorID:   authorID,
+			RuleID:    claimed.ID,
+		})
+
+		// Then
+		if len(env.executions) != 1 || env.executions[0].RuleID != claimed.ID {
+			t.Errorf("executions = %v, want only the claimed rule", env.executions)
+		}
+	})
+}
+
+func TestAutomationEngine_SkipsArchivedProject(t *testing.T) {
+	// Given: a matching rule in an archived project
+	projectID := uuid.New()
+	boardID := uuid.New()
+	archivedAt := time.Now()
+	env := &automationTestEnv{
+		board:   domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID},
+		project: &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, ArchivedAt: &archivedAt},
+	}
+	env.rules = []*domain.AutomationRule{
+		automationTestRule(projectID, domain.AutomationTriggerCommentAdded, "", "", nil,
+			domain.AutomationAction{Type: domain.AutomationActionPostComment, Message: "보관된 프로젝트"}),
+	}
+
+	// When
+	env.engine().Process(context.Background(), AutomationEvent{
+		Trigger:   domain.AutomationTriggerCommentAdded,
+		ProjectID: projectID,
+		BoardID:   boardID,
+	})
+
+	// Then
+	if len(env.comments) != 0 || len(env.executions) != 0 {
+		t.Errorf("rule should not run: comments = %d, executions = %d", len(env.comments), len(env.executions))
+	}
+}
+
+func TestAutomationEngine_LoopProtection(t *testing.T) {
+	// Given: two rules that keep moving the board between review and in_progress
+	projectID := uuid.New()
+	boardID := uuid.New()
+	env := &automationTestEnv{
+		board: domain.Board{
+			BaseModel:    domain.BaseModel{ID: boardID},
+			ProjectID:    projectID,
+			CustomFields: testCustomFields(map[string]interface{}{"stage": "review"}),
+		},
+	}
+	toProgress := automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "review", nil,
+		domain.AutomationAction{Type: domain.AutomationActionSetField, Field: "stage", Value: "in_progress"})
+	toReview := automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "in_progress", nil,
+		domain.AutomationAction{Type: domain.AutomationActionSetField, Field: "stage", Value: "review"})
+	env.rules = []*domain.AutomationRule{toProgress, toReview}
+
+	// When
+	env.engine().Process(context.Background(), AutomationEvent{
+		Trigger:       domain.AutomationTriggerFieldChanged,
+		ProjectID:     projectID,
+		BoardID:       boardID,
+		ChangedFields: map[string]string{"stage": "review"},
+	})
+
+	// Then: each rule runs once, then the chain is cut off
+	statuses := make([]domain.AutomationExecutionStatus, len(env.executions))
+	for i, e := range env.executions {
+		statuses[i] = e.Status
+	}
+	want := []domain.AutomationExecutionStatus{domain.AutomationExecutionSuccess, domain.AutomationExecutionSuccess, domain.AutomationExecutionSkipped}
+	if len(statuses) != len(want) {
+		t.Fatalf("execution statuses = %v, want %v", statuses, want)
+	}
+	for i := range want {
+		if statuses[i] != want[i] {
+			t.Errorf("execution statuses = %v, want %v", statuses, want)
+			break
+		}
+	}
+	if env.executions[2].Depth != 2 {
+		t.Errorf("skipped execution depth = %d, want 2", env.executions[2].Depth)
+	}
+}
+
+func TestAutomationEngine_SetFieldRecordsBoardUpdate(t *testing.T) {
+	// Given: a rule that approves boards entering review
+	projectID := uuid.New()
+	boardID := uuid.New()
+	store := newOutboxTestStore()
+	broadcaster := &recordingBroadcaster{}
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(store, &recordingNotiClient{}, broadcaster, &now)
+	env := &automationTestEnv{
+		board: domain.Board{
+			BaseModel:    domain.BaseModel{ID: boardID},
+			ProjectID:    projectID,
+			CustomFields: testCustomFields(map[string]interface{}{"stage": "review", "importance": "high"}),
+		},
+		outbox: outbox,
+	}
+	env.rules = []*domain.AutomationRule{
+		automationTestRule(projectID, domain.AutomationTriggerFieldChanged, "stage", "review", nil,
+			domain.AutomationAction{Type: domain.AutomationActionSetField, Field: "stage", Value: "approved"}),
+	}
+
+	// When
+	env.engine().Process(context.Background(), AutomationEvent{
+		Trigger:       domain.AutomationTriggerFieldChanged,
+		ProjectID:     projectID,
+		BoardID:       boardID,
+		ChangedFields: map[string]string{"stage": "review"},
+	})
+	if _, err := outbox.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+
+	// Then: the change went through the board update path
+	var fields map[string]interface{}
+	_ = json.Unmarshal(env.board.CustomFields, &fields)
+	if fields["stage"] != "approved" || fields["importance"] != "high" {
+		t.Errorf("custom fields = %v, want stage=approved with importance kept", fields)
+	}
+	if env.board.CompletedAt == nil {
+		t.Error("CompletedAt = nil, want the completion to be tracked")
+	}
+	if len(broadcaster.messages) != 1 || broadcaster.messages[0].Type != domain.WebhookEventBoardUpdated {
+		t.Errorf("broadcasts = %v, want one BOARD_UPDATED", broadcaster.messages)
+	}
+	if len(env.executions) != 1 || env.executions[0].Status != domain.AutomationExecutionSuccess {
+		t.Errorf("executions = %v, want one SUCCESS", env.executions)
+	}
+}
+
+func TestBoardService_UpdateBoard_DispatchesAutomation(t *testing.T) {
+	// Given
+	projectID := uuid.New()
+	boardID := uuid.New()
+	actorID := uuid.New()
+	newAssigneeID := uuid.New()
+	stored := domain.Board{
+		BaseModel:    domain.BaseModel{ID: boardID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		ProjectID:    projectID,
+		Title:        "Test Board",
+		CustomFields: testCustomFields(map[string]interface{}{"stage": "in_progress"}),
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			board := stored
+			return &board, nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			stored = *board
+			return nil
+		},
+	}
+	mockEngine := &MockAutomationEngine{}
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, &MockFieldOptionConverter{}, nil, nil, mockEngine, nil, nil, nil, logger)
+
+	ctx := context.WithValue(context.Background(), "user_id", actorID)
+	customFields := map[string]interface{}{"stage": "review"}
+
+	// When
+	_, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{CustomFields: &customFields, AssigneeID: &newAssigneeID})
+
+	// Then
+	if err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(mockEngine.Events) != 1 {
+		t.Fatalf("dispatched events = %d, want 1", len(mockEngine.Events))
+	}
+	event := mockEngine.Events[0]
+	if event.Trigger != domain.AutomationTriggerFieldChanged || event.ActorID != actorID {
+		t.Errorf("event = %+v, want FIELD_CHANGED by actor", event)
+	}
+	if event.ChangedFields["stage"] != "review" || event.ChangedFields["assignee"] != newAssigneeID.String() {
+		t.Errorf("ChangedFields = %v, want stage=review and assignee=%s", event.ChangedFields, newAssigneeID)
+	}
+}
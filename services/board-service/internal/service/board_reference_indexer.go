@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// BoardReferenceIndexer keeps the cross-references between boards in sync with board content and comments
+// Indexing failures are logged and never fail the board or comment operation that triggered them
+type BoardReferenceIndexer interface {
+	// IndexBoard re-parses the content of a board and replaces its references
+	IndexBoard(ctx context.Context, board *domain.Board)
+	// IndexComment re-parses a comment and replaces its references
+	IndexComment(ctx context.Context, comment *domain.Comment)
+	// RemoveComment drops the references of a deleted comment
+	RemoveComment(ctx context.Context, commentID uuid.UUID)
+	// GetBacklinks returns the boards visible to the viewer that link to the given board
+	GetBacklinks(ctx context.Context, boardID, viewerID uuid.UUID) ([]dto.BoardBacklinkResponse, error)
+}
+
+// boardReferenceIndexerImpl is the implementation of BoardReferenceIndexer
+type boardReferenceIndexerImpl struct {
+	referenceRepo repository.BoardReferenceRepository
+	logger        *zap.Logger
+}
+
+// NewBoardReferenceIndexer creates a new instance of BoardReferenceIndexer
+func NewBoardReferenceIndexer(referenceRepo repository.BoardReferenceRepository, logger *zap.Logger) BoardReferenceIndexer {
+	return &boardReferenceIndexerImpl{
+		referenceRepo: referenceRepo,
+		logger:        logger,
+	}
+}
+
+// IndexBoard replaces the references parsed from the board content
+func (i *boardReferenceIndexerImpl) IndexBoard(ctx context.Context, board *domain.Board) {
+	i.index(ctx, domain.BoardReferenceSourceBoard, board.ID, board.ID, board.Content)
+}
+
+// IndexComment replaces the references parsed from the comment content
+func (i *boardReferenceIndexerImpl) IndexComment(ctx context.Context, comment *domain.Comment) {
+	i.index(ctx, domain.BoardReferenceSourceComment, comment.ID, comment.BoardID, comment.Content)
+}
+
+// RemoveComment drops the references of a deleted comment
+func (i *boardReferenceIndexerImpl) RemoveComment(ctx context.Context, commentID uuid.UUID) {
+	if err := i.referenceRepo.DeleteBySource(ctx, domain.BoardReferenceSourceComment, commentID); err != nil {
+		i.logger.Warn("Failed to remove comment board references",
+			zap.String("comment.id", commentID.String()),
+			zap.Error(err))
+	}
+}
+
+// index parses the text and stores one reference per linked board
+// Links to the source board itself and to boards outside its workspace are ignored
+func (i *boardReferenceIndexerImpl) index(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID, sourceBoardID uuid.UUID, text string) {
+	candidates := make([]uuid.UUID, 0)
+	for _, id := range domain.ExtractBoardReferences(text) {
+		if id != sourceBoardID {
+			candidates = append(candidates, id)
+		}
+	}
+
+	targets, err := i.referenceRepo.FindBoardIDsInSameWorkspace(ctx, sourceBoardID, candidates)
+	if err != nil {
+		i.logger.Warn("Failed to resolve referenced boards",
+			zap.String("source.type", string(sourceType)),
+			zap.String("source.id", sourceID.String()),
+			zap.Error(err))
+		return
+	}
+
+	if err := i.referenceRepo.ReplaceBySource(ctx, sourceType, sourceID, sourceBoardID, targets); err != nil {
+		i.logger.Warn("Failed to store board references",
+			zap.String("source.type", string(sourceType)),
+			zap.String("source.id", sourceID.String()),
+			zap.Error(err))
+	}
+}
+
+// GetBacklinks returns the references pointing at a board as backlink responses
+// Sources in private projects the viewer is not a member of are left out
+func (i *boardReferenceIndexerImpl) GetBacklinks(ctx context.Context, boardID, viewerID uuid.UUID) ([]dto.BoardBacklinkResponse, error) {
+	references, err := i.referenceRepo.FindByTargetBoardID(ctx, boardID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	backlinks := make([]dto.BoardBacklinkResponse, 0, len(references))
+	for _, ref := range references {
+		backlink := dto.BoardBacklinkResponse{
+			BoardID:    ref.SourceBoardID,
+			BoardKey:   domain.FormatBoardKey(ref.SourceBoard.Project.KeyPrefix, ref.SourceBoard.Number),
+			Title:      ref.SourceBoard.Title,
+			ProjectID:  ref.SourceBoard.ProjectID,
+			SourceType: string(ref.SourceType),
+			CreatedAt:  ref.CreatedAt,
+		}
+		if ref.SourceType == domain.BoardReferenceSourceComment {
+			commentID := ref.SourceID
+			backlink.CommentID = &commentID
+		}
+		backlinks = append(backlinks, backlink)
+	}
+	return backlinks, nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+)
+
+func TestBoardReferenceIndexer_IndexBoard(t *testing.T) {
+	sourceID := uuid.New()
+	targetA := uuid.New()
+	targetB := uuid.New()
+	otherWorkspace := uuid.New()
+
+	tests := []struct {
+		name        string
+		content     string
+		wantTargets []uuid.UUID
+	}{
+		{
+			name:        "성공: URL과 board: 토큰 모두 인식",
+			content:     fmt.Sprintf("See https://app.wealist.co.kr/boards/%s and board:%s", targetA, targetB),
+			wantTargets: []uuid.UUID{targetA, targetB},
+		},
+		{
+			name:        "성공: 중복 링크와 자기 자신 링크 제외",
+			content:     fmt.Sprintf("board:%s board:%s board:%s", targetA, sourceID, targetA),
+			wantTargets: []uuid.UUID{targetA},
+		},
+		{
+			name:        "성공: 다른 워크스페이스 보드 제외",
+			content:     fmt.Sprintf("board:%s board:%s", otherWorkspace, targetB),
+			wantTargets: []uuid.UUID{targetB},
+		},
+		{
+			name:        "성공: 링크가 사라지면 참조 비움",
+			content:     "no links anymore",
+			wantTargets: []uuid.UUID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var gotTargets []uuid.UUID
+			var gotSourceType domain.BoardReferenceSourceType
+			repo := &MockBoardReferenceRepository{
+				FindBoardIDsInSameWorkspaceFunc: func(ctx context.Context, boardID uuid.UUID, candidateIDs []uuid.UUID) ([]uuid.UUID, error) {
+					ids := make([]uuid.UUID, 0)
+					for _, id := range candidateIDs {
+						if id != otherWorkspace {
+							ids = append(ids, id)
+						}
+					}
+					return ids, nil
+				},
+				ReplaceBySourceFunc: func(ctx context.Context, sourceType domain.BoardReferenceSourceType, sid, sourceBoardID uuid.UUID, targetBoardIDs []uuid.UUID) error {
+					gotSourceType = sourceType
+					gotTargets = targetBoardIDs
+					return nil
+				},
+			}
+			indexer := NewBoardReferenceIndexer(repo, zap.NewNop())
+
+			// When
+			indexer.IndexBoard(context.Background(), &domain.Board{
+				BaseModel: domain.BaseModel{ID: sourceID},
+				Content:   tt.content,
+			})
+
+			// Then
+			if gotSourceType != domain.BoardReferenceSourceBoard {
+				t.Errorf("source type = %q, want BOARD", gotSourceType)
+			}
+			if fmt.Sprint(gotTargets) != fmt.Sprint(tt.wantTargets) {
+				t.Errorf("targets = %v, want %v", gotTargets, tt.wantTargets)
+			}
+		})
+	}
+}
+
+func TestBoardReferenceIndexer_GetBacklinks(t *testing.T) {
+	// Given
+	targetID := uuid.New()
+	viewerID := uuid.New()
+	sourceBoard := domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		Number:    7,
+		Title:     "Login page redesign",
+		Project:   domain.Project{KeyPrefix: "WEB"},
+	}
+	commentID := uuid.New()
+	repo := &MockBoardReferenceRepository{
+		FindByTargetBoardIDFunc: func(ctx context.Context, id, viewer uuid.UUID) ([]*domain.BoardReference, error) {
+			if viewer != viewerID {
+				t.Errorf("FindByTargetBoardID() viewer = %s, want %s", viewer, viewerID)
+			}
+			return []*domain.BoardReference{
+				{
+					BaseModel:     domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now()},
+					SourceType:    domain.BoardReferenceSourceComment,
+					SourceID:      commentID,
+					SourceBoardID: sourceBoard.ID,
+					TargetBoardID: targetID,
+					SourceBoard:   sourceBoard,
+				},
+				{
+					BaseModel:     domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now()},
+					SourceType:    domain.BoardReferenceSourceBoard,
+					SourceID:      sourceBoard.ID,
+					SourceBoardID: sourceBoard.ID,
+					TargetBoardID: targetID,
+					SourceBoard:   sourceBoard,
+				},
+			}, nil
+		},
+	}
+	indexer := NewBoardReferenceIndexer(repo, zap.NewNop())
+
+	// When
+	backlinks, err := indexer.GetBacklinks(context.Background(), targetID, viewerID)
+
+	// Then
+	if err != nil {
+		t.Fatalf("GetBacklinks() error = %v", err)
+	}
+	if len(backlinks) != 2 {
+		t.Fatalf("expected 2 backlinks, got %d", len(backlinks))
+	}
+	if backlinks[0].BoardKey != "WEB-7" || backlinks[0].Title != sourceBoard.Title || backlinks[0].BoardID != sourceBoard.ID {
+		t.Errorf("unexpected backlink %+v", backlinks[0])
+	}
+	if backlinks[0].CommentID == nil || *backlinks[0].CommentID != commentID {
+		t.Errorf("comment backlink should carry the comment ID")
+	}
+	if backlinks[1].SourceType != string(domain.BoardReferenceSourceBoard) || backlinks[1].CommentID != nil {
+		t.Errorf("unexpected content backlink %+v", backlinks[1])
+	}
+}
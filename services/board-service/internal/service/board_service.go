@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"github.com/google/uuid"
@@ -14,6 +15,7 @@ import (
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
 	"project-board-api/internal/metrics"
 	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
@@ -22,9 +24,11 @@ import (
 // BoardService defines the interface for board business logic
 type BoardService interface {
 	CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
-	GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
+	GetBoard(ctx context.Context, boardID, viewerID uuid.UUID) (*dto.BoardDetailResponse, error)
+	GetBoardByKey(ctx context.Context, workspaceID uuid.UUID, key string, viewerID uuid.UUID) (*dto.BoardDetailResponse, error)
 	GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
 	UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
+	MoveBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest, moved *dto.BoardMovedPayload) (*dto.BoardResponse, error)
 	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
 }
 
@@ -35,9 +39,15 @@ type boardServiceImpl struct {
 	fieldOptionRepo      repository.FieldOptionRepository
 	participantRepo      repository.ParticipantRepository
 	attachmentRepo       repository.AttachmentRepository
+	workflowRepo         repository.WorkflowRepository
+	labelRepo            repository.LabelRepository
 	s3Client             S3Client
 	fieldOptionConverter FieldOptionConverter
-	notiClient           client.NotiClient // for sending notifications
+	notiClient           client.NotiClient     // for sending notifications
+	outbox               Outbox                // optional, nil sends notifications and events on a best-effort basis
+	automation           AutomationEngine      // optional, nil disables automation rules
+	references           BoardReferenceIndexer // optional, nil disables cross-reference tracking
+	watchers             BoardWatchers         // optional, nil notifies only the assignee and participants
 	metrics              *metrics.Metrics
 	logger               *zap.Logger
 }
@@ -48,6 +58,7 @@ type FieldOptionConverter interface {
 	ConvertIDsToValues(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToLabels(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToValuesBatch(ctx context.Context, boards []*domain.Board) error
+	StageCategories(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error)
 }
 
 // NewBoardService creates a new instance of BoardService
@@ -57,24 +68,38 @@ func NewBoardService(
 	fieldOptionRepo repository.FieldOptionRepository,
 	participantRepo repository.ParticipantRepository,
 	attachmentRepo repository.AttachmentRepository,
+	workflowRepo repository.WorkflowRepository,
+	labelRepo repository.LabelRepository,
 	s3Client S3Client,
 	fieldOptionConverter FieldOptionConverter,
 	notiClient client.NotiClient,
+	outbox Outbox,
+	automation AutomationEngine,
+	references BoardReferenceIndexer,
+	watchers BoardWatchers,
 	m *metrics.Metrics,
 	logger *zap.Logger,
 ) BoardService {
-	return &boardServiceImpl{
+	s := &boardServiceImpl{
 		boardRepo:            boardRepo,
 		projectRepo:          projectRepo,
 		fieldOptionRepo:      fieldOptionRepo,
 		participantRepo:      participantRepo,
 		attachmentRepo:       attachmentRepo,
+		workflowRepo:         workflowRepo,
+		labelRepo:            labelRepo,
 		s3Client:             s3Client,
 		fieldOptionConverter: fieldOptionConverter,
 		notiClient:           notiClient,
+		outbox:               outbox,
+		automation:           automation,
+		references:           references,
+		watchers:             watchers,
 		metrics:              m,
 		logger:               logger,
 	}
+	bindAutomationBoardUpdater(automation, s)
+	return s
 }
 
 // log returns a trace-context aware logger
@@ -103,7 +128,7 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 	}
 
 	// Verify project exists
-	_, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Warn("CreateBoard project not found", zap.String("project.id", req.ProjectID.String()))
@@ -112,6 +137,12 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		log.Error("CreateBoard failed to verify project", zap.Error(err))
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
 	}
+	if err := requirePermission(ctx, s.projectRepo, req.ProjectID, authorID, domain.PermissionBoardCreate, "You do not have permission to create boards"); err != nil {
+		return nil, err
+	}
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
+	}
 
 	// Convert CustomFields from values to IDs, then to datatypes.JSON
 	var customFieldsJSON datatypes.JSON
@@ -142,58 +173,136 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		}
 	}
 
+	// Validate labels before anything is written
+	labelIDs, err := s.resolveBoardLabels(ctx, req.ProjectID, req.LabelIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create domain model from request with AuthorID
 	board := &domain.Board{
 		ProjectID:    req.ProjectID,
 		AuthorID:     authorID,
 		Title:        req.Title,
 		Content:      req.Content,
+		ContentText:  markdown.PlainText(req.Content),
 		CustomFields: customFieldsJSON,
 		AssigneeID:   assigneeID,
 		StartDate:    req.StartDate,
 		DueDate:      req.DueDate,
 	}
-
-	// Save to repository
-	if err := s.boardRepo.Create(ctx, board); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+	if stage, ok := req.CustomFields[string(domain.FieldTypeStage)].(string); ok {
+		category, err := s.stageCategory(ctx, req.ProjectID, stage)
+		if err != nil {
+			return nil, err
+		}
+		trackBoardCompletion(board, category, time.Now())
 	}
 
-	// Confirm attachments after board creation
-	var createdAttachments []*domain.Attachment
-	if len(req.AttachmentIDs) > 0 {
-		// 에러 발생 시 board도 롤백
-		if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments, rolling back board creation",
-				zap.String("board_id", board.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(req.AttachmentIDs))
-					for i, id := range req.AttachmentIDs {
-						ids[i] = id.String()
+	// Write the board, its attachments, labels and participants together with the
+	// notifications and the BOARD_CREATED event, so none of them is lost or sent for a rolled back board
+	var resp *dto.BoardResponse
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		// Save to repository
+		if err := s.boardRepo.Create(ctx, board); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
+
+		// Confirm attachments after board creation
+		var createdAttachments []*domain.Attachment
+		if len(req.AttachmentIDs) > 0 {
+			// 에러 발생 시 board도 롤백
+			if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
+				s.logger.Error("Failed to confirm attachments, rolling back board creation",
+					zap.String("board_id", board.ID.String()),
+					zap.Strings("attachment_ids", func() []string {
+						ids := make([]string, len(req.AttachmentIDs))
+						for i, id := range req.AttachmentIDs {
+							ids[i] = id.String()
+						}
+						return ids
+					}()),
+					zap.Error(err))
+
+				// board 삭제 (롤백) - with an outbox the transaction rolls it back
+				if s.outbox == nil {
+					if deleteErr := s.boardRepo.Delete(ctx, board.ID); deleteErr != nil {
+						s.logger.Error("Failed to rollback board after attachment confirmation failure",
+							zap.String("board_id", board.ID.String()),
+							zap.Error(deleteErr))
 					}
-					return ids
-				}()),
-				zap.Error(err))
+				}
 
-			// board 삭제 (롤백)
-			if deleteErr := s.boardRepo.Delete(ctx, board.ID); deleteErr != nil {
-				s.logger.Error("Failed to rollback board after attachment confirmation failure",
+				return response.NewAppError(response.ErrCodeInternal,
+					"Failed to confirm attachments: "+err.Error(),
+					"Please ensure all attachment IDs are valid and not already used")
+			}
+
+			// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
+			attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
+			if err != nil {
+				s.logger.Warn("Failed to fetch confirmed attachments for response", zap.Error(err))
+			} else {
+				createdAttachments = attachments
+			}
+		}
+
+		// Assign labels
+		if len(labelIDs) > 0 {
+			if err := s.labelRepo.ReplaceBoardLabels(ctx, board.ID, labelIDs); err != nil {
+				s.logger.Warn("Failed to assign labels during board creation",
 					zap.String("board_id", board.ID.String()),
-					zap.Error(deleteErr))
+					zap.Error(err))
 			}
+		}
 
-			return nil, response.NewAppError(response.ErrCodeInternal,
-				"Failed to confirm attachments: "+err.Error(),
-				"Please ensure all attachment IDs are valid and not already used")
+		// Add participants if provided
+		if len(req.Participants) > 0 {
+			successCount, err := s.addParticipantsInternal(ctx, board.ID, req.Participants)
+			if err != nil {
+				s.logger.Warn("Error occurred while adding participants during board creation",
+					zap.String("board_id", board.ID.String()),
+					zap.Int("success_count", successCount),
+					zap.Error(err))
+			}
+
+			// Reload board with participants to include them in response
+			reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
+			if err != nil {
+				s.logger.Warn("Failed to reload board with participants",
+					zap.String("board_id", board.ID.String()),
+					zap.Error(err))
+				// Continue with original board if reload fails
+			} else {
+				board = reloadedBoard
+			}
 		}
 
-		// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
-		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
-		if err != nil {
-			s.logger.Warn("Failed to fetch confirmed attachments for response", zap.Error(err))
-		} else {
-			createdAttachments = attachments
+		// 생성된 Attachments를 Board 객체에 할당 (타입 변환 적용)
+		board.Attachments = toDomainAttachments(createdAttachments)
+
+		// Send notifications to assignee and participants
+		// Notify assignee (always, even if same as author - user wants to see notification)
+		if err := s.sendAssigneeNotification(ctx, board, authorID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+		}
+
+		// Notify participants when they are added to the board
+		if len(req.Participants) > 0 {
+			if err := s.sendParticipantAddedNotifications(ctx, board, req.Participants, authorID); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+			}
 		}
+
+		// Convert to response DTO
+		resp = s.toBoardResponseWithWorkspace(ctx, board)
+		if err := recordBoardBroadcast(ctx, s.outbox, board.ProjectID, board.ID, domain.WebhookEventBoardCreated, resp); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board event", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Increment board creation metric
@@ -201,48 +310,29 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		s.metrics.IncrementBoardCreated()
 	}
 
-	// Add participants if provided
-	if len(req.Participants) > 0 {
-		successCount, err := s.addParticipantsInternal(ctx, board.ID, req.Participants)
-		if err != nil {
-			s.logger.Warn("Error occurred while adding participants during board creation",
-				zap.String("board_id", board.ID.String()),
-				zap.Int("success_count", successCount),
-				zap.Error(err))
-		}
-
-		// Reload board with participants to include them in response
-		reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
-		if err != nil {
-			s.logger.Warn("Failed to reload board with participants",
-				zap.String("board_id", board.ID.String()),
-				zap.Error(err))
-			// Continue with original board if reload fails
-		} else {
-			board = reloadedBoard
-		}
+	// Subscribe the assignee to the board
+	if board.AssigneeID != nil && s.watchers != nil {
+		s.watchers.AutoWatch(ctx, board.ID, *board.AssigneeID, domain.WatchReasonAssigned)
 	}
 
-	// 생성된 Attachments를 Board 객체에 할당 (타입 변환 적용)
-	board.Attachments = toDomainAttachments(createdAttachments)
-
-	// Send notifications to assignee and participants
-	// Notify assignee (always, even if same as author - user wants to see notification)
-	if board.AssigneeID != nil {
-		s.sendAssigneeNotification(ctx, board, authorID)
+	// Record links to other boards found in the content
+	if s.references != nil {
+		s.references.IndexBoard(ctx, board)
 	}
 
-	// Notify participants when they are added to the board
-	if len(req.Participants) > 0 {
-		s.sendParticipantAddedNotifications(ctx, board, req.Participants, authorID)
-	}
+	// Run BOARD_CREATED automation rules in the background
+	s.dispatchAutomation(ctx, AutomationEvent{
+		Trigger:   domain.AutomationTriggerBoardCreated,
+		ProjectID: board.ProjectID,
+		BoardID:   board.ID,
+		ActorID:   authorID,
+	})
 
-	// Convert to response DTO
-	return s.toBoardResponseWithWorkspace(ctx, board), nil
+	return resp, nil
 }
 
 // GetBoard retrieves a board by ID with participants and comments
-func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error) {
+func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID, viewerID uuid.UUID) (*dto.BoardDetailResponse, error) {
 	log := s.log(ctx)
 	log.Debug("GetBoard service started", zap.String("board.id", boardID.String()))
 
@@ -271,10 +361,52 @@ func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dt
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
 	}
 
+	// Convert to detailed response DTO
+	detail := s.toBoardDetailResponse(ctx, board)
+
+	// Boards whose content or comments link here, limited to projects the viewer can see
+	if s.references != nil {
+		backlinks, err := s.references.GetBacklinks(ctx, board.ID, viewerID)
+		if err != nil {
+			log.Warn("GetBoard failed to fetch backlinks", zap.String("board.id", boardID.String()), zap.Error(err))
+		} else {
+			detail.MentionedIn = backlinks
+		}
+	}
+
 	log.Debug("GetBoard completed", zap.String("board.id", boardID.String()))
+	return detail, nil
+}
 
-	// Convert to detailed response DTO
-	return s.toBoardDetailResponse(ctx, board), nil
+// GetBoardByKey retrieves a board by its human-readable key (e.g. WEB-42) within a workspace
+func (s *boardServiceImpl) GetBoardByKey(ctx context.Context, workspaceID uuid.UUID, key string, viewerID uuid.UUID) (*dto.BoardDetailResponse, error) {
+	log := s.log(ctx)
+	log.Debug("GetBoardByKey service started", zap.String("workspace.id", workspaceID.String()), zap.String("board.key", key))
+
+	prefix, number, ok := domain.ParseBoardKey(key)
+	if !ok {
+		return nil, response.NewValidationError("Invalid board key", "Board key must look like WEB-42")
+	}
+
+	project, err := s.projectRepo.FindByWorkspaceAndKeyPrefix(ctx, workspaceID, prefix)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		log.Error("GetBoardByKey failed to fetch project", zap.String("board.key", key), zap.Error(err))
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+
+	board, err := s.boardRepo.FindByProjectAndNumber(ctx, project.ID, number)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		log.Error("GetBoardByKey failed to fetch board", zap.String("board.key", key), zap.Error(err))
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	return s.GetBoard(ctx, board.ID, viewerID)
 }
 
 // GetBoardsByProject retrieves all boards for a project with optional filters
@@ -298,6 +430,19 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 	if filters != nil && filters.CustomFields != nil {
 		filterParam = filters.CustomFields
 	}
+	if filters != nil && (len(filters.LabelIDs) > 0 || filters.Query != "") {
+		switch filters.LabelMatch {
+		case "", dto.LabelMatchAny, dto.LabelMatchAll:
+		default:
+			return nil, response.NewValidationError("labelMatch must be 'any' or 'all'", "")
+		}
+		filterParam = repository.BoardListFilter{
+			CustomFields:   filters.CustomFields,
+			LabelIDs:       removeDuplicateUUIDs(filters.LabelIDs),
+			MatchAllLabels: filters.LabelMatch == dto.LabelMatchAll,
+			Query:          filters.Query,
+		}
+	}
 
 	// Fetch boards from repository with filters
 	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, filterParam)
@@ -339,8 +484,11 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 	log := s.log(ctx)
 	log.Debug("DeleteBoard service started", zap.String("board.id", boardID.String()))
 
+	// Extract user_id from context; authors may delete their own boards, others need board.delete.any
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, boardID)
+	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Debug("DeleteBoard board not found", zap.String("board.id", boardID.String()))
@@ -349,6 +497,14 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 		log.Error("DeleteBoard failed to verify board", zap.String("board.id", boardID.String()), zap.Error(err))
 		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
+	if board != nil {
+		if err := requireOwnOrPermission(ctx, s.projectRepo, board.ProjectID, actorID, board.AuthorID, domain.PermissionBoardDeleteAny, "You can only delete boards you created"); err != nil {
+			return err
+		}
+		if err := ensureProjectWritable(ctx, s.projectRepo, board.ProjectID); err != nil {
+			return err
+		}
+	}
 
 	// Find all attachments associated with this board
 	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, boardID)
@@ -367,10 +523,27 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 		s.deleteAttachmentsWithS3(ctx, attachments)
 	}
 
-	// Delete board
-	if err := s.boardRepo.Delete(ctx, boardID); err != nil {
-		log.Error("DeleteBoard failed to delete", zap.String("board.id", boardID.String()), zap.Error(err))
-		return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
+	// Delete board together with the BOARD_DELETED event
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if err := s.boardRepo.Delete(ctx, boardID); err != nil {
+			log.Error("DeleteBoard failed to delete", zap.String("board.id", boardID.String()), zap.Error(err))
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
+		}
+		if board == nil || s.outbox == nil {
+			return nil
+		}
+
+		payload := map[string]string{"boardId": boardID.String()}
+		if project, err := s.projectRepo.FindByID(ctx, board.ProjectID); err == nil && project != nil {
+			payload["boardKey"] = domain.FormatBoardKey(project.KeyPrefix, board.Number)
+		}
+		if err := recordBoardBroadcast(ctx, s.outbox, board.ProjectID, boardID, domain.WebhookEventBoardDeleted, payload); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board event", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Info("Board deleted", zap.String("board.id", boardID.String()))
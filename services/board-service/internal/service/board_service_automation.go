@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// dispatchAutomation hands an event to the automation engine when one is configured
+func (s *boardServiceImpl) dispatchAutomation(ctx context.Context, event AutomationEvent) {
+	if s.automation == nil {
+		return
+	}
+	s.automation.Dispatch(ctx, event)
+}
+
+// automationChangedFields builds the FIELD_CHANGED payload of an update
+// Custom fields are reported with their option values (as sent by the client), not option IDs
+func (s *boardServiceImpl) automationChangedFields(req *dto.UpdateBoardRequest, changes []BoardChange, originalCustomFields map[string]interface{}, board *domain.Board) map[string]string {
+	changedFields := make(map[string]string)
+
+	for _, change := range changes {
+		switch change.Field {
+		case AutomationFieldTitle, WorkflowFieldStartDate, WorkflowFieldDueDate, WorkflowFieldAssignee:
+			changedFields[change.Field] = change.NewValue
+		case WorkflowFieldContent:
+			changedFields[change.Field] = board.Content
+		}
+	}
+
+	if req.CustomFields != nil {
+		var newCustomFields map[string]interface{}
+		if len(board.CustomFields) > 0 {
+			_ = json.Unmarshal(board.CustomFields, &newCustomFields)
+		}
+		for key, value := range *req.CustomFields {
+			newID, exists := newCustomFields[key]
+			if !exists || formatInterface(originalCustomFields[key]) == formatInterface(newID) {
+				continue
+			}
+			changedFields[key] = formatInterface(value)
+		}
+	}
+
+	return changedFields
+}
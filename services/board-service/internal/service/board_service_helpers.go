@@ -15,9 +15,13 @@ import (
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
 	"project-board-api/internal/response"
 )
 
+// commentPreviewLength is the maximum number of characters of a comment shown in notifications
+const commentPreviewLength = 100
+
 // BoardChange represents a single field change in a board update
 type BoardChange struct {
 	Field    string `json:"field"`
@@ -84,6 +88,42 @@ func (s *boardServiceImpl) convertBoardCustomFieldsToValues(ctx context.Context,
 	return nil
 }
 
+// resolveBoardLabels deduplicates label IDs and ensures they all belong to the project
+func (s *boardServiceImpl) resolveBoardLabels(ctx context.Context, projectID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error) {
+	uniqueIDs := removeDuplicateUUIDs(ids)
+	if len(uniqueIDs) == 0 {
+		return uniqueIDs, nil
+	}
+
+	labels, err := s.labelRepo.FindByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+	}
+
+	found := make(map[uuid.UUID]bool, len(labels))
+	for _, label := range labels {
+		if label.ProjectID == projectID {
+			found[label.ID] = true
+		}
+	}
+	for _, id := range uniqueIDs {
+		if !found[id] {
+			return nil, response.NewValidationError("Invalid label", fmt.Sprintf("label %s does not exist in this project", id))
+		}
+	}
+
+	return uniqueIDs, nil
+}
+
+// labelNames joins label names for change notifications
+func labelNames(labels []domain.Label) string {
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label.Name
+	}
+	return strings.Join(names, ", ")
+}
+
 // toBoardResponse converts domain.Board to dto.BoardResponse
 func (s *boardServiceImpl) toBoardResponse(board *domain.Board) *dto.BoardResponse {
 	return s.toBoardResponseWithWorkspace(context.Background(), board)
@@ -108,6 +148,7 @@ func (s *boardServiceImpl) toBoardResponseWithWorkspace(ctx context.Context, boa
 	for _, a := range board.Attachments {
 		// s3Client.GetFileURL을 사용하여 FileURL 필드 채우기 (DB의 FileURL은 S3 Key)
 		fileURL := s.s3Client.GetFileURL(a.FileURL)
+		thumbnailURL, previewURL := attachmentImageURLs(s.s3Client, &a)
 
 		attachments = append(attachments, dto.AttachmentResponse{
 			ID:          a.ID,
@@ -117,19 +158,25 @@ func (s *boardServiceImpl) toBoardResponseWithWorkspace(ctx context.Context, boa
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+
+			ThumbnailURL: thumbnailURL,
+			PreviewURL:   previewURL,
 		})
 	}
 
-	// Get WorkspaceID from project
+	// Get WorkspaceID and board key prefix from project
 	var workspaceID uuid.UUID
+	var keyPrefix string
 	if board.Project.ID != uuid.Nil {
 		// Project was preloaded
 		workspaceID = board.Project.WorkspaceID
+		keyPrefix = board.Project.KeyPrefix
 	} else {
 		// Fetch project to get WorkspaceID
 		project, err := s.projectRepo.FindByID(ctx, board.ProjectID)
 		if err == nil && project != nil {
 			workspaceID = project.WorkspaceID
+			keyPrefix = project.KeyPrefix
 		}
 	}
 
@@ -137,14 +184,20 @@ func (s *boardServiceImpl) toBoardResponseWithWorkspace(ctx context.Context, boa
 		ID:             board.ID,
 		ProjectID:      board.ProjectID,
 		WorkspaceID:    workspaceID,
+		Key:            domain.FormatBoardKey(keyPrefix, board.Number),
+		Number:         board.Number,
 		AuthorID:       board.AuthorID,
 		AssigneeID:     board.AssigneeID,
+		SprintID:       board.SprintID,
+		RecurrenceID:   board.RecurrenceID,
 		Title:          board.Title,
 		Content:        board.Content,
 		CustomFields:   customFields,
 		StartDate:      board.StartDate,
 		DueDate:        board.DueDate,
+		CompletedAt:    board.CompletedAt,
 		ParticipantIDs: participantIDs,
+		Labels:         toBoardLabelResponses(board.Labels),
 		Attachments:    attachments,
 		CreatedAt:      board.CreatedAt,
 		UpdatedAt:      board.UpdatedAt,
@@ -181,6 +234,7 @@ func (s *boardServiceImpl) toBoardDetailResponse(ctx context.Context, board *dom
 		BoardResponse: *s.toBoardResponseWithWorkspace(ctx, board),
 		Participants:  participants,
 		Comments:      comments,
+		MentionedIn:   []dto.BoardBacklinkResponse{},
 	}
 }
 
@@ -217,8 +271,10 @@ func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID
 			UserID:  userID,
 		}
 
-		// Save to repository
-		if err := s.participantRepo.Create(ctx, participant); err != nil {
+		// Save to repository in a savepoint, so a duplicate does not abort the surrounding transaction
+		if err := runInTx(ctx, s.outbox, func(ctx context.Context) error {
+			return s.participantRepo.Create(ctx, participant)
+		}); err != nil {
 			// Check for unique constraint violation
 			if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
 				// Participant already exists, skip
@@ -303,6 +359,7 @@ func (s *boardServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attachme
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		deleteDerivedFiles(ctx, s.s3Client, s.logger, attachment)
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -331,11 +388,14 @@ func (s *boardServiceImpl) isAssigneeChanged(original, current *uuid.UUID) bool
 	return *original != *current
 }
 
-// sendAssigneeNotification sends a BOARD_ASSIGNED notification to the assignee
-// This is called asynchronously (in a goroutine) so notification failures don't affect the main business logic
-func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *domain.Board, actorID uuid.UUID) {
+// sendAssigneeNotification records a BOARD_ASSIGNED notification for the assignee unless they muted the board
+// Notifications go through the outbox, so they commit or roll back together with the change in ctx
+func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *domain.Board, actorID uuid.UUID) error {
 	if s.notiClient == nil || board.AssigneeID == nil {
-		return
+		return nil
+	}
+	if len(unmutedRecipients(ctx, s.watchers, board.ID, []uuid.UUID{*board.AssigneeID})) == 0 {
+		return nil
 	}
 
 	// Get project info for workspace ID
@@ -344,7 +404,7 @@ func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *
 		s.logger.Warn("Failed to get project for notification",
 			zap.String("board.id", board.ID.String()),
 			zap.Error(err))
-		return
+		return nil
 	}
 
 	event := &client.NotificationEvent{
@@ -358,25 +418,22 @@ func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *
 		Metadata: map[string]interface{}{
 			"projectId":   board.ProjectID.String(),
 			"projectName": project.Name,
+			"boardKey":    domain.FormatBoardKey(project.KeyPrefix, board.Number),
 		},
 	}
 
-	// Send notification asynchronously
-	go func() {
-		// Use background context to avoid cancellation when request completes
-		if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
-			s.logger.Warn("Failed to send board assigned notification",
-				zap.String("board.id", board.ID.String()),
-				zap.String("assignee.id", board.AssigneeID.String()),
-				zap.Error(err))
-		}
-	}()
+	return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateBoard, board.ID, []*client.NotificationEvent{event})
 }
 
-// sendParticipantAddedNotifications sends BOARD_PARTICIPANT_ADDED notifications to new participants
-func (s *boardServiceImpl) sendParticipantAddedNotifications(ctx context.Context, board *domain.Board, participantIDs []uuid.UUID, actorID uuid.UUID) {
-	if s.notiClient == nil || len(participantIDs) == 0 {
-		return
+// sendParticipantAddedNotifications records BOARD_PARTICIPANT_ADDED notifications for new participants
+// Participants who muted the board are skipped
+func (s *boardServiceImpl) sendParticipantAddedNotifications(ctx context.Context, board *domain.Board, participantIDs []uuid.UUID, actorID uuid.UUID) error {
+	if s.notiClient == nil {
+		return nil
+	}
+	participantIDs = unmutedRecipients(ctx, s.watchers, board.ID, participantIDs)
+	if len(participantIDs) == 0 {
+		return nil
 	}
 
 	// Get project info for workspace ID
@@ -385,42 +442,36 @@ func (s *boardServiceImpl) sendParticipantAddedNotifications(ctx context.Context
 		s.logger.Warn("Failed to get project for participant notification",
 			zap.String("board.id", board.ID.String()),
 			zap.Error(err))
-		return
-	}
-
-	// Send notification to each participant asynchronously
-	go func() {
-		for _, participantID := range participantIDs {
-			event := &client.NotificationEvent{
-				Type:         client.NotificationTypeBoardParticipantAdded,
-				ActorID:      actorID,
-				TargetUserID: participantID,
-				WorkspaceID:  project.WorkspaceID,
-				ResourceType: client.ResourceTypeBoard,
-				ResourceID:   board.ID,
-				ResourceName: &board.Title,
-				Metadata: map[string]interface{}{
-					"projectId":   board.ProjectID.String(),
-					"projectName": project.Name,
-				},
-			}
+		return nil
+	}
 
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
-				s.logger.Warn("Failed to send participant added notification",
-					zap.String("board.id", board.ID.String()),
-					zap.String("participant.id", participantID.String()),
-					zap.Error(err))
-			}
-		}
-	}()
+	events := make([]*client.NotificationEvent, 0, len(participantIDs))
+	for _, participantID := range participantIDs {
+		events = append(events, &client.NotificationEvent{
+			Type:         client.NotificationTypeBoardParticipantAdded,
+			ActorID:      actorID,
+			TargetUserID: participantID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata: map[string]interface{}{
+				"projectId":   board.ProjectID.String(),
+				"projectName": project.Name,
+				"boardKey":    domain.FormatBoardKey(project.KeyPrefix, board.Number),
+			},
+		})
+	}
+
+	return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateBoard, board.ID, events)
 }
 
-// sendBoardUpdateNotifications sends BOARD_UPDATED notifications to assignee and all participants
-// Excludes the actor (the person who made the update)
+// sendBoardUpdateNotifications records BOARD_UPDATED notifications for the board's watcher set
+// (assignee, participants, board and project watchers), excluding the actor and users who muted the board
 // Includes the list of changes made to the board
-func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, board *domain.Board, actorID uuid.UUID, changes []BoardChange) {
+func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, board *domain.Board, actorID uuid.UUID, changes []BoardChange) error {
 	if s.notiClient == nil {
-		return
+		return nil
 	}
 
 	// Get project info for workspace ID
@@ -429,26 +480,13 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 		s.logger.Warn("Failed to get project for update notification",
 			zap.String("board.id", board.ID.String()),
 			zap.Error(err))
-		return
-	}
-
-	// Collect all users to notify (assignee + participants), excluding actor
-	notifyUserIDs := make(map[uuid.UUID]bool)
-
-	// Add assignee if exists and not the actor
-	if board.AssigneeID != nil && *board.AssigneeID != actorID {
-		notifyUserIDs[*board.AssigneeID] = true
-	}
-
-	// Add participants if not the actor
-	for _, p := range board.Participants {
-		if p.UserID != actorID {
-			notifyUserIDs[p.UserID] = true
-		}
+		return nil
 	}
 
+	// Resolve the watcher set, excluding the actor and muted users
+	notifyUserIDs := boardAudience(ctx, s.watchers, board, actorID)
 	if len(notifyUserIDs) == 0 {
-		return
+		return nil
 	}
 
 	// Convert changes to []interface{} for JSON serialization
@@ -461,39 +499,33 @@ func (s *boardServiceImpl) sendBoardUpdateNotifications(ctx context.Context, boa
 		}
 	}
 
-	// Send notifications asynchronously
-	go func() {
-		for userID := range notifyUserIDs {
-			event := &client.NotificationEvent{
-				Type:         client.NotificationTypeBoardUpdated,
-				ActorID:      actorID,
-				TargetUserID: userID,
-				WorkspaceID:  project.WorkspaceID,
-				ResourceType: client.ResourceTypeBoard,
-				ResourceID:   board.ID,
-				ResourceName: &board.Title,
-				Metadata: map[string]interface{}{
-					"projectId":   board.ProjectID.String(),
-					"projectName": project.Name,
-					"changes":     changesData,
-				},
-			}
+	events := make([]*client.NotificationEvent, 0, len(notifyUserIDs))
+	for _, userID := range notifyUserIDs {
+		events = append(events, &client.NotificationEvent{
+			Type:         client.NotificationTypeBoardUpdated,
+			ActorID:      actorID,
+			TargetUserID: userID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata: map[string]interface{}{
+				"projectId":   board.ProjectID.String(),
+				"projectName": project.Name,
+				"boardKey":    domain.FormatBoardKey(project.KeyPrefix, board.Number),
+				"changes":     changesData,
+			},
+		})
+	}
 
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
-				s.logger.Warn("Failed to send board update notification",
-					zap.String("board.id", board.ID.String()),
-					zap.String("target.user.id", userID.String()),
-					zap.Error(err))
-			}
-		}
-	}()
+	return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateBoard, board.ID, events)
 }
 
-// sendCommentAddedNotifications sends BOARD_COMMENT_ADDED notifications to assignee and all participants
-// Excludes the actor (the person who added the comment)
-func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, board *domain.Board, commentID uuid.UUID, commentPreview string, actorID uuid.UUID) {
+// sendCommentAddedNotifications records BOARD_COMMENT_ADDED notifications for the board's watcher set
+// Excludes the actor (the person who added the comment) and users who muted the board
+func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, board *domain.Board, commentID uuid.UUID, commentPreview string, actorID uuid.UUID) error {
 	if s.notiClient == nil {
-		return
+		return nil
 	}
 
 	// Get project info for workspace ID
@@ -502,58 +534,37 @@ func (s *boardServiceImpl) sendCommentAddedNotifications(ctx context.Context, bo
 		s.logger.Warn("Failed to get project for comment notification",
 			zap.String("board.id", board.ID.String()),
 			zap.Error(err))
-		return
+		return nil
 	}
 
-	// Collect all users to notify (assignee + participants), excluding actor
-	notifyUserIDs := make(map[uuid.UUID]bool)
-
-	// Add assignee if exists and not the actor
-	if board.AssigneeID != nil && *board.AssigneeID != actorID {
-		notifyUserIDs[*board.AssigneeID] = true
+	// Resolve the watcher set, excluding the actor and muted users
+	notifyUserIDs := boardAudience(ctx, s.watchers, board, actorID)
+	if len(notifyUserIDs) == 0 {
+		return nil
 	}
 
-	// Add participants if not the actor
-	for _, p := range board.Participants {
-		if p.UserID != actorID {
-			notifyUserIDs[p.UserID] = true
-		}
+	// Plain-text preview of the Markdown content (max 100 characters)
+	commentPreview = markdown.Preview(commentPreview, commentPreviewLength)
+
+	events := make([]*client.NotificationEvent, 0, len(notifyUserIDs))
+	for _, userID := range notifyUserIDs {
+		events = append(events, &client.NotificationEvent{
+			Type:         client.NotificationTypeBoardCommentAdded,
+			ActorID:      actorID,
+			TargetUserID: userID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata: map[string]interface{}{
+				"projectId":      board.ProjectID.String(),
+				"projectName":    project.Name,
+				"boardKey":       domain.FormatBoardKey(project.KeyPrefix, board.Number),
+				"commentId":      commentID.String(),
+				"commentPreview": commentPreview,
+			},
+		})
 	}
 
-	if len(notifyUserIDs) == 0 {
-		return
-	}
-
-	// Truncate comment content for notification preview (max 100 chars)
-	if len(commentPreview) > 100 {
-		commentPreview = commentPreview[:100] + "..."
-	}
-
-	// Send notifications asynchronously
-	go func() {
-		for userID := range notifyUserIDs {
-			event := &client.NotificationEvent{
-				Type:         client.NotificationTypeBoardCommentAdded,
-				ActorID:      actorID,
-				TargetUserID: userID,
-				WorkspaceID:  project.WorkspaceID,
-				ResourceType: client.ResourceTypeBoard,
-				ResourceID:   board.ID,
-				ResourceName: &board.Title,
-				Metadata: map[string]interface{}{
-					"projectId":      board.ProjectID.String(),
-					"projectName":    project.Name,
-					"commentId":      commentID.String(),
-					"commentPreview": commentPreview,
-				},
-			}
-
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
-				s.logger.Warn("Failed to send comment notification",
-					zap.String("board.id", board.ID.String()),
-					zap.String("target.user.id", userID.String()),
-					zap.Error(err))
-			}
-		}
-	}()
+	return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateBoard, board.ID, events)
 }
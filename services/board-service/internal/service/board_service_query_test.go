@@ -75,10 +75,10 @@ func TestBoardService_GetBoard(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
-			got, err := service.GetBoard(context.Background(), tt.boardID)
+			got, err := service.GetBoard(context.Background(), tt.boardID, uuid.New())
 
 			// Then
 			if tt.wantErr {
@@ -104,6 +104,104 @@ func TestBoardService_GetBoard(t *testing.T) {
 	}
 }
 
+func TestBoardService_GetBoardByKey(t *testing.T) {
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+	boardID := uuid.New()
+	project := &domain.Project{
+		BaseModel:   domain.BaseModel{ID: projectID},
+		WorkspaceID: workspaceID,
+		KeyPrefix:   "WEB",
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		wantErrCode string
+		wantKey     string
+	}{
+		{
+			name:    "성공: 키로 Board 조회",
+			key:     "WEB-42",
+			wantKey: "WEB-42",
+		},
+		{
+			name:    "성공: 소문자 접두사",
+			key:     "web-42",
+			wantKey: "WEB-42",
+		},
+		{
+			name:        "실패: 잘못된 키 형식",
+			key:         "WEB42",
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 존재하지 않는 접두사",
+			key:         "API-42",
+			wantErrCode: response.ErrCodeNotFound,
+		},
+		{
+			name:        "실패: 존재하지 않는 번호",
+			key:         "WEB-7",
+			wantErrCode: response.ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			board := &domain.Board{
+				BaseModel: domain.BaseModel{ID: boardID},
+				ProjectID: projectID,
+				Number:    42,
+				Title:     "Test Board",
+			}
+			mockBoardRepo := &MockBoardRepository{
+				FindByProjectAndNumberFunc: func(ctx context.Context, pid uuid.UUID, number int64) (*domain.Board, error) {
+					if pid == projectID && number == board.Number {
+						return board, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return board, nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindByWorkspaceAndKeyPrefixFunc: func(ctx context.Context, wid uuid.UUID, prefix string) (*domain.Project, error) {
+					if wid == workspaceID && prefix == project.KeyPrefix {
+						return project, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return project, nil
+				},
+			}
+			logger, _ := zap.NewDevelopment()
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, &MockFieldOptionConverter{}, nil, nil, nil, nil, nil, nil, logger)
+
+			// When
+			got, err := service.GetBoardByKey(context.Background(), workspaceID, tt.key, uuid.New())
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Errorf("GetBoardByKey() error = %v, want code %v", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetBoardByKey() unexpected error = %v", err)
+			}
+			if got.ID != boardID || got.Key != tt.wantKey || got.Number != 42 {
+				t.Errorf("GetBoardByKey() = %s %q #%d, want %s %q #42", got.ID, got.Key, got.Number, boardID, tt.wantKey)
+			}
+		})
+	}
+}
+
 func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 	projectID := uuid.New()
 
@@ -263,7 +361,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.GetBoardsByProject(context.Background(), projectID, tt.filters)
@@ -340,14 +438,14 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
 			mockBoardRepo := &MockBoardRepository{}
-			mockProjectRepo := &MockProjectRepository{}
+			mockProjectRepo := &MockProjectRepository{FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleAdmin)}
 			mockFieldOptionRepo := &MockFieldOptionRepository{}
 			mockConverter := &MockFieldOptionConverter{}
 			tt.mockBoard(mockBoardRepo)
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			err := service.DeleteBoard(context.Background(), tt.boardID)
@@ -491,7 +589,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.GetBoardsByProject(context.Background(), projectID, nil)
@@ -64,7 +64,7 @@ func TestBoardService_toBoardResponse_ParticipantIDs(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger).(*boardServiceImpl)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger).(*boardServiceImpl)
 
 			// When
 			response := service.toBoardResponse(tt.board)
@@ -100,7 +100,7 @@ func TestBoardService_toBoardResponse_Attachments(t *testing.T) {
 
 	mockParticipantRepo := &MockParticipantRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, &MockS3Client{}, mockConverter, nil, nil, logger)
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, &MockS3Client{}, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 	boardService := service.(*boardServiceImpl)
 
 	tests := []struct {
@@ -259,7 +259,7 @@ func TestCreateBoard_DateValidation(t *testing.T) {
 
 	mockParticipantRepo := &MockParticipantRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 	ctx := context.WithValue(context.Background(), "user_id", userID)
 
@@ -303,6 +303,7 @@ func TestCreateBoard_ValidDateRange(t *testing.T) {
 	dueDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
 
 	mockProjectRepo := &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 			return &domain.Project{
 				BaseModel: domain.BaseModel{ID: projectID},
@@ -326,7 +327,7 @@ func TestCreateBoard_ValidDateRange(t *testing.T) {
 
 	mockParticipantRepo := &MockParticipantRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 	ctx := context.WithValue(context.Background(), "user_id", userID)
 
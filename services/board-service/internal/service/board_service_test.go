@@ -144,7 +144,7 @@ func TestBoardService_CreateBoard(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			mockProjectRepo := &MockProjectRepository{}
+			mockProjectRepo := &MockProjectRepository{FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember)}
 			mockBoardRepo := &MockBoardRepository{}
 			mockFieldOptionRepo := &MockFieldOptionRepository{}
 			mockConverter := &MockFieldOptionConverter{}
@@ -153,7 +153,7 @@ func TestBoardService_CreateBoard(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.CreateBoard(tt.ctx, tt.req)
@@ -233,6 +233,7 @@ func TestBoardService_CreateBoard_CustomFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
 			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
 				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
 					return &domain.Project{}, nil
 				},
@@ -253,7 +254,7 @@ func TestBoardService_CreateBoard_CustomFields(t *testing.T) {
 			mockConverter := &MockFieldOptionConverter{}
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			req := &dto.CreateBoardRequest{
 				ProjectID:    projectID,
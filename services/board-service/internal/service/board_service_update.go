@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -11,10 +12,37 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
 	"project-board-api/internal/response"
 )
 
+// UpdateBoard updates a board and records the BOARD_UPDATED event with the change
 func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+	return s.updateBoard(ctx, boardID, req, boardUpdateOptions{})
+}
+
+// MoveBoard updates a board moved to another column and records the BOARD_MOVED event with the change
+// instead of BOARD_UPDATED, so the move is broadcast only once it is committed
+func (s *boardServiceImpl) MoveBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest, moved *dto.BoardMovedPayload) (*dto.BoardResponse, error) {
+	return s.updateBoard(ctx, boardID, req, boardUpdateOptions{moved: moved})
+}
+
+// applyAutomationUpdate applies an automation action to a board as an update by the rule creator in ctx
+// Workflow transition rules are not enforced and no automation is dispatched; the engine raises follow-ups itself
+func (s *boardServiceImpl) applyAutomationUpdate(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+	return s.updateBoard(ctx, boardID, req, boardUpdateOptions{automation: true})
+}
+
+// boardUpdateOptions selects how updateBoard records an update
+type boardUpdateOptions struct {
+	// moved selects the BOARD_MOVED event over BOARD_UPDATED
+	moved *dto.BoardMovedPayload
+	// automation marks updates made by automation rules, which act on behalf of an admin
+	automation bool
+}
+
+// updateBoard applies the update and records it as described by opts
+func (s *boardServiceImpl) updateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest, opts boardUpdateOptions) (*dto.BoardResponse, error) {
 	// Extract user_id from context for notification actor
 	actorID, _ := ctx.Value("user_id").(uuid.UUID)
 
@@ -26,6 +54,9 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
 	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, board.ProjectID); err != nil {
+		return nil, err
+	}
 
 	// Store original values for change detection
 	var originalAssigneeID *uuid.UUID
@@ -73,12 +104,22 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		}
 	}
 
+	// Validate labels before anything is written
+	var labelIDs []uuid.UUID
+	if req.LabelIDs != nil {
+		if labelIDs, err = s.resolveBoardLabels(ctx, board.ProjectID, *req.LabelIDs); err != nil {
+			return nil, err
+		}
+	}
+	originalLabelNames := labelNames(board.Labels)
+
 	// Update fields if provided
 	if req.Title != nil {
 		board.Title = *req.Title
 	}
 	if req.Content != nil {
 		board.Content = *req.Content
+		board.ContentText = markdown.PlainText(board.Content)
 	}
 	if req.CustomFields != nil {
 		// Convert values to IDs
@@ -108,172 +149,265 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		board.DueDate = req.DueDate
 	}
 
-	// Update board first
-	if err := s.boardRepo.Update(ctx, board); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
+	// Enforce workflow transition rules when the stage changes (also covers MoveBoard, not automation)
+	if req.CustomFields != nil {
+		if newStage, ok := (*req.CustomFields)[string(domain.FieldTypeStage)].(string); ok {
+			if !opts.automation {
+				participantCount := len(board.Participants)
+				if req.Participants != nil {
+					participantCount = len(removeDuplicateUUIDs(req.Participants))
+				}
+				oldStage := s.stageValueOf(ctx, originalCustomFields)
+				if err := s.validateStageTransition(ctx, board, oldStage, newStage, participantCount, actorID); err != nil {
+					return nil, err
+				}
+			}
+			category, err := s.stageCategory(ctx, board.ProjectID, newStage)
+			if err != nil {
+				return nil, err
+			}
+			trackBoardCompletion(board, category, time.Now())
+		}
 	}
 
-	// Attachments 처리 로직 개선 및 Confirm
-	if len(req.AttachmentIDs) > 0 {
-		// 에러 발생 시 업데이트 실패 처리
-		if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments during board update",
-				zap.String("board_id", board.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(req.AttachmentIDs))
-					for i, id := range req.AttachmentIDs {
-						ids[i] = id.String()
-					}
-					return ids
-				}()),
-				zap.Error(err))
-
-			return nil, response.NewAppError(response.ErrCodeInternal,
-				"Failed to confirm attachments: "+err.Error(),
-				"Please ensure all attachment IDs are valid and not already used")
+	// Write the board and its attachments, labels and participants together with the
+	// notifications and the BOARD_UPDATED (or BOARD_MOVED) event
+	var (
+		changes []BoardChange
+		resp    *dto.BoardResponse
+	)
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		// Update board first
+		if err := s.boardRepo.Update(ctx, board); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
 		}
-	}
 
-	// ✅ [수정] Participants 업데이트 로직 - board 업데이트 후 처리
-	if req.Participants != nil {
-		// 1. 기존 참여자 모두 조회
-		existingParticipants, err := s.participantRepo.FindByBoardID(ctx, boardID)
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			s.logger.Warn("Failed to fetch existing participants for update",
-				zap.String("board_id", boardID.String()),
-				zap.Error(err))
+		// Attachments 처리 로직 개선 및 Confirm
+		if len(req.AttachmentIDs) > 0 {
+			// 에러 발생 시 업데이트 실패 처리
+			if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
+				s.logger.Error("Failed to confirm attachments during board update",
+					zap.String("board_id", board.ID.String()),
+					zap.Strings("attachment_ids", func() []string {
+						ids := make([]string, len(req.AttachmentIDs))
+						for i, id := range req.AttachmentIDs {
+							ids[i] = id.String()
+						}
+						return ids
+					}()),
+					zap.Error(err))
+
+				return response.NewAppError(response.ErrCodeInternal,
+					"Failed to confirm attachments: "+err.Error(),
+					"Please ensure all attachment IDs are valid and not already used")
+			}
 		}
 
-		// 2. 기존 참여자 모두 삭제
-		if len(existingParticipants) > 0 {
-			s.logger.Info("Deleting existing participants",
-				zap.String("board_id", boardID.String()),
-				zap.Int("count", len(existingParticipants)))
-
-			for _, p := range existingParticipants {
-				if err := s.participantRepo.Delete(ctx, boardID, p.UserID); err != nil {
-					s.logger.Warn("Failed to delete existing participant",
-						zap.String("board_id", boardID.String()),
-						zap.String("user_id", p.UserID.String()),
-						zap.Error(err))
-				}
+		// Replace labels
+		if req.LabelIDs != nil {
+			if err := s.labelRepo.ReplaceBoardLabels(ctx, board.ID, labelIDs); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to update labels", err.Error())
 			}
 		}
 
-		// 3. 새로운 참여자 추가
-		if len(req.Participants) > 0 {
-			s.logger.Info("Adding new participants",
-				zap.String("board_id", boardID.String()),
-				zap.Int("count", len(req.Participants)))
-
-			uniqueUserIDs := removeDuplicateUUIDs(req.Participants)
-			for _, userID := range uniqueUserIDs {
-				participant := &domain.Participant{
-					BoardID: boardID,
-					UserID:  userID,
+		// ✅ [수정] Participants 업데이트 로직 - board 업데이트 후 처리
+		if req.Participants != nil {
+			// 1. 기존 참여자 모두 조회
+			existingParticipants, err := s.participantRepo.FindByBoardID(ctx, boardID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				s.logger.Warn("Failed to fetch existing participants for update",
+					zap.String("board_id", boardID.String()),
+					zap.Error(err))
+			}
+
+			// 2. 기존 참여자 모두 삭제
+			if len(existingParticipants) > 0 {
+				s.logger.Info("Deleting existing participants",
+					zap.String("board_id", boardID.String()),
+					zap.Int("count", len(existingParticipants)))
+
+				for _, p := range existingParticipants {
+					userID := p.UserID
+					if err := runInTx(ctx, s.outbox, func(ctx context.Context) error {
+						return s.participantRepo.Delete(ctx, boardID, userID)
+					}); err != nil {
+						s.logger.Warn("Failed to delete existing participant",
+							zap.String("board_id", boardID.String()),
+							zap.String("user_id", p.UserID.String()),
+							zap.Error(err))
+					}
 				}
-				if err := s.participantRepo.Create(ctx, participant); err != nil {
-					s.logger.Warn("Failed to add new participant",
-						zap.String("board_id", boardID.String()),
-						zap.String("user_id", userID.String()),
-						zap.Error(err))
+			}
+
+			// 3. 새로운 참여자 추가
+			if len(req.Participants) > 0 {
+				s.logger.Info("Adding new participants",
+					zap.String("board_id", boardID.String()),
+					zap.Int("count", len(req.Participants)))
+
+				uniqueUserIDs := removeDuplicateUUIDs(req.Participants)
+				for _, userID := range uniqueUserIDs {
+					participant := &domain.Participant{
+						BoardID: boardID,
+						UserID:  userID,
+					}
+					if err := runInTx(ctx, s.outbox, func(ctx context.Context) error {
+						return s.participantRepo.Create(ctx, participant)
+					}); err != nil {
+						s.logger.Warn("Failed to add new participant",
+							zap.String("board_id", boardID.String()),
+							zap.String("user_id", userID.String()),
+							zap.Error(err))
+					}
 				}
 			}
 		}
-	}
-
-	// board와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
-	allAttachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
-	if err != nil {
-		s.logger.Warn("Failed to fetch all confirmed attachments after update", zap.Error(err))
-	} else {
-		board.Attachments = toDomainAttachments(allAttachments)
-	}
 
-	// ✅ [수정] 업데이트된 participants를 다시 로드
-	reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
-	if err != nil {
-		s.logger.Warn("Failed to reload board with participants after update",
-			zap.String("board_id", board.ID.String()),
-			zap.Error(err))
-	} else {
-		board = reloadedBoard
-		// Attachments는 위에서 이미 로드했으므로 다시 할당
-		board.Attachments = toDomainAttachments(allAttachments)
-	}
+		// board와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
+		allAttachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+		if err != nil {
+			s.logger.Warn("Failed to fetch all confirmed attachments after update", zap.Error(err))
+		} else {
+			board.Attachments = toDomainAttachments(allAttachments)
+		}
 
-	// 🔔 Build list of changes for notification
-	changes := make([]BoardChange, 0)
+		// ✅ [수정] 업데이트된 participants를 다시 로드
+		reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
+		if err != nil {
+			s.logger.Warn("Failed to reload board with participants after update",
+				zap.String("board_id", board.ID.String()),
+				zap.Error(err))
+		} else {
+			board = reloadedBoard
+			// Attachments는 위에서 이미 로드했으므로 다시 할당
+			board.Attachments = toDomainAttachments(allAttachments)
+		}
 
-	if req.Title != nil && originalTitle != board.Title {
-		changes = append(changes, BoardChange{Field: "title", OldValue: originalTitle, NewValue: board.Title})
-	}
-	if req.Content != nil && originalContent != board.Content {
-		changes = append(changes, BoardChange{Field: "content", OldValue: "(내용 변경)", NewValue: "(내용 변경)"})
-	}
-	if req.StartDate != nil && !datesEqual(originalStartDate, board.StartDate) {
-		changes = append(changes, BoardChange{Field: "startDate", OldValue: formatDatePtr(originalStartDate), NewValue: formatDatePtr(board.StartDate)})
-	}
-	if req.DueDate != nil && !datesEqual(originalDueDate, board.DueDate) {
-		changes = append(changes, BoardChange{Field: "dueDate", OldValue: formatDatePtr(originalDueDate), NewValue: formatDatePtr(board.DueDate)})
-	}
-	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) {
-		changes = append(changes, BoardChange{Field: "assignee", OldValue: formatUUIDPtr(originalAssigneeID), NewValue: formatUUIDPtr(board.AssigneeID)})
-	}
+		// 🔔 Build list of changes for notification
+		changes = make([]BoardChange, 0)
 
-	// Check customFields changes (stage, role, importance, etc.)
-	if req.CustomFields != nil {
-		var newCustomFields map[string]interface{}
-		if len(board.CustomFields) > 0 {
-			_ = json.Unmarshal(board.CustomFields, &newCustomFields)
+		if req.Title != nil && originalTitle != board.Title {
+			changes = append(changes, BoardChange{Field: "title", OldValue: originalTitle, NewValue: board.Title})
+		}
+		if req.Content != nil && originalContent != board.Content {
+			changes = append(changes, BoardChange{Field: "content", OldValue: "(내용 변경)", NewValue: "(내용 변경)"})
+		}
+		if req.StartDate != nil && !datesEqual(originalStartDate, board.StartDate) {
+			changes = append(changes, BoardChange{Field: "startDate", OldValue: formatDatePtr(originalStartDate), NewValue: formatDatePtr(board.StartDate)})
+		}
+		if req.DueDate != nil && !datesEqual(originalDueDate, board.DueDate) {
+			changes = append(changes, BoardChange{Field: "dueDate", OldValue: formatDatePtr(originalDueDate), NewValue: formatDatePtr(board.DueDate)})
 		}
+		if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) {
+			changes = append(changes, BoardChange{Field: "assignee", OldValue: formatUUIDPtr(originalAssigneeID), NewValue: formatUUIDPtr(board.AssigneeID)})
+		}
+		if req.LabelIDs != nil {
+			if newLabelNames := labelNames(board.Labels); newLabelNames != originalLabelNames {
+				changes = append(changes, BoardChange{Field: "labels", OldValue: originalLabelNames, NewValue: newLabelNames})
+			}
+		}
+
+		// Check customFields changes (stage, role, importance, etc.)
+		if req.CustomFields != nil {
+			var newCustomFields map[string]interface{}
+			if len(board.CustomFields) > 0 {
+				_ = json.Unmarshal(board.CustomFields, &newCustomFields)
+			}
 
-		// Convert UUIDs to human-readable labels (Korean) for notification display
-		originalReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, originalCustomFields)
-		newReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, newCustomFields)
-
-		for key, newVal := range newCustomFields {
-			oldVal, existed := originalCustomFields[key]
-			if !existed || oldVal != newVal {
-				// Use readable values for display
-				oldReadableVal := formatInterface(originalReadable[key])
-				newReadableVal := formatInterface(newReadable[key])
-				changes = append(changes, BoardChange{
-					Field:    key,
-					OldValue: oldReadableVal,
-					NewValue: newReadableVal,
-				})
+			// Convert UUIDs to human-readable labels (Korean) for notification display
+			originalReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, originalCustomFields)
+			newReadable, _ := s.fieldOptionConverter.ConvertIDsToLabels(ctx, newCustomFields)
+
+			for key, newVal := range newCustomFields {
+				oldVal, existed := originalCustomFields[key]
+				if !existed || oldVal != newVal {
+					// Use readable values for display
+					oldReadableVal := formatInterface(originalReadable[key])
+					newReadableVal := formatInterface(newReadable[key])
+					changes = append(changes, BoardChange{
+						Field:    key,
+						OldValue: oldReadableVal,
+						NewValue: newReadableVal,
+					})
+				}
 			}
 		}
-	}
 
-	// Send notifications for board update
+		// Send notifications for board update
 
-	// 1. Notify new assignee if assignee changed
-	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) && board.AssigneeID != nil {
-		s.sendAssigneeNotification(ctx, board, actorID)
-	}
+		// 1. Notify new assignee if assignee changed
+		if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) && board.AssigneeID != nil {
+			if err := s.sendAssigneeNotification(ctx, board, actorID); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+			}
+		}
 
-	// 2. Notify new participants (those who weren't participants before)
-	if req.Participants != nil && len(req.Participants) > 0 {
-		var newParticipantIDs []uuid.UUID
-		for _, pid := range req.Participants {
-			if !originalParticipantIDs[pid] {
-				newParticipantIDs = append(newParticipantIDs, pid)
+		// 2. Notify new participants (those who weren't participants before)
+		if req.Participants != nil && len(req.Participants) > 0 {
+			var newParticipantIDs []uuid.UUID
+			for _, pid := range req.Participants {
+				if !originalParticipantIDs[pid] {
+					newParticipantIDs = append(newParticipantIDs, pid)
+				}
+			}
+			if len(newParticipantIDs) > 0 {
+				if err := s.sendParticipantAddedNotifications(ctx, board, newParticipantIDs, actorID); err != nil {
+					return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+				}
 			}
 		}
-		if len(newParticipantIDs) > 0 {
-			s.sendParticipantAddedNotifications(ctx, board, newParticipantIDs, actorID)
+
+		// 3. Notify the board's watchers about the update (excluding actor) with changes
+		if len(changes) > 0 {
+			if err := s.sendBoardUpdateNotifications(ctx, board, actorID, changes); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+			}
 		}
+
+		// Convert to response DTO
+		resp = s.toBoardResponseWithWorkspace(ctx, board)
+		var eventType string
+		var payload interface{}
+		if opts.moved != nil {
+			eventType, payload = domain.WebhookEventBoardMoved, opts.moved
+		} else {
+			eventType, payload = domain.WebhookEventBoardUpdated, resp
+		}
+		if err := recordBoardBroadcast(ctx, s.outbox, board.ProjectID, board.ID, eventType, payload); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board event", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Notify all assignee + participants about the update (excluding actor) with changes
-	if len(changes) > 0 {
-		s.sendBoardUpdateNotifications(ctx, board, actorID, changes)
+	// Being assigned subscribes the new assignee to the board
+	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) && board.AssigneeID != nil && s.watchers != nil {
+		s.watchers.AutoWatch(ctx, board.ID, *board.AssigneeID, domain.WatchReasonAssigned)
+	}
+
+	// 4. Re-index links to other boards when the content changed
+	if s.references != nil && req.Content != nil && originalContent != board.Content {
+		s.references.IndexBoard(ctx, board)
+	}
+
+	// 5. Run FIELD_CHANGED automation rules in the background
+	// Automation updates are skipped here so that the engine can track the chain depth
+	if opts.automation {
+		return resp, nil
+	}
+	if changedFields := s.automationChangedFields(req, changes, originalCustomFields, board); len(changedFields) > 0 {
+		s.dispatchAutomation(ctx, AutomationEvent{
+			Trigger:       domain.AutomationTriggerFieldChanged,
+			ProjectID:     board.ProjectID,
+			BoardID:       board.ID,
+			ActorID:       actorID,
+			ChangedFields: changedFields,
+		})
 	}
 
-	// Convert to response DTO
-	return s.toBoardResponseWithWorkspace(ctx, board), nil
+	return resp, nil
 }
 
 // DeleteBoard soft deletes a board and its associated attachments
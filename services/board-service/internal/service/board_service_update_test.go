@@ -122,7 +122,7 @@ func TestBoardService_UpdateBoard(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.UpdateBoard(context.Background(), tt.boardID, tt.req)
@@ -225,7 +225,7 @@ func TestBoardService_UpdateBoard_CustomFields(t *testing.T) {
 			mockConverter := &MockFieldOptionConverter{}
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 			req := &dto.UpdateBoardRequest{
 				CustomFields: &tt.updateFields,
@@ -272,6 +272,49 @@ func TestBoardService_UpdateBoard_CustomFields(t *testing.T) {
 	}
 }
 
+func TestBoardService_MoveBoard_RecordsMoveEvent(t *testing.T) {
+	boardID := uuid.New()
+	projectID := uuid.New()
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			customFieldsJSON, _ := json.Marshal(map[string]interface{}{"stage": "in_progress"})
+			return &domain.Board{
+				BaseModel:    domain.BaseModel{ID: boardID},
+				ProjectID:    projectID,
+				Title:        "Test Board",
+				CustomFields: customFieldsJSON,
+			}, nil
+		},
+	}
+	store := newOutboxTestStore()
+	broadcaster := &recordingBroadcaster{}
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(store, &recordingNotiClient{}, broadcaster, &now)
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, &MockFieldOptionConverter{}, nil, outbox, nil, nil, nil, nil, zap.NewNop())
+
+	fields := map[string]interface{}{"stage": "approved"}
+	moved := &dto.BoardMovedPayload{From: "in_progress", To: "approved", BoardKey: "PRJ-1"}
+	if _, err := service.MoveBoard(context.Background(), boardID, &dto.UpdateBoardRequest{CustomFields: &fields}, moved); err != nil {
+		t.Fatalf("MoveBoard() error = %v", err)
+	}
+	if _, err := outbox.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+
+	// Only BOARD_MOVED is recorded, in place of BOARD_UPDATED
+	if len(broadcaster.messages) != 1 {
+		t.Fatalf("expected 1 broadcast, got %d", len(broadcaster.messages))
+	}
+	event := broadcaster.messages[0]
+	if event.Type != domain.WebhookEventBoardMoved || event.BoardID != boardID.String() {
+		t.Errorf("expected BOARD_MOVED for %s, got %s for %s", boardID, event.Type, event.BoardID)
+	}
+	payload, _ := event.Payload.(map[string]interface{})
+	if payload["from"] != "in_progress" || payload["to"] != "approved" || payload["boardKey"] != "PRJ-1" {
+		t.Errorf("unexpected move payload %v", event.Payload)
+	}
+}
+
 func TestUpdateBoard_DateValidation(t *testing.T) {
 	boardID := uuid.New()
 	projectID := uuid.New()
@@ -297,7 +340,7 @@ func TestUpdateBoard_DateValidation(t *testing.T) {
 
 	mockParticipantRepo := &MockParticipantRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 	ctx := context.Background()
 
@@ -351,7 +394,7 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 
 	mockParticipantRepo := &MockParticipantRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, nil, mockConverter, nil, nil, nil, nil, nil, nil, logger)
 
 	ctx := context.Background()
 
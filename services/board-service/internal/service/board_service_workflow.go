@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// stageValueOf returns the stage value of ID-based customFields ("" if the board has no stage)
+func (s *boardServiceImpl) stageValueOf(ctx context.Context, customFields map[string]interface{}) string {
+	stageID, ok := customFields[string(domain.FieldTypeStage)]
+	if !ok || stageID == nil {
+		return ""
+	}
+
+	converted, err := s.fieldOptionConverter.ConvertIDsToValues(ctx, map[string]interface{}{
+		string(domain.FieldTypeStage): stageID,
+	})
+	if err != nil {
+		return formatInterface(stageID)
+	}
+	return formatInterface(converted[string(domain.FieldTypeStage)])
+}
+
+// stageCategory returns the category of a stage value in the board's project
+func (s *boardServiceImpl) stageCategory(ctx context.Context, projectID uuid.UUID, stage string) (domain.StageCategory, error) {
+	categories, err := s.fieldOptionConverter.StageCategories(ctx, projectID)
+	if err != nil {
+		return "", response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+	return boardStages{projectID: categories}.category(projectID, stage), nil
+}
+
+// memberHasWorkflowRole reports whether the member's built-in role or assigned custom role is allowed
+func memberHasWorkflowRole(member *domain.ProjectMember, allowedRoles []string) bool {
+	if containsString(allowedRoles, string(member.RoleName)) {
+		return true
+	}
+	return member.CustomRole != nil && containsString(allowedRoles, member.CustomRole.Name)
+}
+
+// validateStageTransition enforces the project's workflow rules when a board moves between stages
+// board must already hold the effective (post-update) values; participantCount is the effective participant count
+// Projects without any declared transition allow free movement
+func (s *boardServiceImpl) validateStageTransition(ctx context.Context, board *domain.Board, fromStage, toStage string, participantCount int, actorID uuid.UUID) error {
+	if s.workflowRepo == nil || fromStage == toStage {
+		return nil
+	}
+
+	transitions, err := s.workflowRepo.FindByProjectID(ctx, board.ProjectID)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch workflow transitions", err.Error())
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	// Exact source stage takes precedence over the wildcard
+	var matched *domain.WorkflowTransition
+	for _, t := range transitions {
+		if t.ToStage != toStage {
+			continue
+		}
+		if t.FromStage == fromStage {
+			matched = t
+			break
+		}
+		if t.FromStage == domain.WorkflowAnyStage && matched == nil {
+			matched = t
+		}
+	}
+	if matched == nil {
+		return response.NewValidationError(
+			fmt.Sprintf("Transition from '%s' to '%s' is not allowed", fromStage, toStage), "")
+	}
+
+	// Role restriction
+	if allowedRoles := decodeStringList(matched.AllowedRoles); len(allowedRoles) > 0 {
+		member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, board.ProjectID, actorID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return response.NewForbiddenError("You are not a member of this project", "")
+			}
+			return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+		}
+		if member == nil || !memberHasWorkflowRole(member, allowedRoles) {
+			return response.NewForbiddenError(
+				fmt.Sprintf("Your role is not allowed to move boards to '%s'", toStage), "")
+		}
+	}
+
+	// Required fields
+	var customFields map[string]interface{}
+	if len(board.CustomFields) > 0 {
+		_ = json.Unmarshal(board.CustomFields, &customFields)
+	}
+	for _, field := range decodeStringList(matched.RequiredFields) {
+		if !isWorkflowFieldSet(board, customFields, participantCount, field) {
+			return response.NewValidationError(
+				fmt.Sprintf("Field '%s' is required to move to '%s'", field, toStage), "")
+		}
+	}
+
+	return nil
+}
+
+// isWorkflowFieldSet reports whether a required workflow field has a value on the board
+func isWorkflowFieldSet(board *domain.Board, customFields map[string]interface{}, participantCount int, field string) bool {
+	switch field {
+	case WorkflowFieldAssignee:
+		return board.AssigneeID != nil && *board.AssigneeID != uuid.Nil
+	case WorkflowFieldStartDate:
+		return board.StartDate != nil
+	case WorkflowFieldDueDate:
+		return board.DueDate != nil
+	case WorkflowFieldContent:
+		return board.Content != ""
+	case WorkflowFieldParticipants:
+		return participantCount > 0
+	default:
+		value, ok := customFields[field]
+		return ok && value != nil && formatInterface(value) != ""
+	}
+}
+
+// containsString reports whether values contains target
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
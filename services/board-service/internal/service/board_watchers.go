@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// BoardWatchers resolves who is notified about board activity and keeps automatic watches up to date
+// Lookup failures are logged and never fail the operation that triggered the notification
+type BoardWatchers interface {
+	// AutoWatch subscribes a user to a board after they comment on it or are assigned to it
+	AutoWatch(ctx context.Context, boardID, userID uuid.UUID, reason domain.WatchReason)
+	// Audience returns the assignee, participants, board watchers and project watchers of a board,
+	// excluding the actor, the users who muted the board and users who are no longer project members
+	Audience(ctx context.Context, board *domain.Board, actorID uuid.UUID) []uuid.UUID
+	// Unmuted drops the users who muted the board from a list of direct recipients
+	Unmuted(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) []uuid.UUID
+}
+
+// boardWatchersImpl is the implementation of BoardWatchers
+type boardWatchersImpl struct {
+	watcherRepo repository.WatcherRepository
+	projectRepo repository.ProjectRepository
+	logger      *zap.Logger
+}
+
+// NewBoardWatchers creates a new instance of BoardWatchers
+func NewBoardWatchers(watcherRepo repository.WatcherRepository, projectRepo repository.ProjectRepository, logger *zap.Logger) BoardWatchers {
+	return &boardWatchersImpl{
+		watcherRepo: watcherRepo,
+		projectRepo: projectRepo,
+		logger:      logger,
+	}
+}
+
+// AutoWatch subscribes the user unless they already watch the board
+func (w *boardWatchersImpl) AutoWatch(ctx context.Context, boardID, userID uuid.UUID, reason domain.WatchReason) {
+	if userID == uuid.Nil {
+		return
+	}
+	watcher := &domain.Watcher{
+		TargetType: domain.WatchTargetBoard,
+		TargetID:   boardID,
+		UserID:     userID,
+		Reason:     reason,
+	}
+	if _, err := w.watcherRepo.Watch(ctx, watcher); err != nil {
+		w.logger.Warn("Failed to auto-watch board",
+			zap.String("board.id", boardID.String()),
+			zap.String("user.id", userID.String()),
+			zap.String("reason", string(reason)),
+			zap.Error(err))
+	}
+}
+
+// Audience merges the implicit audience of the board with its explicit watchers
+func (w *boardWatchersImpl) Audience(ctx context.Context, board *domain.Board, actorID uuid.UUID) []uuid.UUID {
+	candidates := implicitBoardAudience(board)
+
+	watcherIDs, err := w.watcherRepo.FindBoardWatcherUserIDs(ctx, board.ID, board.ProjectID)
+	if err != nil {
+		w.logger.Warn("Failed to get board watchers",
+			zap.String("board.id", board.ID.String()),
+			zap.Error(err))
+	}
+	candidates = append(candidates, watcherIDs...)
+
+	audience := make([]uuid.UUID, 0, len(candidates))
+	for _, userID := range removeDuplicateUUIDs(candidates) {
+		if userID != actorID {
+			audience = append(audience, userID)
+		}
+	}
+	return w.Unmuted(ctx, board.ID, w.members(ctx, board.ProjectID, audience))
+}
+
+// members keeps the order of the given users and drops those who are not members of the project
+// When membership cannot be checked nobody is returned, so former members never receive board activity
+func (w *boardWatchersImpl) members(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) []uuid.UUID {
+	if len(userIDs) == 0 {
+		return userIDs
+	}
+
+	memberIDs, err := w.projectRepo.FindMemberUserIDs(ctx, projectID, userIDs)
+	if err != nil {
+		w.logger.Warn("Failed to check project membership of board audience",
+			zap.String("project.id", projectID.String()),
+			zap.Error(err))
+		return nil
+	}
+
+	isMember := make(map[uuid.UUID]bool, len(memberIDs))
+	for _, id := range memberIDs {
+		isMember[id] = true
+	}
+	result := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if isMember[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// Unmuted keeps the order of the given users
+// When mutes cannot be loaded the users are returned unchanged rather than dropping notifications
+func (w *boardWatchersImpl) Unmuted(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) []uuid.UUID {
+	if len(userIDs) == 0 {
+		return userIDs
+	}
+
+	mutedIDs, err := w.watcherRepo.FindMutedUserIDs(ctx, boardID)
+	if err != nil {
+		w.logger.Warn("Failed to get board mutes",
+			zap.String("board.id", boardID.String()),
+			zap.Error(err))
+		return userIDs
+	}
+	if len(mutedIDs) == 0 {
+		return userIDs
+	}
+
+	muted := make(map[uuid.UUID]bool, len(mutedIDs))
+	for _, id := range mutedIDs {
+		muted[id] = true
+	}
+	result := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if !muted[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// implicitBoardAudience returns the assignee and participants, who are notified without watching
+func implicitBoardAudience(board *domain.Board) []uuid.UUID {
+	userIDs := make([]uuid.UUID, 0, len(board.Participants)+1)
+	if board.AssigneeID != nil {
+		userIDs = append(userIDs, *board.AssigneeID)
+	}
+	for _, p := range board.Participants {
+		userIDs = append(userIDs, p.UserID)
+	}
+	return userIDs
+}
+
+// boardAudience resolves the recipients of a board activity notification
+// Without watcher support it falls back to the assignee and participants
+func boardAudience(ctx context.Context, watchers BoardWatchers, board *domain.Board, actorID uuid.UUID) []uuid.UUID {
+	if watchers != nil {
+		return watchers.Audience(ctx, board, actorID)
+	}
+	audience := make([]uuid.UUID, 0)
+	for _, userID := range removeDuplicateUUIDs(implicitBoardAudience(board)) {
+		if userID != actorID {
+			audience = append(audience, userID)
+		}
+	}
+	return audience
+}
+
+// unmutedRecipients drops the users who muted the board from direct recipients such as a new assignee
+func unmutedRecipients(ctx context.Context, watchers BoardWatchers, boardID uuid.UUID, userIDs []uuid.UUID) []uuid.UUID {
+	if watchers == nil {
+		return userIDs
+	}
+	return watchers.Unmuted(ctx, boardID, userIDs)
+}
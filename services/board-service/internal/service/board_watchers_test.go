@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardWatchers_Audience(t *testing.T) {
+	projectID := uuid.New()
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	participantID := uuid.New()
+	boardWatcherID := uuid.New()
+	projectWatcherID := uuid.New()
+	mutedParticipantID := uuid.New()
+	formerMemberID := uuid.New()
+
+	board := &domain.Board{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		ProjectID:  projectID,
+		AssigneeID: &assigneeID,
+		Participants: []domain.Participant{
+			{UserID: participantID},
+			{UserID: mutedParticipantID},
+			{UserID: actorID},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		watchers BoardWatchers
+		want     []uuid.UUID
+	}{
+		{
+			name: "성공: 담당자, 참여자, 보드/프로젝트 구독자를 합치고 작성자, 음소거 사용자, 탈퇴한 멤버 제외",
+			watchers: NewBoardWatchers(&MockWatcherRepository{
+				FindBoardWatcherUserIDsFunc: func(ctx context.Context, bID, pID uuid.UUID) ([]uuid.UUID, error) {
+					if bID != board.ID || pID != projectID {
+						t.Errorf("unexpected board/project %s/%s", bID, pID)
+					}
+					return []uuid.UUID{boardWatcherID, formerMemberID, projectWatcherID, assigneeID}, nil
+				},
+				FindMutedUserIDsFunc: func(ctx context.Context, bID uuid.UUID) ([]uuid.UUID, error) {
+					return []uuid.UUID{mutedParticipantID}, nil
+				},
+			}, &MockProjectRepository{
+				FindMemberUserIDsFunc: func(ctx context.Context, pID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+					members := make([]uuid.UUID, 0, len(userIDs))
+					for _, id := range userIDs {
+						if id != formerMemberID {
+							members = append(members, id)
+						}
+					}
+					return members, nil
+				},
+			}, zap.NewNop()),
+			want: []uuid.UUID{assigneeID, participantID, boardWatcherID, projectWatcherID},
+		},
+		{
+			name:     "성공: 구독 기능이 없으면 담당자와 참여자에게만 알림",
+			watchers: nil,
+			want:     []uuid.UUID{assigneeID, participantID, mutedParticipantID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			got := boardAudience(context.Background(), tt.watchers, board, actorID)
+
+			// Then
+			if len(got) != len(tt.want) {
+				t.Fatalf("boardAudience() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("boardAudience()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCommentService_CreateComment_AutoWatch(t *testing.T) {
+	// Given
+	boardID := uuid.New()
+	userID := uuid.New()
+	var watched *domain.Watcher
+	watchers := NewBoardWatchers(&MockWatcherRepository{
+		WatchFunc: func(ctx context.Context, watcher *domain.Watcher) (bool, error) {
+			watched = watcher
+			return true, nil
+		},
+	}, &MockProjectRepository{}, zap.NewNop())
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}, ProjectID: uuid.New()}, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember)}
+	service := NewCommentService(&MockCommentRepository{}, mockBoardRepo, mockProjectRepo, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, watchers, zap.NewNop())
+
+	// When
+	_, err := service.CreateComment(context.Background(), userID, &dto.CreateCommentRequest{BoardID: boardID, Content: "LGTM"})
+
+	// Then
+	if err != nil {
+		t.Fatalf("CreateComment() unexpected error = %v", err)
+	}
+	if watched == nil {
+		t.Fatal("expected the comment author to watch the board")
+	}
+	if watched.TargetType != domain.WatchTargetBoard || watched.TargetID != boardID || watched.UserID != userID {
+		t.Errorf("unexpected watch %+v", watched)
+	}
+	if watched.Reason != domain.WatchReasonCommented {
+		t.Errorf("expected reason COMMENTED, got %s", watched.Reason)
+	}
+}
+
+func TestWatcherService_BoardStatus(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	assigneeID := uuid.New()
+	outsiderID := uuid.New()
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		muted        bool
+		watching     bool
+		wantNotified bool
+		wantInvolved bool
+	}{
+		{name: "성공: 담당자는 구독하지 않아도 알림 대상", userID: assigneeID, wantNotified: true, wantInvolved: true},
+		{name: "성공: 음소거한 담당자는 알림 대상 아님", userID: assigneeID, muted: true, wantInvolved: true},
+		{name: "성공: 구독한 비참여자는 알림 대상", userID: outsiderID, watching: true, wantNotified: true},
+		{name: "성공: 구독하지 않은 비참여자는 알림 대상 아님", userID: outsiderID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockWatcherRepo := &MockWatcherRepository{
+				FindWatcherFunc: func(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) (*domain.Watcher, error) {
+					if tt.watching && targetType == domain.WatchTargetBoard {
+						return &domain.Watcher{TargetType: targetType, TargetID: targetID, UserID: userID, Reason: domain.WatchReasonManual}, nil
+					}
+					return nil, nil
+				},
+				IsMutedFunc: func(ctx context.Context, bID, uID uuid.UUID) (bool, error) {
+					return tt.muted, nil
+				},
+			}
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID, AssigneeID: &assigneeID}, nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
+			}
+			service := NewWatcherService(mockWatcherRepo, mockBoardRepo, mockProjectRepo)
+
+			// When
+			status, err := service.GetBoardWatchStatus(context.Background(), boardID, tt.userID)
+
+			// Then
+			if err != nil {
+				t.Fatalf("GetBoardWatchStatus() unexpected error = %v", err)
+			}
+			if status.Notified != tt.wantNotified || status.Involved != tt.wantInvolved || status.Muted != tt.muted || status.Watching != tt.watching {
+				t.Errorf("GetBoardWatchStatus() = %+v", status)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
 	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
@@ -20,7 +21,7 @@ type CommentService interface {
 	CreateComment(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error)
 	GetComments(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
-	DeleteComment(ctx context.Context, commentID uuid.UUID) error
+	DeleteComment(ctx context.Context, commentID, userID uuid.UUID) error
 }
 
 // commentServiceImpl is the implementation of CommentService
@@ -31,6 +32,10 @@ type commentServiceImpl struct {
 	attachmentRepo repository.AttachmentRepository
 	s3Client       S3Client
 	notiClient     client.NotiClient
+	outbox         Outbox                // optional, nil sends notifications on a best-effort basis
+	automation     AutomationEngine      // optional, nil disables automation rules
+	references     BoardReferenceIndexer // optional, nil disables cross-reference tracking
+	watchers       BoardWatchers         // optional, nil notifies only the assignee and participants
 	logger         *zap.Logger
 }
 
@@ -42,6 +47,10 @@ func NewCommentService(
 	attachmentRepo repository.AttachmentRepository,
 	s3Client S3Client,
 	notiClient client.NotiClient,
+	outbox Outbox,
+	automation AutomationEngine,
+	references BoardReferenceIndexer,
+	watchers BoardWatchers,
 	logger *zap.Logger,
 ) CommentService {
 	return &commentServiceImpl{
@@ -51,6 +60,10 @@ func NewCommentService(
 		attachmentRepo: attachmentRepo,
 		s3Client:       s3Client,
 		notiClient:     notiClient,
+		outbox:         outbox,
+		automation:     automation,
+		references:     references,
+		watchers:       watchers,
 		logger:         logger,
 	}
 }
@@ -68,6 +81,12 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
+	if err := requirePermission(ctx, s.projectRepo, board.ProjectID, userID, domain.PermissionCommentCreate, "You do not have permission to comment on this board"); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, board.ProjectID); err != nil {
+		return nil, err
+	}
 
 	// Validate and confirm attachments if provided
 	if len(validAttachmentIDs) > 0 {
@@ -83,53 +102,84 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 		Content: req.Content,
 	}
 
-	// Save to repository
-	if err := s.commentRepo.Create(ctx, comment); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create comment", err.Error())
-	}
+	// Write the comment and its attachments together with the notifications
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		// Save to repository
+		if err := s.commentRepo.Create(ctx, comment); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create comment", err.Error())
+		}
 
-	// Confirm attachments after comment creation
-	var createdAttachments []*domain.Attachment
-	if len(validAttachmentIDs) > 0 {
-		// 에러 발생 시 comment도 롤백
-		if err := s.attachmentRepo.ConfirmAttachments(ctx, validAttachmentIDs, comment.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments, rolling back comment creation",
-				zap.String("comment_id", comment.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(validAttachmentIDs))
-					for i, id := range validAttachmentIDs {
-						ids[i] = id.String()
+		// Confirm attachments after comment creation
+		var createdAttachments []*domain.Attachment
+		if len(validAttachmentIDs) > 0 {
+			// 에러 발생 시 comment도 롤백
+			if err := s.attachmentRepo.ConfirmAttachments(ctx, validAttachmentIDs, comment.ID); err != nil {
+				s.logger.Error("Failed to confirm attachments, rolling back comment creation",
+					zap.String("comment_id", comment.ID.String()),
+					zap.Strings("attachment_ids", func() []string {
+						ids := make([]string, len(validAttachmentIDs))
+						for i, id := range validAttachmentIDs {
+							ids[i] = id.String()
+						}
+						return ids
+					}()),
+					zap.Error(err))
+
+				// comment 삭제 (롤백) - with an outbox the transaction rolls it back
+				if s.outbox == nil {
+					if deleteErr := s.commentRepo.Delete(ctx, comment.ID); deleteErr != nil {
+						s.logger.Error("Failed to rollback comment after attachment confirmation failure",
+							zap.String("comment_id", comment.ID.String()),
+							zap.Error(deleteErr))
 					}
-					return ids
-				}()),
-				zap.Error(err))
+				}
 
-			// comment 삭제 (롤백)
-			if deleteErr := s.commentRepo.Delete(ctx, comment.ID); deleteErr != nil {
-				s.logger.Error("Failed to rollback comment after attachment confirmation failure",
-					zap.String("comment_id", comment.ID.String()),
-					zap.Error(deleteErr))
+				return response.NewAppError(response.ErrCodeInternal,
+					"Failed to confirm attachments: "+err.Error(),
+					"Please ensure all attachment IDs are valid and not already used")
 			}
 
-			return nil, response.NewAppError(response.ErrCodeInternal,
-				"Failed to confirm attachments: "+err.Error(),
-				"Please ensure all attachment IDs are valid and not already used")
+			// Confirm 후 Attachments 메타데이터를 조회하여 comment 객체에 할당
+			attachments, err := s.attachmentRepo.FindByIDs(ctx, validAttachmentIDs)
+			if err != nil {
+				s.logger.Warn("Failed to fetch confirmed attachments for response", zap.Error(err))
+			} else {
+				createdAttachments = attachments
+			}
 		}
 
-		// Confirm 후 Attachments 메타데이터를 조회하여 comment 객체에 할당
-		attachments, err := s.attachmentRepo.FindByIDs(ctx, validAttachmentIDs)
-		if err != nil {
-			s.logger.Warn("Failed to fetch confirmed attachments for response", zap.Error(err))
-		} else {
-			createdAttachments = attachments
+		// 생성된 Attachments를 Comment 객체에 할당 (타입 변환 적용)
+		comment.Attachments = toDomainAttachments(createdAttachments)
+
+		// Send notification to the board's watchers (excluding comment author)
+		if err := s.sendCommentNotification(ctx, board, comment, userID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Commenting subscribes the author to further activity on the board
+	if s.watchers != nil {
+		s.watchers.AutoWatch(ctx, board.ID, userID, domain.WatchReasonCommented)
 	}
 
-	// 생성된 Attachments를 Comment 객체에 할당 (타입 변환 적용)
-	comment.Attachments = toDomainAttachments(createdAttachments)
+	// Record links to other boards found in the comment
+	if s.references != nil {
+		s.references.IndexComment(ctx, comment)
+	}
 
-	// Send notification to assignee and all participants (excluding comment author)
-	s.sendCommentNotification(ctx, board, comment, userID)
+	// Run COMMENT_ADDED automation rules in the background
+	if s.automation != nil {
+		s.automation.Dispatch(ctx, AutomationEvent{
+			Trigger:   domain.AutomationTriggerCommentAdded,
+			ProjectID: board.ProjectID,
+			BoardID:   board.ID,
+			ActorID:   userID,
+		})
+	}
 
 	// Convert to response DTO
 	return s.toCommentResponse(comment), nil
@@ -183,6 +233,9 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch comment", err.Error())
 	}
+	if err := s.ensureBoardWritable(ctx, comment.BoardID); err != nil {
+		return nil, err
+	}
 
 	// Validate and confirm attachments if provided
 	if len(validAttachmentIDs) > 0 {
@@ -220,6 +273,11 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 		}
 	}
 
+	// Re-index links to other boards found in the edited comment
+	if s.references != nil {
+		s.references.IndexComment(ctx, comment)
+	}
+
 	// comment와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
 	allAttachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeComment, comment.ID)
 	if err != nil {
@@ -235,15 +293,23 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 }
 
 // DeleteComment soft deletes a comment and its associated attachments
-func (s *commentServiceImpl) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+func (s *commentServiceImpl) DeleteComment(ctx context.Context, commentID, userID uuid.UUID) error {
 	// Verify comment exists
-	_, err := s.commentRepo.FindByID(ctx, commentID)
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return response.NewAppError(response.ErrCodeNotFound, "Comment not found", "")
 		}
 		return response.NewAppError(response.ErrCodeInternal, "Failed to verify comment", err.Error())
 	}
+	if comment != nil {
+		if err := s.checkCommentDelete(ctx, comment, userID); err != nil {
+			return err
+		}
+		if err := s.ensureBoardWritable(ctx, comment.BoardID); err != nil {
+			return err
+		}
+	}
 
 	// Find all attachments associated with this comment
 	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeComment, commentID)
@@ -264,9 +330,39 @@ func (s *commentServiceImpl) DeleteComment(ctx context.Context, commentID uuid.U
 		return response.NewAppError(response.ErrCodeInternal, "Failed to delete comment", err.Error())
 	}
 
+	// Drop the backlinks this comment created
+	if s.references != nil {
+		s.references.RemoveComment(ctx, commentID)
+	}
+
 	return nil
 }
 
+// ensureBoardWritable rejects comment changes on a board of an archived project
+func (s *commentServiceImpl) ensureBoardWritable(ctx context.Context, boardID uuid.UUID) error {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil || board == nil {
+		return nil
+	}
+	return ensureProjectWritable(ctx, s.projectRepo, board.ProjectID)
+}
+
+// checkCommentDelete lets authors delete their own comments and otherwise requires comment.delete.any
+// Without a board to resolve the project only the author may delete
+func (s *commentServiceImpl) checkCommentDelete(ctx context.Context, comment *domain.Comment, userID uuid.UUID) error {
+	board, err := s.boardRepo.FindByID(ctx, comment.BoardID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
+	}
+	if board == nil {
+		if comment.UserID != userID {
+			return response.NewForbiddenError("You can only delete your own comments", "")
+		}
+		return nil
+	}
+	return requireOwnOrPermission(ctx, s.projectRepo, board.ProjectID, userID, comment.UserID, domain.PermissionCommentDeleteAny, "You can only delete your own comments")
+}
+
 // toCommentResponse converts domain.Comment to dto.CommentResponse
 func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.CommentResponse {
 	// Convert attachments to response DTOs with s3Client.GetFileURL
@@ -274,6 +370,7 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 	for _, a := range comment.Attachments {
 		// s3Client.GetFileURL을 사용하여 FileURL 필드 채우기 (DB의 FileURL은 S3 Key)
 		fileURL := s.s3Client.GetFileURL(a.FileURL)
+		thumbnailURL, previewURL := attachmentImageURLs(s.s3Client, &a)
 
 		attachments = append(attachments, dto.AttachmentResponse{
 			ID:          a.ID,
@@ -283,6 +380,9 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+
+			ThumbnailURL: thumbnailURL,
+			PreviewURL:   previewURL,
 		})
 	}
 
@@ -366,6 +466,7 @@ func (s *commentServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		deleteDerivedFiles(ctx, s.s3Client, s.logger, attachment)
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -380,12 +481,12 @@ func (s *commentServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 	}
 }
 
-// sendCommentNotification sends a COMMENT_ADDED notification to the board assignee and all participants
-// Excludes the actor (the person who added the comment)
-// This is called asynchronously (in a goroutine) so notification failures don't affect the main business logic
-func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board *domain.Board, comment *domain.Comment, actorID uuid.UUID) {
+// sendCommentNotification records a COMMENT_ADDED notification for the board's watcher set
+// Excludes the actor (the person who added the comment) and users who muted the board
+// Notifications go through the outbox, so they commit or roll back together with the comment
+func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board *domain.Board, comment *domain.Comment, actorID uuid.UUID) error {
 	if s.notiClient == nil {
-		return
+		return nil
 	}
 
 	// Get project info for workspace ID
@@ -394,61 +495,37 @@ func (s *commentServiceImpl) sendCommentNotification(ctx context.Context, board
 		s.logger.Warn("Failed to get project for comment notification",
 			zap.String("board.id", board.ID.String()),
 			zap.Error(err))
-		return
+		return nil
 	}
 
-	// Collect all users to notify (assignee + participants), excluding actor
-	notifyUserIDs := make(map[uuid.UUID]bool)
-
-	// Add assignee if exists and not the actor
-	if board.AssigneeID != nil && *board.AssigneeID != actorID {
-		notifyUserIDs[*board.AssigneeID] = true
+	// Resolve the watcher set, excluding the actor and muted users
+	notifyUserIDs := boardAudience(ctx, s.watchers, board, actorID)
+	if len(notifyUserIDs) == 0 {
+		return nil
 	}
 
-	// Add participants if not the actor
-	for _, p := range board.Participants {
-		if p.UserID != actorID {
-			notifyUserIDs[p.UserID] = true
-		}
+	// Plain-text preview of the Markdown content (max 100 characters)
+	contentPreview := markdown.Preview(comment.Content, commentPreviewLength)
+
+	events := make([]*client.NotificationEvent, 0, len(notifyUserIDs))
+	for _, userID := range notifyUserIDs {
+		events = append(events, &client.NotificationEvent{
+			Type:         client.NotificationTypeBoardCommentAdded,
+			ActorID:      actorID,
+			TargetUserID: userID,
+			WorkspaceID:  project.WorkspaceID,
+			ResourceType: client.ResourceTypeBoard,
+			ResourceID:   board.ID,
+			ResourceName: &board.Title,
+			Metadata: map[string]interface{}{
+				"projectId":      board.ProjectID.String(),
+				"projectName":    project.Name,
+				"boardKey":       domain.FormatBoardKey(project.KeyPrefix, board.Number),
+				"commentId":      comment.ID.String(),
+				"commentPreview": contentPreview,
+			},
+		})
 	}
 
-	if len(notifyUserIDs) == 0 {
-		return
-	}
-
-	// Truncate comment content for notification preview (max 100 chars)
-	contentPreview := comment.Content
-	if len(contentPreview) > 100 {
-		contentPreview = contentPreview[:100] + "..."
-	}
-
-	// Send notifications asynchronously
-	go func() {
-		for userID := range notifyUserIDs {
-			event := &client.NotificationEvent{
-				Type:         client.NotificationTypeBoardCommentAdded,
-				ActorID:      actorID,
-				TargetUserID: userID,
-				WorkspaceID:  project.WorkspaceID,
-				ResourceType: client.ResourceTypeBoard,
-				ResourceID:   board.ID,
-				ResourceName: &board.Title,
-				Metadata: map[string]interface{}{
-					"projectId":      board.ProjectID.String(),
-					"projectName":    project.Name,
-					"commentId":      comment.ID.String(),
-					"commentPreview": contentPreview,
-				},
-			}
-
-			// Use background context to avoid cancellation when request completes
-			if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
-				s.logger.Warn("Failed to send comment notification",
-					zap.String("board.id", board.ID.String()),
-					zap.String("comment.id", comment.ID.String()),
-					zap.String("target.user.id", userID.String()),
-					zap.Error(err))
-			}
-		}
-	}()
+	return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateComment, comment.ID, events)
 }
@@ -89,7 +89,7 @@ func TestCommentService_UpdateComment(t *testing.T) {
 			tt.mockComment(mockCommentRepo)
 
 			logger, _ := zap.NewDevelopment()
-			service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, nil, nil, logger)
+			service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.UpdateComment(context.Background(), tt.commentID, tt.req)
@@ -124,6 +124,7 @@ func TestCommentService_UpdateComment(t *testing.T) {
 
 func TestCommentService_DeleteComment(t *testing.T) {
 	commentID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name        string
@@ -139,6 +140,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
 					return &domain.Comment{
 						BaseModel: domain.BaseModel{ID: commentID},
+						UserID:    userID,
 					}, nil
 				}
 				m.DeleteFunc = func(ctx context.Context, id uuid.UUID) error {
@@ -165,6 +167,7 @@ func TestCommentService_DeleteComment(t *testing.T) {
 				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
 					return &domain.Comment{
 						BaseModel: domain.BaseModel{ID: commentID},
+						UserID:    userID,
 					}, nil
 				}
 				m.DeleteFunc = func(ctx context.Context, id uuid.UUID) error {
@@ -184,10 +187,10 @@ func TestCommentService_DeleteComment(t *testing.T) {
 			tt.mockComment(mockCommentRepo)
 
 			logger, _ := zap.NewDevelopment()
-			service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, nil, nil, logger)
+			service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, nil, logger)
 
 			// When
-			err := service.DeleteComment(context.Background(), tt.commentID)
+			err := service.DeleteComment(context.Background(), tt.commentID, userID)
 
 			// Then
 			if tt.wantErr {
@@ -214,7 +217,7 @@ func TestCommentService_toCommentResponse_Attachments(t *testing.T) {
 	mockCommentRepo := &MockCommentRepository{}
 	mockBoardRepo := &MockBoardRepository{}
 	logger, _ := zap.NewDevelopment()
-	service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, &MockS3Client{}, nil, logger)
+	service := NewCommentService(mockCommentRepo, mockBoardRepo, &MockProjectRepository{}, &MockAttachmentRepository{}, &MockS3Client{}, nil, nil, nil, nil, nil, logger)
 
 	t.Run("첨부파일 변환: 여러 첨부파일", func(t *testing.T) {
 		commentID := uuid.New()
@@ -1,6 +1,13 @@
 package service
 
-import "project-board-api/internal/domain"
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
 
 // fieldOptionTemplate represents a template for creating field options
 type fieldOptionTemplate struct {
@@ -9,6 +16,7 @@ type fieldOptionTemplate struct {
 	Label        string
 	Color        string
 	DisplayOrder int
+	Category     domain.StageCategory // stage options only
 }
 
 // getDefaultFieldOptions returns hardcoded default field options
@@ -17,11 +25,11 @@ type fieldOptionTemplate struct {
 func getDefaultFieldOptions() []fieldOptionTemplate {
 	return []fieldOptionTemplate{
 		// Stage options (5개)
-		{FieldType: domain.FieldTypeStage, Value: "pending", Label: "대기", Color: "#F59E0B", DisplayOrder: 1},
-		{FieldType: domain.FieldTypeStage, Value: "in_progress", Label: "진행중", Color: "#3B82F6", DisplayOrder: 2},
-		{FieldType: domain.FieldTypeStage, Value: "review", Label: "검토", Color: "#8B5CF6", DisplayOrder: 3},
-		{FieldType: domain.FieldTypeStage, Value: "approved", Label: "완료", Color: "#10B981", DisplayOrder: 4},
-		{FieldType: domain.FieldTypeStage, Value: "deleted", Label: "삭제", Color: "#EF4444", DisplayOrder: 5},
+		{FieldType: domain.FieldTypeStage, Value: "pending", Label: "대기", Color: "#F59E0B", DisplayOrder: 1, Category: domain.StageCategoryOpen},
+		{FieldType: domain.FieldTypeStage, Value: "in_progress", Label: "진행중", Color: "#3B82F6", DisplayOrder: 2, Category: domain.StageCategoryOpen},
+		{FieldType: domain.FieldTypeStage, Value: "review", Label: "검토", Color: "#8B5CF6", DisplayOrder: 3, Category: domain.StageCategoryOpen},
+		{FieldType: domain.FieldTypeStage, Value: "approved", Label: "완료", Color: "#10B981", DisplayOrder: 4, Category: domain.StageCategoryDone},
+		{FieldType: domain.FieldTypeStage, Value: "deleted", Label: "삭제", Color: "#EF4444", DisplayOrder: 5, Category: domain.StageCategoryCancelled},
 
 		// Importance options (4개)
 		{FieldType: domain.FieldTypeImportance, Value: "urgent", Label: "긴급", Color: "#EF4444", DisplayOrder: 1},
@@ -36,3 +44,61 @@ func getDefaultFieldOptions() []fieldOptionTemplate {
 		{FieldType: domain.FieldTypeRole, Value: "qa", Label: "QA", Color: "#06B6D4", DisplayOrder: 4},
 	}
 }
+
+// stageCategoryLoader loads the category of each stage value of a project
+type stageCategoryLoader interface {
+	StageCategories(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error)
+}
+
+// boardStages holds the stage categories of projects, keyed by project ID and stage value
+type boardStages map[uuid.UUID]map[string]domain.StageCategory
+
+// loadBoardStages loads the stage categories of every project the boards belong to
+func loadBoardStages(ctx context.Context, loader stageCategoryLoader, boards []*domain.Board) (boardStages, error) {
+	stages := make(boardStages)
+	for _, board := range boards {
+		if _, ok := stages[board.ProjectID]; ok {
+			continue
+		}
+		categories, err := loader.StageCategories(ctx, board.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		stages[board.ProjectID] = categories
+	}
+	return stages, nil
+}
+
+// stageCategoriesOf keys the categories of already loaded stage options by value
+func stageCategoriesOf(options []*domain.FieldOption) map[string]domain.StageCategory {
+	categories := make(map[string]domain.StageCategory, len(options))
+	for _, option := range options {
+		categories[option.Value] = option.Category
+	}
+	return categories
+}
+
+// category returns the category of a stage value in a project; values without an option count as open
+func (s boardStages) category(projectID uuid.UUID, stage string) domain.StageCategory {
+	if category, ok := s[projectID][stage]; ok && category != "" {
+		return category
+	}
+	return domain.StageCategoryOpen
+}
+
+// isBoardClosed reports whether a board (with converted custom fields) is in a closed stage
+func (s boardStages) isBoardClosed(board *domain.Board) bool {
+	return s.category(board.ProjectID, customFieldValue(board, string(domain.FieldTypeStage))).IsClosed()
+}
+
+// trackBoardCompletion keeps CompletedAt in step with the category of a stage written to the board
+// Entering a done stage stamps now (kept when already completed); any other stage clears it
+func trackBoardCompletion(board *domain.Board, category domain.StageCategory, now time.Time) {
+	if category != domain.StageCategoryDone {
+		board.CompletedAt = nil
+		return
+	}
+	if board.CompletedAt == nil {
+		board.CompletedAt = &now
+	}
+}
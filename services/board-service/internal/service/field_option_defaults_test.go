@@ -2,6 +2,10 @@ package service
 
 import (
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
 
 	"project-board-api/internal/domain"
 )
@@ -241,3 +245,92 @@ func TestGetDefaultFieldOptions(t *testing.T) {
 		}
 	})
 }
+
+func TestTrackBoardCompletion(t *testing.T) {
+	completedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 19, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   *time.Time
+		category domain.StageCategory
+		want     *time.Time
+	}{
+		{"성공: 완료 단계 진입 시 완료 시각 기록", nil, domain.StageCategoryDone, &now},
+		{"성공: 이미 완료된 보드는 완료 시각 유지", &completedAt, domain.StageCategoryDone, &completedAt},
+		{"성공: 완료 단계에서 벗어나면 완료 시각 초기화", &completedAt, domain.StageCategoryOpen, nil},
+		{"성공: 취소 단계는 완료로 보지 않음", nil, domain.StageCategoryCancelled, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := &domain.Board{CompletedAt: tt.before}
+			trackBoardCompletion(board, tt.category, now)
+			if (board.CompletedAt == nil) != (tt.want == nil) || (tt.want != nil && !board.CompletedAt.Equal(*tt.want)) {
+				t.Errorf("CompletedAt = %v, want %v", board.CompletedAt, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDefaultFieldOptions_StageCategories(t *testing.T) {
+	want := map[string]domain.StageCategory{
+		"pending":     domain.StageCategoryOpen,
+		"in_progress": domain.StageCategoryOpen,
+		"review":      domain.StageCategoryOpen,
+		"approved":    domain.StageCategoryDone,
+		"deleted":     domain.StageCategoryCancelled,
+	}
+	for _, option := range getDefaultFieldOptions() {
+		if option.FieldType != domain.FieldTypeStage {
+			if option.Category != "" {
+				t.Errorf("%s option %s: category %q, want none", option.FieldType, option.Value, option.Category)
+			}
+			continue
+		}
+		if option.Category != want[option.Value] {
+			t.Errorf("stage %s: category %q, want %q", option.Value, option.Category, want[option.Value])
+		}
+	}
+}
+
+func TestBoardStages_IsBoardClosed(t *testing.T) {
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	stages := boardStages{
+		projectID: stageCategoriesOf([]*domain.FieldOption{
+			{Value: "todo", Category: domain.StageCategoryOpen},
+			{Value: "shipped", Category: domain.StageCategoryDone},
+			{Value: "dropped", Category: domain.StageCategoryCancelled},
+			{Value: "approved", Category: domain.StageCategoryOpen},
+			{Value: "legacy"},
+		}),
+		otherProjectID: {"approved": domain.StageCategoryDone},
+	}
+
+	tests := []struct {
+		name      string
+		projectID uuid.UUID
+		stage     string
+		want      bool
+	}{
+		{"성공: 완료 분류의 사용자 정의 단계는 닫힘", projectID, "shipped", true},
+		{"성공: 취소 분류 단계는 닫힘", projectID, "dropped", true},
+		{"성공: 진행 분류 단계는 열림", projectID, "todo", false},
+		{"성공: 진행 분류로 바꾼 approved 단계는 열림", projectID, "approved", false},
+		{"성공: 분류가 없는 단계는 열림", projectID, "legacy", false},
+		{"성공: 옵션이 없는 단계는 열림", projectID, "unknown", false},
+		{"성공: 단계가 없는 보드는 열림", projectID, "", false},
+		{"성공: 프로젝트마다 분류를 따로 적용", otherProjectID, "approved", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := &domain.Board{ProjectID: tt.projectID}
+			if tt.stage != "" {
+				board.CustomFields = datatypes.JSON(`{"stage":"` + tt.stage + `"}`)
+			}
+			if got := stages.isBoardClosed(board); got != tt.want {
+				t.Errorf("isBoardClosed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,19 +18,21 @@ import (
 type FieldOptionService interface {
 	GetFieldOptions(ctx context.Context, fieldType domain.FieldType) ([]*dto.FieldOptionResponse, error)
 	CreateFieldOption(ctx context.Context, req *dto.CreateFieldOptionRequest) (*dto.FieldOptionResponse, error)
-	UpdateFieldOption(ctx context.Context, optionID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error)
-	DeleteFieldOption(ctx context.Context, optionID uuid.UUID) error
+	UpdateFieldOption(ctx context.Context, optionID, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error)
+	DeleteFieldOption(ctx context.Context, optionID, userID uuid.UUID) error
 }
 
 // fieldOptionServiceImpl is the implementation of FieldOptionService
 type fieldOptionServiceImpl struct {
 	fieldOptionRepo repository.FieldOptionRepository
+	projectRepo     repository.ProjectRepository
 }
 
 // NewFieldOptionService creates a new instance of FieldOptionService
-func NewFieldOptionService(fieldOptionRepo repository.FieldOptionRepository) FieldOptionService {
+func NewFieldOptionService(fieldOptionRepo repository.FieldOptionRepository, projectRepo repository.ProjectRepository) FieldOptionService {
 	return &fieldOptionServiceImpl{
 		fieldOptionRepo: fieldOptionRepo,
+		projectRepo:     projectRepo,
 	}
 }
 
@@ -64,6 +66,11 @@ func (s *fieldOptionServiceImpl) CreateFieldOption(ctx context.Context, req *dto
 		return nil, response.NewValidationError(fmt.Sprintf("Invalid field type: %s", req.FieldType), "")
 	}
 
+	category, err := fieldOptionCategory(fieldType, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check for duplicate value within the same field type
 	existingOptions, err := s.fieldOptionRepo.FindByFieldType(ctx, fieldType)
 	if err != nil {
@@ -84,6 +91,7 @@ func (s *fieldOptionServiceImpl) CreateFieldOption(ctx context.Context, req *dto
 		Color:           req.Color,
 		DisplayOrder:    req.DisplayOrder,
 		IsSystemDefault: false, // User-created options are never system defaults
+		Category:        category,
 	}
 
 	// Save to repository
@@ -96,7 +104,7 @@ func (s *fieldOptionServiceImpl) CreateFieldOption(ctx context.Context, req *dto
 }
 
 // UpdateFieldOption updates an existing field option
-func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
+func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID, userID uuid.UUID, req *dto.UpdateFieldOptionRequest) (*dto.FieldOptionResponse, error) {
 	// Fetch field option from repository
 	fieldOption, err := s.fieldOptionRepo.FindByID(ctx, optionID)
 	if err != nil {
@@ -105,6 +113,9 @@ func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field option", err.Error())
 	}
+	if err := s.ensureCanManageOption(ctx, fieldOption, userID); err != nil {
+		return nil, err
+	}
 
 	// Update fields if provided
 	if req.Label != nil {
@@ -116,6 +127,13 @@ func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID
 	if req.DisplayOrder != nil {
 		fieldOption.DisplayOrder = *req.DisplayOrder
 	}
+	if req.Category != nil {
+		category, err := fieldOptionCategory(fieldOption.FieldType, *req.Category)
+		if err != nil {
+			return nil, err
+		}
+		fieldOption.Category = category
+	}
 
 	// Save to repository
 	if err := s.fieldOptionRepo.Update(ctx, fieldOption); err != nil {
@@ -127,7 +145,7 @@ func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID
 }
 
 // DeleteFieldOption soft deletes a field option (prevents deletion of system defaults)
-func (s *fieldOptionServiceImpl) DeleteFieldOption(ctx context.Context, optionID uuid.UUID) error {
+func (s *fieldOptionServiceImpl) DeleteFieldOption(ctx context.Context, optionID, userID uuid.UUID) error {
 	// Fetch field option from repository
 	fieldOption, err := s.fieldOptionRepo.FindByID(ctx, optionID)
 	if err != nil {
@@ -136,6 +154,9 @@ func (s *fieldOptionServiceImpl) DeleteFieldOption(ctx context.Context, optionID
 		}
 		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch field option", err.Error())
 	}
+	if err := s.ensureCanManageOption(ctx, fieldOption, userID); err != nil {
+		return err
+	}
 
 	// Prevent deletion of system default options
 	if fieldOption.IsSystemDefault {
@@ -150,6 +171,18 @@ func (s *fieldOptionServiceImpl) DeleteFieldOption(ctx context.Context, optionID
 	return nil
 }
 
+// ensureCanManageOption requires field_option.manage for a project-scoped option
+// and rejects changes while its project is archived
+func (s *fieldOptionServiceImpl) ensureCanManageOption(ctx context.Context, option *domain.FieldOption, userID uuid.UUID) error {
+	if option.ProjectID == nil || s.projectRepo == nil {
+		return nil
+	}
+	if err := requirePermission(ctx, s.projectRepo, *option.ProjectID, userID, domain.PermissionFieldOptionManage, "You do not have permission to manage field options"); err != nil {
+		return err
+	}
+	return ensureProjectWritable(ctx, s.projectRepo, *option.ProjectID)
+}
+
 // toFieldOptionResponse converts domain.FieldOption to dto.FieldOptionResponse
 func (s *fieldOptionServiceImpl) toFieldOptionResponse(option *domain.FieldOption) *dto.FieldOptionResponse {
 	return &dto.FieldOptionResponse{
@@ -160,11 +193,30 @@ func (s *fieldOptionServiceImpl) toFieldOptionResponse(option *domain.FieldOptio
 		Color:           option.Color,
 		DisplayOrder:    option.DisplayOrder,
 		IsSystemDefault: option.IsSystemDefault,
+		Category:        string(option.Category),
 		CreatedAt:       option.CreatedAt,
 		UpdatedAt:       option.UpdatedAt,
 	}
 }
 
+// fieldOptionCategory validates a requested stage category: stage options default to open,
+// other field types take none
+func fieldOptionCategory(fieldType domain.FieldType, category string) (domain.StageCategory, error) {
+	if fieldType != domain.FieldTypeStage {
+		if category != "" {
+			return "", response.NewValidationError("Only stage options have a category", "")
+		}
+		return "", nil
+	}
+	if category == "" {
+		return domain.StageCategoryOpen, nil
+	}
+	if !domain.IsValidStageCategory(category) {
+		return "", response.NewValidationError(fmt.Sprintf("Invalid stage category: %s", category), "")
+	}
+	return domain.StageCategory(category), nil
+}
+
 // isValidFieldType validates if the field type is one of the allowed types
 func isValidFieldType(fieldType domain.FieldType) bool {
 	switch fieldType {
@@ -143,10 +143,10 @@ func TestFieldOptionService_UpdateFieldOption(t *testing.T) {
 			// Given
 			mockRepo := &MockFieldOptionRepository{}
 			tt.mockRepo(mockRepo)
-			service := NewFieldOptionService(mockRepo)
+			service := NewFieldOptionService(mockRepo, &MockProjectRepository{})
 
 			// When
-			got, err := service.UpdateFieldOption(context.Background(), tt.optionID, tt.req)
+			got, err := service.UpdateFieldOption(context.Background(), tt.optionID, uuid.New(), tt.req)
 
 			// Then
 			if tt.wantErr {
@@ -273,10 +273,10 @@ func TestFieldOptionService_DeleteFieldOption(t *testing.T) {
 			// Given
 			mockRepo := &MockFieldOptionRepository{}
 			tt.mockRepo(mockRepo)
-			service := NewFieldOptionService(mockRepo)
+			service := NewFieldOptionService(mockRepo, &MockProjectRepository{})
 
 			// When
-			err := service.DeleteFieldOption(context.Background(), tt.optionID)
+			err := service.DeleteFieldOption(context.Background(), tt.optionID, uuid.New())
 
 			// Then
 			if tt.wantErr {
@@ -297,3 +297,94 @@ func TestFieldOptionService_DeleteFieldOption(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldOptionService_StageCategory(t *testing.T) {
+	done := string(domain.StageCategoryDone)
+
+	tests := []struct {
+		name         string
+		create       *dto.CreateFieldOptionRequest
+		existing     *domain.FieldOption
+		update       *dto.UpdateFieldOptionRequest
+		wantCategory string
+		wantErrCode  string
+	}{
+		{
+			name:         "성공: 분류 없이 만든 Stage 옵션은 진행 분류",
+			create:       &dto.CreateFieldOptionRequest{FieldType: "stage", Value: "on_hold", Label: "보류", Color: "#F59E0B"},
+			wantCategory: string(domain.StageCategoryOpen),
+		},
+		{
+			name:         "성공: 완료 분류의 Stage 옵션 생성",
+			create:       &dto.CreateFieldOptionRequest{FieldType: "stage", Value: "shipped", Label: "배포", Color: "#10B981", Category: done},
+			wantCategory: done,
+		},
+		{
+			name:        "실패: Stage가 아닌 옵션에 분류 지정",
+			create:      &dto.CreateFieldOptionRequest{FieldType: "importance", Value: "blocker", Label: "차단", Color: "#EF4444", Category: done},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:         "성공: Stage 옵션을 완료 분류로 변경",
+			existing:     &domain.FieldOption{FieldType: domain.FieldTypeStage, Value: "shipped", Category: domain.StageCategoryOpen},
+			update:       &dto.UpdateFieldOptionRequest{Category: &done},
+			wantCategory: done,
+		},
+		{
+			name:        "실패: Role 옵션의 분류 변경",
+			existing:    &domain.FieldOption{FieldType: domain.FieldTypeRole, Value: "qa"},
+			update:      &dto.UpdateFieldOptionRequest{Category: &done},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var saved *domain.FieldOption
+			mockRepo := &MockFieldOptionRepository{
+				CreateFunc: func(ctx context.Context, fo *domain.FieldOption) error {
+					saved = fo
+					return nil
+				},
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.FieldOption, error) {
+					return tt.existing, nil
+				},
+				UpdateFunc: func(ctx context.Context, fo *domain.FieldOption) error {
+					saved = fo
+					return nil
+				},
+			}
+			service := NewFieldOptionService(mockRepo, &MockProjectRepository{})
+
+			// When
+			var (
+				got *dto.FieldOptionResponse
+				err error
+			)
+			if tt.create != nil {
+				got, err = service.CreateFieldOption(context.Background(), tt.create)
+			} else {
+				got, err = service.UpdateFieldOption(context.Background(), uuid.New(), uuid.New(), tt.update)
+			}
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Fatalf("error = %v, want code %s", err, tt.wantErrCode)
+				}
+				if saved != nil {
+					t.Error("option saved despite the invalid category")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error = %v", err)
+			}
+			if got.Category != tt.wantCategory || string(saved.Category) != tt.wantCategory {
+				t.Errorf("category = %q (saved %q), want %q", got.Category, saved.Category, tt.wantCategory)
+			}
+		})
+	}
+}
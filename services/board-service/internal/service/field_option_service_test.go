@@ -109,7 +109,7 @@ func TestFieldOptionService_GetFieldOptions(t *testing.T) {
 			// Given
 			mockRepo := &MockFieldOptionRepository{}
 			tt.mockRepo(mockRepo)
-			service := NewFieldOptionService(mockRepo)
+			service := NewFieldOptionService(mockRepo, &MockProjectRepository{})
 
 			// When
 			got, err := service.GetFieldOptions(context.Background(), tt.fieldType)
@@ -257,7 +257,7 @@ func TestFieldOptionService_CreateFieldOption(t *testing.T) {
 			// Given
 			mockRepo := &MockFieldOptionRepository{}
 			tt.mockRepo(mockRepo)
-			service := NewFieldOptionService(mockRepo)
+			service := NewFieldOptionService(mockRepo, &MockProjectRepository{})
 
 			// When
 			got, err := service.CreateFieldOption(context.Background(), tt.req)
@@ -0,0 +1,658 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// Fixtures shared by the service tests: in-memory stores behind the repository mocks,
+// so a test sets up a project, its members and boards without wiring every mock itself.
+
+// testCustomFields encodes board custom fields (kept as values, the mock converter does not convert them)
+func testCustomFields(fields map[string]interface{}) datatypes.JSON {
+	jsonBytes, _ := json.Marshal(fields)
+	return jsonBytes
+}
+
+// stageBoard returns a board of the project in the given stage ("" for a board without one)
+func stageBoard(projectID uuid.UUID, stage string) *domain.Board {
+	fields := map[string]interface{}{}
+	if stage != "" {
+		fields[string(domain.FieldTypeStage)] = stage
+	}
+	return &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    projectID,
+		Title:        "Board",
+		CustomFields: testCustomFields(fields),
+	}
+}
+
+// memberProjectRepo serves the project and the built-in roles of its members; other users are not members
+func memberProjectRepo(project *domain.Project, roles map[uuid.UUID]domain.ProjectRole) *MockProjectRepository {
+	return &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return project, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+			role, ok := roles[userID]
+			if !ok {
+				return nil, nil
+			}
+			return &domain.ProjectMember{ProjectID: projectID, UserID: userID, RoleName: role}, nil
+		},
+		IsProjectMemberFunc: func(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
+			_, ok := roles[userID]
+			return ok, nil
+		},
+	}
+}
+
+// testOutbox returns an outbox recording into store, or no outbox when store is nil
+func testOutbox(store *outboxTestStore) Outbox {
+	if store == nil {
+		return nil
+	}
+	return NewOutbox(store, outboxTestTx{}, nil, &recordingBroadcaster{}, nil, zap.NewNop())
+}
+
+// sprintFixture keeps sprints and boards in memory behind the repository mocks
+type sprintFixture struct {
+	projectID uuid.UUID
+	adminID   uuid.UUID
+	memberID  uuid.UUID
+	sprints   map[uuid.UUID]*domain.Sprint
+	order     []uuid.UUID
+	boards    []*domain.Board
+	changes   []*domain.SprintScopeChange
+	store     *outboxTestStore
+}
+
+func newSprintFixture() *sprintFixture {
+	return &sprintFixture{
+		projectID: uuid.New(),
+		adminID:   uuid.New(),
+		memberID:  uuid.New(),
+		sprints:   make(map[uuid.UUID]*domain.Sprint),
+		store:     newOutboxTestStore(),
+	}
+}
+
+func (f *sprintFixture) addSprint(name string, state domain.SprintState, startDay int) *domain.Sprint {
+	sprint := &domain.Sprint{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: f.projectID,
+		Name:      name,
+		State:     state,
+		StartDate: *timelineDate(startDay),
+		EndDate:   *timelineDate(startDay + 13),
+	}
+	f.sprints[sprint.ID] = sprint
+	f.order = append(f.order, sprint.ID)
+	return sprint
+}
+
+func (f *sprintFixture) addBoard(sprint *domain.Sprint, stage string) *domain.Board {
+	board := stageBoard(f.projectID, stage)
+	board.Number = int64(len(f.boards) + 1)
+	if sprint != nil {
+		id := sprint.ID
+		board.SprintID = &id
+	}
+	f.boards = append(f.boards, board)
+	return board
+}
+
+func (f *sprintFixture) service() SprintService {
+	projectRepo := memberProjectRepo(
+		&domain.Project{BaseModel: domain.BaseModel{ID: f.projectID}, KeyPrefix: "WEB"},
+		map[uuid.UUID]domain.ProjectRole{f.adminID: domain.ProjectRoleAdmin, f.memberID: domain.ProjectRoleMember},
+	)
+	boardRepo := &MockBoardRepository{
+		FindByIDsInProjectFunc: func(ctx context.Context, pID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error) {
+			wanted := make(map[uuid.UUID]bool, len(ids))
+			for _, id := range ids {
+				wanted[id] = true
+			}
+			var found []*domain.Board
+			for _, board := range f.boards {
+				if wanted[board.ID] {
+					found = append(found, board)
+				}
+			}
+			return found, nil
+		},
+	}
+	sprintRepo := &MockSprintRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Sprint, error) {
+			if sprint, ok := f.sprints[id]; ok {
+				return sprint, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindByProjectIDFunc: func(ctx context.Context, pID uuid.UUID) ([]*domain.Sprint, error) {
+			sprints := make([]*domain.Sprint, 0, len(f.order))
+			for _, id := range f.order {
+				sprints = append(sprints, f.sprints[id])
+			}
+			return sprints, nil
+		},
+		FindActiveByProjectIDFunc: func(ctx context.Context, pID uuid.UUID) (*domain.Sprint, error) {
+			for _, id := range f.order {
+				if f.sprints[id].State == domain.SprintStateActive {
+					return f.sprints[id], nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindBoardsFunc: func(ctx context.Context, sprintID uuid.UUID) ([]*domain.Board, error) {
+			var boards []*domain.Board
+			for _, board := range f.boards {
+				if board.SprintID != nil && *board.SprintID == sprintID {
+					boards = append(boards, board)
+				}
+			}
+			return boards, nil
+		},
+		AssignBoardsFunc: func(ctx context.Context, sprintID *uuid.UUID, boardIDs []uuid.UUID) error {
+			for _, id := range boardIDs {
+				for _, board := range f.boards {
+					if board.ID == id {
+						board.SprintID = sprintID
+					}
+				}
+			}
+			return nil
+		},
+		AddScopeChangesFunc: func(ctx context.Context, changes []*domain.SprintScopeChange) error {
+			f.changes = append(f.changes, changes...)
+			return nil
+		},
+		FindScopeChangesFunc: func(ctx context.Context, sprintID uuid.UUID) ([]*domain.SprintScopeChange, error) {
+			var changes []*domain.SprintScopeChange
+			for _, change := range f.changes {
+				if change.SprintID == sprintID {
+					changes = append(changes, change)
+				}
+			}
+			return changes, nil
+		},
+	}
+	return NewSprintService(sprintRepo, boardRepo, projectRepo, &MockFieldOptionConverter{}, testOutbox(f.store), zap.NewNop())
+}
+
+// sprintEventCount counts the SPRINT_UPDATED broadcasts recorded in the outbox
+func (f *sprintFixture) sprintEventCount(t *testing.T) int {
+	t.Helper()
+	count := 0
+	for _, event := range f.store.sorted() {
+		var payload dto.BoardEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			t.Fatalf("invalid outbox payload: %v", err)
+		}
+		if event.AggregateType == domain.OutboxAggregateSprint && payload.Type == domain.WebhookEventSprintUpdated {
+			count++
+		}
+	}
+	return count
+}
+
+func reminderBoard(projectID uuid.UUID, stage string, dueDate *time.Time) domain.Board {
+	board := stageBoard(projectID, stage)
+	board.DueDate = dueDate
+	return *board
+}
+
+func newTestReminderService(reminderRepo *MockReminderRepository, boardRepo *MockBoardRepository, memberID uuid.UUID, store *outboxTestStore) *reminderServiceImpl {
+	projectRepo := memberProjectRepo(
+		&domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New()},
+		map[uuid.UUID]domain.ProjectRole{memberID: domain.ProjectRoleMember},
+	)
+	svc := NewReminderService(reminderRepo, boardRepo, projectRepo, &MockFieldOptionConverter{}, nil, testOutbox(store), zap.NewNop()).(*reminderServiceImpl)
+	svc.now = func() time.Time { return reminderNow }
+	return svc
+}
+
+// recurrenceFixture keeps boards and series in memory behind the repository mocks
+type recurrenceFixture struct {
+	projectID   uuid.UUID
+	memberID    uuid.UUID
+	pendingID   uuid.UUID // first open stage option
+	archived    bool
+	boards      map[uuid.UUID]*domain.Board
+	series      map[uuid.UUID]*domain.BoardRecurrence
+	created     []*domain.Board
+	participant []*domain.Participant
+	labels      map[uuid.UUID][]uuid.UUID
+	links       map[uuid.UUID]*uuid.UUID
+	store       *outboxTestStore
+
+	advancedElsewhere bool // another replica advances every series first
+}
+
+func newRecurrenceFixture() *recurrenceFixture {
+	return &recurrenceFixture{
+		projectID: uuid.New(),
+		memberID:  uuid.New(),
+		pendingID: uuid.New(),
+		boards:    make(map[uuid.UUID]*domain.Board),
+		series:    make(map[uuid.UUID]*domain.BoardRecurrence),
+		labels:    make(map[uuid.UUID][]uuid.UUID),
+		links:     make(map[uuid.UUID]*uuid.UUID),
+		store:     newOutboxTestStore(),
+	}
+}
+
+// addBoard stores a board in the given stage (stored as a value, the mock converter does not convert)
+func (f *recurrenceFixture) addBoard(stage string, startDate, dueDate *time.Time) *domain.Board {
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    f.projectID,
+		AuthorID:     f.memberID,
+		Title:        "Weekly checklist",
+		Content:      "- [ ] backups",
+		CustomFields: testCustomFields(map[string]interface{}{"stage": stage, "importance": "high"}),
+		StartDate:    startDate,
+		DueDate:      dueDate,
+	}
+	f.boards[board.ID] = board
+	return board
+}
+
+// addSeries makes board the template of a weekly Monday series
+func (f *recurrenceFixture) addSeries(template *domain.Board, rrule string, dtstart time.Time, nextAt *time.Time) *domain.BoardRecurrence {
+	series := &domain.BoardRecurrence{
+		BaseModel:       domain.BaseModel{ID: uuid.New()},
+		ProjectID:       f.projectID,
+		TemplateBoardID: template.ID,
+		RRule:           rrule,
+		DTStart:         dtstart,
+		LatestBoardID:   template.ID,
+		LatestAt:        dtstart,
+		OccurrenceCount: 1,
+		NextAt:          nextAt,
+		CreatedBy:       f.memberID,
+	}
+	template.RecurrenceID = &series.ID
+	f.series[series.ID] = series
+	return series
+}
+
+func (f *recurrenceFixture) service() *recurrenceServiceImpl {
+	recurrenceRepo := &MockRecurrenceRepository{
+		CreateFunc: func(ctx context.Context, recurrence *domain.BoardRecurrence) error {
+			recurrence.ID = uuid.New()
+			f.series[recurrence.ID] = recurrence
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.BoardRecurrence, error) {
+			if series, ok := f.series[id]; ok {
+				return series, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindActiveFunc: func(ctx context.Context, limit int) ([]*domain.BoardRecurrence, error) {
+			var active []*domain.BoardRecurrence
+			for _, series := range f.series {
+				if series.NextAt != nil {
+					active = append(active, series)
+				}
+			}
+			return active, nil
+		},
+		AdvanceFunc: func(ctx context.Context, recurrence *domain.BoardRecurrence, fromLatestBoardID uuid.UUID, fromNextAt *time.Time) (bool, error) {
+			return !f.advancedElsewhere, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			delete(f.series, id)
+			return nil
+		},
+		LinkBoardFunc: func(ctx context.Context, boardID uuid.UUID, recurrenceID *uuid.UUID) error {
+			f.links[boardID] = recurrenceID
+			return nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			if board, ok := f.boards[id]; ok {
+				copied := *board
+				return &copied, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			board.Number = int64(len(f.boards) + 1)
+			f.boards[board.ID] = board
+			f.created = append(f.created, board)
+			return nil
+		},
+	}
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: f.projectID}, WorkspaceID: uuid.New(), KeyPrefix: "OPS"}
+	if f.archived {
+		archivedAt := recurrenceNow
+		project.ArchivedAt = &archivedAt
+	}
+	projectRepo := memberProjectRepo(project, map[uuid.UUID]domain.ProjectRole{f.memberID: domain.ProjectRoleMember})
+	participantRepo := &MockParticipantRepository{
+		CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+			f.participant = append(f.participant, participant)
+			return nil
+		},
+	}
+	labelRepo := &MockLabelRepository{
+		ReplaceBoardLabelsFunc: func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+			f.labels[boardID] = labelIDs
+			return nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			return []*domain.FieldOption{
+				{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: fieldType, Value: "deleted", DisplayOrder: 0, Category: domain.StageCategoryCancelled},
+				{BaseModel: domain.BaseModel{ID: f.pendingID}, FieldType: fieldType, Value: "pending", DisplayOrder: 1, Category: domain.StageCategoryOpen},
+			}, nil
+		},
+	}
+	svc := NewRecurrenceService(recurrenceRepo, boardRepo, projectRepo, participantRepo, labelRepo, fieldOptionRepo,
+		&MockFieldOptionConverter{}, nil, testOutbox(f.store), zap.NewNop()).(*recurrenceServiceImpl)
+	svc.now = func() time.Time { return recurrenceNow }
+	return svc
+}
+
+// inviteFixture is a project with an owner, an admin and a member
+type inviteFixture struct {
+	project  *domain.Project
+	ownerID  uuid.UUID
+	adminID  uuid.UUID
+	memberID uuid.UUID
+	roles    map[uuid.UUID]domain.ProjectRole
+	added    []*domain.ProjectMember
+	approved []uuid.UUID
+}
+
+func newInviteFixture() *inviteFixture {
+	f := &inviteFixture{
+		project:  &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), Name: "Launch"},
+		ownerID:  uuid.New(),
+		adminID:  uuid.New(),
+		memberID: uuid.New(),
+	}
+	f.roles = map[uuid.UUID]domain.ProjectRole{
+		f.ownerID:  domain.ProjectRoleOwner,
+		f.adminID:  domain.ProjectRoleAdmin,
+		f.memberID: domain.ProjectRoleMember,
+	}
+	return f
+}
+
+func (f *inviteFixture) projectRepo() *MockProjectRepository {
+	repo := memberProjectRepo(f.project, f.roles)
+	repo.AddMemberFunc = func(ctx context.Context, member *domain.ProjectMember) error {
+		member.ID = uuid.New()
+		f.added = append(f.added, member)
+		return nil
+	}
+	repo.FindPendingByProjectAndUserFunc = func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectJoinRequest, error) {
+		return &domain.ProjectJoinRequest{ID: userID, ProjectID: projectID, UserID: userID, Status: domain.JoinRequestPending}, nil
+	}
+	repo.UpdateJoinRequestStatusFunc = func(ctx context.Context, id uuid.UUID, status domain.ProjectJoinRequestStatus) error {
+		if status == domain.JoinRequestApproved {
+			f.approved = append(f.approved, id)
+		}
+		return nil
+	}
+	return repo
+}
+
+func (f *inviteFixture) service(inviteRepo *MockInviteLinkRepository, userClient *MockUserClient, store *outboxTestStore) *inviteServiceImpl {
+	svc := NewInviteService(inviteRepo, f.projectRepo(), userClient, nil, testOutbox(store), zap.NewNop()).(*inviteServiceImpl)
+	svc.now = func() time.Time { return inviteNow }
+	return svc
+}
+
+type reportFixture struct {
+	workspaceID uuid.UUID
+	ownerID     uuid.UUID
+	memberID    uuid.UUID
+	aliceID     uuid.UUID
+	bobID       uuid.UUID
+	project     *domain.Project
+	boards      []*domain.Board
+}
+
+func newReportFixture() *reportFixture {
+	f := &reportFixture{
+		workspaceID: uuid.New(),
+		ownerID:     uuid.New(),
+		memberID:    uuid.New(),
+		aliceID:     uuid.New(),
+		bobID:       uuid.New(),
+	}
+	f.project = &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: f.workspaceID, Name: "Web", KeyPrefix: "WEB"}
+
+	board := func(number int64, title, stage string, assigneeID *uuid.UUID, due *time.Time, completedAt *time.Time) *domain.Board {
+		b := stageBoard(f.project.ID, stage)
+		b.Number, b.Title = number, title
+		b.AssigneeID, b.DueDate, b.CompletedAt = assigneeID, due, completedAt
+		return b
+	}
+	at := func(day, hour int) *time.Time {
+		t := time.Date(2024, 1, day, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+	f.boards = []*domain.Board{
+		board(1, "login", "in_progress", &f.aliceID, timelineDate(15), nil),
+		board(2, "api", "in_progress", &f.bobID, timelineDate(25), nil),
+		board(3, "done", "approved", &f.aliceID, timelineDate(16), at(17, 15)),
+		board(4, "old", "approved", &f.bobID, nil, at(5, 10)),
+		board(5, "legacy", "legacy", nil, timelineDate(10), nil),
+		board(6, "inbox", "", nil, nil, nil),
+		board(7, "dropped", "deleted", &f.aliceID, timelineDate(1), nil),
+	}
+	return f
+}
+
+func (f *reportFixture) service(templateRepo *MockReportTemplateRepository, userClient *MockUserClient) *reportServiceImpl {
+	if templateRepo == nil {
+		templateRepo = &MockReportTemplateRepository{}
+	}
+	if userClient == nil {
+		userClient = &MockUserClient{}
+	}
+	projectRepo := memberProjectRepo(f.project, map[uuid.UUID]domain.ProjectRole{f.memberID: domain.ProjectRoleMember})
+	boardRepo := &MockBoardRepository{
+		FindByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+			return f.boards, nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			return []*domain.FieldOption{
+				{Value: "pending", Label: "대기", Category: domain.StageCategoryOpen},
+				{Value: "in_progress", Label: "진행중", Category: domain.StageCategoryOpen},
+				{Value: "review", Label: "검토", Category: domain.StageCategoryOpen},
+				{Value: "approved", Label: "완료", Category: domain.StageCategoryDone},
+				{Value: "deleted", Label: "삭제", Category: domain.StageCategoryCancelled},
+			}, nil
+		},
+	}
+	svc := NewReportService(templateRepo, boardRepo, projectRepo, fieldOptionRepo, &MockFieldOptionConverter{}, userClient, zap.NewNop()).(*reportServiceImpl)
+	svc.now = func() time.Time { return reportNow }
+	return svc
+}
+
+func (f *reportFixture) profiles() *MockUserClient {
+	return &MockUserClient{
+		GetWorkspaceProfilesFunc: func(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+			return map[uuid.UUID]*client.WorkspaceProfile{
+				f.aliceID: {NickName: "Alice"},
+				f.bobID:   {NickName: "Bob"},
+			}, nil
+		},
+	}
+}
+
+// webhookTestStore is an in-memory subscription/delivery store shared by the webhook tests
+type webhookTestStore struct {
+	mu            sync.Mutex
+	subscriptions map[uuid.UUID]*domain.WebhookSubscription
+	deliveries    map[uuid.UUID]*domain.WebhookDelivery
+}
+
+func newWebhookTestStore(subscriptions ...*domain.WebhookSubscription) *webhookTestStore {
+	s := &webhookTestStore{
+		subscriptions: make(map[uuid.UUID]*domain.WebhookSubscription),
+		deliveries:    make(map[uuid.UUID]*domain.WebhookDelivery),
+	}
+	for _, sub := range subscriptions {
+		s.subscriptions[sub.ID] = sub
+	}
+	return s
+}
+
+func (s *webhookTestStore) repo() *MockWebhookRepository {
+	return &MockWebhookRepository{
+		FindSubscriptionByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if sub, ok := s.subscriptions[id]; ok {
+				return sub, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindEnabledSubscriptionsFunc: func(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			result := make([]*domain.WebhookSubscription, 0)
+			for _, sub := range s.subscriptions {
+				if sub.ProjectID == projectID && sub.Enabled {
+					result = append(result, sub)
+				}
+			}
+			return result, nil
+		},
+		CreateDeliveryFunc: func(ctx context.Context, delivery *domain.WebhookDelivery) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delivery.ID = uuid.New()
+			s.deliveries[delivery.ID] = delivery
+			return nil
+		},
+		FindDeliveryByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if delivery, ok := s.deliveries[id]; ok {
+				return delivery, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindDueDeliveriesFunc: func(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			result := make([]*domain.WebhookDelivery, 0)
+			for _, d := range s.deliveries {
+				due := d.NextAttemptAt != nil && !d.NextAttemptAt.After(now)
+				if due && (d.Status == domain.WebhookDeliveryPending || d.Status == domain.WebhookDeliveryRetrying) {
+					result = append(result, d)
+				}
+			}
+			return result, nil
+		},
+		ClaimDueDeliveryFunc: func(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			d, ok := s.deliveries[id]
+			if !ok || d.NextAttemptAt == nil || d.NextAttemptAt.After(now) {
+				return false, nil
+			}
+			d.NextAttemptAt = &leaseUntil
+			return true, nil
+		},
+		UpdateDeliveryFunc: func(ctx context.Context, delivery *domain.WebhookDelivery) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.deliveries[delivery.ID] = delivery
+			return nil
+		},
+	}
+}
+
+func (s *webhookTestStore) onlyDelivery(t *testing.T) *domain.WebhookDelivery {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deliveries) != 1 {
+		t.Fatalf("deliveries = %d, want 1", len(s.deliveries))
+	}
+	for _, d := range s.deliveries {
+		return d
+	}
+	return nil
+}
+
+// automationTestEnv is an in-memory board store shared by the engine tests
+type automationTestEnv struct {
+	board      domain.Board
+	rules      []*domain.AutomationRule
+	executions []*domain.AutomationExecution
+	comments   []*domain.Comment
+	outbox     Outbox          // optional, records the board events of rule actions
+	project    *domain.Project // optional, nil when the project is not found
+}
+
+func (env *automationTestEnv) engine() AutomationEngine {
+	mockAutomationRepo := &MockAutomationRepository{
+		FindEnabledRulesFunc: func(ctx context.Context, projectID uuid.UUID, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error) {
+			var rules []*domain.AutomationRule
+			for _, r := range env.rules {
+				if r.ProjectID == projectID && r.Trigger == trigger && r.Enabled {
+					rules = append(rules, r)
+				}
+			}
+			return rules, nil
+		},
+		CreateExecutionFunc: func(ctx context.Context, execution *domain.AutomationExecution) error {
+			env.executions = append(env.executions, execution)
+			return nil
+		},
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			board := env.board
+			return &board, nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			env.board = *board
+			return nil
+		},
+	}
+	mockCommentRepo := &MockCommentRepository{
+		CreateFunc: func(ctx context.Context, comment *domain.Comment) error {
+			env.comments = append(env.comments, comment)
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return env.project, nil
+		},
+	}
+	logger, _ := zap.NewDevelopment()
+	engine := NewAutomationEngine(mockAutomationRepo, mockBoardRepo, mockProjectRepo, &MockParticipantRepository{}, mockCommentRepo, &MockFieldOptionConverter{}, nil, env.outbox, nil, logger)
+	// The board service applies the rule actions to the board
+	NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, &MockFieldOptionConverter{}, nil, env.outbox, engine, nil, nil, nil, logger)
+	return engine
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// InviteService defines the interface for joining projects by invitation
+// Invite links and direct invites add members immediately, without the join request approval step
+type InviteService interface {
+	CreateInviteLink(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateInviteLinkRequest) (*dto.InviteLinkResponse, error)
+	GetInviteLinks(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.InviteLinkResponse, error)
+	RevokeInviteLink(ctx context.Context, projectID, requesterID, linkID uuid.UUID) error
+	AcceptInviteLink(ctx context.Context, token string, userID uuid.UUID, jwtToken string) (*dto.ProjectMemberResponse, error)
+	InviteMembers(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.InviteMembersRequest, jwtToken string) (*dto.InviteMembersResponse, error)
+}
+
+// inviteServiceImpl is the implementation of InviteService
+type inviteServiceImpl struct {
+	inviteRepo  repository.InviteLinkRepository
+	projectRepo repository.ProjectRepository
+	userClient  client.UserClient
+	notiClient  client.NotiClient
+	outbox      Outbox // optional, nil sends notifications on a best-effort basis
+	logger      *zap.Logger
+	now         func() time.Time
+}
+
+// NewInviteService creates a new instance of InviteService
+func NewInviteService(
+	inviteRepo repository.InviteLinkRepository,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	notiClient client.NotiClient,
+	outbox Outbox,
+	logger *zap.Logger,
+) InviteService {
+	return &inviteServiceImpl{
+		inviteRepo:  inviteRepo,
+		projectRepo: projectRepo,
+		userClient:  userClient,
+		notiClient:  notiClient,
+		outbox:      outbox,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// CreateInviteLink creates an invite link granting req.Role (requires member.invite, plus role.manage for ADMIN)
+func (s *inviteServiceImpl) CreateInviteLink(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateInviteLinkRequest) (*dto.InviteLinkResponse, error) {
+	role := domain.ProjectRole(req.Role)
+	if err := s.checkInvite(ctx, projectID, requesterID, role); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	expiresInHours := dto.DefaultInviteLinkExpiryHours
+	if req.ExpiresInHours != nil {
+		expiresInHours = *req.ExpiresInHours
+	}
+	if expiresInHours < 1 || expiresInHours > dto.MaxInviteLinkExpiryHours {
+		return nil, response.NewValidationError("Invite links expire within 1 hour to 30 days", "")
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to generate invite token", err.Error())
+	}
+
+	link := &domain.ProjectInviteLink{
+		ProjectID: projectID,
+		Token:     token,
+		Role:      role,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: s.now().Add(time.Duration(expiresInHours) * time.Hour),
+		CreatedBy: requesterID,
+	}
+	if err := s.inviteRepo.Create(ctx, link); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create invite link", err.Error())
+	}
+
+	return s.toInviteLinkResponse(link), nil
+}
+
+// GetInviteLinks retrieves the invite links of a project, including used up and revoked ones (requires member.invite)
+func (s *inviteServiceImpl) GetInviteLinks(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.InviteLinkResponse, error) {
+	if err := requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionMemberInvite, "You do not have permission to manage invite links"); err != nil {
+		return nil, err
+	}
+
+	links, err := s.inviteRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch invite links", err.Error())
+	}
+
+	responses := make([]*dto.InviteLinkResponse, len(links))
+	for i, link := range links {
+		responses[i] = s.toInviteLinkResponse(link)
+	}
+	return responses, nil
+}
+
+// RevokeInviteLink revokes an invite link so it can no longer be accepted (requires member.invite)
+// Members who already joined through the link are kept
+func (s *inviteServiceImpl) RevokeInviteLink(ctx context.Context, projectID, requesterID, linkID uuid.UUID) error {
+	if err := requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionMemberInvite, "You do not have permission to manage invite links"); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	link, err := s.inviteRepo.FindByID(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Invite link not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch invite link", err.Error())
+	}
+	if link.ProjectID != projectID {
+		return response.NewNotFoundError("Invite link not found", "")
+	}
+	if link.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.inviteRepo.Revoke(ctx, link.ID, requesterID, s.now()); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to revoke invite link", err.Error())
+	}
+	return nil
+}
+
+// AcceptInviteLink adds the user to the link's project with the link's role
+// The user must be a member of the project's workspace; a pending join request of the user is approved
+func (s *inviteServiceImpl) AcceptInviteLink(ctx context.Context, token string, userID uuid.UUID, jwtToken string) (*dto.ProjectMemberResponse, error) {
+	link, err := s.inviteRepo.FindByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Invite link not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch invite link", err.Error())
+	}
+	if err := s.checkUsable(link); err != nil {
+		return nil, err
+	}
+	if err := checkProjectWritable(&link.Project); err != nil {
+		return nil, err
+	}
+
+	// Validate workspace membership
+	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, link.Project.WorkspaceID, userID, jwtToken)
+	if err != nil || !isValid {
+		return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
+	}
+
+	isMember, err := s.projectRepo.IsProjectMember(ctx, link.ProjectID, userID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	}
+	if isMember {
+		return nil, response.NewAppError("ALREADY_MEMBER", "User is already a member of this project", "")
+	}
+
+	member := &domain.ProjectMember{
+		ProjectID: link.ProjectID,
+		UserID:    userID,
+		RoleName:  link.Role,
+		JoinedAt:  s.now(),
+	}
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		// Counting the use first keeps concurrent accepts within MaxUses
+		consumed, err := s.inviteRepo.ConsumeUse(ctx, link.ID, s.now())
+		if err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to use invite link", err.Error())
+		}
+		if !consumed {
+			return response.NewInviteLinkInvalidError("Invite link is no longer valid", "")
+		}
+		if err := s.projectRepo.AddMember(ctx, member); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to add member", err.Error())
+		}
+		return s.approvePendingJoinRequest(ctx, link.ProjectID, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProjectMemberResponse(member), nil
+}
+
+// InviteMembers adds workspace members to a project directly and notifies them with PROJECT_INVITED
+// (requires member.invite, plus role.manage for ADMIN)
+// Users who are already project members or not members of the workspace are skipped and reported
+func (s *inviteServiceImpl) InviteMembers(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.InviteMembersRequest, jwtToken string) (*dto.InviteMembersResponse, error) {
+	role := domain.ProjectRole(req.Role)
+	if err := s.checkInvite(ctx, projectID, requesterID, role); err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
+	}
+
+	result := &dto.InviteMembersResponse{
+		Added:   make([]*dto.ProjectMemberResponse, 0, len(req.UserIDs)),
+		Skipped: make([]dto.InviteSkippedUser, 0),
+	}
+	members := make([]*domain.ProjectMember, 0, len(req.UserIDs))
+	seen := make(map[uuid.UUID]bool, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		isMember, err := s.projectRepo.IsProjectMember(ctx, projectID, userID)
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+		}
+		if isMember {
+			result.Skipped = append(result.Skipped, dto.InviteSkippedUser{UserID: userID, Reason: dto.InviteSkipAlreadyMember})
+			continue
+		}
+		isValid, err := s.userClient.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, jwtToken)
+		if err != nil || !isValid {
+			result.Skipped = append(result.Skipped, dto.InviteSkippedUser{UserID: userID, Reason: dto.InviteSkipNotWorkspaceMember})
+			continue
+		}
+
+		members = append(members, &domain.ProjectMember{
+			ProjectID: projectID,
+			UserID:    userID,
+			RoleName:  role,
+			JoinedAt:  s.now(),
+		})
+	}
+	if len(members) == 0 {
+		return result, nil
+	}
+
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		events := make([]*client.NotificationEvent, 0, len(members))
+		for _, member := range members {
+			if err := s.projectRepo.AddMember(ctx, member); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to add member", err.Error())
+			}
+			if err := s.approvePendingJoinRequest(ctx, projectID, member.UserID); err != nil {
+				return err
+			}
+			events = append(events, client.NewProjectInvitedNotification(requesterID, member.UserID, project.WorkspaceID, projectID, project.Name, string(role)))
+		}
+		if err := recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateProject, projectID, events); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record notifications", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		result.Added = append(result.Added, toProjectMemberResponse(member))
+	}
+	return result, nil
+}
+
+// checkInvite verifies the requester may invite members with role
+// Granting ADMIN also requires role.manage, as changing a member's role would; OWNER is never granted by invitation
+func (s *inviteServiceImpl) checkInvite(ctx context.Context, projectID, requesterID uuid.UUID, role domain.ProjectRole) error {
+	if role != domain.ProjectRoleAdmin && role != domain.ProjectRoleMember {
+		return response.NewValidationError("Invalid role", "Invitations grant ADMIN or MEMBER")
+	}
+
+	requesterMember, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID)
+	if err != nil {
+		return err
+	}
+	if !requesterMember.Can(domain.PermissionMemberInvite) {
+		return response.NewForbiddenError("You do not have permission to invite members", "Missing permission: "+string(domain.PermissionMemberInvite))
+	}
+	if role == domain.ProjectRoleAdmin && !requesterMember.Can(domain.PermissionRoleManage) {
+		return response.NewForbiddenError("You do not have permission to invite admins", "Missing permission: "+string(domain.PermissionRoleManage))
+	}
+	return nil
+}
+
+// checkUsable rejects a link that has been revoked, has expired or has no uses left
+func (s *inviteServiceImpl) checkUsable(link *domain.ProjectInviteLink) error {
+	switch s.inviteLinkStatus(link) {
+	case dto.InviteLinkStatusRevoked:
+		return response.NewInviteLinkInvalidError("Invite link has been revoked", "")
+	case dto.InviteLinkStatusExpired:
+		return response.NewInviteLinkInvalidError("Invite link has expired", "")
+	case dto.InviteLinkStatusExhausted:
+		return response.NewInviteLinkInvalidError("Invite link has reached its usage limit", "")
+	}
+	return nil
+}
+
+// approvePendingJoinRequest approves the user's pending join request, since the user has joined anyway
+func (s *inviteServiceImpl) approvePendingJoinRequest(ctx context.Context, projectID, userID uuid.UUID) error {
+	pending, err := s.projectRepo.FindPendingByProjectAndUser(ctx, projectID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to check pending requests", err.Error())
+	}
+	if pending == nil {
+		return nil
+	}
+	if err := s.projectRepo.UpdateJoinRequestStatus(ctx, pending.ID, domain.JoinRequestApproved); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to update join request", err.Error())
+	}
+	return nil
+}
+
+// inviteLinkStatus reports the state of a link at the current time
+func (s *inviteServiceImpl) inviteLinkStatus(link *domain.ProjectInviteLink) string {
+	switch {
+	case link.RevokedAt != nil:
+		return dto.InviteLinkStatusRevoked
+	case !s.now().Before(link.ExpiresAt):
+		return dto.InviteLinkStatusExpired
+	case !link.IsUsable(s.now()):
+		return dto.InviteLinkStatusExhausted
+	default:
+		return dto.InviteLinkStatusActive
+	}
+}
+
+// toInviteLinkResponse converts domain.ProjectInviteLink to dto.InviteLinkResponse
+func (s *inviteServiceImpl) toInviteLinkResponse(link *domain.ProjectInviteLink) *dto.InviteLinkResponse {
+	return &dto.InviteLinkResponse{
+		LinkID:    link.ID,
+		ProjectID: link.ProjectID,
+		Token:     link.Token,
+		Role:      string(link.Role),
+		MaxUses:   link.MaxUses,
+		UseCount:  link.UseCount,
+		Status:    s.inviteLinkStatus(link),
+		ExpiresAt: link.ExpiresAt,
+		CreatedBy: link.CreatedBy,
+		CreatedAt: link.CreatedAt,
+		RevokedAt: link.RevokedAt,
+	}
+}
+
+// generateInviteToken creates a random URL-safe invite token
+func generateInviteToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
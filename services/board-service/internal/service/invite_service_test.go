@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// inviteNow is the fixed clock of the invite tests (2024-03-01 12:00 UTC)
+var inviteNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestInviteService_CreateInviteLink(t *testing.T) {
+	f := newInviteFixture()
+	hours := func(h int) *int { return &h }
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		req         *dto.CreateInviteLinkRequest
+		wantExpiry  time.Time
+		wantErrCode string
+	}{
+		{
+			name:        "성공: 기본 만료 기한 7일",
+			requesterID: f.adminID,
+			req:         &dto.CreateInviteLinkRequest{Role: "MEMBER"},
+			wantExpiry:  inviteNow.Add(7 * 24 * time.Hour),
+		},
+		{
+			name:        "성공: 소유자의 ADMIN 링크",
+			requesterID: f.ownerID,
+			req:         &dto.CreateInviteLinkRequest{Role: "ADMIN", ExpiresInHours: hours(24)},
+			wantExpiry:  inviteNow.Add(24 * time.Hour),
+		},
+		{
+			name:        "실패: 역할 관리 권한 없이 ADMIN 링크",
+			requesterID: f.adminID,
+			req:         &dto.CreateInviteLinkRequest{Role: "ADMIN"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 초대 권한 없음",
+			requesterID: f.memberID,
+			req:         &dto.CreateInviteLinkRequest{Role: "MEMBER"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: OWNER 역할",
+			requesterID: f.ownerID,
+			req:         &dto.CreateInviteLinkRequest{Role: "OWNER"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 30일 초과",
+			requesterID: f.adminID,
+			req:         &dto.CreateInviteLinkRequest{Role: "MEMBER", ExpiresInHours: hours(31 * 24)},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.ProjectInviteLink
+			inviteRepo := &MockInviteLinkRepository{
+				CreateFunc: func(ctx context.Context, link *domain.ProjectInviteLink) error {
+					created = link
+					return nil
+				},
+			}
+			svc := f.service(inviteRepo, &MockUserClient{}, nil)
+
+			result, err := svc.CreateInviteLink(context.Background(), f.project.ID, tt.requesterID, tt.req)
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if created != nil {
+					t.Error("link was created despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateInviteLink() error = %v", err)
+			}
+			if !result.ExpiresAt.Equal(tt.wantExpiry) || result.Role != tt.req.Role || result.Status != dto.InviteLinkStatusActive {
+				t.Errorf("CreateInviteLink() = %s %s expiring %s, want active %s expiring %s", result.Status, result.Role, result.ExpiresAt, tt.req.Role, tt.wantExpiry)
+			}
+			if len(created.Token) < 32 || created.CreatedBy != tt.requesterID {
+				t.Errorf("created link token %q by %s", created.Token, created.CreatedBy)
+			}
+		})
+	}
+}
+
+func TestInviteService_AcceptInviteLink(t *testing.T) {
+	two := 2
+	revokedAt := inviteNow.Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		link         func(f *inviteFixture) *domain.ProjectInviteLink
+		userID       func(f *inviteFixture) uuid.UUID
+		notWorkspace bool
+		consumed     bool
+		wantErrCode  string
+	}{
+		{
+			name:     "성공: 링크의 역할로 가입",
+			consumed: true,
+		},
+		{
+			name: "실패: 만료된 링크",
+			link: func(f *inviteFixture) *domain.ProjectInviteLink {
+				return &domain.ProjectInviteLink{ExpiresAt: inviteNow}
+			},
+			wantErrCode: response.ErrCodeInviteLinkInvalid,
+		},
+		{
+			name: "실패: 취소된 링크",
+			link: func(f *inviteFixture) *domain.ProjectInviteLink {
+				return &domain.ProjectInviteLink{ExpiresAt: inviteNow.Add(time.Hour), RevokedAt: &revokedAt}
+			},
+			wantErrCode: response.ErrCodeInviteLinkInvalid,
+		},
+		{
+			name: "실패: 사용 횟수 초과",
+			link: func(f *inviteFixture) *domain.ProjectInviteLink {
+				return &domain.ProjectInviteLink{ExpiresAt: inviteNow.Add(time.Hour), MaxUses: &two, UseCount: 2}
+			},
+			wantErrCode: response.ErrCodeInviteLinkInvalid,
+		},
+		{
+			name:        "실패: 동시 사용으로 남은 횟수 없음",
+			consumed:    false,
+			wantErrCode: response.ErrCodeInviteLinkInvalid,
+		},
+		{
+			name:         "실패: 워크스페이스 멤버가 아님",
+			notWorkspace: true,
+			consumed:     true,
+			wantErrCode:  response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 이미 프로젝트 멤버",
+			userID:      func(f *inviteFixture) uuid.UUID { return f.memberID },
+			consumed:    true,
+			wantErrCode: "ALREADY_MEMBER",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newInviteFixture()
+			link := &domain.ProjectInviteLink{ExpiresAt: inviteNow.Add(time.Hour), MaxUses: &two, UseCount: 1}
+			if tt.link != nil {
+				link = tt.link(f)
+			}
+			link.ID = uuid.New()
+			link.ProjectID = f.project.ID
+			link.Project = *f.project
+			link.Token = "token"
+			link.Role = domain.ProjectRoleAdmin
+			userID := uuid.New()
+			if tt.userID != nil {
+				userID = tt.userID(f)
+			}
+
+			inviteRepo := &MockInviteLinkRepository{
+				FindByTokenFunc: func(ctx context.Context, token string) (*domain.ProjectInviteLink, error) {
+					if token != link.Token {
+						return nil, gorm.ErrRecordNotFound
+					}
+					return link, nil
+				},
+				ConsumeUseFunc: func(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+					return tt.consumed, nil
+				},
+			}
+			userClient := &MockUserClient{
+				ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, uID uuid.UUID, token string) (bool, error) {
+					return workspaceID == f.project.WorkspaceID && !tt.notWorkspace, nil
+				},
+			}
+			svc := f.service(inviteRepo, userClient, newOutboxTestStore())
+
+			result, err := svc.AcceptInviteLink(context.Background(), link.Token, userID, "jwt")
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if len(f.added) != 0 {
+					t.Error("member was added despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AcceptInviteLink() error = %v", err)
+			}
+			if result.UserID != userID || result.RoleName != string(domain.ProjectRoleAdmin) || len(f.added) != 1 {
+				t.Errorf("AcceptInviteLink() = %s as %s, want %s as ADMIN", result.UserID, result.RoleName, userID)
+			}
+			if len(f.approved) != 1 || f.approved[0] != userID {
+				t.Errorf("approved join requests = %v, want the user's pending request", f.approved)
+			}
+		})
+	}
+
+	t.Run("실패: 존재하지 않는 토큰", func(t *testing.T) {
+		f := newInviteFixture()
+		inviteRepo := &MockInviteLinkRepository{
+			FindByTokenFunc: func(ctx context.Context, token string) (*domain.ProjectInviteLink, error) {
+				return nil, gorm.ErrRecordNotFound
+			},
+		}
+		_, err := f.service(inviteRepo, &MockUserClient{}, nil).AcceptInviteLink(context.Background(), "missing", uuid.New(), "jwt")
+		assertAppErrorCode(t, err, response.ErrCodeNotFound)
+	})
+}
+
+func TestInviteService_InviteMembers(t *testing.T) {
+	f := newInviteFixture()
+	newUser := uuid.New()
+	outsider := uuid.New()
+	userClient := &MockUserClient{
+		ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+			return userID != outsider, nil
+		},
+	}
+	store := newOutboxTestStore()
+	svc := f.service(&MockInviteLinkRepository{}, userClient, store)
+
+	result, err := svc.InviteMembers(context.Background(), f.project.ID, f.adminID, &dto.InviteMembersRequest{
+		UserIDs: []uuid.UUID{newUser, f.memberID, outsider, newUser},
+		Role:    "MEMBER",
+	}, "jwt")
+	if err != nil {
+		t.Fatalf("InviteMembers() error = %v", err)
+	}
+
+	if len(result.Added) != 1 || result.Added[0].UserID != newUser || result.Added[0].RoleName != "MEMBER" {
+		t.Fatalf("added = %+v, want only the new workspace member", result.Added)
+	}
+	wantSkipped := map[uuid.UUID]string{f.memberID: dto.InviteSkipAlreadyMember, outsider: dto.InviteSkipNotWorkspaceMember}
+	if len(result.Skipped) != len(wantSkipped) {
+		t.Fatalf("skipped = %+v, want %d users", result.Skipped, len(wantSkipped))
+	}
+	for _, skipped := range result.Skipped {
+		if wantSkipped[skipped.UserID] != skipped.Reason {
+			t.Errorf("user %s skipped for %s, want %s", skipped.UserID, skipped.Reason, wantSkipped[skipped.UserID])
+		}
+	}
+
+	events := store.sorted()
+	if len(events) != 1 {
+		t.Fatalf("outbox events = %d, want 1", len(events))
+	}
+	var notification client.NotificationEvent
+	if err := json.Unmarshal(events[0].Payload, &notification); err != nil {
+		t.Fatalf("invalid notification payload: %v", err)
+	}
+	if notification.Type != client.NotificationTypeProjectInvited || notification.TargetUserID != newUser ||
+		notification.ActorID != f.adminID || notification.ResourceID != f.project.ID {
+		t.Errorf("notification = %s to %s by %s on %s, want PROJECT_INVITED to the new member", notification.Type, notification.TargetUserID, notification.ActorID, notification.ResourceID)
+	}
+
+	t.Run("실패: 역할 관리 권한 없이 ADMIN 초대", func(t *testing.T) {
+		_, err := svc.InviteMembers(context.Background(), f.project.ID, f.adminID, &dto.InviteMembersRequest{UserIDs: []uuid.UUID{uuid.New()}, Role: "ADMIN"}, "jwt")
+		assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	})
+}
+
+func TestInviteService_RevokeInviteLink(t *testing.T) {
+	f := newInviteFixture()
+	ownLink := &domain.ProjectInviteLink{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: f.project.ID}
+	otherLink := &domain.ProjectInviteLink{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	var revoked []uuid.UUID
+	inviteRepo := &MockInviteLinkRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.ProjectInviteLink, error) {
+			for _, link := range []*domain.ProjectInviteLink{ownLink, otherLink} {
+				if link.ID == id {
+					return link, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		RevokeFunc: func(ctx context.Context, id, revokedBy uuid.UUID, revokedAt time.Time) error {
+			revoked = append(revoked, id)
+			return nil
+		},
+	}
+	svc := f.service(inviteRepo, &MockUserClient{}, nil)
+
+	assertAppErrorCode(t, svc.RevokeInviteLink(context.Background(), f.project.ID, f.memberID, ownLink.ID), response.ErrCodeForbidden)
+	assertAppErrorCode(t, svc.RevokeInviteLink(context.Background(), f.project.ID, f.adminID, otherLink.ID), response.ErrCodeNotFound)
+	if err := svc.RevokeInviteLink(context.Background(), f.project.ID, f.adminID, ownLink.ID); err != nil {
+		t.Fatalf("RevokeInviteLink() error = %v", err)
+	}
+	if len(revoked) != 1 || revoked[0] != ownLink.ID {
+		t.Errorf("revoked = %v, want only the project's link", revoked)
+	}
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// LabelService defines the interface for project label management
+type LabelService interface {
+	GetLabels(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.LabelResponse, error)
+	CreateLabel(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateLabelRequest) (*dto.LabelResponse, error)
+	UpdateLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID, req *dto.UpdateLabelRequest) (*dto.LabelResponse, error)
+	DeleteLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID) error
+	MergeLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID, req *dto.MergeLabelRequest) (*dto.LabelResponse, error)
+}
+
+// labelServiceImpl is the implementation of LabelService
+type labelServiceImpl struct {
+	labelRepo   repository.LabelRepository
+	projectRepo repository.ProjectRepository
+}
+
+// NewLabelService creates a new instance of LabelService
+func NewLabelService(labelRepo repository.LabelRepository, projectRepo repository.ProjectRepository) LabelService {
+	return &labelServiceImpl{
+		labelRepo:   labelRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetLabels retrieves all labels of a project with their usage counts (any project member)
+func (s *labelServiceImpl) GetLabels(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.LabelResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	labels, err := s.labelRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+	}
+	usages, err := s.labelRepo.CountUsageByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count label usage", err.Error())
+	}
+	counts := make(map[uuid.UUID]int64, len(usages))
+	for _, u := range usages {
+		counts[u.LabelID] = u.BoardCount
+	}
+
+	responses := make([]*dto.LabelResponse, len(labels))
+	for i, label := range labels {
+		responses[i] = toLabelResponse(label, counts[label.ID])
+	}
+	return responses, nil
+}
+
+// CreateLabel creates a new label (requires board.create)
+func (s *labelServiceImpl) CreateLabel(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateLabelRequest) (*dto.LabelResponse, error) {
+	if err := s.checkEdit(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, response.NewValidationError("Label name is required", "")
+	}
+	if err := s.ensureNameAvailable(ctx, projectID, name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	color := strings.ToUpper(req.Color)
+	if color == "" {
+		color = domain.DefaultLabelColor
+	}
+
+	label := &domain.Label{
+		ProjectID:   projectID,
+		Name:        name,
+		Color:       color,
+		Description: req.Description,
+		CreatedBy:   requesterID,
+	}
+	if err := s.labelRepo.Create(ctx, label); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create label", err.Error())
+	}
+	return toLabelResponse(label, 0), nil
+}
+
+// UpdateLabel renames or recolors a label (requires board.create)
+// Boards reference labels by ID, so a rename is visible on every board at once
+func (s *labelServiceImpl) UpdateLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID, req *dto.UpdateLabelRequest) (*dto.LabelResponse, error) {
+	if err := s.checkEdit(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	label, err := s.findLabel(ctx, projectID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, response.NewValidationError("Label name is required", "")
+		}
+		if err := s.ensureNameAvailable(ctx, projectID, name, label.ID); err != nil {
+			return nil, err
+		}
+		label.Name = name
+	}
+	if req.Color != nil {
+		label.Color = strings.ToUpper(*req.Color)
+	}
+	if req.Description != nil {
+		label.Description = *req.Description
+	}
+
+	if err := s.labelRepo.Update(ctx, label); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update label", err.Error())
+	}
+	return s.withUsage(ctx, label)
+}
+
+// DeleteLabel deletes a label and removes it from all boards (requires label.manage)
+func (s *labelServiceImpl) DeleteLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID) error {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	if _, err := s.findLabel(ctx, projectID, labelID); err != nil {
+		return err
+	}
+	if err := s.labelRepo.Delete(ctx, labelID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete label", err.Error())
+	}
+	return nil
+}
+
+// MergeLabel moves every board of a label to the target label and deletes it (requires label.manage)
+func (s *labelServiceImpl) MergeLabel(ctx context.Context, projectID, requesterID, labelID uuid.UUID, req *dto.MergeLabelRequest) (*dto.LabelResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+	if req.TargetLabelID == labelID {
+		return nil, response.NewValidationError("Cannot merge a label into itself", "")
+	}
+
+	if _, err := s.findLabel(ctx, projectID, labelID); err != nil {
+		return nil, err
+	}
+	target, err := s.findLabel(ctx, projectID, req.TargetLabelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.Merge(ctx, labelID, target.ID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to merge labels", err.Error())
+	}
+	return s.withUsage(ctx, target)
+}
+
+// checkEdit verifies that the requester holds the board.create permission needed to create and edit labels
+func (s *labelServiceImpl) checkEdit(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionBoardCreate, "You do not have permission to edit labels")
+}
+
+// checkManage verifies that the requester holds the label.manage permission
+func (s *labelServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionLabelManage, "You do not have permission to delete or merge labels")
+}
+
+// findLabel fetches a label and ensures it belongs to the project
+func (s *labelServiceImpl) findLabel(ctx context.Context, projectID, labelID uuid.UUID) (*domain.Label, error) {
+	label, err := s.labelRepo.FindByID(ctx, labelID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Label not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch label", err.Error())
+	}
+	if label.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Label not found", "")
+	}
+	return label, nil
+}
+
+// ensureNameAvailable fails when another label of the project already uses the name
+func (s *labelServiceImpl) ensureNameAvailable(ctx context.Context, projectID uuid.UUID, name string, exceptID uuid.UUID) error {
+	existing, err := s.labelRepo.FindByProjectAndName(ctx, projectID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to check label name", err.Error())
+	}
+	if existing != nil && existing.ID != exceptID {
+		return response.NewAlreadyExistsError("Label name already exists in this project", "Merge the labels instead of renaming")
+	}
+	return nil
+}
+
+// withUsage builds the label response including its current usage count
+func (s *labelServiceImpl) withUsage(ctx context.Context, label *domain.Label) (*dto.LabelResponse, error) {
+	usages, err := s.labelRepo.CountUsageByProjectID(ctx, label.ProjectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count label usage", err.Error())
+	}
+	for _, u := range usages {
+		if u.LabelID == label.ID {
+			return toLabelResponse(label, u.BoardCount), nil
+		}
+	}
+	return toLabelResponse(label, 0), nil
+}
+
+// toLabelResponse converts domain.Label to dto.LabelResponse
+func toLabelResponse(label *domain.Label, usageCount int64) *dto.LabelResponse {
+	return &dto.LabelResponse{
+		LabelID:     label.ID,
+		ProjectID:   label.ProjectID,
+		Name:        label.Name,
+		Color:       label.Color,
+		Description: label.Description,
+		UsageCount:  usageCount,
+		CreatedBy:   label.CreatedBy,
+		CreatedAt:   label.CreatedAt,
+		UpdatedAt:   label.UpdatedAt,
+	}
+}
+
+// toBoardLabelResponses converts the labels attached to a board
+func toBoardLabelResponses(labels []domain.Label) []dto.BoardLabelResponse {
+	responses := make([]dto.BoardLabelResponse, len(labels))
+	for i, label := range labels {
+		responses[i] = dto.BoardLabelResponse{
+			LabelID: label.ID,
+			Name:    label.Name,
+			Color:   label.Color,
+		}
+	}
+	return responses
+}
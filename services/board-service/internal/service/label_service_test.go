@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestLabelService_CreateLabel(t *testing.T) {
+	projectID := uuid.New()
+	memberID := uuid.New()
+	outsiderID := uuid.New()
+
+	tests := []struct {
+		name          string
+		requesterID   uuid.UUID
+		req           *dto.CreateLabelRequest
+		existingLabel *domain.Label
+		wantColor     string
+		wantErrCode   string
+	}{
+		{
+			name:        "성공: 색상을 지정한 라벨 생성",
+			requesterID: memberID,
+			req:         &dto.CreateLabelRequest{Name: " frontend ", Color: "#3b82f6"},
+			wantColor:   "#3B82F6",
+		},
+		{
+			name:        "성공: 색상 생략 시 기본 색상",
+			requesterID: memberID,
+			req:         &dto.CreateLabelRequest{Name: "backend"},
+			wantColor:   domain.DefaultLabelColor,
+		},
+		{
+			name:          "실패: 같은 이름의 라벨이 이미 존재",
+			requesterID:   memberID,
+			req:           &dto.CreateLabelRequest{Name: "frontend"},
+			existingLabel: &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "frontend"},
+			wantErrCode:   response.ErrCodeAlreadyExists,
+		},
+		{
+			name:        "실패: 공백만 있는 이름",
+			requesterID: memberID,
+			req:         &dto.CreateLabelRequest{Name: "   "},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 프로젝트 멤버가 아님",
+			requesterID: outsiderID,
+			req:         &dto.CreateLabelRequest{Name: "frontend"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == memberID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+					}
+					return nil, nil
+				},
+			}
+			var created *domain.Label
+			mockLabelRepo := &MockLabelRepository{
+				FindByProjectAndNameFunc: func(ctx context.Context, pID uuid.UUID, name string) (*domain.Label, error) {
+					if tt.existingLabel != nil && tt.existingLabel.Name == name {
+						return tt.existingLabel, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+				CreateFunc: func(ctx context.Context, label *domain.Label) error {
+					label.ID = uuid.New()
+					created = label
+					return nil
+				},
+			}
+			service := NewLabelService(mockLabelRepo, mockProjectRepo)
+
+			// When
+			got, err := service.CreateLabel(context.Background(), projectID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("CreateLabel() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("CreateLabel() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				if created != nil {
+					t.Error("CreateLabel() must not persist a rejected label")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateLabel() unexpected error = %v", err)
+			}
+			if created == nil || got.LabelID != created.ID {
+				t.Fatal("CreateLabel() did not persist the label")
+			}
+			if got.Color != tt.wantColor {
+				t.Errorf("CreateLabel() color = %s, want %s", got.Color, tt.wantColor)
+			}
+			if got.Name != "frontend" && got.Name != "backend" {
+				t.Errorf("CreateLabel() name = %q, want trimmed name", got.Name)
+			}
+		})
+	}
+}
+
+func TestLabelService_UpdateLabel_RenameConflict(t *testing.T) {
+	projectID := uuid.New()
+	memberID := uuid.New()
+	labelID := uuid.New()
+	otherLabelID := uuid.New()
+
+	mockProjectRepo := &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleMember),
+	}
+	updated := false
+	mockLabelRepo := &MockLabelRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+			return &domain.Label{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID, Name: "web"}, nil
+		},
+		FindByProjectAndNameFunc: func(ctx context.Context, pID uuid.UUID, name string) (*domain.Label, error) {
+			return &domain.Label{BaseModel: domain.BaseModel{ID: otherLabelID}, ProjectID: pID, Name: name}, nil
+		},
+		UpdateFunc: func(ctx context.Context, label *domain.Label) error {
+			updated = true
+			return nil
+		},
+	}
+	service := NewLabelService(mockLabelRepo, mockProjectRepo)
+
+	name := "frontend"
+	_, err := service.UpdateLabel(context.Background(), projectID, memberID, labelID, &dto.UpdateLabelRequest{Name: &name})
+
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeAlreadyExists {
+		t.Fatalf("UpdateLabel() error = %v, want ALREADY_EXISTS", err)
+	}
+	if updated {
+		t.Error("UpdateLabel() must not rename onto an existing label")
+	}
+}
+
+func TestLabelService_MergeLabel(t *testing.T) {
+	projectID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+	foreignID := uuid.New()
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		sourceID    uuid.UUID
+		targetID    uuid.UUID
+		wantErrCode string
+	}{
+		{
+			name:        "성공: ADMIN이 라벨 병합",
+			requesterID: adminID,
+			sourceID:    sourceID,
+			targetID:    targetID,
+		},
+		{
+			name:        "실패: MEMBER는 병합할 수 없음",
+			requesterID: memberID,
+			sourceID:    sourceID,
+			targetID:    targetID,
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 자기 자신으로 병합",
+			requesterID: adminID,
+			sourceID:    sourceID,
+			targetID:    sourceID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 다른 프로젝트의 라벨로 병합",
+			requesterID: adminID,
+			sourceID:    sourceID,
+			targetID:    foreignID,
+			wantErrCode: response.ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == adminID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+				},
+			}
+			var mergedFrom, mergedInto uuid.UUID
+			mockLabelRepo := &MockLabelRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+					owner := projectID
+					if id == foreignID {
+						owner = uuid.New()
+					}
+					return &domain.Label{BaseModel: domain.BaseModel{ID: id}, ProjectID: owner, Name: id.String()[:8]}, nil
+				},
+				MergeFunc: func(ctx context.Context, from, into uuid.UUID) error {
+					mergedFrom, mergedInto = from, into
+					return nil
+				},
+				CountUsageByProjectIDFunc: func(ctx context.Context, pID uuid.UUID) ([]domain.LabelUsage, error) {
+					return []domain.LabelUsage{{LabelID: targetID, BoardCount: 5}}, nil
+				},
+			}
+			service := NewLabelService(mockLabelRepo, mockProjectRepo)
+
+			// When
+			got, err := service.MergeLabel(context.Background(), projectID, tt.requesterID, tt.sourceID, &dto.MergeLabelRequest{TargetLabelID: tt.targetID})
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("MergeLabel() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("MergeLabel() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				if mergedFrom != uuid.Nil {
+					t.Error("MergeLabel() must not merge on a rejected request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeLabel() unexpected error = %v", err)
+			}
+			if mergedFrom != tt.sourceID || mergedInto != tt.targetID {
+				t.Errorf("MergeLabel() merged %s into %s, want %s into %s", mergedFrom, mergedInto, tt.sourceID, tt.targetID)
+			}
+			if got.LabelID != tt.targetID || got.UsageCount != 5 {
+				t.Errorf("MergeLabel() = %+v, want target label with usage count 5", got)
+			}
+		})
+	}
+}
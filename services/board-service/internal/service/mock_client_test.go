@@ -16,6 +16,7 @@ type MockFieldOptionConverter struct {
 	ConvertIDsToValuesFunc      func(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToLabelsFunc      func(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToValuesBatchFunc func(ctx context.Context, boards []*domain.Board) error
+	StageCategoriesFunc         func(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error)
 }
 
 func (m *MockFieldOptionConverter) ConvertValuesToIDs(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error) {
@@ -50,11 +51,23 @@ func (m *MockFieldOptionConverter) ConvertIDsToValuesBatch(ctx context.Context,
 	return nil
 }
 
+func (m *MockFieldOptionConverter) StageCategories(ctx context.Context, projectID uuid.UUID) (map[string]domain.StageCategory, error) {
+	if m.StageCategoriesFunc != nil {
+		return m.StageCategoriesFunc(ctx, projectID)
+	}
+	// Default: the closed stages of the default field options
+	return map[string]domain.StageCategory{
+		"approved": domain.StageCategoryDone,
+		"deleted":  domain.StageCategoryCancelled,
+	}, nil
+}
+
 // MockUserClient is a mock implementation of UserClient
 type MockUserClient struct {
 	ValidateWorkspaceMemberFunc func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
 	GetUserProfileFunc          func(ctx context.Context, userID uuid.UUID, token string) (*client.UserProfile, error)
 	GetWorkspaceProfileFunc     func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceProfile, error)
+	GetWorkspaceProfilesFunc    func(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*client.WorkspaceProfile, error)
 	GetWorkspaceFunc            func(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error)
 	ValidateTokenFunc           func(ctx context.Context, token string) (uuid.UUID, error)
 }
@@ -85,6 +98,24 @@ func (m *MockUserClient) GetWorkspaceProfile(ctx context.Context, workspaceID, u
 	}, nil
 }
 
+func (m *MockUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+	if m.GetWorkspaceProfilesFunc != nil {
+		return m.GetWorkspaceProfilesFunc(ctx, workspaceID, userIDs, token)
+	}
+	// Default: resolve each user through GetWorkspaceProfile
+	profiles := make(map[uuid.UUID]*client.WorkspaceProfile, len(userIDs))
+	for _, userID := range userIDs {
+		profile, err := m.GetWorkspaceProfile(ctx, workspaceID, userID, token)
+		if err != nil {
+			return nil, err
+		}
+		if profile != nil {
+			profiles[userID] = profile
+		}
+	}
+	return profiles, nil
+}
+
 func (m *MockUserClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error) {
 	if m.GetWorkspaceFunc != nil {
 		return m.GetWorkspaceFunc(ctx, workspaceID, token)
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 )
 
 // MockFieldOptionRepository is a mock implementation of FieldOptionRepository
@@ -110,6 +112,10 @@ type MockAttachmentRepository struct {
 	FindExpiredTempAttachmentsFunc func(ctx context.Context) ([]*domain.Attachment, error)
 	ConfirmAttachmentsFunc         func(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error
 	DeleteBatchFunc                func(ctx context.Context, attachmentIDs []uuid.UUID) error
+	UpdateThumbnailFunc            func(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error
+	FindPendingThumbnailsFunc      func(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error)
+	FindReferencedFileKeysFunc     func(ctx context.Context, keys []string) (map[string]bool, error)
+	FindConfirmedAfterFunc         func(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error)
 }
 
 func (m *MockAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
@@ -168,13 +174,45 @@ func (m *MockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return nil
 }
 
+func (m *MockAttachmentRepository) UpdateThumbnail(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, thumbnailKey, previewKey string) error {
+	if m.UpdateThumbnailFunc != nil {
+		return m.UpdateThumbnailFunc(ctx, id, status, thumbnailKey, previewKey)
+	}
+	return nil
+}
+
+func (m *MockAttachmentRepository) FindPendingThumbnails(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Attachment, error) {
+	if m.FindPendingThumbnailsFunc != nil {
+		return m.FindPendingThumbnailsFunc(ctx, createdBefore, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockAttachmentRepository) FindReferencedFileKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	if m.FindReferencedFileKeysFunc != nil {
+		return m.FindReferencedFileKeysFunc(ctx, keys)
+	}
+	return map[string]bool{}, nil
+}
+
+func (m *MockAttachmentRepository) FindConfirmedAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*domain.Attachment, error) {
+	if m.FindConfirmedAfterFunc != nil {
+		return m.FindConfirmedAfterFunc(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
 // MockBoardRepository is a mock implementation of BoardRepository
 type MockBoardRepository struct {
-	CreateFunc          func(ctx context.Context, board *domain.Board) error
-	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
-	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
-	UpdateFunc          func(ctx context.Context, board *domain.Board) error
-	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+	CreateFunc                   func(ctx context.Context, board *domain.Board) error
+	FindByIDFunc                 func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindByProjectAndNumberFunc   func(ctx context.Context, projectID uuid.UUID, number int64) (*domain.Board, error)
+	FindByProjectIDFunc          func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	FindByIDsInProjectFunc       func(ctx context.Context, projectID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error)
+	FindAssignedByProjectIDsFunc func(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.Board, error)
+	UpdateFunc                   func(ctx context.Context, board *domain.Board) error
+	UpdateDatesFunc              func(ctx context.Context, boardID uuid.UUID, startDate, dueDate *time.Time) error
+	DeleteFunc                   func(ctx context.Context, id uuid.UUID) error
 }
 
 func (m *MockBoardRepository) Create(ctx context.Context, board *domain.Board) error {
@@ -191,6 +229,13 @@ func (m *MockBoardRepository) FindByID(ctx context.Context, id uuid.UUID) (*doma
 	return nil, nil
 }
 
+func (m *MockBoardRepository) FindByProjectAndNumber(ctx context.Context, projectID uuid.UUID, number int64) (*domain.Board, error) {
+	if m.FindByProjectAndNumberFunc != nil {
+		return m.FindByProjectAndNumberFunc(ctx, projectID, number)
+	}
+	return nil, nil
+}
+
 func (m *MockBoardRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
 	if m.FindByProjectIDFunc != nil {
 		return m.FindByProjectIDFunc(ctx, projectID, filters)
@@ -198,6 +243,20 @@ func (m *MockBoardRepository) FindByProjectID(ctx context.Context, projectID uui
 	return nil, nil
 }
 
+func (m *MockBoardRepository) FindByIDsInProject(ctx context.Context, projectID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error) {
+	if m.FindByIDsInProjectFunc != nil {
+		return m.FindByIDsInProjectFunc(ctx, projectID, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) FindAssignedByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.Board, error) {
+	if m.FindAssignedByProjectIDsFunc != nil {
+		return m.FindAssignedByProjectIDsFunc(ctx, projectIDs)
+	}
+	return nil, nil
+}
+
 func (m *MockBoardRepository) Update(ctx context.Context, board *domain.Board) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, board)
@@ -205,6 +264,13 @@ func (m *MockBoardRepository) Update(ctx context.Context, board *domain.Board) e
 	return nil
 }
 
+func (m *MockBoardRepository) UpdateDates(ctx context.Context, boardID uuid.UUID, startDate, dueDate *time.Time) error {
+	if m.UpdateDatesFunc != nil {
+		return m.UpdateDatesFunc(ctx, boardID, startDate, dueDate)
+	}
+	return nil
+}
+
 func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -216,8 +282,9 @@ func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 type MockProjectRepository struct {
 	CreateFunc                      func(ctx context.Context, project *domain.Project) error
 	FindByIDFunc                    func(ctx context.Context, id uuid.UUID) (*domain.Project, error)
-	FindByWorkspaceIDFunc           func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
+	FindByWorkspaceIDFunc           func(ctx context.Context, workspaceID uuid.UUID, includeArchived bool) ([]*domain.Project, error)
 	FindDefaultByWorkspaceIDFunc    func(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
+	FindByWorkspaceAndKeyPrefixFunc func(ctx context.Context, workspaceID uuid.UUID, keyPrefix string) (*domain.Project, error)
 	UpdateFunc                      func(ctx context.Context, project *domain.Project) error
 	DeleteFunc                      func(ctx context.Context, id uuid.UUID) error
 	SearchFunc                      func(ctx context.Context, workspaceID uuid.UUID, query string, page, limit int) ([]*domain.Project, int64, error)
@@ -226,6 +293,8 @@ type MockProjectRepository struct {
 	RemoveMemberFunc                func(ctx context.Context, memberID uuid.UUID) error
 	UpdateMemberRoleFunc            func(ctx context.Context, memberID uuid.UUID, role domain.ProjectRole) error
 	IsProjectMemberFunc             func(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
+	FindMemberProjectIDsFunc        func(ctx context.Context, userID uuid.UUID, projectIDs []uuid.UUID) ([]uuid.UUID, error)
+	FindMemberUserIDsFunc           func(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error)
 	FindMembersByProjectIDFunc      func(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectMember, error)
 	CreateJoinRequestFunc           func(ctx context.Context, request *domain.ProjectJoinRequest) error
 	FindJoinRequestByIDFunc         func(ctx context.Context, id uuid.UUID) (*domain.ProjectJoinRequest, error)
@@ -248,9 +317,9 @@ func (m *MockProjectRepository) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return nil, nil
 }
 
-func (m *MockProjectRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
+func (m *MockProjectRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, includeArchived bool) ([]*domain.Project, error) {
 	if m.FindByWorkspaceIDFunc != nil {
-		return m.FindByWorkspaceIDFunc(ctx, workspaceID)
+		return m.FindByWorkspaceIDFunc(ctx, workspaceID, includeArchived)
 	}
 	return nil, nil
 }
@@ -262,6 +331,13 @@ func (m *MockProjectRepository) FindDefaultByWorkspaceID(ctx context.Context, wo
 	return nil, nil
 }
 
+func (m *MockProjectRepository) FindByWorkspaceAndKeyPrefix(ctx context.Context, workspaceID uuid.UUID, keyPrefix string) (*domain.Project, error) {
+	if m.FindByWorkspaceAndKeyPrefixFunc != nil {
+		return m.FindByWorkspaceAndKeyPrefixFunc(ctx, workspaceID, keyPrefix)
+	}
+	return nil, nil
+}
+
 func (m *MockProjectRepository) Update(ctx context.Context, project *domain.Project) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, project)
@@ -325,6 +401,20 @@ func (m *MockProjectRepository) IsProjectMember(ctx context.Context, projectID,
 	return false, nil
 }
 
+func (m *MockProjectRepository) FindMemberProjectIDs(ctx context.Context, userID uuid.UUID, projectIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindMemberProjectIDsFunc != nil {
+		return m.FindMemberProjectIDsFunc(ctx, userID, projectIDs)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectRepository) FindMemberUserIDs(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindMemberUserIDsFunc != nil {
+		return m.FindMemberUserIDsFunc(ctx, projectID, userIDs)
+	}
+	return userIDs, nil
+}
+
 func (m *MockProjectRepository) CreateJoinRequest(ctx context.Context, request *domain.ProjectJoinRequest) error {
 	if m.CreateJoinRequestFunc != nil {
 		return m.CreateJoinRequestFunc(ctx, request)
@@ -439,3 +529,831 @@ func (m *MockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error
 	}
 	return nil
 }
+
+// MockWorkflowRepository is a mock implementation of WorkflowRepository
+type MockWorkflowRepository struct {
+	CreateFunc          func(ctx context.Context, transition *domain.WorkflowTransition) error
+	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.WorkflowTransition, error)
+	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.WorkflowTransition, error)
+	UpdateFunc          func(ctx context.Context, transition *domain.WorkflowTransition) error
+	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockWorkflowRepository) Create(ctx context.Context, transition *domain.WorkflowTransition) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, transition)
+	}
+	return nil
+}
+
+func (m *MockWorkflowRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.WorkflowTransition, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockWorkflowRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WorkflowTransition, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockWorkflowRepository) Update(ctx context.Context, transition *domain.WorkflowTransition) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, transition)
+	}
+	return nil
+}
+
+func (m *MockWorkflowRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockAutomationRepository is a mock implementation of AutomationRepository
+type MockAutomationRepository struct {
+	CreateRuleFunc           func(ctx context.Context, rule *domain.AutomationRule) error
+	FindRuleByIDFunc         func(ctx context.Context, id uuid.UUID) (*domain.AutomationRule, error)
+	FindRulesByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.AutomationRule, error)
+	FindEnabledRulesFunc     func(ctx context.Context, projectID uuid.UUID, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error)
+	UpdateRuleFunc           func(ctx context.Context, rule *domain.AutomationRule) error
+	DeleteRuleFunc           func(ctx context.Context, id uuid.UUID) error
+	CreateExecutionFunc      func(ctx context.Context, execution *domain.AutomationExecution) error
+	FindExecutionsFunc       func(ctx context.Context, projectID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*domain.AutomationExecution, error)
+	FindDueDateTargetsFunc   func(ctx context.Context, since, now time.Time, limit int) ([]*repository.DueDateAutomationTarget, error)
+	ClaimDueDateFiringFunc   func(ctx context.Context, target *repository.DueDateAutomationTarget, firedAt time.Time) (bool, error)
+}
+
+func (m *MockAutomationRepository) CreateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	if m.CreateRuleFunc != nil {
+		return m.CreateRuleFunc(ctx, rule)
+	}
+	return nil
+}
+
+func (m *MockAutomationRepository) FindRuleByID(ctx context.Context, id uuid.UUID) (*domain.AutomationRule, error) {
+	if m.FindRuleByIDFunc != nil {
+		return m.FindRuleByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockAutomationRepository) FindRulesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.AutomationRule, error) {
+	if m.FindRulesByProjectIDFunc != nil {
+		return m.FindRulesByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockAutomationRepository) FindEnabledRules(ctx context.Context, projectID uuid.UUID, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error) {
+	if m.FindEnabledRulesFunc != nil {
+		return m.FindEnabledRulesFunc(ctx, projectID, trigger)
+	}
+	return nil, nil
+}
+
+func (m *MockAutomationRepository) UpdateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	if m.UpdateRuleFunc != nil {
+		return m.UpdateRuleFunc(ctx, rule)
+	}
+	return nil
+}
+
+func (m *MockAutomationRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteRuleFunc != nil {
+		return m.DeleteRuleFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockAutomationRepository) CreateExecution(ctx context.Context, execution *domain.AutomationExecution) error {
+	if m.CreateExecutionFunc != nil {
+		return m.CreateExecutionFunc(ctx, execution)
+	}
+	return nil
+}
+
+func (m *MockAutomationRepository) FindExecutions(ctx context.Context, projectID uuid.UUID, ruleID *uuid.UUID, limit int) ([]*domain.AutomationExecution, error) {
+	if m.FindExecutionsFunc != nil {
+		return m.FindExecutionsFunc(ctx, projectID, ruleID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockAutomationRepository) FindDueDateTargets(ctx context.Context, since, now time.Time, limit int) ([]*repository.DueDateAutomationTarget, error) {
+	if m.FindDueDateTargetsFunc != nil {
+		return m.FindDueDateTargetsFunc(ctx, since, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockAutomationRepository) ClaimDueDateFiring(ctx context.Context, target *repository.DueDateAutomationTarget, firedAt time.Time) (bool, error) {
+	if m.ClaimDueDateFiringFunc != nil {
+		return m.ClaimDueDateFiringFunc(ctx, target, firedAt)
+	}
+	return true, nil
+}
+
+// MockAutomationEngine is a mock implementation of AutomationEngine that records events
+type MockAutomationEngine struct {
+	Events []AutomationEvent
+}
+
+func (m *MockAutomationEngine) Dispatch(ctx context.Context, event AutomationEvent) {
+	m.Events = append(m.Events, event)
+}
+
+func (m *MockAutomationEngine) Process(ctx context.Context, event AutomationEvent) {
+	m.Events = append(m.Events, event)
+}
+
+// MockWebhookRepository is a mock implementation of WebhookRepository
+type MockWebhookRepository struct {
+	CreateSubscriptionFunc             func(ctx context.Context, subscription *domain.WebhookSubscription) error
+	FindSubscriptionByIDFunc           func(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+	FindSubscriptionsByProjectIDFunc   func(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	FindEnabledSubscriptionsFunc       func(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	UpdateSubscriptionFunc             func(ctx context.Context, subscription *domain.WebhookSubscription) error
+	DeleteSubscriptionFunc             func(ctx context.Context, id uuid.UUID) error
+	CreateDeliveryFunc                 func(ctx context.Context, delivery *domain.WebhookDelivery) error
+	FindDeliveryByIDFunc               func(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)
+	FindDeliveriesBySubscriptionIDFunc func(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error)
+	FindDueDeliveriesFunc              func(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+	ClaimDueDeliveryFunc               func(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error)
+	UpdateDeliveryFunc                 func(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+func (m *MockWebhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	if m.CreateSubscriptionFunc != nil {
+		return m.CreateSubscriptionFunc(ctx, subscription)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) FindSubscriptionByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	if m.FindSubscriptionByIDFunc != nil {
+		return m.FindSubscriptionByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) FindSubscriptionsByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	if m.FindSubscriptionsByProjectIDFunc != nil {
+		return m.FindSubscriptionsByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) FindEnabledSubscriptions(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	if m.FindEnabledSubscriptionsFunc != nil {
+		return m.FindEnabledSubscriptionsFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	if m.UpdateSubscriptionFunc != nil {
+		return m.UpdateSubscriptionFunc(ctx, subscription)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteSubscriptionFunc != nil {
+		return m.DeleteSubscriptionFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if m.CreateDeliveryFunc != nil {
+		return m.CreateDeliveryFunc(ctx, delivery)
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) FindDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	if m.FindDeliveryByIDFunc != nil {
+		return m.FindDeliveryByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) FindDeliveriesBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error) {
+	if m.FindDeliveriesBySubscriptionIDFunc != nil {
+		return m.FindDeliveriesBySubscriptionIDFunc(ctx, subscriptionID, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	if m.FindDueDeliveriesFunc != nil {
+		return m.FindDueDeliveriesFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookRepository) ClaimDueDelivery(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error) {
+	if m.ClaimDueDeliveryFunc != nil {
+		return m.ClaimDueDeliveryFunc(ctx, id, now, leaseUntil)
+	}
+	return true, nil
+}
+
+func (m *MockWebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if m.UpdateDeliveryFunc != nil {
+		return m.UpdateDeliveryFunc(ctx, delivery)
+	}
+	return nil
+}
+
+// MockBoardReferenceRepository is a mock implementation of BoardReferenceRepository
+type MockBoardReferenceRepository struct {
+	ReplaceBySourceFunc             func(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID, sourceBoardID uuid.UUID, targetBoardIDs []uuid.UUID) error
+	DeleteBySourceFunc              func(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID uuid.UUID) error
+	FindByTargetBoardIDFunc         func(ctx context.Context, targetBoardID, viewerID uuid.UUID) ([]*domain.BoardReference, error)
+	FindBoardIDsInSameWorkspaceFunc func(ctx context.Context, boardID uuid.UUID, candidateIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+func (m *MockBoardReferenceRepository) ReplaceBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID, sourceBoardID uuid.UUID, targetBoardIDs []uuid.UUID) error {
+	if m.ReplaceBySourceFunc != nil {
+		return m.ReplaceBySourceFunc(ctx, sourceType, sourceID, sourceBoardID, targetBoardIDs)
+	}
+	return nil
+}
+
+func (m *MockBoardReferenceRepository) DeleteBySource(ctx context.Context, sourceType domain.BoardReferenceSourceType, sourceID uuid.UUID) error {
+	if m.DeleteBySourceFunc != nil {
+		return m.DeleteBySourceFunc(ctx, sourceType, sourceID)
+	}
+	return nil
+}
+
+func (m *MockBoardReferenceRepository) FindByTargetBoardID(ctx context.Context, targetBoardID, viewerID uuid.UUID) ([]*domain.BoardReference, error) {
+	if m.FindByTargetBoardIDFunc != nil {
+		return m.FindByTargetBoardIDFunc(ctx, targetBoardID, viewerID)
+	}
+	return []*domain.BoardReference{}, nil
+}
+
+func (m *MockBoardReferenceRepository) FindBoardIDsInSameWorkspace(ctx context.Context, boardID uuid.UUID, candidateIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindBoardIDsInSameWorkspaceFunc != nil {
+		return m.FindBoardIDsInSameWorkspaceFunc(ctx, boardID, candidateIDs)
+	}
+	return candidateIDs, nil
+}
+
+// MockLabelRepository is a mock implementation of LabelRepository
+type MockLabelRepository struct {
+	CreateFunc                func(ctx context.Context, label *domain.Label) error
+	FindByIDFunc              func(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	FindByIDsFunc             func(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error)
+	FindByProjectIDFunc       func(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error)
+	FindByProjectAndNameFunc  func(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error)
+	UpdateFunc                func(ctx context.Context, label *domain.Label) error
+	DeleteFunc                func(ctx context.Context, id uuid.UUID) error
+	CountUsageByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]domain.LabelUsage, error)
+	ReplaceBoardLabelsFunc    func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	MergeFunc                 func(ctx context.Context, sourceID, targetID uuid.UUID) error
+}
+
+func (m *MockLabelRepository) Create(ctx context.Context, label *domain.Label) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, label)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error) {
+	if m.FindByProjectAndNameFunc != nil {
+		return m.FindByProjectAndNameFunc(ctx, projectID, name)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) Update(ctx context.Context, label *domain.Label) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, label)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) CountUsageByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.LabelUsage, error) {
+	if m.CountUsageByProjectIDFunc != nil {
+		return m.CountUsageByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) ReplaceBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if m.ReplaceBoardLabelsFunc != nil {
+		return m.ReplaceBoardLabelsFunc(ctx, boardID, labelIDs)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) Merge(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	if m.MergeFunc != nil {
+		return m.MergeFunc(ctx, sourceID, targetID)
+	}
+	return nil
+}
+
+// memberWithRole returns a FindMemberByProjectAndUserFunc that treats every user as a member with the role
+func memberWithRole(role domain.ProjectRole) func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	return func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+		return &domain.ProjectMember{ID: uuid.New(), ProjectID: projectID, UserID: userID, RoleName: role}, nil
+	}
+}
+
+// MockProjectRoleRepository is a mock implementation of ProjectRoleRepository
+type MockProjectRoleRepository struct {
+	CreateFunc               func(ctx context.Context, role *domain.ProjectCustomRole) error
+	FindByIDFunc             func(ctx context.Context, id uuid.UUID) (*domain.ProjectCustomRole, error)
+	FindByProjectIDFunc      func(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectCustomRole, error)
+	FindByProjectAndNameFunc func(ctx context.Context, projectID uuid.UUID, name string) (*domain.ProjectCustomRole, error)
+	UpdateFunc               func(ctx context.Context, role *domain.ProjectCustomRole) error
+	DeleteFunc               func(ctx context.Context, id uuid.UUID) error
+	AssignToMemberFunc       func(ctx context.Context, memberID uuid.UUID, roleID *uuid.UUID) error
+}
+
+func (m *MockProjectRoleRepository) Create(ctx context.Context, role *domain.ProjectCustomRole) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, role)
+	}
+	return nil
+}
+
+func (m *MockProjectRoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectCustomRole, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectRoleRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectCustomRole, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectRoleRepository) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.ProjectCustomRole, error) {
+	if m.FindByProjectAndNameFunc != nil {
+		return m.FindByProjectAndNameFunc(ctx, projectID, name)
+	}
+	return nil, nil
+}
+
+func (m *MockProjectRoleRepository) Update(ctx context.Context, role *domain.ProjectCustomRole) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, role)
+	}
+	return nil
+}
+
+func (m *MockProjectRoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockProjectRoleRepository) AssignToMember(ctx context.Context, memberID uuid.UUID, roleID *uuid.UUID) error {
+	if m.AssignToMemberFunc != nil {
+		return m.AssignToMemberFunc(ctx, memberID, roleID)
+	}
+	return nil
+}
+
+// MockWatcherRepository is a mock implementation of WatcherRepository
+type MockWatcherRepository struct {
+	WatchFunc                   func(ctx context.Context, watcher *domain.Watcher) (bool, error)
+	UnwatchFunc                 func(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) error
+	FindWatcherFunc             func(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) (*domain.Watcher, error)
+	FindByTargetFunc            func(ctx context.Context, targetType domain.WatchTargetType, targetID uuid.UUID) ([]*domain.Watcher, error)
+	FindBoardWatcherUserIDsFunc func(ctx context.Context, boardID, projectID uuid.UUID) ([]uuid.UUID, error)
+	MuteFunc                    func(ctx context.Context, boardID, userID uuid.UUID) error
+	UnmuteFunc                  func(ctx context.Context, boardID, userID uuid.UUID) error
+	IsMutedFunc                 func(ctx context.Context, boardID, userID uuid.UUID) (bool, error)
+	FindMutedUserIDsFunc        func(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error)
+}
+
+func (m *MockWatcherRepository) Watch(ctx context.Context, watcher *domain.Watcher) (bool, error) {
+	if m.WatchFunc != nil {
+		return m.WatchFunc(ctx, watcher)
+	}
+	return true, nil
+}
+
+func (m *MockWatcherRepository) Unwatch(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) error {
+	if m.UnwatchFunc != nil {
+		return m.UnwatchFunc(ctx, targetType, targetID, userID)
+	}
+	return nil
+}
+
+func (m *MockWatcherRepository) FindWatcher(ctx context.Context, targetType domain.WatchTargetType, targetID, userID uuid.UUID) (*domain.Watcher, error) {
+	if m.FindWatcherFunc != nil {
+		return m.FindWatcherFunc(ctx, targetType, targetID, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockWatcherRepository) FindByTarget(ctx context.Context, targetType domain.WatchTargetType, targetID uuid.UUID) ([]*domain.Watcher, error) {
+	if m.FindByTargetFunc != nil {
+		return m.FindByTargetFunc(ctx, targetType, targetID)
+	}
+	return nil, nil
+}
+
+func (m *MockWatcherRepository) FindBoardWatcherUserIDs(ctx context.Context, boardID, projectID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindBoardWatcherUserIDsFunc != nil {
+		return m.FindBoardWatcherUserIDsFunc(ctx, boardID, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockWatcherRepository) Mute(ctx context.Context, boardID, userID uuid.UUID) error {
+	if m.MuteFunc != nil {
+		return m.MuteFunc(ctx, boardID, userID)
+	}
+	return nil
+}
+
+func (m *MockWatcherRepository) Unmute(ctx context.Context, boardID, userID uuid.UUID) error {
+	if m.UnmuteFunc != nil {
+		return m.UnmuteFunc(ctx, boardID, userID)
+	}
+	return nil
+}
+
+func (m *MockWatcherRepository) IsMuted(ctx context.Context, boardID, userID uuid.UUID) (bool, error) {
+	if m.IsMutedFunc != nil {
+		return m.IsMutedFunc(ctx, boardID, userID)
+	}
+	return false, nil
+}
+
+func (m *MockWatcherRepository) FindMutedUserIDs(ctx context.Context, boardID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindMutedUserIDsFunc != nil {
+		return m.FindMutedUserIDsFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+// MockSprintRepository is a mock implementation of SprintRepository
+type MockSprintRepository struct {
+	CreateFunc                 func(ctx context.Context, sprint *domain.Sprint) error
+	FindByIDFunc               func(ctx context.Context, id uuid.UUID) (*domain.Sprint, error)
+	FindByProjectIDFunc        func(ctx context.Context, projectID uuid.UUID) ([]*domain.Sprint, error)
+	FindActiveByProjectIDFunc  func(ctx context.Context, projectID uuid.UUID) (*domain.Sprint, error)
+	UpdateFunc                 func(ctx context.Context, sprint *domain.Sprint) error
+	DeleteFunc                 func(ctx context.Context, id uuid.UUID) error
+	FindBoardsFunc             func(ctx context.Context, sprintID uuid.UUID) ([]*domain.Board, error)
+	CountBoardsByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]domain.SprintBoardCount, error)
+	AssignBoardsFunc           func(ctx context.Context, sprintID *uuid.UUID, boardIDs []uuid.UUID) error
+	AddScopeChangesFunc        func(ctx context.Context, changes []*domain.SprintScopeChange) error
+	FindScopeChangesFunc       func(ctx context.Context, sprintID uuid.UUID) ([]*domain.SprintScopeChange, error)
+}
+
+func (m *MockSprintRepository) Create(ctx context.Context, sprint *domain.Sprint) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, sprint)
+	}
+	return nil
+}
+
+func (m *MockSprintRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Sprint, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockSprintRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Sprint, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockSprintRepository) FindActiveByProjectID(ctx context.Context, projectID uuid.UUID) (*domain.Sprint, error) {
+	if m.FindActiveByProjectIDFunc != nil {
+		return m.FindActiveByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockSprintRepository) Update(ctx context.Context, sprint *domain.Sprint) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, sprint)
+	}
+	return nil
+}
+
+func (m *MockSprintRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockSprintRepository) FindBoards(ctx context.Context, sprintID uuid.UUID) ([]*domain.Board, error) {
+	if m.FindBoardsFunc != nil {
+		return m.FindBoardsFunc(ctx, sprintID)
+	}
+	return nil, nil
+}
+
+func (m *MockSprintRepository) CountBoardsByProjectID(ctx context.Context, projectID uuid.UUID) ([]domain.SprintBoardCount, error) {
+	if m.CountBoardsByProjectIDFunc != nil {
+		return m.CountBoardsByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockSprintRepository) AssignBoards(ctx context.Context, sprintID *uuid.UUID, boardIDs []uuid.UUID) error {
+	if m.AssignBoardsFunc != nil {
+		return m.AssignBoardsFunc(ctx, sprintID, boardIDs)
+	}
+	return nil
+}
+
+func (m *MockSprintRepository) AddScopeChanges(ctx context.Context, changes []*domain.SprintScopeChange) error {
+	if m.AddScopeChangesFunc != nil {
+		return m.AddScopeChangesFunc(ctx, changes)
+	}
+	return nil
+}
+
+func (m *MockSprintRepository) FindScopeChanges(ctx context.Context, sprintID uuid.UUID) ([]*domain.SprintScopeChange, error) {
+	if m.FindScopeChangesFunc != nil {
+		return m.FindScopeChangesFunc(ctx, sprintID)
+	}
+	return nil, nil
+}
+
+// MockReminderRepository is a mock implementation of ReminderRepository
+type MockReminderRepository struct {
+	CreateFunc                     func(ctx context.Context, reminder *domain.BoardReminder) error
+	FindByIDFunc                   func(ctx context.Context, id uuid.UUID) (*domain.BoardReminder, error)
+	FindByUserFunc                 func(ctx context.Context, userID uuid.UUID, status *domain.ReminderStatus) ([]*domain.BoardReminder, error)
+	FindByBoardAndUserFunc         func(ctx context.Context, boardID, userID uuid.UUID) ([]*domain.BoardReminder, error)
+	CountPendingByBoardAndUserFunc func(ctx context.Context, boardID, userID uuid.UUID) (int64, error)
+	FindDueFunc                    func(ctx context.Context, now time.Time, limit int) ([]*domain.BoardReminder, error)
+	ClaimDueFunc                   func(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error)
+	CancelPendingFunc              func(ctx context.Context, id uuid.UUID, reason domain.ReminderCancelReason, cancelledAt time.Time) (bool, error)
+	UpdateFunc                     func(ctx context.Context, reminder *domain.BoardReminder) error
+	DeleteFunc                     func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *domain.BoardReminder) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardReminder, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) FindByUser(ctx context.Context, userID uuid.UUID, status *domain.ReminderStatus) ([]*domain.BoardReminder, error) {
+	if m.FindByUserFunc != nil {
+		return m.FindByUserFunc(ctx, userID, status)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) ([]*domain.BoardReminder, error) {
+	if m.FindByBoardAndUserFunc != nil {
+		return m.FindByBoardAndUserFunc(ctx, boardID, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) CountPendingByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (int64, error) {
+	if m.CountPendingByBoardAndUserFunc != nil {
+		return m.CountPendingByBoardAndUserFunc(ctx, boardID, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockReminderRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.BoardReminder, error) {
+	if m.FindDueFunc != nil {
+		return m.FindDueFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) ClaimDue(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+	if m.ClaimDueFunc != nil {
+		return m.ClaimDueFunc(ctx, id, sentAt)
+	}
+	return false, nil
+}
+
+func (m *MockReminderRepository) CancelPending(ctx context.Context, id uuid.UUID, reason domain.ReminderCancelReason, cancelledAt time.Time) (bool, error) {
+	if m.CancelPendingFunc != nil {
+		return m.CancelPendingFunc(ctx, id, reason, cancelledAt)
+	}
+	return false, nil
+}
+
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *domain.BoardReminder) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockRecurrenceRepository is a mock implementation of RecurrenceRepository
+type MockRecurrenceRepository struct {
+	CreateFunc     func(ctx context.Context, recurrence *domain.BoardRecurrence) error
+	FindByIDFunc   func(ctx context.Context, id uuid.UUID) (*domain.BoardRecurrence, error)
+	FindActiveFunc func(ctx context.Context, limit int) ([]*domain.BoardRecurrence, error)
+	UpdateFunc     func(ctx context.Context, recurrence *domain.BoardRecurrence) error
+	AdvanceFunc    func(ctx context.Context, recurrence *domain.BoardRecurrence, fromLatestBoardID uuid.UUID, fromNextAt *time.Time) (bool, error)
+	DeleteFunc     func(ctx context.Context, id uuid.UUID) error
+	LinkBoardFunc  func(ctx context.Context, boardID uuid.UUID, recurrenceID *uuid.UUID) error
+}
+
+func (m *MockRecurrenceRepository) Create(ctx context.Context, recurrence *domain.BoardRecurrence) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, recurrence)
+	}
+	return nil
+}
+
+func (m *MockRecurrenceRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardRecurrence, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockRecurrenceRepository) FindActive(ctx context.Context, limit int) ([]*domain.BoardRecurrence, error) {
+	if m.FindActiveFunc != nil {
+		return m.FindActiveFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockRecurrenceRepository) Update(ctx context.Context, recurrence *domain.BoardRecurrence) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, recurrence)
+	}
+	return nil
+}
+
+func (m *MockRecurrenceRepository) Advance(ctx context.Context, recurrence *domain.BoardRecurrence, fromLatestBoardID uuid.UUID, fromNextAt *time.Time) (bool, error) {
+	if m.AdvanceFunc != nil {
+		return m.AdvanceFunc(ctx, recurrence, fromLatestBoardID, fromNextAt)
+	}
+	return false, nil
+}
+
+func (m *MockRecurrenceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockRecurrenceRepository) LinkBoard(ctx context.Context, boardID uuid.UUID, recurrenceID *uuid.UUID) error {
+	if m.LinkBoardFunc != nil {
+		return m.LinkBoardFunc(ctx, boardID, recurrenceID)
+	}
+	return nil
+}
+
+// MockInviteLinkRepository is a mock implementation of InviteLinkRepository
+type MockInviteLinkRepository struct {
+	CreateFunc        func(ctx context.Context, link *domain.ProjectInviteLink) error
+	FindByIDFunc      func(ctx context.Context, id uuid.UUID) (*domain.ProjectInviteLink, error)
+	FindByTokenFunc   func(ctx context.Context, token string) (*domain.ProjectInviteLink, error)
+	FindByProjectFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectInviteLink, error)
+	RevokeFunc        func(ctx context.Context, id, revokedBy uuid.UUID, revokedAt time.Time) error
+	ConsumeUseFunc    func(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+}
+
+func (m *MockInviteLinkRepository) Create(ctx context.Context, link *domain.ProjectInviteLink) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, link)
+	}
+	return nil
+}
+
+func (m *MockInviteLinkRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ProjectInviteLink, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockInviteLinkRepository) FindByToken(ctx context.Context, token string) (*domain.ProjectInviteLink, error) {
+	if m.FindByTokenFunc != nil {
+		return m.FindByTokenFunc(ctx, token)
+	}
+	return nil, nil
+}
+
+func (m *MockInviteLinkRepository) FindByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectInviteLink, error) {
+	if m.FindByProjectFunc != nil {
+		return m.FindByProjectFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockInviteLinkRepository) Revoke(ctx context.Context, id, revokedBy uuid.UUID, revokedAt time.Time) error {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, id, revokedBy, revokedAt)
+	}
+	return nil
+}
+
+func (m *MockInviteLinkRepository) ConsumeUse(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	if m.ConsumeUseFunc != nil {
+		return m.ConsumeUseFunc(ctx, id, now)
+	}
+	return true, nil
+}
+
+// MockReportTemplateRepository is a mock implementation of ReportTemplateRepository
+type MockReportTemplateRepository struct {
+	FindByWorkspaceFunc          func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ReportTemplate, error)
+	FindByWorkspaceAndFormatFunc func(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) (*domain.ReportTemplate, error)
+	SaveFunc                     func(ctx context.Context, template *domain.ReportTemplate) error
+	DeleteFunc                   func(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) error
+}
+
+func (m *MockReportTemplateRepository) FindByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ReportTemplate, error) {
+	if m.FindByWorkspaceFunc != nil {
+		return m.FindByWorkspaceFunc(ctx, workspaceID)
+	}
+	return nil, nil
+}
+
+func (m *MockReportTemplateRepository) FindByWorkspaceAndFormat(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) (*domain.ReportTemplate, error) {
+	if m.FindByWorkspaceAndFormatFunc != nil {
+		return m.FindByWorkspaceAndFormatFunc(ctx, workspaceID, format)
+	}
+	return nil, nil
+}
+
+func (m *MockReportTemplateRepository) Save(ctx context.Context, template *domain.ReportTemplate) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, template)
+	}
+	return nil
+}
+
+func (m *MockReportTemplateRepository) Delete(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, workspaceID, format)
+	}
+	return nil
+}
@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/metrics"
+	"project-board-api/internal/repository"
+)
+
+// Outbox relay retry policy
+// A failed attempt n is retried after outboxRetryBaseDelay * 2^(n-1) (capped at outboxRetryMaxDelay)
+// and the event becomes DEAD after outboxMaxAttempts failed attempts (about an hour after the change)
+const (
+	outboxMaxAttempts    = 12
+	outboxRetryBaseDelay = 5 * time.Second
+	outboxRetryMaxDelay  = 10 * time.Minute
+	outboxBatchSize      = 100
+	outboxRetention      = 7 * 24 * time.Hour // delivered events are purged after this
+)
+
+// outboxAttemptLease is how long an in-flight attempt keeps an event away from other relays
+const outboxAttemptLease = time.Minute
+
+// errOutboxUndeliverable marks events that no retry can deliver; they go to DEAD right away
+var errOutboxUndeliverable = errors.New("undeliverable outbox event")
+
+// OutboxBroadcaster publishes a real-time message on the channel of a project
+type OutboxBroadcaster interface {
+	Broadcast(ctx context.Context, projectID uuid.UUID, message []byte) error
+}
+
+// Outbox records side effects (notifications, real-time events) in the transaction of the change
+// that causes them and relays them to noti-service and Redis afterwards
+// Delivery is at least once: notifications carry an idempotency key and broadcasts an event ID
+type Outbox interface {
+	// WithinTx runs fn in a transaction; events added with the context passed to fn commit with it
+	// Called with a transaction context it runs fn in a savepoint of that transaction
+	// The relay is woken up once the outermost transaction has committed
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// AddNotifications records notifications about an aggregate
+	AddNotifications(ctx context.Context, aggregateType domain.OutboxAggregateType, aggregateID uuid.UUID, events []*client.NotificationEvent) error
+	// AddBroadcast records a real-time event for the WebSocket clients of a project
+	AddBroadcast(ctx context.Context, aggregateType domain.OutboxAggregateType, aggregateID, projectID uuid.UUID, event *dto.BoardEvent) error
+	// Kick delivers due events in the background without waiting for the relay job
+	Kick()
+	// DeliverDue delivers due events in order per aggregate and returns how many were attempted
+	DeliverDue(ctx context.Context) (int, error)
+	// PurgeDelivered removes delivered events older than the retention period
+	PurgeDelivered(ctx context.Context) (int64, error)
+}
+
+// outboxImpl is the implementation of Outbox
+type outboxImpl struct {
+	outboxRepo  repository.OutboxRepository
+	txManager   repository.TxManager
+	notiClient  client.NotiClient // nil moves notifications to DEAD
+	broadcaster OutboxBroadcaster // nil moves broadcasts to DEAD
+	metrics     *metrics.Metrics
+	logger      *zap.Logger
+	now         func() time.Time
+
+	mu   sync.Mutex    // one delivery pass at a time per replica
+	wake chan struct{} // holds a token while a kicked pass is waiting to start
+}
+
+// NewOutbox creates a new instance of Outbox
+func NewOutbox(
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TxManager,
+	notiClient client.NotiClient,
+	broadcaster OutboxBroadcaster,
+	m *metrics.Metrics,
+	logger *zap.Logger,
+) Outbox {
+	return &outboxImpl{
+		outboxRepo:  outboxRepo,
+		txManager:   txManager,
+		notiClient:  notiClient,
+		broadcaster: broadcaster,
+		metrics:     m,
+		logger:      logger,
+		now:         time.Now,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// outboxBackoff returns the delay before the next attempt after the given number of failed attempts
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= outboxRetryMaxDelay {
+			return outboxRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// WithinTx runs fn in a transaction and wakes the relay after the commit
+func (o *outboxImpl) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	nested := repository.InTx(ctx)
+	if err := o.txManager.WithinTx(ctx, fn); err != nil {
+		return err
+	}
+	if !nested {
+		o.Kick()
+	}
+	return nil
+}
+
+// AddNotifications stores one outbox event per notification
+// The idempotency key and the event time travel with the notification so that noti-service
+// stores it once and with its original time, however late it is delivered
+func (o *outboxImpl) AddNotifications(ctx context.Context, aggregateType domain.OutboxAggregateType, aggregateID uuid.UUID, events []*client.NotificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := o.now()
+	occurredAt := now.UTC()
+	rows := make([]*domain.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		key := uuid.New()
+		keyString := key.String()
+		event.IdempotencyKey = &keyString
+		event.OccurredAt = &occurredAt
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode notification: %w", err)
+		}
+		rows = append(rows, &domain.OutboxEvent{
+			IdempotencyKey: key,
+			AggregateType:  aggregateType,
+			AggregateID:    aggregateID,
+			Topic:          domain.OutboxTopicNotification,
+			EventType:      string(event.Type),
+			Payload:        payload,
+			Status:         domain.OutboxStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+	return o.outboxRepo.Create(ctx, rows)
+}
+
+// AddBroadcast stores a real-time event; its event ID doubles as the idempotency key
+func (o *outboxImpl) AddBroadcast(ctx context.Context, aggregateType domain.OutboxAggregateType, aggregateID, projectID uuid.UUID, event *dto.BoardEvent) error {
+	now := o.now()
+	key := uuid.New()
+	event.EventID = key.String()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode board event: %w", err)
+	}
+	return o.outboxRepo.Create(ctx, []*domain.OutboxEvent{{
+		IdempotencyKey: key,
+		AggregateType:  aggregateType,
+		AggregateID:    aggregateID,
+		Topic:          domain.OutboxTopicBroadcast,
+		EventType:      event.Type,
+		ProjectID:      &projectID,
+		Payload:        payload,
+		Status:         domain.OutboxStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}})
+}
+
+// Kick starts a delivery pass in the background
+// At most one pass waits behind the running one; it picks up everything committed before it starts
+func (o *outboxImpl) Kick() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+		return
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				o.logger.Error("Outbox delivery panicked", zap.Any("panic", r))
+			}
+		}()
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		<-o.wake
+
+		if _, err := o.deliverDue(context.Background()); err != nil {
+			o.logger.Warn("Failed to deliver outbox events", zap.Error(err))
+		}
+	}()
+}
+
+// DeliverDue delivers due events until none is left or a pass delivers nothing
+func (o *outboxImpl) DeliverDue(ctx context.Context) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.deliverDue(ctx)
+}
+
+// deliverDue runs delivery rounds; each round only sees the oldest pending event of every
+// aggregate and topic, so the next event of an aggregate becomes visible once its predecessor
+// is delivered
+func (o *outboxImpl) deliverDue(ctx context.Context) (int, error) {
+	attempted := 0
+	defer o.recordBacklog(ctx)
+
+	for {
+		now := o.now()
+		events, err := o.outboxRepo.FindDeliverable(ctx, now, outboxBatchSize)
+		if err != nil {
+			return attempted, err
+		}
+		if len(events) == 0 {
+			return attempted, nil
+		}
+
+		delivered := 0
+		for _, event := range events {
+			claimed, err := o.outboxRepo.ClaimDue(ctx, event.ID, now, now.Add(outboxAttemptLease))
+			if err != nil {
+				return attempted, err
+			}
+			if !claimed {
+				continue
+			}
+
+			attempted++
+			ok, err := o.attempt(ctx, event)
+			if err != nil {
+				return attempted, err
+			}
+			if ok {
+				delivered++
+			}
+		}
+		if delivered == 0 {
+			return attempted, nil
+		}
+	}
+}
+
+// attempt delivers one claimed event and stores the outcome
+// It reports whether the event was delivered; the error only reports failures to store the outcome
+func (o *outboxImpl) attempt(ctx context.Context, event *domain.OutboxEvent) (bool, error) {
+	sendErr := o.send(ctx, event)
+
+	now := o.now()
+	event.Attempts++
+	if sendErr == nil {
+		event.Status = domain.OutboxStatusDelivered
+		event.LastError = ""
+		event.DeliveredAt = &now
+		if o.metrics != nil {
+			o.metrics.RecordOutboxDelivered()
+		}
+		return true, o.outboxRepo.Update(ctx, event)
+	}
+
+	event.LastError = sendErr.Error()
+	dead := errors.Is(sendErr, errOutboxUndeliverable) || event.Attempts >= outboxMaxAttempts
+	if dead {
+		event.Status = domain.OutboxStatusDead
+		o.logger.Warn("Outbox event moved to dead letter",
+			zap.Int64("outbox.id", event.ID),
+			zap.String("aggregate.type", string(event.AggregateType)),
+			zap.String("aggregate.id", event.AggregateID.String()),
+			zap.String("event.type", event.EventType),
+			zap.Int("attempts", event.Attempts),
+			zap.Error(sendErr))
+	} else {
+		event.NextAttemptAt = now.Add(outboxBackoff(event.Attempts))
+	}
+	if o.metrics != nil {
+		o.metrics.RecordOutboxFailure(dead)
+	}
+	return false, o.outboxRepo.Update(ctx, event)
+}
+
+// send hands the event to its destination
+func (o *outboxImpl) send(ctx context.Context, event *domain.OutboxEvent) error {
+	switch event.Topic {
+	case domain.OutboxTopicNotification:
+		if o.notiClient == nil {
+			return fmt.Errorf("%w: noti-service is not configured", errOutboxUndeliverable)
+		}
+		var notification client.NotificationEvent
+		if err := json.Unmarshal(event.Payload, &notification); err != nil {
+			return fmt.Errorf("%w: %v", errOutboxUndeliverable, err)
+		}
+		return o.notiClient.SendNotification(ctx, &notification)
+	case domain.OutboxTopicBroadcast:
+		if o.broadcaster == nil || event.ProjectID == nil {
+			return fmt.Errorf("%w: no broadcast channel", errOutboxUndeliverable)
+		}
+		return o.broadcaster.Broadcast(ctx, *event.ProjectID, event.Payload)
+	default:
+		return fmt.Errorf("%w: unknown topic %q", errOutboxUndeliverable, event.Topic)
+	}
+}
+
+// recordBacklog updates the pending count and lag gauges
+func (o *outboxImpl) recordBacklog(ctx context.Context) {
+	if o.metrics == nil {
+		return
+	}
+	pending, err := o.outboxRepo.CountPending(ctx)
+	if err != nil {
+		o.logger.Warn("Failed to count pending outbox events", zap.Error(err))
+		return
+	}
+	oldest, err := o.outboxRepo.FindOldestPendingCreatedAt(ctx)
+	if err != nil {
+		o.logger.Warn("Failed to find the oldest pending outbox event", zap.Error(err))
+		return
+	}
+	var lag time.Duration
+	if oldest != nil {
+		lag = o.now().Sub(*oldest)
+	}
+	o.metrics.SetOutboxBacklog(pending, lag)
+}
+
+// PurgeDelivered removes delivered events older than outboxRetention
+func (o *outboxImpl) PurgeDelivered(ctx context.Context) (int64, error) {
+	return o.outboxRepo.DeleteDeliveredBefore(ctx, o.now().Add(-outboxRetention))
+}
+
+// runInTx runs fn in an outbox transaction, or directly when no outbox is configured
+func runInTx(ctx context.Context, outbox Outbox, fn func(ctx context.Context) error) error {
+	if outbox == nil {
+		return fn(ctx)
+	}
+	return outbox.WithinTx(ctx, fn)
+}
+
+// recordNotifications adds notifications to the outbox
+// Without an outbox they are sent in the background on a best-effort basis
+func recordNotifications(ctx context.Context, outbox Outbox, notiClient client.NotiClient, logger *zap.Logger, aggregateType domain.OutboxAggregateType, aggregateID uuid.UUID, events []*client.NotificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if outbox != nil {
+		return outbox.AddNotifications(ctx, aggregateType, aggregateID, events)
+	}
+	if notiClient == nil {
+		return nil
+	}
+	go func() {
+		// Use background context to avoid cancellation when request completes
+		for _, event := range events {
+			if err := notiClient.SendNotification(context.Background(), event); err != nil {
+				logger.Warn("Failed to send notification",
+					zap.String("notification.type", string(event.Type)),
+					zap.String("resource.id", event.ResourceID.String()),
+					zap.String("target.user.id", event.TargetUserID.String()),
+					zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+// recordBoardBroadcast adds a real-time board event to the outbox (no-op without an outbox)
+func recordBoardBroadcast(ctx context.Context, outbox Outbox, projectID, boardID uuid.UUID, eventType string, payload interface{}) error {
+	if outbox == nil {
+		return nil
+	}
+	return outbox.AddBroadcast(ctx, domain.OutboxAggregateBoard, boardID, projectID, &dto.BoardEvent{
+		Type:    eventType,
+		BoardID: boardID.String(),
+		Payload: payload,
+	})
+}
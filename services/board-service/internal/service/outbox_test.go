@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// outboxTestStore is an in-memory OutboxRepository with the same deliverability rules as the GORM one
+type outboxTestStore struct {
+	mu     sync.Mutex
+	nextID int64
+	events map[int64]*domain.OutboxEvent
+}
+
+func newOutboxTestStore() *outboxTestStore {
+	return &outboxTestStore{events: make(map[int64]*domain.OutboxEvent)}
+}
+
+func (s *outboxTestStore) Create(ctx context.Context, events []*domain.OutboxEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range events {
+		s.nextID++
+		event.ID = s.nextID
+		copied := *event
+		s.events[event.ID] = &copied
+	}
+	return nil
+}
+
+func (s *outboxTestStore) sorted() []*domain.OutboxEvent {
+	events := make([]*domain.OutboxEvent, 0, len(s.events))
+	for _, event := range s.events {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+	return events
+}
+
+func (s *outboxTestStore) FindDeliverable(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type key struct {
+		aggregateType domain.OutboxAggregateType
+		aggregateID   uuid.UUID
+		topic         domain.OutboxTopic
+	}
+	seen := make(map[key]bool)
+	result := make([]*domain.OutboxEvent, 0)
+	for _, event := range s.sorted() {
+		if event.Status != domain.OutboxStatusPending {
+			continue
+		}
+		k := key{event.AggregateType, event.AggregateID, event.Topic}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if !event.NextAttemptAt.After(now) && len(result) < limit {
+			copied := *event
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (s *outboxTestStore) ClaimDue(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	event, ok := s.events[id]
+	if !ok || event.Status != domain.OutboxStatusPending || event.NextAttemptAt.After(now) {
+		return false, nil
+	}
+	event.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+func (s *outboxTestStore) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *event
+	s.events[event.ID] = &copied
+	return nil
+}
+
+func (s *outboxTestStore) FindOldestPendingCreatedAt(ctx context.Context) (*time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range s.sorted() {
+		if event.Status == domain.OutboxStatusPending {
+			createdAt := event.CreatedAt
+			return &createdAt, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *outboxTestStore) CountPending(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	for _, event := range s.events {
+		if event.Status == domain.OutboxStatusPending {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *outboxTestStore) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var removed int64
+	for id, event := range s.events {
+		if event.Status == domain.OutboxStatusDelivered && event.DeliveredAt != nil && event.DeliveredAt.Before(before) {
+			delete(s.events, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (s *outboxTestStore) get(id int64) domain.OutboxEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.events[id]
+}
+
+// outboxTestTx runs fn directly; the store has no transactions
+type outboxTestTx struct{}
+
+func (outboxTestTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// recordingNotiClient records delivered notifications and fails while err is set
+type recordingNotiClient struct {
+	mu   sync.Mutex
+	err  error
+	sent []*client.NotificationEvent
+}
+
+func (c *recordingNotiClient) SendNotification(ctx context.Context, event *client.NotificationEvent) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.sent = append(c.sent, event)
+	return nil
+}
+
+func (c *recordingNotiClient) SendBulkNotifications(ctx context.Context, events []*client.NotificationEvent) error {
+	for _, event := range events {
+		if err := c.SendNotification(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordingBroadcaster records broadcast messages per project
+type recordingBroadcaster struct {
+	mu       sync.Mutex
+	messages []dto.BoardEvent
+}
+
+func (b *recordingBroadcaster) Broadcast(ctx context.Context, projectID uuid.UUID, message []byte) error {
+	var event dto.BoardEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.messages = append(b.messages, event)
+	return nil
+}
+
+func newTestOutbox(store *outboxTestStore, notiClient client.NotiClient, broadcaster OutboxBroadcaster, now *time.Time) *outboxImpl {
+	o := NewOutbox(store, outboxTestTx{}, notiClient, broadcaster, nil, zap.NewNop()).(*outboxImpl)
+	o.now = func() time.Time { return *now }
+	return o
+}
+
+func TestOutbox_DeliversInOrderWithIdempotencyKeys(t *testing.T) {
+	store := newOutboxTestStore()
+	notiClient := &recordingNotiClient{}
+	broadcaster := &recordingBroadcaster{}
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(store, notiClient, broadcaster, &now)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	projectID := uuid.New()
+	events := []*client.NotificationEvent{
+		{Type: client.NotificationTypeBoardAssigned, TargetUserID: uuid.New(), ResourceID: boardID},
+		{Type: client.NotificationTypeBoardUpdated, TargetUserID: uuid.New(), ResourceID: boardID},
+	}
+	if err := outbox.AddNotifications(ctx, domain.OutboxAggregateBoard, boardID, events); err != nil {
+		t.Fatalf("AddNotifications() error = %v", err)
+	}
+	for _, eventType := range []string{domain.WebhookEventBoardCreated, domain.WebhookEventBoardUpdated} {
+		if err := outbox.AddBroadcast(ctx, domain.OutboxAggregateBoard, boardID, projectID, &dto.BoardEvent{Type: eventType, BoardID: boardID.String()}); err != nil {
+			t.Fatalf("AddBroadcast() error = %v", err)
+		}
+	}
+
+	attempted, err := outbox.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if attempted != 4 {
+		t.Errorf("expected 4 attempts, got %d", attempted)
+	}
+
+	if len(notiClient.sent) != 2 {
+		t.Fatalf("expected 2 notifications, got %d", len(notiClient.sent))
+	}
+	if notiClient.sent[0].Type != client.NotificationTypeBoardAssigned || notiClient.sent[1].Type != client.NotificationTypeBoardUpdated {
+		t.Errorf("notifications delivered out of order: %s, %s", notiClient.sent[0].Type, notiClient.sent[1].Type)
+	}
+	for _, sent := range notiClient.sent {
+		if sent.IdempotencyKey == nil || *sent.IdempotencyKey == "" {
+			t.Errorf("expected an idempotency key on %s", sent.Type)
+		}
+		if sent.OccurredAt == nil || !sent.OccurredAt.Equal(now) {
+			t.Errorf("expected occurredAt %v, got %v", now, sent.OccurredAt)
+		}
+	}
+
+	if len(broadcaster.messages) != 2 {
+		t.Fatalf("expected 2 broadcasts, got %d", len(broadcaster.messages))
+	}
+	if broadcaster.messages[0].Type != domain.WebhookEventBoardCreated || broadcaster.messages[1].Type != domain.WebhookEventBoardUpdated {
+		t.Errorf("broadcasts delivered out of order: %s, %s", broadcaster.messages[0].Type, broadcaster.messages[1].Type)
+	}
+	if broadcaster.messages[0].EventID == "" || broadcaster.messages[0].EventID == broadcaster.messages[1].EventID {
+		t.Errorf("expected distinct event IDs, got %q and %q", broadcaster.messages[0].EventID, broadcaster.messages[1].EventID)
+	}
+
+	for id := int64(1); id <= 4; id++ {
+		if event := store.get(id); event.Status != domain.OutboxStatusDelivered || event.DeliveredAt == nil {
+			t.Errorf("event %d: expected DELIVERED, got %s", id, event.Status)
+		}
+	}
+}
+
+func TestOutbox_RetriesWithBackoffAndHoldsLaterEvents(t *testing.T) {
+	store := newOutboxTestStore()
+	notiClient := &recordingNotiClient{err: errors.New("noti-service returned status 503")}
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(store, notiClient, nil, &now)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	events := []*client.NotificationEvent{
+		{Type: client.NotificationTypeBoardAssigned, TargetUserID: uuid.New(), ResourceID: boardID},
+		{Type: client.NotificationTypeBoardUpdated, TargetUserID: uuid.New(), ResourceID: boardID},
+	}
+	if err := outbox.AddNotifications(ctx, domain.OutboxAggregateBoard, boardID, events); err != nil {
+		t.Fatalf("AddNotifications() error = %v", err)
+	}
+
+	// Only the first event of the board is attempted; the second waits behind it
+	attempted, err := outbox.DeliverDue(ctx)
+	if err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if attempted != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempted)
+	}
+	first := store.get(1)
+	if first.Status != domain.OutboxStatusPending || first.Attempts != 1 || first.LastError == "" {
+		t.Errorf("expected a pending retry with the error recorded, got %+v", first)
+	}
+	if want := now.Add(outboxRetryBaseDelay); !first.NextAttemptAt.Equal(want) {
+		t.Errorf("expected next attempt at %v, got %v", want, first.NextAttemptAt)
+	}
+	if second := store.get(2); second.Attempts != 0 {
+		t.Errorf("expected the second event to wait, got %d attempts", second.Attempts)
+	}
+
+	// Nothing is due before the backoff elapses
+	if attempted, _ := outbox.DeliverDue(ctx); attempted != 0 {
+		t.Errorf("expected no attempt before the backoff elapsed, got %d", attempted)
+	}
+
+	// Once noti-service recovers both events go out in order
+	notiClient.err = nil
+	now = now.Add(outboxRetryBaseDelay)
+	if attempted, err := outbox.DeliverDue(ctx); err != nil || attempted != 2 {
+		t.Fatalf("DeliverDue() attempted = %d, error = %v", attempted, err)
+	}
+	if len(notiClient.sent) != 2 || notiClient.sent[0].Type != client.NotificationTypeBoardAssigned {
+		t.Errorf("expected both notifications in order, got %d", len(notiClient.sent))
+	}
+	if *notiClient.sent[0].IdempotencyKey != first.IdempotencyKey.String() {
+		t.Errorf("expected the stored idempotency key to be sent, got %s", *notiClient.sent[0].IdempotencyKey)
+	}
+}
+
+func TestOutbox_DeadLetters(t *testing.T) {
+	store := newOutboxTestStore()
+	notiClient := &recordingNotiClient{err: errors.New("connection refused")}
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	outbox := newTestOutbox(store, notiClient, nil, &now)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	if err := outbox.AddNotifications(ctx, domain.OutboxAggregateBoard, boardID, []*client.NotificationEvent{{Type: client.NotificationTypeBoardAssigned}}); err != nil {
+		t.Fatalf("AddNotifications() error = %v", err)
+	}
+	// Broadcasts cannot be delivered without a broadcaster and are dead right away
+	if err := outbox.AddBroadcast(ctx, domain.OutboxAggregateBoard, boardID, uuid.New(), &dto.BoardEvent{Type: domain.WebhookEventBoardDeleted}); err != nil {
+		t.Fatalf("AddBroadcast() error = %v", err)
+	}
+
+	for i := 0; i < outboxMaxAttempts; i++ {
+		if _, err := outbox.DeliverDue(ctx); err != nil {
+			t.Fatalf("DeliverDue() error = %v", err)
+		}
+		now = now.Add(outboxRetryMaxDelay)
+	}
+
+	notification := store.get(1)
+	if notification.Status != domain.OutboxStatusDead || notification.Attempts != outboxMaxAttempts {
+		t.Errorf("expected DEAD after %d attempts, got %s after %d", outboxMaxAttempts, notification.Status, notification.Attempts)
+	}
+	broadcast := store.get(2)
+	if broadcast.Status != domain.OutboxStatusDead || broadcast.Attempts != 1 {
+		t.Errorf("expected undeliverable broadcast DEAD after 1 attempt, got %s after %d", broadcast.Status, broadcast.Attempts)
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{4, 40 * time.Second},
+		{8, 10 * time.Minute},
+		{11, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := outboxBackoff(tt.attempts); got != tt.want {
+			t.Errorf("outboxBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
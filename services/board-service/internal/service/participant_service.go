@@ -26,26 +26,31 @@ type ParticipantService interface {
 type participantServiceImpl struct {
 	participantRepo repository.ParticipantRepository
 	boardRepo       repository.BoardRepository
+	projectRepo     repository.ProjectRepository
 }
 
 // NewParticipantService creates a new instance of ParticipantService
-func NewParticipantService(participantRepo repository.ParticipantRepository, boardRepo repository.BoardRepository) ParticipantService {
+func NewParticipantService(participantRepo repository.ParticipantRepository, boardRepo repository.BoardRepository, projectRepo repository.ProjectRepository) ParticipantService {
 	return &participantServiceImpl{
 		participantRepo: participantRepo,
 		boardRepo:       boardRepo,
+		projectRepo:     projectRepo,
 	}
 }
 
 // AddParticipants adds one or more participants to a board (supports single and bulk operations)
 func (s *participantServiceImpl) AddParticipants(ctx context.Context, req *dto.AddParticipantsRequest) (*dto.AddParticipantsResponse, error) {
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, req.BoardID)
+	board, err := s.boardRepo.FindByID(ctx, req.BoardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
+	if err := s.ensureBoardWritable(ctx, board); err != nil {
+		return nil, err
+	}
 
 	// Remove duplicates from the request
 	uniqueUserIDs := removeDuplicateUUIDs(req.UserIDs)
@@ -192,13 +197,16 @@ func (s *participantServiceImpl) GetParticipants(ctx context.Context, boardID uu
 // RemoveParticipant removes a participant from a board
 func (s *participantServiceImpl) RemoveParticipant(ctx context.Context, boardID, userID uuid.UUID) error {
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, boardID)
+	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
 		}
 		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
+	if err := s.ensureBoardWritable(ctx, board); err != nil {
+		return err
+	}
 
 	// Check if participant exists
 	_, err = s.participantRepo.FindByBoardAndUser(ctx, boardID, userID)
@@ -217,6 +225,14 @@ func (s *participantServiceImpl) RemoveParticipant(ctx context.Context, boardID,
 	return nil
 }
 
+// ensureBoardWritable rejects participant changes on a board of an archived project
+func (s *participantServiceImpl) ensureBoardWritable(ctx context.Context, board *domain.Board) error {
+	if board == nil || s.projectRepo == nil {
+		return nil
+	}
+	return ensureProjectWritable(ctx, s.projectRepo, board.ProjectID)
+}
+
 // toParticipantResponse converts domain.Participant to dto.ParticipantResponse
 func (s *participantServiceImpl) toParticipantResponse(participant *domain.Participant) *dto.ParticipantResponse {
 	return &dto.ParticipantResponse{
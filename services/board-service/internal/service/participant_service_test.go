@@ -223,7 +223,7 @@ func TestParticipantService_AddParticipants(t *testing.T) {
 			tt.mockBoard(mockBoardRepo)
 			tt.mockParticipant(mockParticipantRepo)
 
-			service := NewParticipantService(mockParticipantRepo, mockBoardRepo)
+			service := NewParticipantService(mockParticipantRepo, mockBoardRepo, &MockProjectRepository{})
 
 			// When
 			result, err := service.AddParticipants(context.Background(), tt.req)
@@ -342,7 +342,7 @@ func TestParticipantService_GetParticipants(t *testing.T) {
 			tt.mockBoard(mockBoardRepo)
 			tt.mockParticipant(mockParticipantRepo)
 
-			service := NewParticipantService(mockParticipantRepo, mockBoardRepo)
+			service := NewParticipantService(mockParticipantRepo, mockBoardRepo, &MockProjectRepository{})
 
 			// When
 			got, err := service.GetParticipants(context.Background(), tt.boardID)
@@ -475,7 +475,7 @@ func TestParticipantService_RemoveParticipant(t *testing.T) {
 			tt.mockBoard(mockBoardRepo)
 			tt.mockParticipant(mockParticipantRepo)
 
-			service := NewParticipantService(mockParticipantRepo, mockBoardRepo)
+			service := NewParticipantService(mockParticipantRepo, mockBoardRepo, &MockProjectRepository{})
 
 			// When
 			err := service.RemoveParticipant(context.Background(), tt.boardID, tt.userID)
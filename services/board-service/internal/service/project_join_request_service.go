@@ -100,16 +100,9 @@ func (s *projectJoinRequestServiceImpl) CreateJoinRequest(ctx context.Context, p
 
 // GetJoinRequests retrieves join requests for a project with authorization checks
 func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, projectID, userID uuid.UUID, status *string, token string) ([]*dto.ProjectJoinRequestResponse, error) {
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return nil, response.NewForbiddenError("Only project owner or admin can view join requests", "")
+	// Check if requester may review join requests
+	if err := requirePermission(ctx, s.projectRepo, projectID, userID, domain.PermissionMemberInvite, "You do not have permission to view join requests"); err != nil {
+		return nil, err
 	}
 
 	// Fetch project to get workspace ID
@@ -134,6 +127,17 @@ func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, pro
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch join requests", err.Error())
 	}
 
+	// Fetch workspace profiles of all requesters in one batch
+	userIDs := make([]uuid.UUID, len(requests))
+	for i, request := range requests {
+		userIDs[i] = request.UserID
+	}
+	profiles, err := s.userClient.GetWorkspaceProfiles(ctx, project.WorkspaceID, userIDs, token)
+	if err != nil {
+		// Graceful degradation: if profile fetch fails, continue without user details
+		profiles = nil
+	}
+
 	// Convert to response DTOs with user profile information
 	responses := make([]*dto.ProjectJoinRequestResponse, len(requests))
 	for i, request := range requests {
@@ -145,14 +149,10 @@ func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, pro
 			RequestedAt: request.RequestedAt,
 			UpdatedAt:   request.UpdatedAt,
 		}
-
-		// Fetch workspace profile for requester
-		profile, err := s.userClient.GetWorkspaceProfile(ctx, project.WorkspaceID, request.UserID, token)
-		if err == nil && profile != nil {
+		if profile := profiles[request.UserID]; profile != nil {
 			responses[i].UserEmail = profile.Email
 			responses[i].UserName = profile.NickName
 		}
-		// Graceful degradation: if profile fetch fails, continue without user details
 	}
 
 	return responses, nil
@@ -180,16 +180,9 @@ func (s *projectJoinRequestServiceImpl) UpdateJoinRequest(ctx context.Context, r
 		return nil, response.NewValidationError("Join request has already been processed", "")
 	}
 
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, joinRequest.ProjectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return nil, response.NewForbiddenError("Only project owner or admin can update join requests", "")
+	// Check if requester may review join requests
+	if err := requirePermission(ctx, s.projectRepo, joinRequest.ProjectID, userID, domain.PermissionMemberInvite, "You do not have permission to update join requests"); err != nil {
+		return nil, err
 	}
 
 	// Update join request status
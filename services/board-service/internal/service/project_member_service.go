@@ -61,24 +61,25 @@ func (s *projectMemberServiceImpl) GetMembers(ctx context.Context, projectID, us
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch members", err.Error())
 	}
 
+	// Fetch workspace profiles of all members in one batch
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	profiles, err := s.userClient.GetWorkspaceProfiles(ctx, project.WorkspaceID, userIDs, token)
+	if err != nil {
+		// Graceful degradation: if profile fetch fails, continue without user details
+		profiles = nil
+	}
+
 	// Convert to response DTOs with user profile information
 	responses := make([]*dto.ProjectMemberResponse, len(members))
 	for i, member := range members {
-		responses[i] = &dto.ProjectMemberResponse{
-			MemberID:  member.ID,
-			ProjectID: member.ProjectID,
-			UserID:    member.UserID,
-			RoleName:  string(member.RoleName),
-			JoinedAt:  member.JoinedAt,
-		}
-
-		// Fetch workspace profile for member
-		profile, err := s.userClient.GetWorkspaceProfile(ctx, project.WorkspaceID, member.UserID, token)
-		if err == nil && profile != nil {
+		responses[i] = toProjectMemberResponse(member)
+		if profile := profiles[member.UserID]; profile != nil {
 			responses[i].UserEmail = profile.Email
 			responses[i].UserName = profile.NickName
 		}
-		// Graceful degradation: if profile fetch fails, continue without user details
 	}
 
 	return responses, nil
@@ -86,16 +87,9 @@ func (s *projectMemberServiceImpl) GetMembers(ctx context.Context, projectID, us
 
 // RemoveMember removes a member from a project with authorization checks
 func (s *projectMemberServiceImpl) RemoveMember(ctx context.Context, projectID, requesterID, memberID uuid.UUID) error {
-	// Check if requester is OWNER or ADMIN
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, requesterID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
-		return response.NewForbiddenError("Only project owner or admin can remove members", "")
+	// Check if requester may remove members
+	if err := requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionMemberRemove, "You do not have permission to remove members"); err != nil {
+		return err
 	}
 
 	// Fetch the member to be removed
@@ -127,23 +121,30 @@ func (s *projectMemberServiceImpl) RemoveMember(ctx context.Context, projectID,
 
 // UpdateMemberRole updates a member's role with authorization checks
 func (s *projectMemberServiceImpl) UpdateMemberRole(ctx context.Context, projectID, requesterID, memberID uuid.UUID, role string) (*dto.ProjectMemberResponse, error) {
-	// Check if requester is OWNER
-	requesterMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, requesterID)
+	// Check if requester may change member roles
+	requesterMember, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+		return nil, err
+	}
+	if !requesterMember.Can(domain.PermissionRoleManage) {
+		return nil, response.NewForbiddenError("You do not have permission to change member roles", "Missing permission: "+string(domain.PermissionRoleManage))
 	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner {
-		return nil, response.NewForbiddenError("Only project owner can change member roles", "")
+	if requesterID == memberID {
+		return nil, response.NewForbiddenError("You cannot change your own role", "")
 	}
 
 	// Validate role
 	projectRole := domain.ProjectRole(role)
-	if projectRole != domain.ProjectRoleOwner && projectRole != domain.ProjectRoleAdmin && projectRole != domain.ProjectRoleMember {
+	if !domain.IsBuiltinRole(role) {
 		return nil, response.NewValidationError("Invalid role", "")
 	}
+	// Ownership can only be handed over by the owner
+	if projectRole == domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleOwner {
+		return nil, response.NewForbiddenError("Only project owner can grant the OWNER role", "")
+	}
+	if err := requireHeldPermissions(requesterMember, domain.RolePermissions(projectRole)); err != nil {
+		return nil, err
+	}
 
 	// Fetch the member to be updated
 	targetMember, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, memberID)
@@ -171,11 +172,21 @@ func (s *projectMemberServiceImpl) UpdateMemberRole(ctx context.Context, project
 	}
 
 	// Convert to response DTO
-	return &dto.ProjectMemberResponse{
-		MemberID:  updatedMember.ID,
-		ProjectID: updatedMember.ProjectID,
-		UserID:    updatedMember.UserID,
-		RoleName:  string(updatedMember.RoleName),
-		JoinedAt:  updatedMember.JoinedAt,
-	}, nil
+	return toProjectMemberResponse(updatedMember), nil
+}
+
+// toProjectMemberResponse converts domain.ProjectMember to dto.ProjectMemberResponse
+func toProjectMemberResponse(member *domain.ProjectMember) *dto.ProjectMemberResponse {
+	resp := &dto.ProjectMemberResponse{
+		MemberID:     member.ID,
+		ProjectID:    member.ProjectID,
+		UserID:       member.UserID,
+		RoleName:     string(member.RoleName),
+		JoinedAt:     member.JoinedAt,
+		CustomRoleID: member.CustomRoleID,
+	}
+	if member.CustomRole != nil {
+		resp.CustomRoleName = member.CustomRole.Name
+	}
+	return resp
 }
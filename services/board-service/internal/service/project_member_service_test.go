@@ -152,6 +152,54 @@ func TestProjectMemberService_GetMembers(t *testing.T) {
 	}
 }
 
+func TestProjectMemberService_GetMembers_BatchesProfileLookups(t *testing.T) {
+	projectID := uuid.New()
+	workspaceID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+
+	mockRepo := &MockProjectRepository{
+		IsProjectMemberFunc: func(ctx context.Context, pID, uID uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, WorkspaceID: workspaceID}, nil
+		},
+		FindMembersByProjectIDFunc: func(ctx context.Context, pID uuid.UUID) ([]*domain.ProjectMember, error) {
+			return []*domain.ProjectMember{
+				{ID: uuid.New(), ProjectID: pID, UserID: ownerID, RoleName: domain.ProjectRoleOwner},
+				{ID: uuid.New(), ProjectID: pID, UserID: memberID, RoleName: domain.ProjectRoleMember},
+			}, nil
+		},
+	}
+
+	batchCalls := 0
+	mockClient := &MockUserClient{
+		GetWorkspaceProfileFunc: func(ctx context.Context, wID, uID uuid.UUID, t string) (*client.WorkspaceProfile, error) {
+			panic("members must be resolved with a single batch lookup")
+		},
+		GetWorkspaceProfilesFunc: func(ctx context.Context, wID uuid.UUID, userIDs []uuid.UUID, t string) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+			batchCalls++
+			// The member without a profile is left without user details
+			return map[uuid.UUID]*client.WorkspaceProfile{
+				ownerID: {UserID: ownerID, WorkspaceID: wID, NickName: "Owner", Email: "owner@example.com"},
+			}, nil
+		},
+	}
+
+	service := NewProjectMemberService(mockRepo, mockClient)
+	got, err := service.GetMembers(context.Background(), projectID, ownerID, "test-token")
+	if err != nil {
+		t.Fatalf("GetMembers() unexpected error = %v", err)
+	}
+	if batchCalls != 1 {
+		t.Errorf("expected 1 batch profile lookup, got %d", batchCalls)
+	}
+	if len(got) != 2 || got[0].UserName != "Owner" || got[0].UserEmail != "owner@example.com" || got[1].UserName != "" {
+		t.Errorf("unexpected members: %+v, %+v", got[0], got[1])
+	}
+}
+
 func TestProjectMemberService_RemoveMember(t *testing.T) {
 	projectID := uuid.New()
 	requesterID := uuid.New()
@@ -320,6 +368,7 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 	tests := []struct {
 		name        string
 		role        string
+		self        bool
 		mockRepo    func(*MockProjectRepository)
 		wantErr     bool
 		wantErrCode string
@@ -382,6 +431,27 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 			wantErr:     true,
 			wantErrCode: response.ErrCodeValidation,
 		},
+		{
+			name: "실패: 자기 자신의 역할 변경",
+			role: "ADMIN",
+			self: true,
+			mockRepo: func(m *MockProjectRepository) {
+				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					return &domain.ProjectMember{
+						ID: uuid.New(),
+						ProjectID: pID,
+						UserID:    uID,
+						RoleName:  domain.ProjectRoleOwner,
+					}, nil
+				}
+				m.UpdateMemberRoleFunc = func(ctx context.Context, memberID uuid.UUID, role domain.ProjectRole) error {
+					t.Error("own role must not be changed")
+					return nil
+				}
+			},
+			wantErr:     true,
+			wantErrCode: response.ErrCodeForbidden,
+		},
 		{
 			name: "실패: OWNER 역할 변경 시도",
 			role: "MEMBER",
@@ -414,7 +484,11 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 			tt.mockRepo(mockRepo)
 
 			service := NewProjectMemberService(mockRepo, &MockUserClient{})
-			_, err := service.UpdateMemberRole(context.Background(), projectID, requesterID, memberID, tt.role)
+			targetID := memberID
+			if tt.self {
+				targetID = requesterID
+			}
+			_, err := service.UpdateMemberRole(context.Background(), projectID, requesterID, targetID, tt.role)
 
 			if tt.wantErr {
 				if err == nil {
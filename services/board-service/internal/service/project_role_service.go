@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// ProjectRoleService defines the interface for project roles and the permission matrix
+type ProjectRoleService interface {
+	GetRoles(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.ProjectRoleResponse, error)
+	CreateRole(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateProjectRoleRequest) (*dto.ProjectRoleResponse, error)
+	UpdateRole(ctx context.Context, projectID, requesterID, roleID uuid.UUID, req *dto.UpdateProjectRoleRequest) (*dto.ProjectRoleResponse, error)
+	DeleteRole(ctx context.Context, projectID, requesterID, roleID uuid.UUID) error
+	AssignMemberRole(ctx context.Context, projectID, requesterID, memberUserID uuid.UUID, req *dto.AssignProjectRoleRequest) (*dto.ProjectMemberResponse, error)
+	GetMyPermissions(ctx context.Context, projectID, requesterID uuid.UUID) (*dto.ProjectPermissionsResponse, error)
+}
+
+// projectRoleServiceImpl is the implementation of ProjectRoleService
+type projectRoleServiceImpl struct {
+	roleRepo    repository.ProjectRoleRepository
+	projectRepo repository.ProjectRepository
+}
+
+// NewProjectRoleService creates a new instance of ProjectRoleService
+func NewProjectRoleService(roleRepo repository.ProjectRoleRepository, projectRepo repository.ProjectRepository) ProjectRoleService {
+	return &projectRoleServiceImpl{
+		roleRepo:    roleRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetRoles lists the built-in roles followed by the project's custom roles (any project member)
+func (s *projectRoleServiceImpl) GetRoles(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.ProjectRoleResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.roleRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project roles", err.Error())
+	}
+
+	responses := make([]*dto.ProjectRoleResponse, 0, len(roles)+3)
+	for _, builtin := range []domain.ProjectRole{domain.ProjectRoleOwner, domain.ProjectRoleAdmin, domain.ProjectRoleMember} {
+		responses = append(responses, &dto.ProjectRoleResponse{
+			Name:        string(builtin),
+			IsBuiltin:   true,
+			Permissions: permissionStrings(domain.RolePermissions(builtin)),
+		})
+	}
+	for _, role := range roles {
+		responses = append(responses, toProjectRoleResponse(role))
+	}
+	return responses, nil
+}
+
+// CreateRole defines a custom role (requires role.manage)
+// The role may only hold permissions the requester holds
+func (s *projectRoleServiceImpl) CreateRole(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateProjectRoleRequest) (*dto.ProjectRoleResponse, error) {
+	requester, err := s.checkManage(ctx, projectID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	name, err := s.validateName(ctx, projectID, req.Name, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := encodePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &domain.ProjectCustomRole{
+		ProjectID:   projectID,
+		Name:        name,
+		Description: req.Description,
+		Permissions: permissions,
+		CreatedBy:   requesterID,
+	}
+	if err := requireHeldPermissions(requester, role.PermissionList()); err != nil {
+		return nil, err
+	}
+	if err := s.roleRepo.Create(ctx, role); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create project role", err.Error())
+	}
+	return toProjectRoleResponse(role), nil
+}
+
+// UpdateRole renames a custom role or replaces its permissions (requires role.manage)
+// Members holding the role are affected immediately; new permissions must be held by the requester
+func (s *projectRoleServiceImpl) UpdateRole(ctx context.Context, projectID, requesterID, roleID uuid.UUID, req *dto.UpdateProjectRoleRequest) (*dto.ProjectRoleResponse, error) {
+	requester, err := s.checkManage(ctx, projectID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	role, err := s.findRole(ctx, projectID, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name, err := s.validateName(ctx, projectID, *req.Name, role.ID)
+		if err != nil {
+			return nil, err
+		}
+		role.Name = name
+	}
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+	if req.Permissions != nil {
+		permissions, err := encodePermissions(*req.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		role.Permissions = permissions
+		if err := requireHeldPermissions(requester, role.PermissionList()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.roleRepo.Update(ctx, role); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update project role", err.Error())
+	}
+	return toProjectRoleResponse(role), nil
+}
+
+// DeleteRole deletes a custom role; its members fall back to their built-in role (requires role.manage)
+func (s *projectRoleServiceImpl) DeleteRole(ctx context.Context, projectID, requesterID, roleID uuid.UUID) error {
+	if _, err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	if _, err := s.findRole(ctx, projectID, roleID); err != nil {
+		return err
+	}
+	if err := s.roleRepo.Delete(ctx, roleID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete project role", err.Error())
+	}
+	return nil
+}
+
+// AssignMemberRole sets or clears the custom role of a member (requires role.manage)
+// The owner always keeps every permission, so a custom role cannot be assigned to the owner.
+// Requesters cannot change their own role or hand out permissions they do not hold
+func (s *projectRoleServiceImpl) AssignMemberRole(ctx context.Context, projectID, requesterID, memberUserID uuid.UUID, req *dto.AssignProjectRoleRequest) (*dto.ProjectMemberResponse, error) {
+	requester, err := s.checkManage(ctx, projectID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if memberUserID == requesterID {
+		return nil, response.NewForbiddenError("You cannot change your own role", "")
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	target, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, memberUserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Member not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch member", err.Error())
+	}
+	if target.RoleName == domain.ProjectRoleOwner {
+		return nil, response.NewValidationError("Cannot assign a custom role to the project owner", "")
+	}
+
+	// Clearing the custom role gives the member back the permissions of the built-in role
+	var roleID *uuid.UUID
+	granted := domain.RolePermissions(target.RoleName)
+	if req.CustomRoleID != nil && *req.CustomRoleID != uuid.Nil {
+		role, err := s.findRole(ctx, projectID, *req.CustomRoleID)
+		if err != nil {
+			return nil, err
+		}
+		roleID = &role.ID
+		granted = role.PermissionList()
+	}
+	if err := requireHeldPermissions(requester, granted); err != nil {
+		return nil, err
+	}
+
+	if err := s.roleRepo.AssignToMember(ctx, target.ID, roleID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to assign project role", err.Error())
+	}
+
+	updated, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, memberUserID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch updated member", err.Error())
+	}
+	return toProjectMemberResponse(updated), nil
+}
+
+// GetMyPermissions returns the requester's effective permissions so clients can hide unavailable actions
+func (s *projectRoleServiceImpl) GetMyPermissions(ctx context.Context, projectID, requesterID uuid.UUID) (*dto.ProjectPermissionsResponse, error) {
+	member, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ProjectPermissionsResponse{
+		ProjectID:    projectID,
+		RoleName:     string(member.RoleName),
+		CustomRoleID: member.CustomRoleID,
+		Permissions:  permissionStrings(member.Permissions()),
+	}
+	if member.CustomRole != nil {
+		resp.CustomRoleName = member.CustomRole.Name
+	}
+	return resp, nil
+}
+
+// checkManage verifies that the requester holds the role.manage permission and returns the requester's membership
+func (s *projectRoleServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) (*domain.ProjectMember, error) {
+	member, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Can(domain.PermissionRoleManage) {
+		return nil, response.NewForbiddenError("You do not have permission to manage project roles", "Missing permission: "+string(domain.PermissionRoleManage))
+	}
+	return member, nil
+}
+
+// findRole fetches a custom role and ensures it belongs to the project
+func (s *projectRoleServiceImpl) findRole(ctx context.Context, projectID, roleID uuid.UUID) (*domain.ProjectCustomRole, error) {
+	role, err := s.roleRepo.FindByID(ctx, roleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project role not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project role", err.Error())
+	}
+	if role.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Project role not found", "")
+	}
+	return role, nil
+}
+
+// validateName trims the name and fails when it is empty, shadows a built-in role or is already used
+func (s *projectRoleServiceImpl) validateName(ctx context.Context, projectID uuid.UUID, name string, exceptID uuid.UUID) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", response.NewValidationError("Role name is required", "")
+	}
+	if domain.IsBuiltinRole(strings.ToUpper(name)) {
+		return "", response.NewValidationError(fmt.Sprintf("Role name %s is reserved for a built-in role", name), "")
+	}
+
+	existing, err := s.roleRepo.FindByProjectAndName(ctx, projectID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return name, nil
+		}
+		return "", response.NewAppError(response.ErrCodeInternal, "Failed to check role name", err.Error())
+	}
+	if existing != nil && existing.ID != exceptID {
+		return "", response.NewAlreadyExistsError("Role name already exists in this project", "")
+	}
+	return name, nil
+}
+
+// encodePermissions validates and deduplicates permissions and converts them to datatypes.JSON
+func encodePermissions(values []string) (datatypes.JSON, error) {
+	seen := make(map[domain.Permission]bool, len(values))
+	permissions := make([]domain.Permission, 0, len(values))
+	for _, v := range values {
+		p := domain.Permission(strings.TrimSpace(v))
+		if !p.IsValid() {
+			return nil, response.NewValidationError(fmt.Sprintf("Invalid permission: %s", v), "")
+		}
+		if !p.IsGrantable() {
+			return nil, response.NewValidationError(fmt.Sprintf("Permission %s cannot be granted to a custom role", v), "")
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		permissions = append(permissions, p)
+	}
+
+	data, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to encode permissions", err.Error())
+	}
+	return data, nil
+}
+
+// permissionStrings converts permissions to their string form
+func permissionStrings(permissions []domain.Permission) []string {
+	values := make([]string, len(permissions))
+	for i, p := range permissions {
+		values[i] = string(p)
+	}
+	return values
+}
+
+// toProjectRoleResponse converts domain.ProjectCustomRole to dto.ProjectRoleResponse
+func toProjectRoleResponse(role *domain.ProjectCustomRole) *dto.ProjectRoleResponse {
+	id := role.ID
+	createdAt := role.CreatedAt
+	updatedAt := role.UpdatedAt
+	return &dto.ProjectRoleResponse{
+		RoleID:      &id,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissionStrings(role.PermissionList()),
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+	}
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestProjectRoleService_CreateRole(t *testing.T) {
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+
+	tests := []struct {
+		name            string
+		requesterID     uuid.UUID
+		req             *dto.CreateProjectRoleRequest
+		wantPermissions []string
+		wantErrCode     string
+	}{
+		{
+			name:            "성공: 중복 권한을 제거하고 커스텀 역할 생성",
+			requesterID:     ownerID,
+			req:             &dto.CreateProjectRoleRequest{Name: " Reviewer ", Permissions: []string{"comment.create", "comment.delete.any", "comment.create"}},
+			wantPermissions: []string{"comment.create", "comment.delete.any"},
+		},
+		{
+			name:        "실패: 알 수 없는 권한",
+			requesterID: ownerID,
+			req:         &dto.CreateProjectRoleRequest{Name: "Reviewer", Permissions: []string{"board.fly"}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 부여할 수 없는 권한 (project.delete)",
+			requesterID: ownerID,
+			req:         &dto.CreateProjectRoleRequest{Name: "Reviewer", Permissions: []string{"project.delete"}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 부여할 수 없는 권한 (role.manage)",
+			requesterID: ownerID,
+			req:         &dto.CreateProjectRoleRequest{Name: "Reviewer", Permissions: []string{"comment.create", "role.manage"}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 기본 역할과 같은 이름",
+			requesterID: ownerID,
+			req:         &dto.CreateProjectRoleRequest{Name: "admin"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: ADMIN은 role.manage 권한이 없음",
+			requesterID: adminID,
+			req:         &dto.CreateProjectRoleRequest{Name: "Reviewer"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == ownerID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleOwner}, nil
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+				},
+			}
+			var created *domain.ProjectCustomRole
+			mockRoleRepo := &MockProjectRoleRepository{
+				FindByProjectAndNameFunc: func(ctx context.Context, pID uuid.UUID, name string) (*domain.ProjectCustomRole, error) {
+					return nil, gorm.ErrRecordNotFound
+				},
+				CreateFunc: func(ctx context.Context, role *domain.ProjectCustomRole) error {
+					role.ID = uuid.New()
+					created = role
+					return nil
+				},
+			}
+			service := NewProjectRoleService(mockRoleRepo, mockProjectRepo)
+
+			// When
+			got, err := service.CreateRole(context.Background(), projectID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("CreateRole() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("CreateRole() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				if created != nil {
+					t.Error("CreateRole() must not persist a rejected role")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateRole() unexpected error = %v", err)
+			}
+			if got.Name != "Reviewer" {
+				t.Errorf("CreateRole() name = %q, want trimmed name", got.Name)
+			}
+			if len(got.Permissions) != len(tt.wantPermissions) {
+				t.Fatalf("CreateRole() permissions = %v, want %v", got.Permissions, tt.wantPermissions)
+			}
+			for i, p := range tt.wantPermissions {
+				if got.Permissions[i] != p {
+					t.Errorf("CreateRole() permissions = %v, want %v", got.Permissions, tt.wantPermissions)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectRoleService_AssignMemberRole(t *testing.T) {
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	coOwnerID := uuid.New()
+	memberID := uuid.New()
+	role := &domain.ProjectCustomRole{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		ProjectID:   projectID,
+		Name:        "Reviewer",
+		Permissions: []byte(`["comment.create","comment.delete.any"]`),
+	}
+
+	tests := []struct {
+		name         string
+		requesterID  uuid.UUID
+		memberUserID uuid.UUID
+		wantErrCode  string
+	}{
+		{
+			name:         "성공: 멤버에게 커스텀 역할 부여",
+			requesterID:  ownerID,
+			memberUserID: memberID,
+		},
+		{
+			name:         "실패: 자기 자신에게 역할 부여",
+			requesterID:  ownerID,
+			memberUserID: ownerID,
+			wantErrCode:  response.ErrCodeForbidden,
+		},
+		{
+			name:         "실패: OWNER에게 커스텀 역할 부여",
+			requesterID:  ownerID,
+			memberUserID: coOwnerID,
+			wantErrCode:  response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == memberID {
+						return &domain.ProjectMember{ID: uuid.New(), ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+					}
+					return &domain.ProjectMember{ID: uuid.New(), ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleOwner}, nil
+				},
+			}
+			assigned := false
+			mockRoleRepo := &MockProjectRoleRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.ProjectCustomRole, error) {
+					return role, nil
+				},
+				AssignToMemberFunc: func(ctx context.Context, memberID uuid.UUID, roleID *uuid.UUID) error {
+					assigned = true
+					return nil
+				},
+			}
+			service := NewProjectRoleService(mockRoleRepo, mockProjectRepo)
+
+			// When
+			_, err := service.AssignMemberRole(context.Background(), projectID, tt.requesterID, tt.memberUserID, &dto.AssignProjectRoleRequest{CustomRoleID: &role.ID})
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Fatalf("AssignMemberRole() error = %v, want %s", err, tt.wantErrCode)
+				}
+				if assigned {
+					t.Error("AssignMemberRole() must not change the role on a rejected request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AssignMemberRole() unexpected error = %v", err)
+			}
+			if !assigned {
+				t.Error("AssignMemberRole() did not assign the role")
+			}
+		})
+	}
+}
+
+func TestRequireHeldPermissions(t *testing.T) {
+	admin := &domain.ProjectMember{RoleName: domain.ProjectRoleAdmin}
+	owner := &domain.ProjectMember{RoleName: domain.ProjectRoleOwner}
+
+	tests := []struct {
+		name        string
+		requester   *domain.ProjectMember
+		permissions []domain.Permission
+		wantErr     bool
+	}{
+		{"성공: 보유한 권한만 부여", admin, []domain.Permission{domain.PermissionBoardDeleteAny, domain.PermissionMemberInvite}, false},
+		{"성공: OWNER는 모든 권한 부여", owner, domain.RolePermissions(domain.ProjectRoleOwner), false},
+		{"실패: 보유하지 않은 권한 (project.update)", admin, []domain.Permission{domain.PermissionBoardCreate, domain.PermissionProjectUpdate}, true},
+		{"실패: MEMBER는 ADMIN 권한을 부여할 수 없음", &domain.ProjectMember{RoleName: domain.ProjectRoleMember}, domain.RolePermissions(domain.ProjectRoleAdmin), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requireHeldPermissions(tt.requester, tt.permissions)
+			if tt.wantErr {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != response.ErrCodeForbidden {
+					t.Errorf("requireHeldPermissions() error = %v, want FORBIDDEN", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("requireHeldPermissions() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+// TestPermissionMatrix_CustomRole checks that a custom role replaces the built-in role's permissions
+// across services using the shared authorization helper
+func TestPermissionMatrix_CustomRole(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	authorID := uuid.New()
+	memberID := uuid.New()
+	moderatorID := uuid.New()
+	viewerID := uuid.New()
+
+	rolePermissions := func(permissions ...domain.Permission) *domain.ProjectCustomRole {
+		data, _ := json.Marshal(permissions)
+		return &domain.ProjectCustomRole{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Permissions: data}
+	}
+	moderator := rolePermissions(domain.PermissionCommentCreate, domain.PermissionBoardDeleteAny)
+	viewer := rolePermissions()
+
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+			member := &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}
+			switch uID {
+			case moderatorID:
+				member.CustomRoleID, member.CustomRole = &moderator.ID, moderator
+			case viewerID:
+				member.CustomRoleID, member.CustomRole = &viewer.ID, viewer
+			}
+			return member, nil
+		},
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID, AuthorID: authorID}, nil
+		},
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			return nil
+		},
+	}
+	logger := zap.NewNop()
+	boardService := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, &MockFieldOptionConverter{}, nil, nil, nil, nil, nil, nil, logger)
+	commentService := NewCommentService(&MockCommentRepository{}, mockBoardRepo, mockProjectRepo, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, nil, logger)
+	labelService := NewLabelService(&MockLabelRepository{}, mockProjectRepo)
+	sprintService := NewSprintService(&MockSprintRepository{}, mockBoardRepo, mockProjectRepo, &MockFieldOptionConverter{}, nil, logger)
+	timelineService := NewTimelineService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockFieldOptionConverter{}, nil, logger)
+	reminderService := NewReminderService(&MockReminderRepository{}, mockBoardRepo, mockProjectRepo, &MockFieldOptionConverter{}, nil, nil, logger)
+	remindAt := time.Now().Add(time.Hour)
+
+	asUser := func(userID uuid.UUID) context.Context {
+		return context.WithValue(context.Background(), "user_id", userID)
+	}
+
+	tests := []struct {
+		name        string
+		action      func() error
+		wantErrCode string
+	}{
+		{
+			name: "성공: 작성자는 자신의 보드 삭제",
+			action: func() error {
+				return boardService.DeleteBoard(asUser(authorID), boardID)
+			},
+		},
+		{
+			name: "실패: MEMBER는 다른 사용자의 보드 삭제 불가",
+			action: func() error {
+				return boardService.DeleteBoard(asUser(memberID), boardID)
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "성공: board.delete.any 커스텀 역할은 다른 사용자의 보드 삭제",
+			action: func() error {
+				return boardService.DeleteBoard(asUser(moderatorID), boardID)
+			},
+		},
+		{
+			name: "실패: board.create가 없는 커스텀 역할은 보드 생성 불가",
+			action: func() error {
+				_, err := boardService.CreateBoard(asUser(moderatorID), &dto.CreateBoardRequest{ProjectID: projectID, Title: "New"})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "실패: 권한 없는 커스텀 역할은 댓글 작성 불가",
+			action: func() error {
+				_, err := commentService.CreateComment(context.Background(), viewerID, &dto.CreateCommentRequest{BoardID: boardID, Content: "hi"})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "성공: 권한 없는 커스텀 역할도 라벨 조회",
+			action: func() error {
+				_, err := labelService.GetLabels(context.Background(), projectID, viewerID)
+				return err
+			},
+		},
+		{
+			name: "실패: 권한 없는 커스텀 역할은 라벨 생성 불가",
+			action: func() error {
+				_, err := labelService.CreateLabel(context.Background(), projectID, viewerID, &dto.CreateLabelRequest{Name: "bug"})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "실패: 권한 없는 커스텀 역할은 스프린트 보드 변경 불가",
+			action: func() error {
+				_, err := sprintService.UpdateSprintBoards(context.Background(), projectID, viewerID, uuid.New(), &dto.UpdateSprintBoardsRequest{Add: []uuid.UUID{boardID}})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "실패: 권한 없는 커스텀 역할은 일정 이동 불가",
+			action: func() error {
+				_, err := timelineService.RescheduleBoards(context.Background(), projectID, viewerID, &dto.RescheduleBoardsRequest{BoardIDs: []uuid.UUID{boardID}, OffsetDays: 1})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name: "실패: 권한 없는 커스텀 역할은 리마인더 설정 불가",
+			action: func() error {
+				_, err := reminderService.CreateReminder(context.Background(), boardID, viewerID, &dto.CreateReminderRequest{RemindAt: &remindAt})
+				return err
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.action()
+
+			if tt.wantErrCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error = %v", err)
+				}
+				return
+			}
+			appErr, ok := err.(*response.AppError)
+			if !ok {
+				t.Fatalf("error = %v, want AppError %s", err, tt.wantErrCode)
+			}
+			if appErr.Code != tt.wantErrCode {
+				t.Errorf("error code = %v, want %v", appErr.Code, tt.wantErrCode)
+			}
+		})
+	}
+}
@@ -29,13 +29,15 @@ type S3Client interface {
 // ProjectService defines the interface for project business logic
 type ProjectService interface {
 	CreateProject(ctx context.Context, req *dto.CreateProjectRequest, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
-	GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error)
+	GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, includeArchived bool, token string) ([]*dto.ProjectResponse, error)
 	GetDefaultProject(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	GetProject(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectResponse, error)
 	UpdateProject(ctx context.Context, projectID, userID uuid.UUID, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
 	DeleteProject(ctx context.Context, projectID, userID uuid.UUID) error
 	SearchProjects(ctx context.Context, workspaceID, userID uuid.UUID, query string, page, limit int, token string) (*dto.PaginatedProjectsResponse, error)
 	GetProjectInitSettings(ctx context.Context, projectID, userID uuid.UUID, token string) (*dto.ProjectInitSettingsResponse, error)
+	ArchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
+	UnarchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error)
 }
 
 // projectServiceImpl is the implementation of ProjectService
@@ -87,12 +89,19 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 		}
 	}
 
+	// Resolve the board key prefix (immutable once the project exists)
+	keyPrefix, err := s.resolveProjectKeyPrefix(ctx, req.WorkspaceID, req.KeyPrefix, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create domain model from request
 	project := &domain.Project{
 		WorkspaceID: req.WorkspaceID,
 		OwnerID:     userID,
 		Name:        req.Name,
 		Description: req.Description,
+		KeyPrefix:   keyPrefix,
 		StartDate:   req.StartDate,
 		DueDate:     req.DueDate,
 		IsDefault:   false, // Default to false, can be changed later
@@ -172,7 +181,8 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 }
 
 // GetProjectsByWorkspace retrieves all projects for a workspace
-func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error) {
+// Archived projects are hidden unless includeArchived is set
+func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, includeArchived bool, token string) ([]*dto.ProjectResponse, error) {
 	// Validate workspace membership
 	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
 	if err != nil {
@@ -185,7 +195,7 @@ func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspa
 	}
 
 	// Fetch projects from repository
-	projects, err := s.projectRepo.FindByWorkspaceID(ctx, workspaceID)
+	projects, err := s.projectRepo.FindByWorkspaceID(ctx, workspaceID, includeArchived)
 	if err != nil {
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch projects", err.Error())
 	}
@@ -195,6 +205,8 @@ func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspa
 		return []*dto.ProjectResponse{}, nil
 	}
 
+	ownerProfiles := s.fetchOwnerProfiles(ctx, workspaceID, projects, token)
+
 	// Convert to response DTOs with owner profile information
 	// 동적으로 append하여 개별 프로젝트 변환 실패 시 전체 실패 방지
 	responses := make([]*dto.ProjectResponse, 0, len(projects))
@@ -212,8 +224,9 @@ func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspa
 		project.Attachments = toDomainAttachments(attachments) // 🚨 타입 변환 적용
 
 		// 개별 변환 실패 시 해당 프로젝트만 스킵
-		projectResp := s.toProjectResponseWithProfile(ctx, project, token)
+		projectResp := s.toProjectResponse(project)
 		if projectResp != nil {
+			applyOwnerProfile(projectResp, ownerProfiles[project.OwnerID])
 			responses = append(responses, projectResp)
 		} else {
 			// Log when a project response is nil to help debugging
@@ -344,16 +357,9 @@ func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID, userI
 		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
 	}
 
-	// Check if user is the project owner
-	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
-	}
-	if member.RoleName != domain.ProjectRoleOwner {
-		return response.NewForbiddenError("Only project owner can delete project", "")
+	// Only the owner holds project.delete
+	if err := requirePermission(ctx, s.projectRepo, projectID, userID, domain.PermissionProjectDelete, "Only project owner can delete project"); err != nil {
+		return err
 	}
 
 	// Find all attachments associated with this project
@@ -412,6 +418,8 @@ func (s *projectServiceImpl) SearchProjects(ctx context.Context, workspaceID, us
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to search projects", err.Error())
 	}
 
+	ownerProfiles := s.fetchOwnerProfiles(ctx, workspaceID, projects, token)
+
 	// Convert to response DTOs with owner profile information
 	responses := make([]dto.ProjectResponse, len(projects))
 	for i, project := range projects {
@@ -423,7 +431,9 @@ func (s *projectServiceImpl) SearchProjects(ctx context.Context, workspaceID, us
 		}
 		project.Attachments = toDomainAttachments(attachments) // 🚨 타입 변환 적용
 
-		responses[i] = *s.toProjectResponseWithProfile(ctx, project, token)
+		projectResp := s.toProjectResponse(project)
+		applyOwnerProfile(projectResp, ownerProfiles[project.OwnerID])
+		responses[i] = *projectResp
 	}
 
 	return &dto.PaginatedProjectsResponse{
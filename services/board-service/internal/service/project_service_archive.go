@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// ArchiveProject marks a project as archived, making it read-only (requires project.archive)
+func (s *projectServiceImpl) ArchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	project, err := s.findProjectForArchive(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermission(ctx, s.projectRepo, projectID, userID, domain.PermissionProjectArchive, "You do not have permission to archive project"); err != nil {
+		return nil, err
+	}
+
+	if project.IsDefault {
+		return nil, response.NewValidationError("Default project cannot be archived", "")
+	}
+	if project.IsArchived() {
+		return s.toProjectResponse(project), nil
+	}
+
+	now := time.Now()
+	project.ArchivedAt = &now
+	project.ArchivedBy = &userID
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to archive project", err.Error())
+	}
+
+	return s.toProjectResponse(project), nil
+}
+
+// UnarchiveProject restores an archived project to its writable state
+// Only the owner and built-in admins may unarchive; project.archive on a custom role is not enough
+func (s *projectServiceImpl) UnarchiveProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectResponse, error) {
+	project, err := s.findProjectForArchive(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireBuiltinAdmin(ctx, s.projectRepo, projectID, userID, "You do not have permission to unarchive project"); err != nil {
+		return nil, err
+	}
+
+	if !project.IsArchived() {
+		return s.toProjectResponse(project), nil
+	}
+
+	project.ArchivedAt = nil
+	project.ArchivedBy = nil
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to unarchive project", err.Error())
+	}
+
+	return s.toProjectResponse(project), nil
+}
+
+// findProjectForArchive fetches the project to archive or unarchive
+func (s *projectServiceImpl) findProjectForArchive(ctx context.Context, projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	if project == nil {
+		return nil, response.NewNotFoundError("Project not found", "")
+	}
+	return project, nil
+}
+
+// checkProjectWritable rejects mutations on an archived project
+func checkProjectWritable(project *domain.Project) error {
+	if project != nil && project.IsArchived() {
+		return response.NewProjectArchivedError("Unarchive the project to make changes")
+	}
+	return nil
+}
+
+// ensureProjectWritable loads the project and rejects mutations when it is archived
+// A missing project is left to the caller's own lookup so existing not-found handling is unchanged
+func ensureProjectWritable(ctx context.Context, projectRepo repository.ProjectRepository, projectID uuid.UUID) error {
+	project, err := projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	return checkProjectWritable(project)
+}
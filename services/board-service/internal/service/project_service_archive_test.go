@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestProjectService_ArchiveProject(t *testing.T) {
+	adminID := uuid.New()
+	memberID := uuid.New()
+	archiverID := uuid.New()
+	archiverRoleID := uuid.New()
+	archiverRole := &domain.ProjectCustomRole{
+		BaseModel:   domain.BaseModel{ID: archiverRoleID},
+		Name:        "Archiver",
+		Permissions: []byte(`["project.archive"]`),
+	}
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		isDefault   bool
+		unarchive   bool
+		wantErrCode string
+	}{
+		{
+			name:        "성공: ADMIN이 프로젝트 보관",
+			requesterID: adminID,
+		},
+		{
+			name:        "성공: ADMIN이 보관 해제",
+			requesterID: adminID,
+			unarchive:   true,
+		},
+		{
+			name:        "실패: MEMBER는 보관할 수 없음",
+			requesterID: memberID,
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: MEMBER는 보관 해제할 수 없음",
+			requesterID: memberID,
+			unarchive:   true,
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "성공: project.archive 커스텀 역할이 프로젝트 보관",
+			requesterID: archiverID,
+		},
+		{
+			name:        "실패: project.archive 커스텀 역할은 보관 해제할 수 없음",
+			requesterID: archiverID,
+			unarchive:   true,
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 기본 프로젝트는 보관할 수 없음",
+			requesterID: adminID,
+			isDefault:   true,
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), IsDefault: tt.isDefault}
+			if tt.unarchive {
+				archivedAt := time.Now()
+				project.ArchivedAt = &archivedAt
+				project.ArchivedBy = &adminID
+			}
+			updated := false
+			mockProjectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return project, nil
+				},
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == adminID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+					}
+					if uID == archiverID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin, CustomRoleID: &archiverRoleID, CustomRole: archiverRole}, nil
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+				},
+				UpdateFunc: func(ctx context.Context, p *domain.Project) error {
+					updated = true
+					return nil
+				},
+			}
+			service := NewProjectService(mockProjectRepo, &MockFieldOptionRepository{}, &MockAttachmentRepository{}, &MockS3Client{}, &MockUserClient{}, nil, zap.NewNop())
+
+			// When
+			var got *dto.ProjectResponse
+			var err error
+			if tt.unarchive {
+				got, err = service.UnarchiveProject(context.Background(), project.ID, tt.requesterID)
+			} else {
+				got, err = service.ArchiveProject(context.Background(), project.ID, tt.requesterID)
+			}
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				if updated {
+					t.Error("project must not be updated on a rejected request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error = %v", err)
+			}
+			if !updated {
+				t.Error("project archive state was not persisted")
+			}
+			if got.IsArchived == tt.unarchive {
+				t.Errorf("IsArchived = %v, want %v", got.IsArchived, !tt.unarchive)
+			}
+		})
+	}
+}
+
+func TestArchivedProject_RejectsMutations(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	userID := uuid.New()
+	archivedAt := time.Now()
+	logger := zap.NewNop()
+
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}, ArchivedAt: &archivedAt}, nil
+		},
+		IsProjectMemberFunc: func(ctx context.Context, pID, uID uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		FindMemberByProjectAndUserFunc: memberWithRole(domain.ProjectRoleAdmin),
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}, ProjectID: projectID}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+
+	boardService := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger)
+	commentService := NewCommentService(&MockCommentRepository{}, mockBoardRepo, mockProjectRepo, &MockAttachmentRepository{}, nil, nil, nil, nil, nil, nil, logger)
+	participantService := NewParticipantService(&MockParticipantRepository{}, mockBoardRepo, mockProjectRepo)
+	labelService := NewLabelService(&MockLabelRepository{}, mockProjectRepo)
+	workflowService := NewWorkflowService(&MockWorkflowRepository{}, mockProjectRepo, &MockFieldOptionRepository{}, &MockProjectRoleRepository{})
+	automationService := NewAutomationService(&MockAutomationRepository{}, mockProjectRepo, &MockFieldOptionRepository{})
+	webhookService := NewWebhookService(&MockWebhookRepository{}, mockProjectRepo, nil)
+	inviteService := NewInviteService(&MockInviteLinkRepository{}, mockProjectRepo, &MockUserClient{}, nil, nil, logger)
+	title := "renamed"
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"실패: 보드 생성", func() error {
+			_, err := boardService.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "New"})
+			return err
+		}},
+		{"실패: 보드 수정", func() error {
+			_, err := boardService.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{Title: &title})
+			return err
+		}},
+		{"실패: 보드 삭제", func() error {
+			return boardService.DeleteBoard(ctx, boardID)
+		}},
+		{"실패: 댓글 작성", func() error {
+			_, err := commentService.CreateComment(ctx, userID, &dto.CreateCommentRequest{BoardID: boardID, Content: "hi"})
+			return err
+		}},
+		{"실패: 참여자 추가", func() error {
+			_, err := participantService.AddParticipants(ctx, &dto.AddParticipantsRequest{BoardID: boardID, UserIDs: []uuid.UUID{uuid.New()}})
+			return err
+		}},
+		{"실패: 라벨 생성", func() error {
+			_, err := labelService.CreateLabel(ctx, projectID, userID, &dto.CreateLabelRequest{Name: "bug"})
+			return err
+		}},
+		{"실패: 워크플로 전이 생성", func() error {
+			_, err := workflowService.CreateTransition(ctx, projectID, userID, &dto.CreateWorkflowTransitionRequest{FromStage: "in_progress", ToStage: "review"})
+			return err
+		}},
+		{"실패: 자동화 규칙 삭제", func() error {
+			return automationService.DeleteRule(ctx, projectID, userID, uuid.New())
+		}},
+		{"실패: 웹훅 생성", func() error {
+			_, err := webhookService.CreateWebhook(ctx, projectID, userID, &dto.CreateWebhookRequest{Name: "hook", URL: "https://example.com/hook", EventTypes: []string{domain.WebhookEventBoardCreated}})
+			return err
+		}},
+		{"실패: 초대 링크 생성", func() error {
+			_, err := inviteService.CreateInviteLink(ctx, projectID, userID, &dto.CreateInviteLinkRequest{Role: string(domain.ProjectRoleMember)})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			appErr, ok := err.(*response.AppError)
+			if !ok || appErr.Code != response.ErrCodeProjectArchived {
+				t.Errorf("error = %v, want %s", err, response.ErrCodeProjectArchived)
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
+	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -19,6 +23,7 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 
 		// 💡 [수정] s3Client.GetFileURL을 사용하여 FileURL 필드 채우기 (DB의 FileURL은 S3 Key)
 		fileURL := s.s3Client.GetFileURL(a.FileURL)
+		thumbnailURL, previewURL := attachmentImageURLs(s.s3Client, &a)
 
 		attachments = append(attachments, dto.AttachmentResponse{
 			ID:       a.ID,
@@ -29,6 +34,9 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 			ContentType: a.ContentType,
 			UploadedBy:  a.UploadedBy,
 			UploadedAt:  a.CreatedAt,
+
+			ThumbnailURL: thumbnailURL,
+			PreviewURL:   previewURL,
 		})
 	}
 
@@ -38,9 +46,12 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 		OwnerID:     project.OwnerID,
 		Name:        project.Name,
 		Description: project.Description,
+		KeyPrefix:   project.KeyPrefix,
 		StartDate:   project.StartDate,
 		DueDate:     project.DueDate,
 		IsPublic:    project.IsPublic,
+		IsArchived:  project.IsArchived(),
+		ArchivedAt:  project.ArchivedAt,
 		Attachments: attachments,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
@@ -68,13 +79,34 @@ func (s *projectServiceImpl) toProjectResponseWithProfile(ctx context.Context, p
 		return response
 	}
 
+	applyOwnerProfile(response, profile)
+	return response
+}
+
+// fetchOwnerProfiles fetches the owner profiles of projects in one workspace with a single batch call
+func (s *projectServiceImpl) fetchOwnerProfiles(ctx context.Context, workspaceID uuid.UUID, projects []*domain.Project, token string) map[uuid.UUID]*client.WorkspaceProfile {
+	ownerIDs := make([]uuid.UUID, 0, len(projects))
+	for _, project := range projects {
+		if project != nil {
+			ownerIDs = append(ownerIDs, project.OwnerID)
+		}
+	}
+
+	profiles, err := s.userClient.GetWorkspaceProfiles(ctx, workspaceID, ownerIDs, token)
+	if err != nil {
+		// 에러 발생 시 owner 정보 없이 반환 (graceful degradation)
+		return nil
+	}
+	return profiles
+}
+
+// applyOwnerProfile fills the owner fields of a project response
+func applyOwnerProfile(response *dto.ProjectResponse, profile *client.WorkspaceProfile) {
 	// profile이 nil이 아닐 때만 정보 추가
-	if profile != nil {
+	if response != nil && profile != nil {
 		response.OwnerEmail = profile.Email
 		response.OwnerName = profile.NickName
 	}
-
-	return response
 }
 
 // GetProject retrieves a project by ID with membership validation
@@ -94,6 +126,7 @@ func (s *projectServiceImpl) createDefaultFieldOptions(ctx context.Context, proj
 			Color:           template.Color,
 			DisplayOrder:    template.DisplayOrder,
 			IsSystemDefault: false,
+			Category:        template.Category,
 		}
 	}
 
@@ -116,6 +149,54 @@ func validateProjectDateRange(startDate, dueDate *time.Time) error {
 	return nil
 }
 
+// maxKeyPrefixCandidates bounds the search for a free derived key prefix
+const maxKeyPrefixCandidates = 100
+
+// resolveProjectKeyPrefix returns the board key prefix for a new project
+// A requested prefix must be valid and free in the workspace; otherwise one is derived from the name
+func (s *projectServiceImpl) resolveProjectKeyPrefix(ctx context.Context, workspaceID uuid.UUID, requested, name string) (string, error) {
+	if requested != "" {
+		prefix := strings.ToUpper(requested)
+		if !domain.IsValidProjectKeyPrefix(prefix) {
+			return "", response.NewValidationError("Invalid key prefix",
+				"keyPrefix must be 2-10 letters or digits and start with a letter")
+		}
+		taken, err := s.isKeyPrefixTaken(ctx, workspaceID, prefix)
+		if err != nil {
+			return "", response.NewAppError(response.ErrCodeInternal, "Failed to check key prefix", err.Error())
+		}
+		if taken {
+			return "", response.NewAlreadyExistsError("Key prefix is already used in this workspace", prefix)
+		}
+		return prefix, nil
+	}
+
+	base := domain.DeriveProjectKeyPrefix(name)
+	for n := 0; n < maxKeyPrefixCandidates; n++ {
+		candidate := domain.ProjectKeyPrefixCandidate(base, n)
+		taken, err := s.isKeyPrefixTaken(ctx, workspaceID, candidate)
+		if err != nil {
+			return "", response.NewAppError(response.ErrCodeInternal, "Failed to check key prefix", err.Error())
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+	return "", response.NewAlreadyExistsError("Could not derive a free key prefix", "Please provide keyPrefix")
+}
+
+// isKeyPrefixTaken checks whether a project of the workspace already uses the prefix
+func (s *projectServiceImpl) isKeyPrefixTaken(ctx context.Context, workspaceID uuid.UUID, prefix string) (bool, error) {
+	project, err := s.projectRepo.FindByWorkspaceAndKeyPrefix(ctx, workspaceID, prefix)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return project != nil, nil
+}
+
 // validateAndConfirmAttachments validates that attachments exist and are in TEMP status
 
 func (s *projectServiceImpl) validateAndConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityType domain.EntityType) error {
@@ -176,6 +257,7 @@ func (s *projectServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attach
 				zap.Error(err))
 			// Continue even if S3 deletion fails
 		}
+		deleteDerivedFiles(ctx, s.s3Client, s.logger, attachment)
 
 		attachmentIDs = append(attachmentIDs, attachment.ID)
 	}
@@ -107,6 +107,7 @@ func (s *projectServiceImpl) GetProjectInitSettings(ctx context.Context, project
 		WorkspaceEmail: workspace.OwnerEmail,
 		Name:           project.Name,
 		Description:    project.Description,
+		KeyPrefix:      project.KeyPrefix,
 		OwnerID:        project.OwnerID,
 		IsPublic:       project.IsPublic,
 		StartDate:      project.StartDate,
@@ -146,6 +147,7 @@ func (s *projectServiceImpl) GetProjectInitSettings(ctx context.Context, project
 			OptionValue:  opt.Value,
 			Color:        opt.Color,
 			DisplayOrder: opt.DisplayOrder,
+			Category:     string(opt.Category),
 			FieldID:      "stage",
 		}
 	}
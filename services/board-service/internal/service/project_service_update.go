@@ -23,16 +23,14 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
 	}
 
-	// Check if user is the project owner
-	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, response.NewForbiddenError("You are not a member of this project", "")
-		}
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	// Check if user may edit the project
+	if err := requirePermission(ctx, s.projectRepo, projectID, userID, domain.PermissionProjectUpdate, "You do not have permission to update project"); err != nil {
+		return nil, err
 	}
-	if member.RoleName != domain.ProjectRoleOwner {
-		return nil, response.NewForbiddenError("Only project owner can update project", "")
+
+	// Archived projects are read-only until they are unarchived
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
 	}
 
 	// Determine the effective start and due dates for validation
@@ -0,0 +1,496 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/markdown"
+	"project-board-api/internal/recurrence"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// recurrenceBatchSize limits the series examined by one generation run
+const recurrenceBatchSize = 500
+
+// errRecurrenceAdvanced rolls back an occurrence whose series changed since it was read
+var errRecurrenceAdvanced = errors.New("recurrence already advanced")
+
+// RecurrenceService defines the interface for recurring boards
+// A series is set on a template board; every occurrence is a new board copied from the template
+type RecurrenceService interface {
+	GetRecurrence(ctx context.Context, boardID, userID uuid.UUID) (*dto.RecurrenceResponse, error)
+	SetRecurrence(ctx context.Context, boardID, userID uuid.UUID, req *dto.SetRecurrenceRequest) (*dto.RecurrenceResponse, error)
+	DeleteRecurrence(ctx context.Context, boardID, userID uuid.UUID) error
+	// GenerateDue creates the next occurrence of the series whose next date arrived or whose latest board was completed
+	GenerateDue(ctx context.Context) (int, error)
+}
+
+// recurrenceServiceImpl is the implementation of RecurrenceService
+type recurrenceServiceImpl struct {
+	recurrenceRepo       repository.RecurrenceRepository
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	participantRepo      repository.ParticipantRepository
+	labelRepo            repository.LabelRepository
+	fieldOptionRepo      repository.FieldOptionRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	notiClient           client.NotiClient
+	outbox               Outbox // optional, nil sends notifications on a best-effort basis
+	logger               *zap.Logger
+	now                  func() time.Time
+}
+
+// NewRecurrenceService creates a new instance of RecurrenceService
+func NewRecurrenceService(
+	recurrenceRepo repository.RecurrenceRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	participantRepo repository.ParticipantRepository,
+	labelRepo repository.LabelRepository,
+	fieldOptionRepo repository.FieldOptionRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	notiClient client.NotiClient,
+	outbox Outbox,
+	logger *zap.Logger,
+) RecurrenceService {
+	return &recurrenceServiceImpl{
+		recurrenceRepo:       recurrenceRepo,
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		participantRepo:      participantRepo,
+		labelRepo:            labelRepo,
+		fieldOptionRepo:      fieldOptionRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		notiClient:           notiClient,
+		outbox:               outbox,
+		logger:               logger,
+		now:                  time.Now,
+	}
+}
+
+// GetRecurrence retrieves the series a board is the template or an occurrence of (any project member)
+func (s *recurrenceServiceImpl) GetRecurrence(ctx context.Context, boardID, userID uuid.UUID) (*dto.RecurrenceResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.findSeries(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceResponse(series), nil
+}
+
+// SetRecurrence makes a board the template of a new series, or changes the rule of the series it is the template of
+func (s *recurrenceServiceImpl) SetRecurrence(ctx context.Context, boardID, userID uuid.UUID, req *dto.SetRecurrenceRequest) (*dto.RecurrenceResponse, error) {
+	rule, err := recurrence.Parse(req.RRule)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid recurrence rule", err.Error())
+	}
+
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanManage(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	dtstart := req.DTStart
+	if dtstart == nil {
+		dtstart = board.DueDate
+	}
+	if dtstart == nil {
+		dtstart = board.StartDate
+	}
+	if dtstart == nil {
+		return nil, response.NewValidationError("Set dtstart or give the board a due or start date", "")
+	}
+	start := dtstart.UTC()
+
+	var series *domain.BoardRecurrence
+	if board.RecurrenceID != nil {
+		if series, err = s.findSeries(ctx, board); err != nil {
+			return nil, err
+		}
+		if series.TemplateBoardID != board.ID {
+			return nil, response.NewValidationError("The board was generated by a series; change the recurrence on its template board", series.TemplateBoardID.String())
+		}
+	}
+
+	if series == nil {
+		series = &domain.BoardRecurrence{
+			ProjectID:       board.ProjectID,
+			TemplateBoardID: board.ID,
+			LatestBoardID:   board.ID,
+			CreatedBy:       userID,
+		}
+	}
+	series.RRule = rule.String()
+	series.DTStart = start
+	if series.LatestBoardID == series.TemplateBoardID {
+		// Nothing was generated yet, so the template stays the first occurrence at the new start
+		series.LatestAt = start
+	}
+	scheduleNext(series, rule)
+
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if series.ID == uuid.Nil {
+			if err := s.recurrenceRepo.Create(ctx, series); err != nil {
+				return err
+			}
+			return s.recurrenceRepo.LinkBoard(ctx, board.ID, &series.ID)
+		}
+		return s.recurrenceRepo.Update(ctx, series)
+	})
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to save recurrence", err.Error())
+	}
+	return toRecurrenceResponse(series), nil
+}
+
+// DeleteRecurrence stops the series of a board; boards generated so far stay
+func (s *recurrenceServiceImpl) DeleteRecurrence(ctx context.Context, boardID, userID uuid.UUID) error {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	series, err := s.findSeries(ctx, board)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCanManage(ctx, board.ProjectID, userID); err != nil {
+		return err
+	}
+	if err := s.recurrenceRepo.Delete(ctx, series.ID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete recurrence", err.Error())
+	}
+	return nil
+}
+
+// GenerateDue creates one occurrence for every series whose next date arrived, or whose latest board reached a
+// closed stage before that date. Dates missed while the job was not running are skipped, not backfilled
+func (s *recurrenceServiceImpl) GenerateDue(ctx context.Context) (int, error) {
+	seriesList, err := s.recurrenceRepo.FindActive(ctx, recurrenceBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now()
+	generated := 0
+	for _, series := range seriesList {
+		ok, err := s.generateNext(ctx, series, now)
+		if err != nil {
+			s.logger.Warn("Failed to generate recurring board",
+				zap.String("recurrence.id", series.ID.String()),
+				zap.Error(err))
+			continue
+		}
+		if ok {
+			generated++
+		}
+	}
+	return generated, nil
+}
+
+// generateNext creates the next occurrence of a series when it is due and reports whether it did
+func (s *recurrenceServiceImpl) generateNext(ctx context.Context, series *domain.BoardRecurrence, now time.Time) (bool, error) {
+	rule, err := recurrence.Parse(series.RRule)
+	if err != nil {
+		return false, err
+	}
+
+	var at time.Time
+	var index int
+	if !series.NextAt.After(now) {
+		// Take the latest date that has arrived so a stopped job does not flood the project
+		at, index, _ = rule.Latest(series.DTStart, now)
+	} else {
+		completed, err := s.isLatestCompleted(ctx, series)
+		if err != nil || !completed {
+			return false, err
+		}
+		var ok bool
+		if at, index, ok = rule.After(series.DTStart, series.LatestAt); !ok {
+			return false, nil
+		}
+	}
+
+	template, err := s.boardRepo.FindByID(ctx, series.TemplateBoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Without a template there is nothing to copy; end the series and keep its boards
+			series.NextAt = nil
+			return false, s.recurrenceRepo.Update(ctx, series)
+		}
+		return false, err
+	}
+	project, err := s.projectRepo.FindByID(ctx, series.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	if project.IsArchived() {
+		// Archived projects do not change; the series resumes once the project is unarchived
+		return false, nil
+	}
+
+	board, err := s.newOccurrence(ctx, series, template, at)
+	if err != nil {
+		return false, err
+	}
+	participantIDs := make([]uuid.UUID, 0, len(template.Participants))
+	labelIDs := make([]uuid.UUID, 0, len(template.Labels))
+	for _, p := range template.Participants {
+		participantIDs = append(participantIDs, p.UserID)
+	}
+	for _, l := range template.Labels {
+		labelIDs = append(labelIDs, l.ID)
+	}
+
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if err := s.boardRepo.Create(ctx, board); err != nil {
+			return err
+		}
+		for _, userID := range participantIDs {
+			if err := s.participantRepo.Create(ctx, &domain.Participant{BoardID: board.ID, UserID: userID}); err != nil {
+				return err
+			}
+		}
+		if len(labelIDs) > 0 {
+			if err := s.labelRepo.ReplaceBoardLabels(ctx, board.ID, labelIDs); err != nil {
+				return err
+			}
+		}
+
+		// Conditional on the series as it was read, so with several replicas only one generates the occurrence
+		fromLatestBoardID, fromNextAt := series.LatestBoardID, series.NextAt
+		series.LatestBoardID = board.ID
+		series.LatestAt = at
+		series.OccurrenceCount = index
+		scheduleNext(series, rule)
+		advanced, err := s.recurrenceRepo.Advance(ctx, series, fromLatestBoardID, fromNextAt)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return errRecurrenceAdvanced
+		}
+
+		var events []*client.NotificationEvent
+		if board.AssigneeID != nil {
+			events = append(events, client.NewBoardAssignedNotification(series.CreatedBy, *board.AssigneeID, project.WorkspaceID, board.ID, board.Title))
+		}
+		if err := recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateBoard, board.ID, events); err != nil {
+			return err
+		}
+		return recordBoardBroadcast(ctx, s.outbox, board.ProjectID, board.ID, domain.WebhookEventBoardCreated,
+			s.toBoardResponse(ctx, board, project, participantIDs, template.Labels))
+	})
+	if errors.Is(err, errRecurrenceAdvanced) {
+		// Rolled back: another worker generated this occurrence or the series was changed meanwhile
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	s.logger.Info("Recurring board generated",
+		zap.String("recurrence.id", series.ID.String()),
+		zap.String("board.id", board.ID.String()),
+		zap.Int("occurrence", index))
+	return true, nil
+}
+
+// isLatestCompleted reports whether the latest occurrence reached a closed stage
+// A deleted latest board waits for the next date
+func (s *recurrenceServiceImpl) isLatestCompleted(ctx context.Context, series *domain.BoardRecurrence) (bool, error) {
+	latest, err := s.boardRepo.FindByID(ctx, series.LatestBoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, []*domain.Board{latest}); err != nil {
+		return false, err
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, []*domain.Board{latest})
+	if err != nil {
+		return false, err
+	}
+	return stages.isBoardClosed(latest), nil
+}
+
+// newOccurrence copies the template into an occurrence at the given date
+// Dates move by the distance from DTStart, so the template's start-to-due span is kept; a template without dates
+// gives occurrences due at their date. The stage starts over at the project's first open stage
+func (s *recurrenceServiceImpl) newOccurrence(ctx context.Context, series *domain.BoardRecurrence, template *domain.Board, at time.Time) (*domain.Board, error) {
+	shift := at.Sub(series.DTStart)
+	board := &domain.Board{
+		ProjectID:    series.ProjectID,
+		AuthorID:     series.CreatedBy,
+		AssigneeID:   template.AssigneeID,
+		RecurrenceID: &series.ID,
+		Title:        template.Title,
+		Content:      template.Content,
+		ContentText:  markdown.PlainText(template.Content),
+	}
+	if template.StartDate != nil {
+		startDate := template.StartDate.Add(shift)
+		board.StartDate = &startDate
+	}
+	if template.DueDate != nil {
+		dueDate := template.DueDate.Add(shift)
+		board.DueDate = &dueDate
+	}
+	if board.StartDate == nil && board.DueDate == nil {
+		dueDate := at
+		board.DueDate = &dueDate
+	}
+
+	customFields, err := s.resetStage(ctx, series.ProjectID, template.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	board.CustomFields = customFields
+	return board, nil
+}
+
+// resetStage returns a copy of stored custom fields (option IDs) with the stage set to the first open stage
+func (s *recurrenceServiceImpl) resetStage(ctx context.Context, projectID uuid.UUID, stored datatypes.JSON) (datatypes.JSON, error) {
+	if len(stored) == 0 {
+		return stored, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(stored, &fields); err != nil {
+		return nil, err
+	}
+	if _, ok := fields[string(domain.FieldTypeStage)]; !ok {
+		return stored, nil
+	}
+
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldTypeStage)
+	if err != nil {
+		return nil, err
+	}
+	delete(fields, string(domain.FieldTypeStage))
+	for _, option := range options {
+		if !option.Category.IsClosed() {
+			fields[string(domain.FieldTypeStage)] = option.ID.String()
+			break
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// toBoardResponse builds the BOARD_CREATED payload of a generated board
+func (s *recurrenceServiceImpl) toBoardResponse(ctx context.Context, board *domain.Board, project *domain.Project, participantIDs []uuid.UUID, labels []domain.Label) *dto.BoardResponse {
+	var customFields map[string]interface{}
+	converted := *board
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, []*domain.Board{&converted}); err == nil && len(converted.CustomFields) > 0 {
+		_ = json.Unmarshal(converted.CustomFields, &customFields)
+	}
+	return &dto.BoardResponse{
+		ID:             board.ID,
+		ProjectID:      board.ProjectID,
+		WorkspaceID:    project.WorkspaceID,
+		Key:            domain.FormatBoardKey(project.KeyPrefix, board.Number),
+		Number:         board.Number,
+		AuthorID:       board.AuthorID,
+		AssigneeID:     board.AssigneeID,
+		RecurrenceID:   board.RecurrenceID,
+		Title:          board.Title,
+		Content:        board.Content,
+		CustomFields:   customFields,
+		StartDate:      board.StartDate,
+		DueDate:        board.DueDate,
+		ParticipantIDs: participantIDs,
+		Labels:         toBoardLabelResponses(labels),
+		Attachments:    []dto.AttachmentResponse{},
+		CreatedAt:      board.CreatedAt,
+		UpdatedAt:      board.UpdatedAt,
+	}
+}
+
+// scheduleNext sets the next date after the latest occurrence, or ends the series when the rule has ended
+func scheduleNext(series *domain.BoardRecurrence, rule *recurrence.Rule) {
+	next, index, ok := rule.After(series.DTStart, series.LatestAt)
+	if !ok {
+		series.NextAt = nil
+		return
+	}
+	series.NextAt = &next
+	series.OccurrenceCount = index - 1
+}
+
+// checkCanManage verifies that the requester may create boards in a writable project
+func (s *recurrenceServiceImpl) checkCanManage(ctx context.Context, projectID, userID uuid.UUID) error {
+	if err := requirePermission(ctx, s.projectRepo, projectID, userID, domain.PermissionBoardCreate, "You do not have permission to create boards"); err != nil {
+		return err
+	}
+	return ensureProjectWritable(ctx, s.projectRepo, projectID)
+}
+
+// findBoard fetches a board and verifies that the requester is a member of its project
+func (s *recurrenceServiceImpl) findBoard(ctx context.Context, boardID, userID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+// findSeries fetches the series a board belongs to
+func (s *recurrenceServiceImpl) findSeries(ctx context.Context, board *domain.Board) (*domain.BoardRecurrence, error) {
+	if board.RecurrenceID == nil {
+		return nil, response.NewNotFoundError("The board does not recur", "")
+	}
+	series, err := s.recurrenceRepo.FindByID(ctx, *board.RecurrenceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("The board does not recur", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch recurrence", err.Error())
+	}
+	return series, nil
+}
+
+// toRecurrenceResponse converts domain.BoardRecurrence to dto.RecurrenceResponse with a preview of upcoming dates
+func toRecurrenceResponse(series *domain.BoardRecurrence) *dto.RecurrenceResponse {
+	upcoming := []time.Time{}
+	if rule, err := recurrence.Parse(series.RRule); err == nil && series.NextAt != nil {
+		upcoming = rule.Upcoming(series.DTStart, series.LatestAt, dto.RecurrencePreviewSize)
+	}
+	return &dto.RecurrenceResponse{
+		RecurrenceID:    series.ID,
+		ProjectID:       series.ProjectID,
+		TemplateBoardID: series.TemplateBoardID,
+		RRule:           series.RRule,
+		DTStart:         series.DTStart,
+		LatestBoardID:   series.LatestBoardID,
+		LatestAt:        series.LatestAt,
+		OccurrenceCount: series.OccurrenceCount,
+		NextAt:          series.NextAt,
+		Upcoming:        upcoming,
+		CreatedBy:       series.CreatedBy,
+		CreatedAt:       series.CreatedAt,
+	}
+}
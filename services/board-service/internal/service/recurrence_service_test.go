@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// recurrenceNow is the fixed clock of the recurrence tests (Wednesday 2024-01-17 10:00 UTC)
+var recurrenceNow = time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC)
+
+// recurrenceDate returns 09:00 UTC on a day of January 2024
+func recurrenceDate(day int) time.Time {
+	return time.Date(2024, 1, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestRecurrenceService_SetRecurrence(t *testing.T) {
+	due := recurrenceDate(15) // Monday
+
+	tests := []struct {
+		name        string
+		rrule       string
+		dtstart     *time.Time
+		dueDate     *time.Time
+		occurrence  bool
+		requester   func(f *recurrenceFixture) uuid.UUID
+		wantStart   time.Time
+		wantNext    time.Time
+		wantErrCode string
+	}{
+		{
+			name:      "성공: 마감일이 시작 일시",
+			rrule:     "FREQ=WEEKLY;BYDAY=MO",
+			dueDate:   &due,
+			wantStart: due,
+			wantNext:  recurrenceDate(22),
+		},
+		{
+			name:      "성공: 시작 일시 지정",
+			rrule:     "FREQ=DAILY;INTERVAL=2",
+			dtstart:   func() *time.Time { d := recurrenceDate(20); return &d }(),
+			wantStart: recurrenceDate(20),
+			wantNext:  recurrenceDate(22),
+		},
+		{
+			name:        "실패: 잘못된 규칙",
+			rrule:       "FREQ=YEARLY",
+			dueDate:     &due,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 날짜 없는 보드",
+			rrule:       "FREQ=DAILY",
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 시리즈가 생성한 보드",
+			rrule:       "FREQ=DAILY",
+			dueDate:     &due,
+			occurrence:  true,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 프로젝트 멤버가 아님",
+			rrule:       "FREQ=DAILY",
+			dueDate:     &due,
+			requester:   func(f *recurrenceFixture) uuid.UUID { return uuid.New() },
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRecurrenceFixture()
+			board := f.addBoard("in_progress", nil, tt.dueDate)
+			if tt.occurrence {
+				template := f.addBoard("in_progress", nil, tt.dueDate)
+				series := f.addSeries(template, "FREQ=DAILY", due, nil)
+				board.RecurrenceID = &series.ID
+			}
+			requesterID := f.memberID
+			if tt.requester != nil {
+				requesterID = tt.requester(f)
+			}
+
+			resp, err := f.service().SetRecurrence(context.Background(), board.ID, requesterID,
+				&dto.SetRecurrenceRequest{RRule: tt.rrule, DTStart: tt.dtstart})
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetRecurrence() error = %v", err)
+			}
+			if !resp.DTStart.Equal(tt.wantStart) || resp.NextAt == nil || !resp.NextAt.Equal(tt.wantNext) {
+				t.Errorf("dtstart, nextAt = %v, %v, want %v, %v", resp.DTStart, resp.NextAt, tt.wantStart, tt.wantNext)
+			}
+			if resp.TemplateBoardID != board.ID || resp.LatestBoardID != board.ID || resp.OccurrenceCount != 1 {
+				t.Errorf("template, latest, count = %s, %s, %d; want the board itself as occurrence 1", resp.TemplateBoardID, resp.LatestBoardID, resp.OccurrenceCount)
+			}
+			if link := f.links[board.ID]; link == nil || *link != resp.RecurrenceID {
+				t.Errorf("template board link = %v, want %s", link, resp.RecurrenceID)
+			}
+			if len(resp.Upcoming) != dto.RecurrencePreviewSize || !resp.Upcoming[0].Equal(tt.wantNext) {
+				t.Errorf("upcoming = %v", resp.Upcoming)
+			}
+		})
+	}
+}
+
+func TestRecurrenceService_GenerateDue(t *testing.T) {
+	t.Run("다음 일시가 된 시리즈는 템플릿을 복사해 새 보드를 만든다", func(t *testing.T) {
+		f := newRecurrenceFixture()
+		start, due := recurrenceDate(5), recurrenceDate(8)
+		template := f.addBoard("approved", &start, &due)
+		assigneeID, participantID, labelID := uuid.New(), uuid.New(), uuid.New()
+		template.AssigneeID = &assigneeID
+		template.Participants = []domain.Participant{{BoardID: template.ID, UserID: participantID}}
+		template.Labels = []domain.Label{{BaseModel: domain.BaseModel{ID: labelID}, Name: "ops"}}
+		next := recurrenceDate(15)
+		series := f.addSeries(template, "FREQ=WEEKLY;BYDAY=MO", due, &next)
+		series.LatestBoardID = uuid.New() // the template is not the open occurrence
+
+		generated, err := f.service().GenerateDue(context.Background())
+		if err != nil {
+			t.Fatalf("GenerateDue() error = %v", err)
+		}
+		if generated != 1 || len(f.created) != 1 {
+			t.Fatalf("generated = %d (%d boards), want 1", generated, len(f.created))
+		}
+
+		board := f.created[0]
+		if board.RecurrenceID == nil || *board.RecurrenceID != series.ID {
+			t.Errorf("recurrenceId = %v, want %s", board.RecurrenceID, series.ID)
+		}
+		if board.Title != template.Title || board.AssigneeID == nil || *board.AssigneeID != assigneeID || board.AuthorID != f.memberID {
+			t.Errorf("board = %q assignee %v author %s, want a copy of the template", board.Title, board.AssigneeID, board.AuthorID)
+		}
+		if !board.DueDate.Equal(recurrenceDate(15)) || !board.StartDate.Equal(recurrenceDate(12)) {
+			t.Errorf("dates = %v - %v, want Jan 12 - Jan 15 (template span kept)", board.StartDate, board.DueDate)
+		}
+		var fields map[string]interface{}
+		_ = json.Unmarshal(board.CustomFields, &fields)
+		if fields["stage"] != f.pendingID.String() || fields["importance"] != "high" {
+			t.Errorf("custom fields = %v, want importance copied and the first open stage", fields)
+		}
+		if len(f.participant) != 1 || f.participant[0].BoardID != board.ID || f.participant[0].UserID != participantID {
+			t.Errorf("participants = %+v, want the template's participant", f.participant)
+		}
+		if ids := f.labels[board.ID]; len(ids) != 1 || ids[0] != labelID {
+			t.Errorf("labels = %v, want the template's label", ids)
+		}
+
+		if series.LatestBoardID != board.ID || !series.LatestAt.Equal(next) || series.OccurrenceCount != 2 {
+			t.Errorf("series latest = %s at %v (#%d), want the new board at %v (#2)", series.LatestBoardID, series.LatestAt, series.OccurrenceCount, next)
+		}
+		if series.NextAt == nil || !series.NextAt.Equal(recurrenceDate(22)) {
+			t.Errorf("nextAt = %v, want Jan 22", series.NextAt)
+		}
+
+		events := f.store.sorted()
+		if len(events) != 2 {
+			t.Fatalf("outbox events = %d, want the assignee notification and BOARD_CREATED", len(events))
+		}
+		if events[1].EventType != domain.WebhookEventBoardCreated {
+			t.Errorf("broadcast = %s, want BOARD_CREATED", events[1].EventType)
+		}
+	})
+
+	t.Run("놓친 일시는 건너뛰고 가장 최근 일시 하나만 만든다", func(t *testing.T) {
+		f := newRecurrenceFixture()
+		dtstart := time.Date(2023, 12, 1, 9, 0, 0, 0, time.UTC)
+		template := f.addBoard("in_progress", nil, &dtstart)
+		next := time.Date(2023, 12, 2, 9, 0, 0, 0, time.UTC)
+		series := f.addSeries(template, "FREQ=DAILY", dtstart, &next)
+
+		if _, err := f.service().GenerateDue(context.Background()); err != nil {
+			t.Fatalf("GenerateDue() error = %v", err)
+		}
+		if len(f.created) != 1 || !f.created[0].DueDate.Equal(recurrenceDate(17)) {
+			t.Fatalf("created %d boards, want one due today", len(f.created))
+		}
+		if series.NextAt == nil || !series.NextAt.Equal(recurrenceDate(18)) || series.OccurrenceCount != 48 {
+			t.Errorf("nextAt = %v (#%d), want Jan 18 after occurrence 48", series.NextAt, series.OccurrenceCount)
+		}
+	})
+
+	t.Run("최근 보드가 완료되면 다음 일시 전에 미리 만든다", func(t *testing.T) {
+		f := newRecurrenceFixture()
+		due := recurrenceDate(15)
+		template := f.addBoard("approved", nil, &due)
+		next := recurrenceDate(22)
+		series := f.addSeries(template, "FREQ=WEEKLY;COUNT=2", due, &next)
+
+		generated, err := f.service().GenerateDue(context.Background())
+		if err != nil {
+			t.Fatalf("GenerateDue() error = %v", err)
+		}
+		if generated != 1 || !f.created[0].DueDate.Equal(next) {
+			t.Fatalf("generated = %d, want the Jan 22 occurrence", generated)
+		}
+		if series.NextAt != nil {
+			t.Errorf("nextAt = %v, want nil after COUNT=2", series.NextAt)
+		}
+	})
+
+	t.Run("다른 복제본이 먼저 만든 일시는 다시 만들지 않는다", func(t *testing.T) {
+		f := newRecurrenceFixture()
+		f.advancedElsewhere = true
+		due := recurrenceDate(8)
+		template := f.addBoard("in_progress", nil, &due)
+		next := recurrenceDate(15)
+		f.addSeries(template, "FREQ=WEEKLY;BYDAY=MO", due, &next)
+
+		generated, err := f.service().GenerateDue(context.Background())
+		if err != nil || generated != 0 {
+			t.Errorf("GenerateDue() = %d, %v, want nothing generated", generated, err)
+		}
+	})
+
+	t.Run("진행 중인 보드와 보관된 프로젝트는 기다린다", func(t *testing.T) {
+		f := newRecurrenceFixture()
+		due := recurrenceDate(15)
+		open := f.addBoard("in_progress", nil, &due)
+		next := recurrenceDate(22)
+		f.addSeries(open, "FREQ=WEEKLY", due, &next)
+
+		archived := newRecurrenceFixture()
+		archived.archived = true
+		template := archived.addBoard("in_progress", nil, &due)
+		past := recurrenceDate(16)
+		archived.addSeries(template, "FREQ=DAILY", due, &past)
+
+		for _, fixture := range []*recurrenceFixture{f, archived} {
+			generated, err := fixture.service().GenerateDue(context.Background())
+			if err != nil || generated != 0 {
+				t.Errorf("GenerateDue() = %d, %v, want nothing generated", generated, err)
+			}
+		}
+	})
+}
+
+func TestRecurrenceService_DeleteRecurrence(t *testing.T) {
+	f := newRecurrenceFixture()
+	due := recurrenceDate(15)
+	template := f.addBoard("in_progress", nil, &due)
+	series := f.addSeries(template, "FREQ=WEEKLY", due, nil)
+	svc := f.service()
+
+	if err := svc.DeleteRecurrence(context.Background(), template.ID, f.memberID); err != nil {
+		t.Fatalf("DeleteRecurrence() error = %v", err)
+	}
+	if _, ok := f.series[series.ID]; ok {
+		t.Error("series still exists")
+	}
+
+	plain := f.addBoard("in_progress", nil, &due)
+	_, err := svc.GetRecurrence(context.Background(), plain.ID, f.memberID)
+	assertAppErrorCode(t, err, response.ErrCodeNotFound)
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// reminderBatchSize limits the reminders examined by one delivery run
+const reminderBatchSize = 500
+
+// ReminderService defines the interface for personal board reminders
+// Reminders are a personal preference, so any project member may keep them, even in archived projects
+type ReminderService interface {
+	CreateReminder(ctx context.Context, boardID, userID uuid.UUID, req *dto.CreateReminderRequest) (*dto.ReminderResponse, error)
+	GetBoardReminders(ctx context.Context, boardID, userID uuid.UUID) ([]*dto.ReminderResponse, error)
+	GetMyReminders(ctx context.Context, userID uuid.UUID, status string) ([]*dto.ReminderResponse, error)
+	SnoozeReminder(ctx context.Context, reminderID, userID uuid.UUID, req *dto.SnoozeReminderRequest) (*dto.ReminderResponse, error)
+	DeleteReminder(ctx context.Context, reminderID, userID uuid.UUID) error
+	// DeliverDue sends the reminders whose time has come and cancels those of completed or deleted boards
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+// reminderServiceImpl is the implementation of ReminderService
+type reminderServiceImpl struct {
+	reminderRepo         repository.ReminderRepository
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	notiClient           client.NotiClient
+	outbox               Outbox // optional, nil sends notifications on a best-effort basis
+	logger               *zap.Logger
+	now                  func() time.Time
+}
+
+// NewReminderService creates a new instance of ReminderService
+func NewReminderService(
+	reminderRepo repository.ReminderRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	notiClient client.NotiClient,
+	outbox Outbox,
+	logger *zap.Logger,
+) ReminderService {
+	return &reminderServiceImpl{
+		reminderRepo:         reminderRepo,
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		notiClient:           notiClient,
+		outbox:               outbox,
+		logger:               logger,
+		now:                  time.Now,
+	}
+}
+
+// CreateReminder creates a reminder of the requester on a board (requires board.create)
+func (s *reminderServiceImpl) CreateReminder(ctx context.Context, boardID, userID uuid.UUID, req *dto.CreateReminderRequest) (*dto.ReminderResponse, error) {
+	if (req.RemindAt == nil) == (req.OffsetMinutes == nil) {
+		return nil, response.NewValidationError("Set exactly one of remindAt or offsetMinutes", "")
+	}
+	if req.OffsetMinutes != nil && (*req.OffsetMinutes < -dto.MaxReminderOffsetMinutes || *req.OffsetMinutes > dto.MaxReminderOffsetMinutes) {
+		return nil, response.NewValidationError(fmt.Sprintf("offsetMinutes must be between -%d and %d", dto.MaxReminderOffsetMinutes, dto.MaxReminderOffsetMinutes), "")
+	}
+
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermission(ctx, s.projectRepo, board.ProjectID, userID, domain.PermissionBoardCreate, "You do not have permission to set reminders"); err != nil {
+		return nil, err
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, []*domain.Board{board}); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, []*domain.Board{board})
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+	if stages.isBoardClosed(board) {
+		return nil, response.NewValidationError("Reminders cannot be set on a completed board", "")
+	}
+
+	reminder := &domain.BoardReminder{
+		BoardID:       boardID,
+		UserID:        userID,
+		RemindAt:      req.RemindAt,
+		OffsetMinutes: req.OffsetMinutes,
+		Note:          req.Note,
+		Status:        domain.ReminderStatusPending,
+	}
+	if req.OffsetMinutes != nil && board.DueDate == nil {
+		return nil, response.NewValidationError("The board has no due date to remind relative to", "")
+	}
+	if fireAt := reminder.FireAt(board.DueDate); !fireAt.After(s.now()) {
+		return nil, response.NewValidationError("The reminder time must be in the future", fireAt.UTC().Format(time.RFC3339))
+	}
+
+	count, err := s.reminderRepo.CountPendingByBoardAndUser(ctx, boardID, userID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count reminders", err.Error())
+	}
+	if count >= dto.MaxPendingRemindersPerBoard {
+		return nil, response.NewValidationError(fmt.Sprintf("At most %d pending reminders per board are allowed", dto.MaxPendingRemindersPerBoard), "")
+	}
+
+	if err := s.reminderRepo.Create(ctx, reminder); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create reminder", err.Error())
+	}
+	reminder.Board = *board
+	return toReminderResponse(reminder), nil
+}
+
+// GetBoardReminders lists the requester's reminders on a board
+func (s *reminderServiceImpl) GetBoardReminders(ctx context.Context, boardID, userID uuid.UUID) ([]*dto.ReminderResponse, error) {
+	if _, err := s.findBoard(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+	reminders, err := s.reminderRepo.FindByBoardAndUser(ctx, boardID, userID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch reminders", err.Error())
+	}
+	return s.toResponses(ctx, reminders)
+}
+
+// GetMyReminders lists the requester's reminders on every board, optionally filtered by status
+func (s *reminderServiceImpl) GetMyReminders(ctx context.Context, userID uuid.UUID, status string) ([]*dto.ReminderResponse, error) {
+	var filter *domain.ReminderStatus
+	if status != "" {
+		st := domain.ReminderStatus(status)
+		switch st {
+		case domain.ReminderStatusPending, domain.ReminderStatusSent, domain.ReminderStatusCancelled:
+			filter = &st
+		default:
+			return nil, response.NewValidationError("status must be one of PENDING, SENT or CANCELLED", status)
+		}
+	}
+
+	reminders, err := s.reminderRepo.FindByUser(ctx, userID, filter)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch reminders", err.Error())
+	}
+	responses, err := s.toResponses(ctx, reminders)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		return responses, nil
+	}
+	// Reminders cancelled while listing no longer match a PENDING filter
+	filtered := make([]*dto.ReminderResponse, 0, len(responses))
+	for _, r := range responses {
+		if r.Status == string(*filter) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// SnoozeReminder postpones a pending or sent reminder of the requester to an absolute time
+func (s *reminderServiceImpl) SnoozeReminder(ctx context.Context, reminderID, userID uuid.UUID, req *dto.SnoozeReminderRequest) (*dto.ReminderResponse, error) {
+	if (req.Minutes == nil) == (req.Until == nil) {
+		return nil, response.NewValidationError("Set exactly one of minutes or until", "")
+	}
+	now := s.now()
+	var until time.Time
+	if req.Minutes != nil {
+		if *req.Minutes < 1 || *req.Minutes > dto.MaxReminderSnoozeMinutes {
+			return nil, response.NewValidationError(fmt.Sprintf("minutes must be between 1 and %d", dto.MaxReminderSnoozeMinutes), "")
+		}
+		until = now.Add(time.Duration(*req.Minutes) * time.Minute)
+	} else {
+		if !req.Until.After(now) {
+			return nil, response.NewValidationError("until must be in the future", "")
+		}
+		until = *req.Until
+	}
+
+	reminder, err := s.findReminder(ctx, reminderID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if reminder.Status == domain.ReminderStatusCancelled {
+		return nil, response.NewValidationError("Cancelled reminders cannot be snoozed", string(reminder.CancelReason))
+	}
+
+	reminder.RemindAt = &until
+	reminder.OffsetMinutes = nil
+	reminder.Status = domain.ReminderStatusPending
+	reminder.SentAt = nil
+	if err := s.reminderRepo.Update(ctx, reminder); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to snooze reminder", err.Error())
+	}
+	return toReminderResponse(reminder), nil
+}
+
+// DeleteReminder deletes a reminder of the requester
+func (s *reminderServiceImpl) DeleteReminder(ctx context.Context, reminderID, userID uuid.UUID) error {
+	if _, err := s.findReminder(ctx, reminderID, userID); err != nil {
+		return err
+	}
+	if err := s.reminderRepo.Delete(ctx, reminderID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete reminder", err.Error())
+	}
+	return nil
+}
+
+// DeliverDue sends the reminders whose time has come as noti-service notifications (shown over SSE)
+// Reminders of completed or deleted boards are cancelled instead. Each reminder is claimed with a
+// conditional update, so with several replicas it is sent by only one of them
+func (s *reminderServiceImpl) DeliverDue(ctx context.Context) (int, error) {
+	now := s.now()
+	reminders, err := s.reminderRepo.FindDue(ctx, now, reminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	stages, err := s.convertBoards(ctx, reminders)
+	if err != nil {
+		return 0, err
+	}
+
+	projects := make(map[uuid.UUID]*domain.Project)
+	delivered := 0
+	for _, reminder := range reminders {
+		if s.cancelIfClosed(ctx, stages, reminder) {
+			continue
+		}
+		fireAt := reminder.FireAt(reminder.Board.DueDate)
+		if fireAt == nil || fireAt.After(now) {
+			continue
+		}
+
+		project, ok := projects[reminder.Board.ProjectID]
+		if !ok {
+			if project, err = s.projectRepo.FindByID(ctx, reminder.Board.ProjectID); err != nil {
+				s.logger.Warn("Failed to fetch project for reminder",
+					zap.String("reminder.id", reminder.ID.String()),
+					zap.Error(err))
+				continue
+			}
+			projects[reminder.Board.ProjectID] = project
+		}
+
+		event := client.NewBoardReminderNotification(reminder.UserID, project.WorkspaceID, reminder.BoardID, reminder.ID, reminder.Board.Title, reminder.Note, *fireAt)
+		claimed := false
+		if err := runInTx(ctx, s.outbox, func(ctx context.Context) error {
+			var err error
+			if claimed, err = s.reminderRepo.ClaimDue(ctx, reminder.ID, now); err != nil || !claimed {
+				return err
+			}
+			return recordNotifications(ctx, s.outbox, s.notiClient, s.logger, domain.OutboxAggregateReminder, reminder.ID, []*client.NotificationEvent{event})
+		}); err != nil {
+			s.logger.Warn("Failed to deliver reminder",
+				zap.String("reminder.id", reminder.ID.String()),
+				zap.Error(err))
+			continue
+		}
+		if !claimed {
+			// Another replica sent or cancelled it first
+			continue
+		}
+		sentAt := now
+		reminder.Status = domain.ReminderStatusSent
+		reminder.SentAt = &sentAt
+		delivered++
+	}
+	return delivered, nil
+}
+
+// cancelIfClosed cancels a pending reminder whose board was deleted or reached a closed stage
+// The board's custom fields must already be converted to values
+func (s *reminderServiceImpl) cancelIfClosed(ctx context.Context, stages boardStages, reminder *domain.BoardReminder) bool {
+	if reminder.Status != domain.ReminderStatusPending {
+		return false
+	}
+	var reason domain.ReminderCancelReason
+	switch {
+	case reminder.Board.ID == uuid.Nil:
+		reason = domain.ReminderCancelBoardDeleted
+	case stages.isBoardClosed(&reminder.Board):
+		reason = domain.ReminderCancelBoardCompleted
+	default:
+		return false
+	}
+
+	now := s.now()
+	reminder.Status = domain.ReminderStatusCancelled
+	reminder.CancelledAt = &now
+	reminder.CancelReason = reason
+	// Conditional, so a reminder another replica has just sent is not overwritten
+	if _, err := s.reminderRepo.CancelPending(ctx, reminder.ID, reason, now); err != nil {
+		s.logger.Warn("Failed to cancel reminder",
+			zap.String("reminder.id", reminder.ID.String()),
+			zap.Error(err))
+	}
+	return true
+}
+
+// toResponses converts reminders, cancelling the pending ones of completed or deleted boards first
+func (s *reminderServiceImpl) toResponses(ctx context.Context, reminders []*domain.BoardReminder) ([]*dto.ReminderResponse, error) {
+	stages, err := s.convertBoards(ctx, reminders)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]*dto.ReminderResponse, len(reminders))
+	for i, reminder := range reminders {
+		s.cancelIfClosed(ctx, stages, reminder)
+		responses[i] = toReminderResponse(reminder)
+	}
+	return responses, nil
+}
+
+// convertBoards converts the custom fields of the reminders' boards to values and loads their stage categories
+func (s *reminderServiceImpl) convertBoards(ctx context.Context, reminders []*domain.BoardReminder) (boardStages, error) {
+	boards := make([]*domain.Board, 0, len(reminders))
+	for _, reminder := range reminders {
+		if reminder.Board.ID != uuid.Nil {
+			boards = append(boards, &reminder.Board)
+		}
+	}
+	if len(boards) == 0 {
+		return boardStages{}, nil
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, boards)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+	return stages, nil
+}
+
+// findBoard fetches a board and verifies that the requester is a member of its project
+func (s *reminderServiceImpl) findBoard(ctx context.Context, boardID, userID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+// findReminder fetches a reminder owned by the requester; reminders of other users are reported as not found
+func (s *reminderServiceImpl) findReminder(ctx context.Context, reminderID, userID uuid.UUID) (*domain.BoardReminder, error) {
+	reminder, err := s.reminderRepo.FindByID(ctx, reminderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Reminder not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch reminder", err.Error())
+	}
+	if reminder.UserID != userID {
+		return nil, response.NewNotFoundError("Reminder not found", "")
+	}
+	return reminder, nil
+}
+
+// toReminderResponse converts domain.BoardReminder to dto.ReminderResponse
+func toReminderResponse(reminder *domain.BoardReminder) *dto.ReminderResponse {
+	return &dto.ReminderResponse{
+		ReminderID:    reminder.ID,
+		BoardID:       reminder.BoardID,
+		ProjectID:     reminder.Board.ProjectID,
+		BoardTitle:    reminder.Board.Title,
+		RemindAt:      reminder.FireAt(reminder.Board.DueDate),
+		OffsetMinutes: reminder.OffsetMinutes,
+		Note:          reminder.Note,
+		Status:        string(reminder.Status),
+		SentAt:        reminder.SentAt,
+		CancelledAt:   reminder.CancelledAt,
+		CancelReason:  string(reminder.CancelReason),
+		CreatedAt:     reminder.CreatedAt,
+	}
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// reminderNow is the fixed clock of the reminder tests (2024-01-10 09:00 UTC)
+var reminderNow = time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+
+func TestReminderService_CreateReminder(t *testing.T) {
+	projectID := uuid.New()
+	memberID := uuid.New()
+	tomorrow := reminderNow.Add(24 * time.Hour)
+	past := reminderNow.Add(-time.Hour)
+	offset := func(minutes int) *int { return &minutes }
+
+	tests := []struct {
+		name        string
+		req         *dto.CreateReminderRequest
+		stage       string
+		dueDate     *time.Time
+		requesterID uuid.UUID
+		pending     int64
+		wantAt      *time.Time
+		wantErrCode string
+	}{
+		{
+			name:        "성공: 절대 시각",
+			req:         &dto.CreateReminderRequest{RemindAt: &tomorrow, Note: "check"},
+			stage:       "in_progress",
+			requesterID: memberID,
+			wantAt:      &tomorrow,
+		},
+		{
+			name:        "성공: 마감일 하루 전",
+			req:         &dto.CreateReminderRequest{OffsetMinutes: offset(-24 * 60)},
+			stage:       "in_progress",
+			dueDate:     timelineDate(15),
+			requesterID: memberID,
+			wantAt:      timelineDate(14),
+		},
+		{
+			name:        "실패: 시각과 오프셋을 모두 지정",
+			req:         &dto.CreateReminderRequest{RemindAt: &tomorrow, OffsetMinutes: offset(10)},
+			stage:       "in_progress",
+			requesterID: memberID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 과거 시각",
+			req:         &dto.CreateReminderRequest{RemindAt: &past},
+			stage:       "in_progress",
+			requesterID: memberID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 마감일 없는 보드에 오프셋",
+			req:         &dto.CreateReminderRequest{OffsetMinutes: offset(-60)},
+			stage:       "in_progress",
+			requesterID: memberID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 완료된 보드",
+			req:         &dto.CreateReminderRequest{RemindAt: &tomorrow},
+			stage:       "approved",
+			requesterID: memberID,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 보드당 대기 리마인더 한도 초과",
+			req:         &dto.CreateReminderRequest{RemindAt: &tomorrow},
+			stage:       "in_progress",
+			requesterID: memberID,
+			pending:     dto.MaxPendingRemindersPerBoard,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 프로젝트 멤버가 아님",
+			req:         &dto.CreateReminderRequest{RemindAt: &tomorrow},
+			stage:       "in_progress",
+			requesterID: uuid.New(),
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			board := reminderBoard(projectID, tt.stage, tt.dueDate)
+			boardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					b := board
+					return &b, nil
+				},
+			}
+			var created *domain.BoardReminder
+			reminderRepo := &MockReminderRepository{
+				CountPendingByBoardAndUserFunc: func(ctx context.Context, boardID, userID uuid.UUID) (int64, error) {
+					return tt.pending, nil
+				},
+				CreateFunc: func(ctx context.Context, reminder *domain.BoardReminder) error {
+					created = reminder
+					return nil
+				},
+			}
+			svc := newTestReminderService(reminderRepo, boardRepo, memberID, nil)
+
+			// When
+			result, err := svc.CreateReminder(context.Background(), board.ID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if created != nil {
+					t.Error("reminder created for a failed request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateReminder() error = %v", err)
+			}
+			if created == nil || created.UserID != memberID || created.Status != domain.ReminderStatusPending {
+				t.Fatalf("created = %+v, want a pending reminder of the requester", created)
+			}
+			if result.RemindAt == nil || !result.RemindAt.Equal(*tt.wantAt) {
+				t.Errorf("remindAt = %v, want %v", result.RemindAt, tt.wantAt)
+			}
+		})
+	}
+}
+
+func TestReminderService_DeliverDue(t *testing.T) {
+	projectID := uuid.New()
+	userID := uuid.New()
+	offset := -60
+	earlier := reminderNow.Add(-time.Hour)
+	dueSoon := reminderNow.Add(30 * time.Minute)
+
+	absolute := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, RemindAt: &earlier,
+		Status: domain.ReminderStatusPending, Board: reminderBoard(projectID, "in_progress", nil)}
+	relative := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, OffsetMinutes: &offset,
+		Status: domain.ReminderStatusPending, Board: reminderBoard(projectID, "in_progress", &dueSoon)}
+	notYet := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, OffsetMinutes: &offset,
+		Status: domain.ReminderStatusPending, Board: reminderBoard(projectID, "in_progress", timelineDate(12))}
+	completed := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, RemindAt: &earlier,
+		Status: domain.ReminderStatusPending, Board: reminderBoard(projectID, "approved", nil)}
+	deleted := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, OffsetMinutes: &offset,
+		Status: domain.ReminderStatusPending}
+	sentElsewhere := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: userID, RemindAt: &earlier,
+		Status: domain.ReminderStatusPending, Board: reminderBoard(projectID, "in_progress", nil)}
+	for _, r := range []*domain.BoardReminder{absolute, relative, notYet, completed, sentElsewhere} {
+		r.BoardID = r.Board.ID
+	}
+	deleted.BoardID = uuid.New()
+
+	updated := make(map[uuid.UUID]domain.ReminderStatus)
+	reminderRepo := &MockReminderRepository{
+		FindDueFunc: func(ctx context.Context, now time.Time, limit int) ([]*domain.BoardReminder, error) {
+			if !now.Equal(reminderNow) {
+				t.Errorf("FindDue(%v) now is wrong", now)
+			}
+			return []*domain.BoardReminder{absolute, relative, notYet, completed, deleted, sentElsewhere}, nil
+		},
+		ClaimDueFunc: func(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+			// Another replica claimed sentElsewhere first
+			if id == sentElsewhere.ID {
+				return false, nil
+			}
+			updated[id] = domain.ReminderStatusSent
+			return true, nil
+		},
+		CancelPendingFunc: func(ctx context.Context, id uuid.UUID, reason domain.ReminderCancelReason, cancelledAt time.Time) (bool, error) {
+			updated[id] = domain.ReminderStatusCancelled
+			return true, nil
+		},
+		UpdateFunc: func(ctx context.Context, reminder *domain.BoardReminder) error {
+			t.Errorf("reminder %s saved unconditionally", reminder.ID)
+			return nil
+		},
+	}
+	store := newOutboxTestStore()
+	svc := newTestReminderService(reminderRepo, &MockBoardRepository{}, userID, store)
+
+	delivered, err := svc.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if delivered != 2 {
+		t.Errorf("delivered = %d, want 2", delivered)
+	}
+
+	want := map[uuid.UUID]domain.ReminderStatus{
+		absolute.ID:  domain.ReminderStatusSent,
+		relative.ID:  domain.ReminderStatusSent,
+		completed.ID: domain.ReminderStatusCancelled,
+		deleted.ID:   domain.ReminderStatusCancelled,
+	}
+	if len(updated) != len(want) {
+		t.Errorf("updated %d reminders, want %d", len(updated), len(want))
+	}
+	for id, status := range want {
+		if updated[id] != status {
+			t.Errorf("reminder %s status = %s, want %s", id, updated[id], status)
+		}
+	}
+	if completed.CancelReason != domain.ReminderCancelBoardCompleted || deleted.CancelReason != domain.ReminderCancelBoardDeleted {
+		t.Errorf("cancel reasons = %s, %s", completed.CancelReason, deleted.CancelReason)
+	}
+	if notYet.Status != domain.ReminderStatusPending {
+		t.Errorf("future relative reminder status = %s, want PENDING", notYet.Status)
+	}
+	if sentElsewhere.Status != domain.ReminderStatusPending {
+		t.Errorf("reminder claimed by another replica status = %s, want PENDING", sentElsewhere.Status)
+	}
+
+	events := store.sorted()
+	if len(events) != 2 {
+		t.Fatalf("outbox events = %d, want 2", len(events))
+	}
+	for _, event := range events {
+		var notification client.NotificationEvent
+		if err := json.Unmarshal(event.Payload, &notification); err != nil {
+			t.Fatalf("invalid notification payload: %v", err)
+		}
+		if event.AggregateType != domain.OutboxAggregateReminder || notification.Type != client.NotificationTypeBoardReminder || notification.TargetUserID != userID {
+			t.Errorf("event = %s/%s to %s, want a BOARD_REMINDER to the reminder's user", event.AggregateType, notification.Type, notification.TargetUserID)
+		}
+	}
+}
+
+func TestReminderService_SnoozeReminder(t *testing.T) {
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	minutes := func(m int) *int { return &m }
+	offset := -60
+
+	tests := []struct {
+		name        string
+		status      domain.ReminderStatus
+		requesterID uuid.UUID
+		req         *dto.SnoozeReminderRequest
+		wantErrCode string
+	}{
+		{
+			name:        "성공: 전달된 리마인더를 한 시간 뒤로",
+			status:      domain.ReminderStatusSent,
+			requesterID: ownerID,
+			req:         &dto.SnoozeReminderRequest{Minutes: minutes(60)},
+		},
+		{
+			name:        "실패: 취소된 리마인더",
+			status:      domain.ReminderStatusCancelled,
+			requesterID: ownerID,
+			req:         &dto.SnoozeReminderRequest{Minutes: minutes(60)},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 다른 사용자의 리마인더",
+			status:      domain.ReminderStatusPending,
+			requesterID: uuid.New(),
+			req:         &dto.SnoozeReminderRequest{Minutes: minutes(60)},
+			wantErrCode: response.ErrCodeNotFound,
+		},
+		{
+			name:        "실패: 스누즈 시간 없음",
+			status:      domain.ReminderStatusPending,
+			requesterID: ownerID,
+			req:         &dto.SnoozeReminderRequest{},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			sentAt := reminderNow.Add(-time.Minute)
+			reminder := &domain.BoardReminder{BaseModel: domain.BaseModel{ID: uuid.New()}, UserID: ownerID, OffsetMinutes: &offset,
+				Status: tt.status, SentAt: &sentAt, Board: reminderBoard(projectID, "in_progress", timelineDate(10))}
+			saved := false
+			reminderRepo := &MockReminderRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.BoardReminder, error) {
+					if id != reminder.ID {
+						return nil, gorm.ErrRecordNotFound
+					}
+					return reminder, nil
+				},
+				UpdateFunc: func(ctx context.Context, r *domain.BoardReminder) error {
+					saved = true
+					return nil
+				},
+			}
+			svc := newTestReminderService(reminderRepo, &MockBoardRepository{}, ownerID, nil)
+
+			// When
+			result, err := svc.SnoozeReminder(context.Background(), reminder.ID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if saved {
+					t.Error("reminder saved for a failed snooze")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SnoozeReminder() error = %v", err)
+			}
+			want := reminderNow.Add(time.Hour)
+			if result.Status != string(domain.ReminderStatusPending) || result.RemindAt == nil || !result.RemindAt.Equal(want) {
+				t.Errorf("status = %s, remindAt = %v; want PENDING at %v", result.Status, result.RemindAt, want)
+			}
+			if result.OffsetMinutes != nil || result.SentAt != nil {
+				t.Errorf("snoozed reminder keeps offset %v or sentAt %v", result.OffsetMinutes, result.SentAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/report"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// reportTemplateFormats are the report formats rendered from templates, in display order
+var reportTemplateFormats = []domain.ReportFormat{domain.ReportFormatMarkdown, domain.ReportFormatCSV}
+
+// ReportService defines the interface for project reports and their workspace templates
+type ReportService interface {
+	GetProjectReport(ctx context.Context, projectID, requesterID uuid.UUID, query *dto.ProjectReportQuery, token string) (*dto.ProjectReportResponse, error)
+	// RenderProjectReport renders a report as Markdown or CSV with the template of its workspace
+	RenderProjectReport(ctx context.Context, projectReport *dto.ProjectReportResponse, format domain.ReportFormat) ([]byte, error)
+	GetReportTemplates(ctx context.Context, workspaceID, requesterID uuid.UUID, token string) ([]*dto.ReportTemplateResponse, error)
+	SetReportTemplate(ctx context.Context, workspaceID, requesterID uuid.UUID, format string, req *dto.SetReportTemplateRequest, token string) (*dto.ReportTemplateResponse, error)
+	DeleteReportTemplate(ctx context.Context, workspaceID, requesterID uuid.UUID, format string, token string) error
+}
+
+// reportServiceImpl is the implementation of ReportService
+type reportServiceImpl struct {
+	templateRepo         repository.ReportTemplateRepository
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionRepo      repository.FieldOptionRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	userClient           client.UserClient
+	logger               *zap.Logger
+	now                  func() time.Time
+}
+
+// NewReportService creates a new instance of ReportService
+func NewReportService(
+	templateRepo repository.ReportTemplateRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	fieldOptionRepo repository.FieldOptionRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	userClient client.UserClient,
+	logger *zap.Logger,
+) ReportService {
+	return &reportServiceImpl{
+		templateRepo:         templateRepo,
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		fieldOptionRepo:      fieldOptionRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		userClient:           userClient,
+		logger:               logger,
+		now:                  time.Now,
+	}
+}
+
+// GetProjectReport builds a snapshot of a project: boards by stage, overdue boards,
+// boards completed in the range and counts per assignee (any project member)
+func (s *reportServiceImpl) GetProjectReport(ctx context.Context, projectID, requesterID uuid.UUID, query *dto.ProjectReportQuery, token string) (*dto.ProjectReportResponse, error) {
+	if query == nil {
+		query = &dto.ProjectReportQuery{}
+	}
+	if query.Format != "" && !domain.ReportFormat(query.Format).IsValid() {
+		return nil, response.NewValidationError("format must be 'json', 'markdown' or 'csv'", "")
+	}
+	now := s.now()
+	from, to, err := reportRange(query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stageOptions, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldTypeStage)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+	}
+	stageLabels := make(map[string]string, len(stageOptions))
+	for _, option := range stageOptions {
+		stageLabels[option.Value] = option.Label
+	}
+	stages := boardStages{projectID: stageCategoriesOf(stageOptions)}
+	names := s.assigneeNames(ctx, project.WorkspaceID, boards, token)
+
+	resp := &dto.ProjectReportResponse{
+		ProjectID:   project.ID,
+		WorkspaceID: project.WorkspaceID,
+		ProjectName: project.Name,
+		KeyPrefix:   project.KeyPrefix,
+		GeneratedAt: now,
+		From:        from,
+		To:          to,
+		TotalBoards: len(boards),
+		Stages:      []dto.ReportStageResponse{},
+		Overdue:     []dto.ReportBoardResponse{},
+		Completed:   []dto.ReportBoardResponse{},
+		Assignees:   []dto.ReportAssigneeResponse{},
+	}
+
+	end := to.AddDate(0, 0, 1)
+	grouped := make(map[string][]dto.ReportBoardResponse)
+	assignees := make(map[uuid.UUID]*dto.ReportAssigneeResponse)
+	assigneeOf := func(item dto.ReportBoardResponse) *dto.ReportAssigneeResponse {
+		key := uuid.Nil
+		if item.AssigneeID != nil {
+			key = *item.AssigneeID
+		}
+		assignee, ok := assignees[key]
+		if !ok {
+			assignee = &dto.ReportAssigneeResponse{AssigneeID: item.AssigneeID, Name: item.AssigneeName}
+			assignees[key] = assignee
+		}
+		return assignee
+	}
+	for _, board := range boards {
+		item := toReportBoard(board, project, stages, stageLabels, names, now)
+		grouped[item.Stage] = append(grouped[item.Stage], item)
+
+		if !stages.isBoardClosed(board) {
+			resp.OpenBoards++
+			assigneeOf(item).Open++
+			if item.Overdue {
+				resp.Overdue = append(resp.Overdue, item)
+				assigneeOf(item).Overdue++
+			}
+		}
+		if stages.category(projectID, item.Stage) == domain.StageCategoryDone && item.CompletedAt != nil &&
+			!item.CompletedAt.Before(from) && item.CompletedAt.Before(end) {
+			resp.Completed = append(resp.Completed, item)
+			assigneeOf(item).Completed++
+		}
+	}
+
+	resp.Stages = buildReportStages(stageOptions, grouped)
+	sortReportBoards(resp.Overdue)
+	sort.SliceStable(resp.Completed, func(i, j int) bool {
+		return resp.Completed[i].CompletedAt.Before(*resp.Completed[j].CompletedAt)
+	})
+	for _, assignee := range assignees {
+		resp.Assignees = append(resp.Assignees, *assignee)
+	}
+	sortReportAssignees(resp.Assignees)
+	return resp, nil
+}
+
+// RenderProjectReport renders a report with the workspace's template for the format, or the built-in one
+// A workspace template that fails at render time is logged and the built-in template is used instead
+func (s *reportServiceImpl) RenderProjectReport(ctx context.Context, projectReport *dto.ProjectReportResponse, format domain.ReportFormat) ([]byte, error) {
+	defaultBody, ok := report.DefaultTemplate(format)
+	if !ok {
+		return nil, response.NewValidationError("format must be 'markdown' or 'csv'", "")
+	}
+
+	override, err := s.templateRepo.FindByWorkspaceAndFormat(ctx, projectReport.WorkspaceID, format)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch report template", err.Error())
+	}
+	if override != nil {
+		output, err := report.Render(override.Body, projectReport)
+		if err == nil {
+			return output, nil
+		}
+		s.logger.Warn("Workspace report template failed, using the built-in template",
+			zap.String("workspace.id", projectReport.WorkspaceID.String()),
+			zap.String("report.format", string(format)),
+			zap.Error(err))
+	}
+
+	output, err := report.Render(defaultBody, projectReport)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to render report", err.Error())
+	}
+	return output, nil
+}
+
+// GetReportTemplates returns the Markdown and CSV templates a workspace uses (any workspace member)
+func (s *reportServiceImpl) GetReportTemplates(ctx context.Context, workspaceID, requesterID uuid.UUID, token string) ([]*dto.ReportTemplateResponse, error) {
+	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, requesterID, token)
+	if err != nil || !isValid {
+		return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
+	}
+
+	overrides, err := s.templateRepo.FindByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch report templates", err.Error())
+	}
+	byFormat := make(map[domain.ReportFormat]*domain.ReportTemplate, len(overrides))
+	for _, override := range overrides {
+		byFormat[override.Format] = override
+	}
+
+	responses := make([]*dto.ReportTemplateResponse, 0, len(reportTemplateFormats))
+	for _, format := range reportTemplateFormats {
+		if override, ok := byFormat[format]; ok {
+			responses = append(responses, toReportTemplateResponse(override))
+			continue
+		}
+		body, _ := report.DefaultTemplate(format)
+		responses = append(responses, &dto.ReportTemplateResponse{Format: string(format), Body: body, IsDefault: true})
+	}
+	return responses, nil
+}
+
+// SetReportTemplate replaces the template of a format for the workspace (workspace owner only)
+// The template must parse and render a sample report
+func (s *reportServiceImpl) SetReportTemplate(ctx context.Context, workspaceID, requesterID uuid.UUID, format string, req *dto.SetReportTemplateRequest, token string) (*dto.ReportTemplateResponse, error) {
+	reportFormat := domain.ReportFormat(format)
+	if !reportFormat.IsTemplated() {
+		return nil, response.NewValidationError("format must be 'markdown' or 'csv'", "")
+	}
+	if err := s.checkWorkspaceOwner(ctx, workspaceID, requesterID, token); err != nil {
+		return nil, err
+	}
+	if _, err := report.Render(req.Body, sampleProjectReport(workspaceID, s.now())); err != nil {
+		return nil, response.NewValidationError("Invalid template: "+err.Error(), "")
+	}
+
+	template, err := s.templateRepo.FindByWorkspaceAndFormat(ctx, workspaceID, reportFormat)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch report template", err.Error())
+	}
+	if template == nil {
+		template = &domain.ReportTemplate{WorkspaceID: workspaceID, Format: reportFormat}
+	}
+	template.Body = req.Body
+	template.UpdatedBy = requesterID
+	if err := s.templateRepo.Save(ctx, template); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to save report template", err.Error())
+	}
+	return toReportTemplateResponse(template), nil
+}
+
+// DeleteReportTemplate restores the built-in template of a format for the workspace (workspace owner only)
+func (s *reportServiceImpl) DeleteReportTemplate(ctx context.Context, workspaceID, requesterID uuid.UUID, format string, token string) error {
+	reportFormat := domain.ReportFormat(format)
+	if !reportFormat.IsTemplated() {
+		return response.NewValidationError("format must be 'markdown' or 'csv'", "")
+	}
+	if err := s.checkWorkspaceOwner(ctx, workspaceID, requesterID, token); err != nil {
+		return err
+	}
+	if err := s.templateRepo.Delete(ctx, workspaceID, reportFormat); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete report template", err.Error())
+	}
+	return nil
+}
+
+// checkWorkspaceOwner verifies that the requester owns the workspace
+func (s *reportServiceImpl) checkWorkspaceOwner(ctx context.Context, workspaceID, requesterID uuid.UUID, token string) error {
+	workspace, err := s.userClient.GetWorkspace(ctx, workspaceID, token)
+	if err != nil || workspace == nil {
+		return response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
+	}
+	if workspace.OwnerID != requesterID {
+		return response.NewForbiddenError("Only the workspace owner can manage report templates", "")
+	}
+	return nil
+}
+
+// assigneeNames fetches the workspace nicknames of the board assignees
+// Names are left empty when the profiles cannot be fetched
+func (s *reportServiceImpl) assigneeNames(ctx context.Context, workspaceID uuid.UUID, boards []*domain.Board, token string) map[uuid.UUID]string {
+	seen := make(map[uuid.UUID]bool)
+	var assigneeIDs []uuid.UUID
+	for _, board := range boards {
+		if board.AssigneeID != nil && !seen[*board.AssigneeID] {
+			seen[*board.AssigneeID] = true
+			assigneeIDs = append(assigneeIDs, *board.AssigneeID)
+		}
+	}
+	names := make(map[uuid.UUID]string, len(assigneeIDs))
+	if len(assigneeIDs) == 0 {
+		return names
+	}
+
+	profiles, err := s.userClient.GetWorkspaceProfiles(ctx, workspaceID, assigneeIDs, token)
+	if err != nil {
+		s.logger.Warn("Failed to fetch assignee profiles for report",
+			zap.String("workspace.id", workspaceID.String()),
+			zap.Error(err))
+		return names
+	}
+	for id, profile := range profiles {
+		if profile != nil {
+			names[id] = profile.NickName
+		}
+	}
+	return names
+}
+
+// reportRange resolves the completed range of a report to whole days (UTC), both inclusive
+func reportRange(query *dto.ProjectReportQuery, now time.Time) (time.Time, time.Time, error) {
+	to := startOfDay(now)
+	if query.To != nil {
+		to = startOfDay(*query.To)
+	}
+	from := to.AddDate(0, 0, -(dto.DefaultReportRangeDays - 1))
+	if query.From != nil {
+		from = startOfDay(*query.From)
+	}
+	if from.After(to) {
+		return from, to, response.NewValidationError("from must not be after to", "")
+	}
+	if to.Sub(from) >= dto.MaxReportRangeDays*24*time.Hour {
+		return from, to, response.NewValidationError("The report range may span at most 366 days", "")
+	}
+	return from, to, nil
+}
+
+// toReportBoard converts a board (with converted custom fields) to a report board
+func toReportBoard(board *domain.Board, project *domain.Project, stages boardStages, stageLabels map[string]string, names map[uuid.UUID]string, now time.Time) dto.ReportBoardResponse {
+	item := dto.ReportBoardResponse{
+		BoardID:     board.ID,
+		Key:         domain.FormatBoardKey(project.KeyPrefix, board.Number),
+		Title:       board.Title,
+		Stage:       customFieldValue(board, string(domain.FieldTypeStage)),
+		AssigneeID:  board.AssigneeID,
+		DueDate:     board.DueDate,
+		CompletedAt: board.CompletedAt,
+	}
+	item.StageLabel = stageLabels[item.Stage]
+	if board.AssigneeID != nil {
+		item.AssigneeName = names[*board.AssigneeID]
+	}
+	if board.DueDate != nil && board.DueDate.Before(now) && !stages.isBoardClosed(board) {
+		item.Overdue = true
+		item.OverdueDays = int(now.Sub(*board.DueDate).Hours() / 24)
+	}
+	return item
+}
+
+// buildReportStages orders the stages: options in display order (also when empty), then values
+// without an option, then boards without a stage
+func buildReportStages(options []*domain.FieldOption, grouped map[string][]dto.ReportBoardResponse) []dto.ReportStageResponse {
+	stages := []dto.ReportStageResponse{}
+	categories := stageCategoriesOf(options)
+	seen := make(map[string]bool)
+	addStage := func(value, label string) {
+		boards := grouped[value]
+		if boards == nil {
+			boards = []dto.ReportBoardResponse{}
+		}
+		sortReportBoards(boards)
+		stages = append(stages, dto.ReportStageResponse{
+			Value:  value,
+			Label:  label,
+			Closed: categories[value].IsClosed(),
+			Count:  len(boards),
+			Boards: boards,
+		})
+	}
+
+	for _, option := range options {
+		if seen[option.Value] {
+			continue
+		}
+		seen[option.Value] = true
+		addStage(option.Value, option.Label)
+	}
+
+	var rest []string
+	for value := range grouped {
+		if !seen[value] && value != "" {
+			rest = append(rest, value)
+		}
+	}
+	sort.Strings(rest)
+	for _, value := range rest {
+		addStage(value, "")
+	}
+	if _, ok := grouped[""]; ok {
+		addStage("", "")
+	}
+	return stages
+}
+
+// sortReportBoards orders boards by due date (boards without one last), then by key and title
+func sortReportBoards(boards []dto.ReportBoardResponse) {
+	sort.SliceStable(boards, func(i, j int) bool {
+		a, b := boards[i], boards[j]
+		if a.DueDate != nil && b.DueDate != nil && !a.DueDate.Equal(*b.DueDate) {
+			return a.DueDate.Before(*b.DueDate)
+		}
+		if (a.DueDate == nil) != (b.DueDate == nil) {
+			return a.DueDate != nil
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.Title < b.Title
+	})
+}
+
+// sortReportAssignees orders assignees by open boards, then overdue boards, then name; unassigned last
+func sortReportAssignees(assignees []dto.ReportAssigneeResponse) {
+	sort.Slice(assignees, func(i, j int) bool {
+		a, b := assignees[i], assignees[j]
+		if (a.AssigneeID == nil) != (b.AssigneeID == nil) {
+			return a.AssigneeID != nil
+		}
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		if a.Overdue != b.Overdue {
+			return a.Overdue > b.Overdue
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.AssigneeID != nil && b.AssigneeID != nil && a.AssigneeID.String() < b.AssigneeID.String()
+	})
+}
+
+// sampleProjectReport is a report with an entry in every list, used to check that a template renders
+func sampleProjectReport(workspaceID uuid.UUID, now time.Time) *dto.ProjectReportResponse {
+	assigneeID := uuid.New()
+	due := startOfDay(now).AddDate(0, 0, -2)
+	completedAt := now.Add(-time.Hour)
+	open := dto.ReportBoardResponse{
+		BoardID: uuid.New(), Key: "WEB-1", Title: "Sample board", Stage: "in_progress", StageLabel: "진행중",
+		AssigneeID: &assigneeID, AssigneeName: "Sample", DueDate: &due, Overdue: true, OverdueDays: 2,
+	}
+	done := dto.ReportBoardResponse{
+		BoardID: uuid.New(), Key: "WEB-2", Title: "Sample board", Stage: "approved", StageLabel: "완료",
+		CompletedAt: &completedAt,
+	}
+	return &dto.ProjectReportResponse{
+		ProjectID:   uuid.New(),
+		WorkspaceID: workspaceID,
+		ProjectName: "Sample project",
+		KeyPrefix:   "WEB",
+		GeneratedAt: now,
+		From:        startOfDay(now).AddDate(0, 0, -(dto.DefaultReportRangeDays - 1)),
+		To:          startOfDay(now),
+		TotalBoards: 2,
+		OpenBoards:  1,
+		Stages: []dto.ReportStageResponse{
+			{Value: "in_progress", Label: "진행중", Count: 1, Boards: []dto.ReportBoardResponse{open}},
+			{Value: "approved", Label: "완료", Closed: true, Count: 1, Boards: []dto.ReportBoardResponse{done}},
+		},
+		Overdue:   []dto.ReportBoardResponse{open},
+		Completed: []dto.ReportBoardResponse{done},
+		Assignees: []dto.ReportAssigneeResponse{
+			{AssigneeID: &assigneeID, Name: "Sample", Open: 1, Overdue: 1},
+			{Completed: 1},
+		},
+	}
+}
+
+// toReportTemplateResponse converts a workspace template to its response
+func toReportTemplateResponse(template *domain.ReportTemplate) *dto.ReportTemplateResponse {
+	updatedBy := template.UpdatedBy
+	updatedAt := template.UpdatedAt
+	return &dto.ReportTemplateResponse{
+		Format:    string(template.Format),
+		Body:      template.Body,
+		UpdatedBy: &updatedBy,
+		UpdatedAt: &updatedAt,
+	}
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+var reportNow = time.Date(2024, 1, 19, 9, 0, 0, 0, time.UTC)
+
+func reportKeys(boards []dto.ReportBoardResponse) string {
+	keys := make([]string, 0, len(boards))
+	for _, b := range boards {
+		keys = append(keys, b.Key)
+	}
+	return strings.Join(keys, ",")
+}
+
+func TestReportService_GetProjectReport(t *testing.T) {
+	f := newReportFixture()
+
+	t.Run("성공: 단계별 보드, 기한 지남, 기간 내 완료, 담당자별 집계", func(t *testing.T) {
+		report, err := f.service(nil, f.profiles()).GetProjectReport(context.Background(), f.project.ID, f.memberID, nil, "token")
+		if err != nil {
+			t.Fatalf("GetProjectReport() error = %v", err)
+		}
+
+		if !report.From.Equal(*timelineDate(13)) || !report.To.Equal(*timelineDate(19)) {
+			t.Errorf("Range = %v ~ %v, want 1/13 ~ 1/19", report.From, report.To)
+		}
+		if report.TotalBoards != 7 || report.OpenBoards != 4 {
+			t.Errorf("TotalBoards/OpenBoards = %d/%d, want 7/4", report.TotalBoards, report.OpenBoards)
+		}
+
+		var stages []string
+		for _, stage := range report.Stages {
+			stages = append(stages, stage.Value+"="+reportKeys(stage.Boards))
+		}
+		want := "pending=|in_progress=WEB-1,WEB-2|review=|approved=WEB-3,WEB-4|deleted=WEB-7|legacy=WEB-5|=WEB-6"
+		if got := strings.Join(stages, "|"); got != want {
+			t.Errorf("Stages = %s, want %s", got, want)
+		}
+		if report.Stages[1].Label != "진행중" || report.Stages[1].Count != 2 || !report.Stages[3].Closed {
+			t.Errorf("Stage metadata = %+v / %+v", report.Stages[1], report.Stages[3])
+		}
+
+		if got := reportKeys(report.Overdue); got != "WEB-5,WEB-1" {
+			t.Errorf("Overdue = %s, want WEB-5,WEB-1", got)
+		}
+		if report.Overdue[1].OverdueDays != 4 || report.Overdue[1].AssigneeName != "Alice" {
+			t.Errorf("Overdue login = %+v, want 4 days, Alice", report.Overdue[1])
+		}
+		if got := reportKeys(report.Completed); got != "WEB-3" {
+			t.Errorf("Completed = %s, want WEB-3", got)
+		}
+
+		if len(report.Assignees) != 3 {
+			t.Fatalf("Assignees = %+v, want 3", report.Assignees)
+		}
+		alice, bob, unassigned := report.Assignees[0], report.Assignees[1], report.Assignees[2]
+		if alice.Name != "Alice" || alice.Open != 1 || alice.Overdue != 1 || alice.Completed != 1 {
+			t.Errorf("Alice = %+v, want open 1, overdue 1, completed 1", alice)
+		}
+		if bob.Name != "Bob" || bob.Open != 1 || bob.Overdue != 0 || bob.Completed != 0 {
+			t.Errorf("Bob = %+v, want open 1", bob)
+		}
+		if unassigned.AssigneeID != nil || unassigned.Open != 2 || unassigned.Overdue != 1 {
+			t.Errorf("Unassigned = %+v, want open 2, overdue 1", unassigned)
+		}
+	})
+
+	t.Run("성공: 완료 기간의 마지막 날 포함", func(t *testing.T) {
+		report, err := f.service(nil, f.profiles()).GetProjectReport(context.Background(), f.project.ID, f.memberID,
+			&dto.ProjectReportQuery{From: timelineDate(1), To: timelineDate(5)}, "token")
+		if err != nil {
+			t.Fatalf("GetProjectReport() error = %v", err)
+		}
+		if got := reportKeys(report.Completed); got != "WEB-4" {
+			t.Errorf("Completed = %s, want WEB-4", got)
+		}
+		if report.Assignees[0].Name != "Alice" || report.Assignees[0].Completed != 0 {
+			t.Errorf("Alice = %+v, want no completed boards in range", report.Assignees[0])
+		}
+	})
+
+	t.Run("성공: 프로필 조회 실패 시 이름 없이 생성", func(t *testing.T) {
+		userClient := &MockUserClient{
+			GetWorkspaceProfilesFunc: func(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+				return nil, errors.New("user service down")
+			},
+		}
+		report, err := f.service(nil, userClient).GetProjectReport(context.Background(), f.project.ID, f.memberID, nil, "token")
+		if err != nil {
+			t.Fatalf("GetProjectReport() error = %v", err)
+		}
+		if report.Assignees[0].Name != "" || report.Assignees[0].AssigneeID == nil {
+			t.Errorf("Assignee = %+v, want ID without name", report.Assignees[0])
+		}
+	})
+
+	errorTests := []struct {
+		name        string
+		requesterID uuid.UUID
+		query       *dto.ProjectReportQuery
+		wantCode    string
+	}{
+		{"실패: 프로젝트 멤버가 아님", uuid.New(), nil, response.ErrCodeForbidden},
+		{"실패: 알 수 없는 형식", f.memberID, &dto.ProjectReportQuery{Format: "pdf"}, response.ErrCodeValidation},
+		{"실패: 시작일이 종료일 이후", f.memberID, &dto.ProjectReportQuery{From: timelineDate(20), To: timelineDate(10)}, response.ErrCodeValidation},
+		{"실패: 기간이 366일 초과", f.memberID, &dto.ProjectReportQuery{From: timelineDate(1), To: timelineDate(367)}, response.ErrCodeValidation},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.service(nil, nil).GetProjectReport(context.Background(), f.project.ID, tt.requesterID, tt.query, "token")
+			assertAppErrorCode(t, err, tt.wantCode)
+		})
+	}
+}
+
+func TestReportService_RenderProjectReport(t *testing.T) {
+	f := newReportFixture()
+	report, err := f.service(nil, f.profiles()).GetProjectReport(context.Background(), f.project.ID, f.memberID, nil, "token")
+	if err != nil {
+		t.Fatalf("GetProjectReport() error = %v", err)
+	}
+	override := func(body string) *MockReportTemplateRepository {
+		return &MockReportTemplateRepository{
+			FindByWorkspaceAndFormatFunc: func(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) (*domain.ReportTemplate, error) {
+				if workspaceID != f.workspaceID || format != domain.ReportFormatMarkdown {
+					return nil, nil
+				}
+				return &domain.ReportTemplate{WorkspaceID: workspaceID, Format: format, Body: body}, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		templateRepo *MockReportTemplateRepository
+		format       domain.ReportFormat
+		want         []string
+	}{
+		{
+			name:   "성공: 기본 Markdown 템플릿",
+			format: domain.ReportFormatMarkdown,
+			want:   []string{"# Web 현황 보고서", "### 진행중 (2)", "- **WEB-1** login — Alice (마감 2024-01-15, 4일 지남)", "| Alice | 1 | 1 | 1 |", "| 미지정 | 2 | 1 | 0 |"},
+		},
+		{
+			name:   "성공: 기본 CSV 템플릿",
+			format: domain.ReportFormatCSV,
+			want:   []string{"key,title,stage,assignee,due_date,overdue,completed_at\n", "WEB-1,login,진행중,Alice,2024-01-15,true,\n", "WEB-3,done,완료,Alice,2024-01-16,false,2024-01-17\n"},
+		},
+		{
+			name:         "성공: 워크스페이스 템플릿 사용",
+			templateRepo: override("{{.ProjectName}}: {{len .Overdue}} overdue"),
+			format:       domain.ReportFormatMarkdown,
+			want:         []string{"Web: 2 overdue"},
+		},
+		{
+			name:         "성공: 렌더링에 실패한 워크스페이스 템플릿은 기본 템플릿으로 대체",
+			templateRepo: override("{{index .Stages 99}}"),
+			format:       domain.ReportFormatMarkdown,
+			want:         []string{"# Web 현황 보고서"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := f.service(tt.templateRepo, nil).RenderProjectReport(context.Background(), report, tt.format)
+			if err != nil {
+				t.Fatalf("RenderProjectReport() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Rendered report missing %q:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+func TestReportService_Templates(t *testing.T) {
+	f := newReportFixture()
+	owner := &MockUserClient{
+		GetWorkspaceFunc: func(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error) {
+			return &client.Workspace{ID: workspaceID, OwnerID: f.ownerID}, nil
+		},
+		ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+			return userID == f.ownerID || userID == f.memberID, nil
+		},
+	}
+
+	t.Run("성공: 재정의한 템플릿과 기본 템플릿 조회", func(t *testing.T) {
+		templateRepo := &MockReportTemplateRepository{
+			FindByWorkspaceFunc: func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ReportTemplate, error) {
+				return []*domain.ReportTemplate{{WorkspaceID: workspaceID, Format: domain.ReportFormatMarkdown, Body: "custom", UpdatedBy: f.ownerID}}, nil
+			},
+		}
+		templates, err := f.service(templateRepo, owner).GetReportTemplates(context.Background(), f.workspaceID, f.memberID, "token")
+		if err != nil {
+			t.Fatalf("GetReportTemplates() error = %v", err)
+		}
+		if len(templates) != 2 {
+			t.Fatalf("len(templates) = %d, want 2", len(templates))
+		}
+		if templates[0].Format != "markdown" || templates[0].Body != "custom" || templates[0].IsDefault {
+			t.Errorf("Markdown template = %+v, want workspace override", templates[0])
+		}
+		if templates[1].Format != "csv" || !templates[1].IsDefault || !strings.HasPrefix(templates[1].Body, "key,title") {
+			t.Errorf("CSV template = %+v, want built-in template", templates[1])
+		}
+	})
+
+	t.Run("실패: 워크스페이스 멤버가 아니면 조회 불가", func(t *testing.T) {
+		_, err := f.service(nil, owner).GetReportTemplates(context.Background(), f.workspaceID, uuid.New(), "token")
+		assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	})
+
+	t.Run("성공: 소유자가 템플릿 재정의", func(t *testing.T) {
+		var saved *domain.ReportTemplate
+		templateRepo := &MockReportTemplateRepository{
+			SaveFunc: func(ctx context.Context, template *domain.ReportTemplate) error {
+				saved = template
+				return nil
+			},
+		}
+		body := "{{range .Overdue}}{{csv .Title}},{{date .DueDate}}\n{{end}}"
+		result, err := f.service(templateRepo, owner).SetReportTemplate(context.Background(), f.workspaceID, f.ownerID, "csv",
+			&dto.SetReportTemplateRequest{Body: body}, "token")
+		if err != nil {
+			t.Fatalf("SetReportTemplate() error = %v", err)
+		}
+		if saved == nil || saved.WorkspaceID != f.workspaceID || saved.Format != domain.ReportFormatCSV || saved.Body != body || saved.UpdatedBy != f.ownerID {
+			t.Errorf("Saved template = %+v", saved)
+		}
+		if result.IsDefault || result.Body != body {
+			t.Errorf("Result = %+v, want workspace override", result)
+		}
+	})
+
+	setErrorTests := []struct {
+		name        string
+		requesterID uuid.UUID
+		format      string
+		body        string
+		wantCode    string
+	}{
+		{"실패: 소유자가 아닌 멤버", f.memberID, "markdown", "# {{.ProjectName}}", response.ErrCodeForbidden},
+		{"실패: 템플릿이 없는 형식", f.ownerID, "json", "{}", response.ErrCodeValidation},
+		{"실패: 문법 오류", f.ownerID, "markdown", "{{range .Stages}}", response.ErrCodeValidation},
+		{"실패: 없는 필드 참조", f.ownerID, "markdown", "{{.Budget}}", response.ErrCodeValidation},
+	}
+	for _, tt := range setErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			templateRepo := &MockReportTemplateRepository{
+				SaveFunc: func(ctx context.Context, template *domain.ReportTemplate) error {
+					t.Error("Save should not be called")
+					return nil
+				},
+			}
+			_, err := f.service(templateRepo, owner).SetReportTemplate(context.Background(), f.workspaceID, tt.requesterID, tt.format,
+				&dto.SetReportTemplateRequest{Body: tt.body}, "token")
+			assertAppErrorCode(t, err, tt.wantCode)
+		})
+	}
+
+	t.Run("성공: 소유자가 기본 템플릿으로 초기화", func(t *testing.T) {
+		var deleted domain.ReportFormat
+		templateRepo := &MockReportTemplateRepository{
+			DeleteFunc: func(ctx context.Context, workspaceID uuid.UUID, format domain.ReportFormat) error {
+				deleted = format
+				return nil
+			},
+		}
+		if err := f.service(templateRepo, owner).DeleteReportTemplate(context.Background(), f.workspaceID, f.ownerID, "markdown", "token"); err != nil {
+			t.Fatalf("DeleteReportTemplate() error = %v", err)
+		}
+		if deleted != domain.ReportFormatMarkdown {
+			t.Errorf("Deleted format = %q, want markdown", deleted)
+		}
+	})
+
+	t.Run("실패: 소유자가 아니면 초기화 불가", func(t *testing.T) {
+		err := f.service(nil, owner).DeleteReportTemplate(context.Background(), f.workspaceID, f.memberID, "markdown", "token")
+		assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	})
+}
@@ -0,0 +1,651 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// SprintService defines the interface for sprint management
+type SprintService interface {
+	GetSprints(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.SprintResponse, error)
+	GetSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) (*dto.SprintDetailResponse, error)
+	CreateSprint(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateSprintRequest) (*dto.SprintResponse, error)
+	UpdateSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.UpdateSprintRequest) (*dto.SprintResponse, error)
+	DeleteSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) error
+	StartSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) (*dto.SprintResponse, error)
+	CloseSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+	UpdateSprintBoards(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.UpdateSprintBoardsRequest) (*dto.SprintDetailResponse, error)
+}
+
+// sprintServiceImpl is the implementation of SprintService
+type sprintServiceImpl struct {
+	sprintRepo           repository.SprintRepository
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	outbox               Outbox
+	logger               *zap.Logger
+	now                  func() time.Time
+}
+
+// NewSprintService creates a new instance of SprintService
+func NewSprintService(
+	sprintRepo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	outbox Outbox,
+	logger *zap.Logger,
+) SprintService {
+	return &sprintServiceImpl{
+		sprintRepo:           sprintRepo,
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		outbox:               outbox,
+		logger:               logger,
+		now:                  time.Now,
+	}
+}
+
+// GetSprints retrieves all sprints of a project with their board counts (any project member)
+func (s *sprintServiceImpl) GetSprints(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.SprintResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	sprints, err := s.sprintRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprints", err.Error())
+	}
+	counts, err := s.sprintRepo.CountBoardsByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count sprint boards", err.Error())
+	}
+	boardCounts := make(map[uuid.UUID]int64, len(counts))
+	for _, c := range counts {
+		boardCounts[c.SprintID] = c.BoardCount
+	}
+
+	responses := make([]*dto.SprintResponse, len(sprints))
+	for i, sprint := range sprints {
+		responses[i] = toSprintResponse(sprint, boardCounts[sprint.ID])
+	}
+	return responses, nil
+}
+
+// GetSprint retrieves a sprint with its boards and scope report (any project member)
+func (s *sprintServiceImpl) GetSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) (*dto.SprintDetailResponse, error) {
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildDetail(ctx, sprint, project)
+}
+
+// CreateSprint creates a planned sprint (requires sprint.manage)
+func (s *sprintServiceImpl) CreateSprint(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateSprintRequest) (*dto.SprintResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, response.NewValidationError("Sprint name is required", "")
+	}
+	if err := validateSprintDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	sprint := &domain.Sprint{
+		ProjectID: projectID,
+		Name:      name,
+		Goal:      req.Goal,
+		State:     domain.SprintStatePlanned,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		CreatedBy: requesterID,
+	}
+	if err := s.sprintRepo.Create(ctx, sprint); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create sprint", err.Error())
+	}
+	return toSprintResponse(sprint, 0), nil
+}
+
+// UpdateSprint renames a sprint or changes its goal and dates (requires sprint.manage)
+func (s *sprintServiceImpl) UpdateSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.UpdateSprintRequest) (*dto.SprintResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.State == domain.SprintStateClosed {
+		return nil, response.NewSprintStateError("Closed sprints cannot be changed", "")
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, response.NewValidationError("Sprint name is required", "")
+		}
+		sprint.Name = name
+	}
+	if req.Goal != nil {
+		sprint.Goal = *req.Goal
+	}
+	if req.StartDate != nil {
+		sprint.StartDate = *req.StartDate
+	}
+	if req.EndDate != nil {
+		sprint.EndDate = *req.EndDate
+	}
+	if err := validateSprintDates(sprint.StartDate, sprint.EndDate); err != nil {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.Update(ctx, sprint); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update sprint", err.Error())
+	}
+	return s.withBoardCount(ctx, sprint)
+}
+
+// DeleteSprint deletes a planned or closed sprint; its boards go back to the backlog (requires sprint.manage)
+func (s *sprintServiceImpl) DeleteSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) error {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.State == domain.SprintStateActive {
+		return response.NewSprintStateError("The active sprint cannot be deleted", "Close the sprint first")
+	}
+	if err := s.sprintRepo.Delete(ctx, sprintID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete sprint", err.Error())
+	}
+	return nil
+}
+
+// StartSprint activates a planned sprint; a project has at most one active sprint (requires sprint.manage)
+func (s *sprintServiceImpl) StartSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID) (*dto.SprintResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.State != domain.SprintStatePlanned {
+		return nil, response.NewSprintStateError("Only planned sprints can be started", string(sprint.State))
+	}
+	active, err := s.sprintRepo.FindActiveByProjectID(ctx, projectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch active sprint", err.Error())
+	}
+	if active != nil {
+		return nil, response.NewSprintStateError("Another sprint is already active", active.ID.String())
+	}
+
+	now := s.now()
+	sprint.State = domain.SprintStateActive
+	sprint.StartedAt = &now
+
+	var resp *dto.SprintResponse
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if err := s.sprintRepo.Update(ctx, sprint); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to start sprint", err.Error())
+		}
+		if resp, err = s.withBoardCount(ctx, sprint); err != nil {
+			return err
+		}
+		return s.recordSprintEvent(ctx, sprint, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Sprint started",
+		zap.String("project.id", projectID.String()),
+		zap.String("sprint.id", sprintID.String()),
+		zap.String("user.id", requesterID.String()))
+	return resp, nil
+}
+
+// CloseSprint closes the active sprint and rolls its unfinished boards to the next sprint (requires sprint.manage)
+// The next sprint is the requested one, otherwise the earliest planned sprint; without one the boards go to the backlog
+func (s *sprintServiceImpl) CloseSprint(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	if req == nil {
+		req = &dto.CloseSprintRequest{}
+	}
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.State != domain.SprintStateActive {
+		return nil, response.NewSprintStateError("Only the active sprint can be closed", string(sprint.State))
+	}
+
+	next, err := s.findNextSprint(ctx, projectID, sprintID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := s.sprintRepo.FindBoards(ctx, sprintID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprint boards", err.Error())
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, boards)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+
+	rolledOver := []uuid.UUID{}
+	var changes []*domain.SprintScopeChange
+	for _, board := range boards {
+		if stages.isBoardClosed(board) {
+			continue
+		}
+		rolledOver = append(rolledOver, board.ID)
+		changes = append(changes, &domain.SprintScopeChange{
+			SprintID:   sprintID,
+			BoardID:    board.ID,
+			ChangeType: domain.SprintScopeRolledOver,
+			ChangedBy:  requesterID,
+		})
+	}
+
+	now := s.now()
+	sprint.State = domain.SprintStateClosed
+	sprint.ClosedAt = &now
+	resp := &dto.CloseSprintResponse{RolledOver: rolledOver}
+	var target *uuid.UUID
+	if next != nil {
+		target = &next.ID
+		resp.NextSprintID = &next.ID
+	}
+
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if err := s.sprintRepo.AssignBoards(ctx, target, rolledOver); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to roll over boards", err.Error())
+		}
+		if err := s.sprintRepo.AddScopeChanges(ctx, changes); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record sprint scope", err.Error())
+		}
+		if err := s.sprintRepo.Update(ctx, sprint); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to close sprint", err.Error())
+		}
+		detail, err := s.buildDetail(ctx, sprint, project)
+		if err != nil {
+			return err
+		}
+		resp.Sprint = *detail
+		return s.recordSprintEvent(ctx, sprint, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Sprint closed",
+		zap.String("project.id", projectID.String()),
+		zap.String("sprint.id", sprintID.String()),
+		zap.String("user.id", requesterID.String()),
+		zap.Int("rolled_over.count", len(rolledOver)))
+	return resp, nil
+}
+
+// UpdateSprintBoards adds boards to and removes boards from a sprint (requires board.create)
+// Added boards leave their previous sprint; while a sprint is active the changes are recorded as scope changes
+func (s *sprintServiceImpl) UpdateSprintBoards(ctx context.Context, projectID, requesterID, sprintID uuid.UUID, req *dto.UpdateSprintBoardsRequest) (*dto.SprintDetailResponse, error) {
+	addIDs := removeDuplicateUUIDs(req.Add)
+	removeIDs := removeDuplicateUUIDs(req.Remove)
+	if len(addIDs) == 0 && len(removeIDs) == 0 {
+		return nil, response.NewValidationError("add or remove must contain at least one board", "")
+	}
+	if len(addIDs)+len(removeIDs) > dto.MaxSprintBoardChanges {
+		return nil, response.NewValidationError(fmt.Sprintf("At most %d boards can be changed at once", dto.MaxSprintBoardChanges), "")
+	}
+	removing := make(map[uuid.UUID]bool, len(removeIDs))
+	for _, id := range removeIDs {
+		removing[id] = true
+	}
+	for _, id := range addIDs {
+		if removing[id] {
+			return nil, response.NewValidationError("A board cannot be both added and removed", id.String())
+		}
+	}
+
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionBoardCreate, "You do not have permission to change sprint boards"); err != nil {
+		return nil, err
+	}
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
+	}
+	sprint, err := s.findSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.State == domain.SprintStateClosed {
+		return nil, response.NewSprintStateError("Boards of a closed sprint cannot be changed", "")
+	}
+
+	boardIDs := append(append([]uuid.UUID{}, addIDs...), removeIDs...)
+	boards, err := s.boardRepo.FindByIDsInProject(ctx, projectID, boardIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	if len(boards) != len(boardIDs) {
+		return nil, response.NewNotFoundError("Some boards were not found in this project", strings.Join(missingBoardIDs(boardIDs, boards), ","))
+	}
+
+	sprints, err := s.sprintRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprints", err.Error())
+	}
+	sprintByID := make(map[uuid.UUID]*domain.Sprint, len(sprints))
+	for _, sp := range sprints {
+		sprintByID[sp.ID] = sp
+	}
+
+	var added, removed []uuid.UUID
+	var changes []*domain.SprintScopeChange
+	recordChange := func(target *domain.Sprint, boardID uuid.UUID, changeType domain.SprintScopeChangeType) {
+		if target == nil || target.State != domain.SprintStateActive {
+			return
+		}
+		changes = append(changes, &domain.SprintScopeChange{
+			SprintID:   target.ID,
+			BoardID:    boardID,
+			ChangeType: changeType,
+			ChangedBy:  requesterID,
+		})
+	}
+	for _, board := range boards {
+		if removing[board.ID] {
+			if board.SprintID == nil || *board.SprintID != sprintID {
+				continue
+			}
+			removed = append(removed, board.ID)
+			recordChange(sprint, board.ID, domain.SprintScopeRemoved)
+			continue
+		}
+
+		if board.SprintID != nil && *board.SprintID == sprintID {
+			continue
+		}
+		if board.SprintID != nil {
+			previous := sprintByID[*board.SprintID]
+			if previous != nil && previous.State == domain.SprintStateClosed {
+				return nil, response.NewSprintStateError(
+					fmt.Sprintf("Board %s belongs to a closed sprint", boardLabel(board, project)), board.ID.String())
+			}
+			recordChange(previous, board.ID, domain.SprintScopeRemoved)
+		}
+		added = append(added, board.ID)
+		recordChange(sprint, board.ID, domain.SprintScopeAdded)
+	}
+
+	var resp *dto.SprintDetailResponse
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		if err := s.sprintRepo.AssignBoards(ctx, &sprintID, added); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to assign boards", err.Error())
+		}
+		if err := s.sprintRepo.AssignBoards(ctx, nil, removed); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to remove boards", err.Error())
+		}
+		if err := s.sprintRepo.AddScopeChanges(ctx, changes); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record sprint scope", err.Error())
+		}
+		if resp, err = s.buildDetail(ctx, sprint, project); err != nil {
+			return err
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+		return s.recordSprintEvent(ctx, sprint, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// findNextSprint resolves where the unfinished boards of a closing sprint go (nil: backlog)
+func (s *sprintServiceImpl) findNextSprint(ctx context.Context, projectID, closingID uuid.UUID, req *dto.CloseSprintRequest) (*domain.Sprint, error) {
+	if req.ToBacklog {
+		if req.NextSprintID != nil {
+			return nil, response.NewValidationError("nextSprintId cannot be combined with toBacklog", "")
+		}
+		return nil, nil
+	}
+	if req.NextSprintID != nil {
+		if *req.NextSprintID == closingID {
+			return nil, response.NewValidationError("The next sprint must differ from the closing sprint", "")
+		}
+		next, err := s.findSprint(ctx, projectID, *req.NextSprintID)
+		if err != nil {
+			return nil, err
+		}
+		if next.State != domain.SprintStatePlanned {
+			return nil, response.NewSprintStateError("Unfinished boards can only roll over to a planned sprint", string(next.State))
+		}
+		return next, nil
+	}
+
+	sprints, err := s.sprintRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprints", err.Error())
+	}
+	for _, sprint := range sprints {
+		if sprint.State == domain.SprintStatePlanned {
+			return sprint, nil
+		}
+	}
+	return nil, nil
+}
+
+// buildDetail loads the boards and scope changes of a sprint
+func (s *sprintServiceImpl) buildDetail(ctx context.Context, sprint *domain.Sprint, project *domain.Project) (*dto.SprintDetailResponse, error) {
+	boards, err := s.sprintRepo.FindBoards(ctx, sprint.ID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprint boards", err.Error())
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, boards)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+	changes, err := s.sprintRepo.FindScopeChanges(ctx, sprint.ID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprint scope", err.Error())
+	}
+
+	detail := &dto.SprintDetailResponse{
+		SprintResponse: *toSprintResponse(sprint, int64(len(boards))),
+		Boards:         make([]dto.SprintBoardResponse, 0, len(boards)),
+		Scope: dto.SprintScopeResponse{
+			Added:      []dto.SprintScopeChangeResponse{},
+			Removed:    []dto.SprintScopeChangeResponse{},
+			RolledOver: []dto.SprintScopeChangeResponse{},
+		},
+	}
+	for _, board := range boards {
+		item := dto.SprintBoardResponse{
+			BoardID:    board.ID,
+			Key:        domain.FormatBoardKey(project.KeyPrefix, board.Number),
+			Title:      board.Title,
+			AssigneeID: board.AssigneeID,
+			Stage:      customFieldValue(board, string(domain.FieldTypeStage)),
+			Completed:  stages.isBoardClosed(board),
+		}
+		if item.Completed {
+			detail.Scope.CompletedCount++
+		} else {
+			detail.Scope.RemainingCount++
+		}
+		detail.Boards = append(detail.Boards, item)
+	}
+	for _, change := range changes {
+		item := dto.SprintScopeChangeResponse{
+			BoardID:   change.BoardID,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.CreatedAt,
+		}
+		switch change.ChangeType {
+		case domain.SprintScopeAdded:
+			detail.Scope.Added = append(detail.Scope.Added, item)
+		case domain.SprintScopeRemoved:
+			detail.Scope.Removed = append(detail.Scope.Removed, item)
+		case domain.SprintScopeRolledOver:
+			detail.Scope.RolledOver = append(detail.Scope.RolledOver, item)
+		}
+	}
+	detail.Scope.AddedCount = len(detail.Scope.Added)
+	detail.Scope.RemovedCount = len(detail.Scope.Removed)
+	detail.Scope.RolledOverCount = len(detail.Scope.RolledOver)
+	return detail, nil
+}
+
+// recordSprintEvent broadcasts a SPRINT_UPDATED event to the project
+func (s *sprintServiceImpl) recordSprintEvent(ctx context.Context, sprint *domain.Sprint, payload interface{}) error {
+	if s.outbox == nil {
+		return nil
+	}
+	if err := s.outbox.AddBroadcast(ctx, domain.OutboxAggregateSprint, sprint.ID, sprint.ProjectID, &dto.BoardEvent{
+		Type:    domain.WebhookEventSprintUpdated,
+		Payload: payload,
+	}); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to record sprint event", err.Error())
+	}
+	return nil
+}
+
+// findProject fetches the project of the sprints
+func (s *sprintServiceImpl) findProject(ctx context.Context, projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	return project, nil
+}
+
+// checkManage verifies that the requester holds the sprint.manage permission
+func (s *sprintServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionSprintManage, "You do not have permission to manage sprints")
+}
+
+// findSprint fetches a sprint and ensures it belongs to the project
+func (s *sprintServiceImpl) findSprint(ctx context.Context, projectID, sprintID uuid.UUID) (*domain.Sprint, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Sprint not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch sprint", err.Error())
+	}
+	if sprint.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Sprint not found", "")
+	}
+	return sprint, nil
+}
+
+// withBoardCount builds the sprint response including its current board count
+func (s *sprintServiceImpl) withBoardCount(ctx context.Context, sprint *domain.Sprint) (*dto.SprintResponse, error) {
+	counts, err := s.sprintRepo.CountBoardsByProjectID(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count sprint boards", err.Error())
+	}
+	for _, c := range counts {
+		if c.SprintID == sprint.ID {
+			return toSprintResponse(sprint, c.BoardCount), nil
+		}
+	}
+	return toSprintResponse(sprint, 0), nil
+}
+
+// validateSprintDates rejects a sprint ending before it starts
+func validateSprintDates(startDate, endDate time.Time) error {
+	if endDate.Before(startDate) {
+		return response.NewValidationError("Sprint end date must not be before its start date", "")
+	}
+	return nil
+}
+
+// toSprintResponse converts domain.Sprint to dto.SprintResponse
+func toSprintResponse(sprint *domain.Sprint, boardCount int64) *dto.SprintResponse {
+	return &dto.SprintResponse{
+		SprintID:   sprint.ID,
+		ProjectID:  sprint.ProjectID,
+		Name:       sprint.Name,
+		Goal:       sprint.Goal,
+		State:      string(sprint.State),
+		StartDate:  sprint.StartDate,
+		EndDate:    sprint.EndDate,
+		StartedAt:  sprint.StartedAt,
+		ClosedAt:   sprint.ClosedAt,
+		BoardCount: boardCount,
+		CreatedBy:  sprint.CreatedBy,
+		CreatedAt:  sprint.CreatedAt,
+		UpdatedAt:  sprint.UpdatedAt,
+	}
+}
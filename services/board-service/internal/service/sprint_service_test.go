@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestSprintService_StartSprint(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(f *sprintFixture) *domain.Sprint
+		asMember    bool
+		wantErrCode string
+	}{
+		{
+			name: "성공: 계획 스프린트 시작",
+			setup: func(f *sprintFixture) *domain.Sprint {
+				f.addSprint("Sprint 1", domain.SprintStateClosed, 1)
+				return f.addSprint("Sprint 2", domain.SprintStatePlanned, 15)
+			},
+		},
+		{
+			name: "실패: 이미 진행 중인 스프린트가 있음",
+			setup: func(f *sprintFixture) *domain.Sprint {
+				f.addSprint("Sprint 1", domain.SprintStateActive, 1)
+				return f.addSprint("Sprint 2", domain.SprintStatePlanned, 15)
+			},
+			wantErrCode: response.ErrCodeSprintState,
+		},
+		{
+			name: "실패: 종료된 스프린트",
+			setup: func(f *sprintFixture) *domain.Sprint {
+				return f.addSprint("Sprint 1", domain.SprintStateClosed, 1)
+			},
+			wantErrCode: response.ErrCodeSprintState,
+		},
+		{
+			name: "실패: sprint.manage 권한 없음",
+			setup: func(f *sprintFixture) *domain.Sprint {
+				return f.addSprint("Sprint 1", domain.SprintStatePlanned, 1)
+			},
+			asMember:    true,
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			f := newSprintFixture()
+			sprint := tt.setup(f)
+			f.addBoard(sprint, "in_progress")
+			requesterID := f.adminID
+			if tt.asMember {
+				requesterID = f.memberID
+			}
+
+			// When
+			result, err := f.service().StartSprint(context.Background(), f.projectID, requesterID, sprint.ID)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if f.sprintEventCount(t) != 0 {
+					t.Error("sprint event recorded for a failed start")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("StartSprint() error = %v", err)
+			}
+			if result.State != string(domain.SprintStateActive) || result.StartedAt == nil {
+				t.Errorf("state = %s, startedAt = %v; want active with start time", result.State, result.StartedAt)
+			}
+			if f.sprintEventCount(t) != 1 {
+				t.Errorf("sprint events = %d, want 1", f.sprintEventCount(t))
+			}
+		})
+	}
+}
+
+func TestSprintService_CloseSprint(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest
+		noPlanned   bool
+		wantBacklog bool
+		wantErrCode string
+	}{
+		{
+			name: "성공: 가장 빠른 계획 스프린트로 이월",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				return nil
+			},
+		},
+		{
+			name: "성공: 지정한 스프린트로 이월",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				return &dto.CloseSprintRequest{NextSprintID: &next.ID}
+			},
+		},
+		{
+			name: "성공: toBacklog이면 백로그로 이동",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				return &dto.CloseSprintRequest{ToBacklog: true}
+			},
+			wantBacklog: true,
+		},
+		{
+			name: "성공: 계획 스프린트가 없으면 백로그로 이동",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				return nil
+			},
+			noPlanned:   true,
+			wantBacklog: true,
+		},
+		{
+			name: "실패: 다음 스프린트가 종료된 상태",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				closed := f.addSprint("Old", domain.SprintStateClosed, 1)
+				return &dto.CloseSprintRequest{NextSprintID: &closed.ID}
+			},
+			wantErrCode: response.ErrCodeSprintState,
+		},
+		{
+			name: "실패: nextSprintId와 toBacklog를 함께 지정",
+			req: func(f *sprintFixture, next *domain.Sprint) *dto.CloseSprintRequest {
+				return &dto.CloseSprintRequest{NextSprintID: &next.ID, ToBacklog: true}
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			f := newSprintFixture()
+			current := f.addSprint("Sprint 2", domain.SprintStateActive, 15)
+			var earliest, later *domain.Sprint
+			if !tt.noPlanned {
+				later = f.addSprint("Sprint 4", domain.SprintStatePlanned, 43)
+				earliest = f.addSprint("Sprint 3", domain.SprintStatePlanned, 29)
+				// FindByProjectID returns sprints ordered by start date
+				f.order = []uuid.UUID{current.ID, earliest.ID, later.ID}
+			}
+			done := f.addBoard(current, "approved")
+			open := f.addBoard(current, "in_progress")
+			waiting := f.addBoard(current, "")
+			req := tt.req(f, later)
+
+			// When
+			result, err := f.service().CloseSprint(context.Background(), f.projectID, f.adminID, current.ID, req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if current.State != domain.SprintStateActive || *open.SprintID != current.ID {
+					t.Error("sprint changed by a failed close")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CloseSprint() error = %v", err)
+			}
+
+			var wantTarget *domain.Sprint
+			switch {
+			case tt.wantBacklog:
+			case req != nil && req.NextSprintID != nil:
+				wantTarget = later
+			default:
+				wantTarget = earliest
+			}
+			for _, board := range []*domain.Board{open, waiting} {
+				if wantTarget == nil && board.SprintID != nil {
+					t.Errorf("board %d sprint = %v, want backlog", board.Number, board.SprintID)
+				}
+				if wantTarget != nil && (board.SprintID == nil || *board.SprintID != wantTarget.ID) {
+					t.Errorf("board %d sprint = %v, want %s", board.Number, board.SprintID, wantTarget.Name)
+				}
+			}
+			if done.SprintID == nil || *done.SprintID != current.ID {
+				t.Error("completed board left the closed sprint")
+			}
+			if wantTarget == nil && result.NextSprintID != nil {
+				t.Errorf("nextSprintId = %v, want none", result.NextSprintID)
+			}
+			if wantTarget != nil && (result.NextSprintID == nil || *result.NextSprintID != wantTarget.ID) {
+				t.Errorf("nextSprintId = %v, want %s", result.NextSprintID, wantTarget.ID)
+			}
+			if len(result.RolledOver) != 2 || result.Sprint.Scope.RolledOverCount != 2 {
+				t.Errorf("rolled over = %d (scope %d), want 2", len(result.RolledOver), result.Sprint.Scope.RolledOverCount)
+			}
+			if result.Sprint.State != string(domain.SprintStateClosed) || result.Sprint.ClosedAt == nil {
+				t.Errorf("state = %s, want closed", result.Sprint.State)
+			}
+			if result.Sprint.Scope.CompletedCount != 1 || result.Sprint.Scope.RemainingCount != 0 {
+				t.Errorf("completed = %d, remaining = %d; want 1, 0", result.Sprint.Scope.CompletedCount, result.Sprint.Scope.RemainingCount)
+			}
+			if f.sprintEventCount(t) != 1 {
+				t.Errorf("sprint events = %d, want 1", f.sprintEventCount(t))
+			}
+		})
+	}
+}
+
+func TestSprintService_UpdateSprintBoards(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       domain.SprintState
+		otherState  domain.SprintState
+		wantAdded   int
+		wantRemoved int
+		wantOther   int
+		wantErrCode string
+	}{
+		{
+			name:        "성공: 진행 중인 스프린트는 범위 변경 기록",
+			state:       domain.SprintStateActive,
+			otherState:  domain.SprintStatePlanned,
+			wantAdded:   2,
+			wantRemoved: 1,
+		},
+		{
+			name:       "성공: 계획 스프린트는 범위 변경을 기록하지 않음",
+			state:      domain.SprintStatePlanned,
+			otherState: domain.SprintStateActive,
+			wantOther:  1,
+		},
+		{
+			name:        "실패: 종료된 스프린트",
+			state:       domain.SprintStateClosed,
+			otherState:  domain.SprintStatePlanned,
+			wantErrCode: response.ErrCodeSprintState,
+		},
+		{
+			name:        "실패: 종료된 스프린트의 보드 추가",
+			state:       domain.SprintStateActive,
+			otherState:  domain.SprintStateClosed,
+			wantErrCode: response.ErrCodeSprintState,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			f := newSprintFixture()
+			sprint := f.addSprint("Sprint 1", tt.state, 1)
+			other := f.addSprint("Sprint 2", tt.otherState, 15)
+			backlog := f.addBoard(nil, "backlog")
+			moved := f.addBoard(other, "in_progress")
+			dropped := f.addBoard(sprint, "in_progress")
+			req := &dto.UpdateSprintBoardsRequest{
+				Add:    []uuid.UUID{backlog.ID, moved.ID},
+				Remove: []uuid.UUID{dropped.ID},
+			}
+
+			// When
+			result, err := f.service().UpdateSprintBoards(context.Background(), f.projectID, f.memberID, sprint.ID, req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if backlog.SprintID != nil || len(f.changes) != 0 {
+					t.Error("boards changed by a failed update")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateSprintBoards() error = %v", err)
+			}
+			if backlog.SprintID == nil || *backlog.SprintID != sprint.ID || moved.SprintID == nil || *moved.SprintID != sprint.ID {
+				t.Error("added boards not moved to the sprint")
+			}
+			if dropped.SprintID != nil {
+				t.Error("removed board not moved to the backlog")
+			}
+			if len(result.Boards) != 2 {
+				t.Errorf("boards = %d, want 2", len(result.Boards))
+			}
+			if result.Scope.AddedCount != tt.wantAdded || result.Scope.RemovedCount != tt.wantRemoved {
+				t.Errorf("scope added = %d, removed = %d; want %d, %d",
+					result.Scope.AddedCount, result.Scope.RemovedCount, tt.wantAdded, tt.wantRemoved)
+			}
+			otherRemoved := 0
+			for _, change := range f.changes {
+				if change.SprintID == other.ID && change.ChangeType == domain.SprintScopeRemoved {
+					otherRemoved++
+				}
+			}
+			if otherRemoved != tt.wantOther {
+				t.Errorf("previous sprint removals = %d, want %d", otherRemoved, tt.wantOther)
+			}
+		})
+	}
+}
+
+func TestSprintService_UpdateSprintBoards_Validation(t *testing.T) {
+	f := newSprintFixture()
+	sprint := f.addSprint("Sprint 1", domain.SprintStateActive, 1)
+	board := f.addBoard(nil, "backlog")
+	svc := f.service()
+
+	_, err := svc.UpdateSprintBoards(context.Background(), f.projectID, f.memberID, sprint.ID, &dto.UpdateSprintBoardsRequest{})
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+
+	_, err = svc.UpdateSprintBoards(context.Background(), f.projectID, f.memberID, sprint.ID, &dto.UpdateSprintBoardsRequest{
+		Add: []uuid.UUID{board.ID}, Remove: []uuid.UUID{board.ID},
+	})
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+
+	_, err = svc.UpdateSprintBoards(context.Background(), f.projectID, f.memberID, sprint.ID, &dto.UpdateSprintBoardsRequest{
+		Add: []uuid.UUID{uuid.New()},
+	})
+	assertAppErrorCode(t, err, response.ErrCodeNotFound)
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// TimelineService defines the interface for the project timeline (Gantt) view
+type TimelineService interface {
+	GetTimeline(ctx context.Context, projectID, requesterID uuid.UUID, groupBy string) (*dto.TimelineResponse, error)
+	RescheduleBoards(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.RescheduleBoardsRequest) (*dto.RescheduleBoardsResponse, error)
+}
+
+// timelineServiceImpl is the implementation of TimelineService
+type timelineServiceImpl struct {
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionRepo      repository.FieldOptionRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	outbox               Outbox
+	logger               *zap.Logger
+}
+
+// NewTimelineService creates a new instance of TimelineService
+func NewTimelineService(
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	fieldOptionRepo repository.FieldOptionRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	outbox Outbox,
+	logger *zap.Logger,
+) TimelineService {
+	return &timelineServiceImpl{
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		fieldOptionRepo:      fieldOptionRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		outbox:               outbox,
+		logger:               logger,
+	}
+}
+
+// GetTimeline returns the boards of a project as timeline bars grouped by assignee, stage or role (any project member)
+func (s *timelineServiceImpl) GetTimeline(ctx context.Context, projectID, requesterID uuid.UUID, groupBy string) (*dto.TimelineResponse, error) {
+	if groupBy == "" {
+		groupBy = dto.TimelineGroupByAssignee
+	}
+	switch groupBy {
+	case dto.TimelineGroupByAssignee, dto.TimelineGroupByStage, dto.TimelineGroupByRole:
+	default:
+		return nil, response.NewValidationError("groupBy must be 'assignee', 'stage' or 'role'", "")
+	}
+
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	resp := &dto.TimelineResponse{
+		ProjectID:        projectID,
+		GroupBy:          groupBy,
+		ProjectStartDate: project.StartDate,
+		ProjectDueDate:   project.DueDate,
+		Unscheduled:      []dto.TimelineItemResponse{},
+		Overlaps:         []dto.TimelineOverlapResponse{},
+		Overruns:         []dto.TimelineOverrunResponse{},
+	}
+
+	grouped := make(map[string][]dto.TimelineItemResponse)
+	byAssignee := make(map[uuid.UUID][]dto.TimelineItemResponse)
+	for _, board := range boards {
+		item := toTimelineItem(board, project)
+		if item.BarStart == nil {
+			resp.Unscheduled = append(resp.Unscheduled, item)
+			continue
+		}
+
+		if resp.RangeStart == nil || item.BarStart.Before(*resp.RangeStart) {
+			resp.RangeStart = item.BarStart
+		}
+		if resp.RangeEnd == nil || item.BarEnd.After(*resp.RangeEnd) {
+			resp.RangeEnd = item.BarEnd
+		}
+		if item.Overrun {
+			resp.Overruns = append(resp.Overruns, dto.TimelineOverrunResponse{
+				BoardID:     item.BoardID,
+				BarEnd:      *item.BarEnd,
+				OverrunDays: int(math.Ceil(item.BarEnd.Sub(*project.DueDate).Hours() / 24)),
+			})
+		}
+
+		key := timelineGroupKey(board, item, groupBy)
+		grouped[key] = append(grouped[key], item)
+		if item.AssigneeID != nil {
+			byAssignee[*item.AssigneeID] = append(byAssignee[*item.AssigneeID], item)
+		}
+	}
+
+	groups, err := s.buildGroups(ctx, projectID, groupBy, grouped)
+	if err != nil {
+		return nil, err
+	}
+	resp.Groups = groups
+	resp.Overlaps = findOverlaps(byAssignee)
+
+	sortTimelineItems(resp.Unscheduled)
+	sort.Slice(resp.Overruns, func(i, j int) bool {
+		return resp.Overruns[i].BarEnd.After(resp.Overruns[j].BarEnd)
+	})
+	return resp, nil
+}
+
+// RescheduleBoards shifts the dates of several boards by the same number of days in one transaction
+// and broadcasts a single BOARDS_RESCHEDULED event (requires board.create)
+func (s *timelineServiceImpl) RescheduleBoards(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.RescheduleBoardsRequest) (*dto.RescheduleBoardsResponse, error) {
+	shift := req.Shift
+	if shift == "" {
+		shift = dto.RescheduleShiftBoth
+	}
+	switch shift {
+	case dto.RescheduleShiftBoth, dto.RescheduleShiftStart, dto.RescheduleShiftDue:
+	default:
+		return nil, response.NewValidationError("shift must be 'both', 'start' or 'due'", "")
+	}
+	if req.OffsetDays == 0 {
+		return nil, response.NewValidationError("offsetDays must not be zero", "")
+	}
+	boardIDs := removeDuplicateUUIDs(req.BoardIDs)
+	if len(boardIDs) == 0 || len(boardIDs) > dto.MaxRescheduleBoards {
+		return nil, response.NewValidationError(fmt.Sprintf("boardIds must contain between 1 and %d boards", dto.MaxRescheduleBoards), "")
+	}
+
+	project, err := s.findProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionBoardCreate, "You do not have permission to reschedule boards"); err != nil {
+		return nil, err
+	}
+	if err := checkProjectWritable(project); err != nil {
+		return nil, err
+	}
+
+	boards, err := s.boardRepo.FindByIDsInProject(ctx, projectID, boardIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	if len(boards) != len(boardIDs) {
+		return nil, response.NewNotFoundError("Some boards were not found in this project", strings.Join(missingBoardIDs(boardIDs, boards), ","))
+	}
+
+	// Compute every new schedule first so that one invalid board rejects the whole request
+	resp := &dto.RescheduleBoardsResponse{
+		Boards:  make([]dto.TimelineItemResponse, 0, len(boards)),
+		Skipped: []uuid.UUID{},
+	}
+	var changed []*domain.Board
+	for _, board := range boards {
+		startDate, dueDate := board.StartDate, board.DueDate
+		moved := false
+		if startDate != nil && shift != dto.RescheduleShiftDue {
+			shifted := startDate.AddDate(0, 0, req.OffsetDays)
+			startDate, moved = &shifted, true
+		}
+		if dueDate != nil && shift != dto.RescheduleShiftStart {
+			shifted := dueDate.AddDate(0, 0, req.OffsetDays)
+			dueDate, moved = &shifted, true
+		}
+		if !moved {
+			resp.Skipped = append(resp.Skipped, board.ID)
+			continue
+		}
+		if startDate != nil && dueDate != nil && startDate.After(*dueDate) {
+			return nil, response.NewValidationError(
+				fmt.Sprintf("Start date of board %s would be after its due date", boardLabel(board, project)), board.ID.String())
+		}
+		board.StartDate, board.DueDate = startDate, dueDate
+		changed = append(changed, board)
+	}
+
+	for _, board := range changed {
+		resp.Boards = append(resp.Boards, toTimelineItem(board, project))
+	}
+	sortTimelineItems(resp.Boards)
+
+	err = runInTx(ctx, s.outbox, func(ctx context.Context) error {
+		for _, board := range changed {
+			if err := s.boardRepo.UpdateDates(ctx, board.ID, board.StartDate, board.DueDate); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to reschedule boards", err.Error())
+			}
+		}
+		if len(changed) == 0 || s.outbox == nil {
+			return nil
+		}
+		if err := s.outbox.AddBroadcast(ctx, domain.OutboxAggregateProject, projectID, projectID, &dto.BoardEvent{
+			Type:    domain.WebhookEventBoardsRescheduled,
+			Payload: resp,
+		}); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board event", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Boards rescheduled",
+		zap.String("project.id", projectID.String()),
+		zap.String("user.id", requesterID.String()),
+		zap.Int("offset.days", req.OffsetDays),
+		zap.String("shift", shift),
+		zap.Int("board.count", len(changed)))
+	return resp, nil
+}
+
+// buildGroups orders the groups: field options in display order (also when empty), then values
+// without an option, then boards without a value. Assignee groups are ordered by ID, unassigned last
+func (s *timelineServiceImpl) buildGroups(ctx context.Context, projectID uuid.UUID, groupBy string, grouped map[string][]dto.TimelineItemResponse) ([]dto.TimelineGroupResponse, error) {
+	groups := []dto.TimelineGroupResponse{}
+	seen := make(map[string]bool)
+
+	if groupBy != dto.TimelineGroupByAssignee {
+		options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldType(groupBy))
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+		}
+		for _, option := range options {
+			if seen[option.Value] {
+				continue
+			}
+			seen[option.Value] = true
+			groups = append(groups, dto.TimelineGroupResponse{
+				Key:   option.Value,
+				Label: option.Label,
+				Color: option.Color,
+				Items: sortTimelineItems(grouped[option.Value]),
+			})
+		}
+	}
+
+	var rest []string
+	for key := range grouped {
+		if !seen[key] && key != "" {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	if _, ok := grouped[""]; ok {
+		rest = append(rest, "")
+	}
+	for _, key := range rest {
+		groups = append(groups, dto.TimelineGroupResponse{Key: key, Items: sortTimelineItems(grouped[key])})
+	}
+	return groups, nil
+}
+
+// findProject fetches the project of the timeline
+func (s *timelineServiceImpl) findProject(ctx context.Context, projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	return project, nil
+}
+
+// toTimelineItem converts a board to a timeline item
+// The bar spans StartDate ~ DueDate; a board with only one of them is a single-day bar
+func toTimelineItem(board *domain.Board, project *domain.Project) dto.TimelineItemResponse {
+	item := dto.TimelineItemResponse{
+		BoardID:    board.ID,
+		Key:        domain.FormatBoardKey(project.KeyPrefix, board.Number),
+		Title:      board.Title,
+		AssigneeID: board.AssigneeID,
+		Stage:      customFieldValue(board, string(domain.FieldTypeStage)),
+		StartDate:  board.StartDate,
+		DueDate:    board.DueDate,
+	}
+
+	switch {
+	case board.StartDate != nil && board.DueDate != nil:
+		item.BarStart, item.BarEnd = board.StartDate, board.DueDate
+	case board.StartDate != nil:
+		item.BarStart, item.BarEnd = board.StartDate, board.StartDate
+	case board.DueDate != nil:
+		item.BarStart, item.BarEnd = board.DueDate, board.DueDate
+	}
+	item.Overrun = item.BarEnd != nil && project.DueDate != nil && item.BarEnd.After(*project.DueDate)
+	return item
+}
+
+// timelineGroupKey returns the group of a board for the grouping mode
+func timelineGroupKey(board *domain.Board, item dto.TimelineItemResponse, groupBy string) string {
+	if groupBy == dto.TimelineGroupByAssignee {
+		if item.AssigneeID == nil {
+			return ""
+		}
+		return item.AssigneeID.String()
+	}
+	return customFieldValue(board, groupBy)
+}
+
+// customFieldValue reads a (converted) custom field value of a board
+func customFieldValue(board *domain.Board, fieldType string) string {
+	if len(board.CustomFields) == 0 {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(board.CustomFields, &fields); err != nil {
+		return ""
+	}
+	value, _ := fields[fieldType].(string)
+	return value
+}
+
+// findOverlaps returns the pairs of bars of the same assignee that intersect (both ends inclusive)
+func findOverlaps(byAssignee map[uuid.UUID][]dto.TimelineItemResponse) []dto.TimelineOverlapResponse {
+	overlaps := []dto.TimelineOverlapResponse{}
+	for assigneeID, items := range byAssignee {
+		sortTimelineItems(items)
+		for i := range items {
+			for j := i + 1; j < len(items) && !items[j].BarStart.After(*items[i].BarEnd); j++ {
+				end := *items[i].BarEnd
+				if items[j].BarEnd.Before(end) {
+					end = *items[j].BarEnd
+				}
+				overlaps = append(overlaps, dto.TimelineOverlapResponse{
+					AssigneeID: assigneeID,
+					BoardIDs:   []uuid.UUID{items[i].BoardID, items[j].BoardID},
+					Start:      *items[j].BarStart,
+					End:        end,
+				})
+			}
+		}
+	}
+	sort.Slice(overlaps, func(i, j int) bool {
+		if !overlaps[i].Start.Equal(overlaps[j].Start) {
+			return overlaps[i].Start.Before(overlaps[j].Start)
+		}
+		return overlaps[i].AssigneeID.String() < overlaps[j].AssigneeID.String()
+	})
+	return overlaps
+}
+
+// sortTimelineItems orders items by bar start (unscheduled items by title) and returns them
+func sortTimelineItems(items []dto.TimelineItemResponse) []dto.TimelineItemResponse {
+	if items == nil {
+		return []dto.TimelineItemResponse{}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.BarStart != nil && b.BarStart != nil && !a.BarStart.Equal(*b.BarStart) {
+			return a.BarStart.Before(*b.BarStart)
+		}
+		if (a.BarStart == nil) != (b.BarStart == nil) {
+			return a.BarStart != nil
+		}
+		return a.Title < b.Title
+	})
+	return items
+}
+
+// missingBoardIDs lists the requested IDs that were not found
+func missingBoardIDs(ids []uuid.UUID, boards []*domain.Board) []string {
+	found := make(map[uuid.UUID]bool, len(boards))
+	for _, board := range boards {
+		found[board.ID] = true
+	}
+	var missing []string
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id.String())
+		}
+	}
+	return missing
+}
+
+// boardLabel identifies a board in messages by its key, or its ID before it has a number
+func boardLabel(board *domain.Board, project *domain.Project) string {
+	if key := domain.FormatBoardKey(project.KeyPrefix, board.Number); key != "" {
+		return key
+	}
+	return board.ID.String()
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func timelineDate(day int) *time.Time {
+	d := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func TestTimelineService_GetTimeline(t *testing.T) {
+	projectID := uuid.New()
+	memberID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	stage := func(value string) datatypes.JSON {
+		data, _ := json.Marshal(map[string]interface{}{"stage": value})
+		return data
+	}
+	design := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 1, Title: "design", AssigneeID: &aliceID,
+		StartDate: timelineDate(1), DueDate: timelineDate(10), CustomFields: stage("in_progress")}
+	build := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 2, Title: "build", AssigneeID: &aliceID,
+		StartDate: timelineDate(8), DueDate: timelineDate(20), CustomFields: stage("pending")}
+	review := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 3, Title: "review", AssigneeID: &bobID,
+		DueDate: timelineDate(31), CustomFields: stage("pending")}
+	backlog := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 4, Title: "backlog"}
+
+	newService := func() TimelineService {
+		projectRepo := &MockProjectRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, KeyPrefix: "WEB", DueDate: timelineDate(25)}, nil
+			},
+			FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+				if uID == memberID {
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+				}
+				return nil, nil
+			},
+		}
+		boardRepo := &MockBoardRepository{
+			FindByProjectIDFunc: func(ctx context.Context, pID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+				return []*domain.Board{review, backlog, build, design}, nil
+			},
+		}
+		fieldOptionRepo := &MockFieldOptionRepository{
+			FindByProjectAndFieldTypeFunc: func(ctx context.Context, pID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+				return []*domain.FieldOption{
+					{Value: "pending", Label: "대기", Color: "#9CA3AF"},
+					{Value: "in_progress", Label: "진행중", Color: "#F59E0B"},
+					{Value: "completed", Label: "완료", Color: "#10B981"},
+				}, nil
+			},
+		}
+		return NewTimelineService(boardRepo, projectRepo, fieldOptionRepo, &MockFieldOptionConverter{}, nil, zap.NewNop())
+	}
+
+	t.Run("성공: 담당자별 그룹, 겹침, 마감 초과, 미예정", func(t *testing.T) {
+		timeline, err := newService().GetTimeline(context.Background(), projectID, memberID, "")
+		if err != nil {
+			t.Fatalf("GetTimeline() error = %v", err)
+		}
+
+		if timeline.GroupBy != dto.TimelineGroupByAssignee {
+			t.Errorf("GroupBy = %s, want assignee", timeline.GroupBy)
+		}
+		if len(timeline.Groups) != 2 {
+			t.Fatalf("len(Groups) = %d, want 2", len(timeline.Groups))
+		}
+		for _, group := range timeline.Groups {
+			if group.Key == aliceID.String() && (len(group.Items) != 2 || group.Items[0].Title != "design") {
+				t.Errorf("alice items = %+v, want design then build", group.Items)
+			}
+		}
+		if len(timeline.Unscheduled) != 1 || timeline.Unscheduled[0].BoardID != backlog.ID {
+			t.Errorf("Unscheduled = %+v, want backlog only", timeline.Unscheduled)
+		}
+		if len(timeline.Overlaps) != 1 {
+			t.Fatalf("len(Overlaps) = %d, want 1", len(timeline.Overlaps))
+		}
+		overlap := timeline.Overlaps[0]
+		if overlap.AssigneeID != aliceID || !overlap.Start.Equal(*timelineDate(8)) || !overlap.End.Equal(*timelineDate(10)) {
+			t.Errorf("Overlap = %+v, want alice 1/8 ~ 1/10", overlap)
+		}
+		if len(timeline.Overruns) != 1 || timeline.Overruns[0].BoardID != review.ID || timeline.Overruns[0].OverrunDays != 6 {
+			t.Errorf("Overruns = %+v, want review by 6 days", timeline.Overruns)
+		}
+		if !timeline.RangeStart.Equal(*timelineDate(1)) || !timeline.RangeEnd.Equal(*timelineDate(31)) {
+			t.Errorf("Range = %v ~ %v, want 1/1 ~ 1/31", timeline.RangeStart, timeline.RangeEnd)
+		}
+	})
+
+	t.Run("성공: 단계별 그룹은 옵션 순서를 따르고 빈 그룹도 포함", func(t *testing.T) {
+		timeline, err := newService().GetTimeline(context.Background(), projectID, memberID, dto.TimelineGroupByStage)
+		if err != nil {
+			t.Fatalf("GetTimeline() error = %v", err)
+		}
+		want := []struct {
+			key   string
+			count int
+		}{{"pending", 2}, {"in_progress", 1}, {"completed", 0}}
+		if len(timeline.Groups) != len(want) {
+			t.Fatalf("len(Groups) = %d, want %d", len(timeline.Groups), len(want))
+		}
+		for i, w := range want {
+			if timeline.Groups[i].Key != w.key || len(timeline.Groups[i].Items) != w.count {
+				t.Errorf("Groups[%d] = %s (%d items), want %s (%d items)", i, timeline.Groups[i].Key, len(timeline.Groups[i].Items), w.key, w.count)
+			}
+		}
+		if timeline.Groups[1].Label != "진행중" {
+			t.Errorf("Groups[1].Label = %s, want 진행중", timeline.Groups[1].Label)
+		}
+	})
+
+	t.Run("실패: 잘못된 groupBy", func(t *testing.T) {
+		_, err := newService().GetTimeline(context.Background(), projectID, memberID, "importance")
+		assertAppErrorCode(t, err, response.ErrCodeValidation)
+	})
+
+	t.Run("실패: 프로젝트 멤버가 아님", func(t *testing.T) {
+		_, err := newService().GetTimeline(context.Background(), projectID, uuid.New(), "")
+		assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	})
+}
+
+func TestTimelineService_RescheduleBoards(t *testing.T) {
+	projectID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name        string
+		req         *dto.RescheduleBoardsRequest
+		boards      func() []*domain.Board
+		archived    bool
+		wantErrCode string
+		wantUpdated int
+		wantSkipped int
+	}{
+		{
+			name: "성공: 시작일과 마감일을 함께 이동",
+			req:  &dto.RescheduleBoardsRequest{OffsetDays: 7},
+			boards: func() []*domain.Board {
+				return []*domain.Board{
+					{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 1, StartDate: timelineDate(1), DueDate: timelineDate(5)},
+					{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 2, DueDate: timelineDate(9)},
+					{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 3},
+				}
+			},
+			wantUpdated: 2,
+			wantSkipped: 1,
+		},
+		{
+			name: "실패: 시작일만 이동해서 마감일보다 늦어짐",
+			req:  &dto.RescheduleBoardsRequest{OffsetDays: 5, Shift: dto.RescheduleShiftStart},
+			boards: func() []*domain.Board {
+				return []*domain.Board{
+					{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 1, StartDate: timelineDate(1), DueDate: timelineDate(10)},
+					{BaseModel: domain.BaseModel{ID: uuid.New()}, Number: 2, StartDate: timelineDate(1), DueDate: timelineDate(3)},
+				}
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name: "실패: offsetDays가 0",
+			req:  &dto.RescheduleBoardsRequest{OffsetDays: 0},
+			boards: func() []*domain.Board {
+				return []*domain.Board{{BaseModel: domain.BaseModel{ID: uuid.New()}, DueDate: timelineDate(3)}}
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name: "실패: 보관된 프로젝트",
+			req:  &dto.RescheduleBoardsRequest{OffsetDays: 1},
+			boards: func() []*domain.Board {
+				return []*domain.Board{{BaseModel: domain.BaseModel{ID: uuid.New()}, DueDate: timelineDate(3)}}
+			},
+			archived:    true,
+			wantErrCode: response.ErrCodeProjectArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			boards := tt.boards()
+			for _, board := range boards {
+				tt.req.BoardIDs = append(tt.req.BoardIDs, board.ID)
+			}
+			project := &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, KeyPrefix: "WEB"}
+			if tt.archived {
+				project.ArchivedAt = timelineDate(1)
+			}
+			projectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return project, nil
+				},
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == memberID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+					}
+					return nil, nil
+				},
+			}
+			updated := make(map[uuid.UUID][2]*time.Time)
+			boardRepo := &MockBoardRepository{
+				FindByIDsInProjectFunc: func(ctx context.Context, pID uuid.UUID, ids []uuid.UUID) ([]*domain.Board, error) {
+					return boards, nil
+				},
+				UpdateDatesFunc: func(ctx context.Context, boardID uuid.UUID, startDate, dueDate *time.Time) error {
+					updated[boardID] = [2]*time.Time{startDate, dueDate}
+					return nil
+				},
+			}
+			store := newOutboxTestStore()
+			outbox := NewOutbox(store, outboxTestTx{}, nil, &recordingBroadcaster{}, nil, zap.NewNop())
+			svc := NewTimelineService(boardRepo, projectRepo, &MockFieldOptionRepository{}, &MockFieldOptionConverter{}, outbox, zap.NewNop())
+
+			// When
+			result, err := svc.RescheduleBoards(context.Background(), projectID, memberID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				assertAppErrorCode(t, err, tt.wantErrCode)
+				if len(updated) != 0 {
+					t.Errorf("updated %d boards, want none", len(updated))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RescheduleBoards() error = %v", err)
+			}
+			if len(updated) != tt.wantUpdated || len(result.Boards) != tt.wantUpdated || len(result.Skipped) != tt.wantSkipped {
+				t.Errorf("updated = %d, boards = %d, skipped = %d; want %d, %d, %d",
+					len(updated), len(result.Boards), len(result.Skipped), tt.wantUpdated, tt.wantUpdated, tt.wantSkipped)
+			}
+			if dates := updated[boards[0].ID]; !dates[0].Equal(*timelineDate(8)) || !dates[1].Equal(*timelineDate(12)) {
+				t.Errorf("first board dates = %v ~ %v, want 1/8 ~ 1/12", dates[0], dates[1])
+			}
+
+			events := store.sorted()
+			if len(events) != 1 || events[0].Topic != domain.OutboxTopicBroadcast || events[0].AggregateType != domain.OutboxAggregateProject {
+				t.Fatalf("outbox events = %+v, want one project broadcast", events)
+			}
+			var event dto.BoardEvent
+			if err := json.Unmarshal(events[0].Payload, &event); err != nil || event.Type != domain.WebhookEventBoardsRescheduled {
+				t.Errorf("broadcast type = %s (err %v), want %s", event.Type, err, domain.WebhookEventBoardsRescheduled)
+			}
+		})
+	}
+}
+
+// assertAppErrorCode fails the test unless err is an AppError with the code
+func assertAppErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != code {
+		t.Errorf("error = %v, want %s", err, code)
+	}
+}
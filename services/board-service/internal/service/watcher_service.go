@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WatcherService defines the interface for watching, unwatching and muting boards and projects
+// Watching is a personal preference, so it is allowed for any project member even in archived projects
+type WatcherService interface {
+	GetBoardWatchStatus(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error)
+	GetBoardWatchers(ctx context.Context, boardID, userID uuid.UUID) ([]*dto.WatcherResponse, error)
+	WatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error)
+	UnwatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error)
+	MuteBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error)
+	UnmuteBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error)
+	GetProjectWatchStatus(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error)
+	WatchProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error)
+	UnwatchProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error)
+}
+
+// watcherServiceImpl is the implementation of WatcherService
+type watcherServiceImpl struct {
+	watcherRepo repository.WatcherRepository
+	boardRepo   repository.BoardRepository
+	projectRepo repository.ProjectRepository
+}
+
+// NewWatcherService creates a new instance of WatcherService
+func NewWatcherService(watcherRepo repository.WatcherRepository, boardRepo repository.BoardRepository, projectRepo repository.ProjectRepository) WatcherService {
+	return &watcherServiceImpl{
+		watcherRepo: watcherRepo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// GetBoardWatchStatus returns how the requester is subscribed to a board
+func (s *watcherServiceImpl) GetBoardWatchStatus(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.boardStatus(ctx, board, userID)
+}
+
+// GetBoardWatchers returns the users explicitly or automatically watching a board
+// Assignee, participants and project watchers are notified as well but are not listed
+func (s *watcherServiceImpl) GetBoardWatchers(ctx context.Context, boardID, userID uuid.UUID) ([]*dto.WatcherResponse, error) {
+	if _, err := s.findBoard(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	watchers, err := s.watcherRepo.FindByTarget(ctx, domain.WatchTargetBoard, boardID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch watchers", err.Error())
+	}
+	responses := make([]*dto.WatcherResponse, len(watchers))
+	for i, w := range watchers {
+		responses[i] = &dto.WatcherResponse{
+			UserID:    w.UserID,
+			Reason:    string(w.Reason),
+			CreatedAt: w.CreatedAt,
+		}
+	}
+	return responses, nil
+}
+
+// WatchBoard subscribes the requester to a board; an automatic watch is kept with its reason
+func (s *watcherServiceImpl) WatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &domain.Watcher{
+		TargetType: domain.WatchTargetBoard,
+		TargetID:   boardID,
+		UserID:     userID,
+		Reason:     domain.WatchReasonManual,
+	}
+	if _, err := s.watcherRepo.Watch(ctx, watcher); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to watch board", err.Error())
+	}
+	return s.boardStatus(ctx, board, userID)
+}
+
+// UnwatchBoard removes the requester's board watch
+// Assignees, participants and project watchers keep receiving notifications until they mute the board
+func (s *watcherServiceImpl) UnwatchBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.watcherRepo.Unwatch(ctx, domain.WatchTargetBoard, boardID, userID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to unwatch board", err.Error())
+	}
+	return s.boardStatus(ctx, board, userID)
+}
+
+// MuteBoard silences every notification of a board for the requester
+func (s *watcherServiceImpl) MuteBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.watcherRepo.Mute(ctx, boardID, userID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to mute board", err.Error())
+	}
+	return s.boardStatus(ctx, board, userID)
+}
+
+// UnmuteBoard restores the notifications of a board for the requester
+func (s *watcherServiceImpl) UnmuteBoard(ctx context.Context, boardID, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	board, err := s.findBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.watcherRepo.Unmute(ctx, boardID, userID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to unmute board", err.Error())
+	}
+	return s.boardStatus(ctx, board, userID)
+}
+
+// GetProjectWatchStatus returns whether the requester watches every board of a project
+func (s *watcherServiceImpl) GetProjectWatchStatus(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, userID); err != nil {
+		return nil, err
+	}
+	return s.projectStatus(ctx, projectID, userID)
+}
+
+// WatchProject subscribes the requester to every board of a project
+func (s *watcherServiceImpl) WatchProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	watcher := &domain.Watcher{
+		TargetType: domain.WatchTargetProject,
+		TargetID:   projectID,
+		UserID:     userID,
+		Reason:     domain.WatchReasonManual,
+	}
+	if _, err := s.watcherRepo.Watch(ctx, watcher); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to watch project", err.Error())
+	}
+	return s.projectStatus(ctx, projectID, userID)
+}
+
+// UnwatchProject removes the requester's project watch; board watches are kept
+func (s *watcherServiceImpl) UnwatchProject(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.watcherRepo.Unwatch(ctx, domain.WatchTargetProject, projectID, userID); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to unwatch project", err.Error())
+	}
+	return s.projectStatus(ctx, projectID, userID)
+}
+
+// findBoard fetches a board and verifies that the requester is a member of its project
+func (s *watcherServiceImpl) findBoard(ctx context.Context, boardID, userID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if _, err := findRequesterMember(ctx, s.projectRepo, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+// boardStatus combines the board watch, project watch, involvement and mute of the requester
+func (s *watcherServiceImpl) boardStatus(ctx context.Context, board *domain.Board, userID uuid.UUID) (*dto.BoardWatchStatusResponse, error) {
+	status := &dto.BoardWatchStatusResponse{BoardID: board.ID}
+
+	watcher, err := s.watcherRepo.FindWatcher(ctx, domain.WatchTargetBoard, board.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch watch status", err.Error())
+	}
+	if watcher != nil {
+		status.Watching = true
+		status.Reason = string(watcher.Reason)
+	}
+
+	projectStatus, err := s.projectStatus(ctx, board.ProjectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	status.WatchingProject = projectStatus.Watching
+
+	for _, id := range implicitBoardAudience(board) {
+		if id == userID {
+			status.Involved = true
+			break
+		}
+	}
+
+	status.Muted, err = s.watcherRepo.IsMuted(ctx, board.ID, userID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch mute status", err.Error())
+	}
+	status.Notified = !status.Muted && (status.Watching || status.WatchingProject || status.Involved)
+	return status, nil
+}
+
+// projectStatus returns whether the requester watches the project
+func (s *watcherServiceImpl) projectStatus(ctx context.Context, projectID, userID uuid.UUID) (*dto.ProjectWatchStatusResponse, error) {
+	watcher, err := s.watcherRepo.FindWatcher(ctx, domain.WatchTargetProject, projectID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch watch status", err.Error())
+	}
+	return &dto.ProjectWatchStatusResponse{
+		ProjectID: projectID,
+		Watching:  watcher != nil,
+	}, nil
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// Webhook request headers
+const (
+	WebhookHeaderEvent     = "X-Wealist-Event"
+	WebhookHeaderDelivery  = "X-Wealist-Delivery"
+	WebhookHeaderSignature = "X-Wealist-Signature"
+)
+
+// Webhook delivery retry policy
+// A failed attempt n is retried after webhookRetryBaseDelay * 2^(n-1) (capped at webhookRetryMaxDelay)
+// and the delivery becomes DEAD after webhookMaxAttempts failed attempts (about an hour after the event)
+const (
+	webhookMaxAttempts     = 8
+	webhookRetryBaseDelay  = 30 * time.Second
+	webhookRetryMaxDelay   = time.Hour
+	webhookRequestTimeout  = 10 * time.Second
+	webhookRetryBatchSize  = 100
+	webhookMaxErrorBodyLen = 512
+)
+
+// webhookAttemptLease is how long an in-flight attempt keeps a delivery away from the retry job
+const webhookAttemptLease = 2 * time.Minute
+
+// WebhookDispatcher sends board events to the outgoing webhooks of a project
+type WebhookDispatcher interface {
+	// Publish records a delivery for every matching subscription and sends them in the background
+	Publish(ctx context.Context, projectID uuid.UUID, eventType, boardID string, data interface{})
+	// Deliver performs one delivery attempt and stores the outcome on the delivery
+	Deliver(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) error
+	// RetryDue re-attempts deliveries whose next attempt is due and returns how many were attempted
+	RetryDue(ctx context.Context) (int, error)
+}
+
+// webhookDispatcherImpl is the implementation of WebhookDispatcher
+type webhookDispatcherImpl struct {
+	webhookRepo repository.WebhookRepository
+	httpClient  *http.Client
+	logger      *zap.Logger
+	now         func() time.Time
+}
+
+// NewWebhookDispatcher creates a new instance of WebhookDispatcher
+// NewWebhookHTTPClient(false) is used when httpClient is nil
+func NewWebhookDispatcher(webhookRepo repository.WebhookRepository, httpClient *http.Client, logger *zap.Logger) WebhookDispatcher {
+	if httpClient == nil {
+		httpClient = NewWebhookHTTPClient(false)
+	}
+	return &webhookDispatcherImpl{
+		webhookRepo: webhookRepo,
+		httpClient:  httpClient,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// errWebhookTargetBlocked is returned when a webhook URL resolves to an address that must not be reached
+var errWebhookTargetBlocked = errors.New("webhook target address is not allowed")
+
+// NewWebhookHTTPClient creates the HTTP client used for webhook deliveries
+// Unless allowPrivateTargets is set, connections to loopback, link-local, private and unique-local
+// addresses are refused at dial time, after DNS resolution, so a receiver host that later resolves to
+// an internal address is still blocked. Redirects are never followed; a 3xx response counts as a failure
+func NewWebhookHTTPClient(allowPrivateTargets bool) *http.Client {
+	dialer := &net.Dialer{Timeout: webhookRequestTimeout}
+	if !allowPrivateTargets {
+		dialer.Control = guardWebhookDial
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   webhookRequestTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// guardWebhookDial rejects connections to addresses that webhooks must not reach
+func guardWebhookDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicWebhookIP(ip) {
+		return fmt.Errorf("%w: %s", errWebhookTargetBlocked, host)
+	}
+	return nil
+}
+
+// nonPublicWebhookNets are the reserved ranges not covered by the net.IP classification methods
+var nonPublicWebhookNets = func() []*net.IPNet {
+	cidrs := []string{
+		"0.0.0.0/8",      // "this network"
+		"100.64.0.0/10",  // carrier-grade NAT
+		"198.18.0.0/15",  // benchmarking
+		"fc00::/7",       // unique local
+		"64:ff9b::/96",   // NAT64 well-known prefix
+		"64:ff9b:1::/48", // local-use NAT64
+	}
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}()
+
+// isPublicWebhookIP reports whether the address is a globally routable unicast address
+func isPublicWebhookIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, ipNet := range nonPublicWebhookNets {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// SignWebhookPayload returns the X-Wealist-Signature header value for a request body
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the next attempt after the given number of failed attempts
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookRetryMaxDelay {
+			return webhookRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// Publish records and sends the event without blocking the caller
+func (d *webhookDispatcherImpl) Publish(ctx context.Context, projectID uuid.UUID, eventType, boardID string, data interface{}) {
+	// Detach from the request context so deliveries keep running after the response is sent
+	bgCtx := context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				d.logger.Error("Webhook publish panicked",
+					zap.String("project.id", projectID.String()),
+					zap.String("event.type", eventType),
+					zap.Any("panic", r))
+			}
+		}()
+		d.publish(bgCtx, projectID, eventType, boardID, data)
+	}()
+}
+
+// publish creates one delivery per matching subscription and attempts each of them once
+// Deliveries that fail here are picked up by RetryDue
+func (d *webhookDispatcherImpl) publish(ctx context.Context, projectID uuid.UUID, eventType, boardID string, data interface{}) {
+	subscriptions, err := d.webhookRepo.FindEnabledSubscriptions(ctx, projectID)
+	if err != nil {
+		d.logger.Warn("Failed to fetch webhook subscriptions",
+			zap.String("project.id", projectID.String()),
+			zap.Error(err))
+		return
+	}
+
+	matched := make([]*domain.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if webhookSubscribes(subscription, eventType) {
+			matched = append(matched, subscription)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		d.logger.Warn("Failed to encode webhook event data", zap.String("event.type", eventType), zap.Error(err))
+		return
+	}
+	now := d.now()
+	eventID := uuid.New()
+	payload, _ := json.Marshal(dto.WebhookEventPayload{
+		ID:         eventID,
+		Type:       eventType,
+		ProjectID:  projectID,
+		BoardID:    boardID,
+		OccurredAt: now.UTC(),
+		Data:       dataBytes,
+	})
+
+	for _, subscription := range matched {
+		// The first attempt happens right below; the lease keeps the retry job away meanwhile
+		leaseUntil := now.Add(webhookAttemptLease)
+		delivery := &domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			ProjectID:      projectID,
+			EventID:        eventID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  &leaseUntil,
+		}
+		if err := d.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+			d.logger.Warn("Failed to record webhook delivery",
+				zap.String("webhook.id", subscription.ID.String()),
+				zap.String("event.type", eventType),
+				zap.Error(err))
+			continue
+		}
+		if err := d.Deliver(ctx, subscription, delivery); err != nil {
+			d.logger.Warn("Failed to store webhook delivery result",
+				zap.String("delivery.id", delivery.ID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// Deliver POSTs the stored payload to the subscription URL and records the outcome
+// A 2xx answer marks the delivery SUCCEEDED; anything else schedules a retry or, once
+// webhookMaxAttempts is reached, moves the delivery to the DEAD state
+// The returned error only reports failures to store the outcome
+func (d *webhookDispatcherImpl) Deliver(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) error {
+	statusCode, sendErr := d.send(ctx, subscription, delivery)
+
+	now := d.now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	if sendErr == nil {
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.LastError = sendErr.Error()
+		if delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = domain.WebhookDeliveryDead
+			delivery.NextAttemptAt = nil
+			d.logger.Warn("Webhook delivery moved to dead letter",
+				zap.String("delivery.id", delivery.ID.String()),
+				zap.String("webhook.id", subscription.ID.String()),
+				zap.Int("attempts", delivery.Attempts),
+				zap.Error(sendErr))
+		} else {
+			next := now.Add(webhookBackoff(delivery.Attempts))
+			delivery.Status = domain.WebhookDeliveryRetrying
+			delivery.NextAttemptAt = &next
+		}
+	}
+
+	return d.webhookRepo.UpdateDelivery(ctx, delivery)
+}
+
+// send performs the HTTP request and returns the response status code (0 when no response)
+func (d *webhookDispatcherImpl) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("invalid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "weAlist-Webhook/1.0")
+	req.Header.Set(WebhookHeaderEvent, delivery.EventType)
+	req.Header.Set(WebhookHeaderDelivery, delivery.ID.String())
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(subscription.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBodyLen))
+	return resp.StatusCode, fmt.Errorf("receiver responded %d: %s", resp.StatusCode, string(respBody))
+}
+
+// RetryDue attempts deliveries whose next attempt time has passed
+// Each delivery is claimed first so that concurrent replicas never send it twice
+func (d *webhookDispatcherImpl) RetryDue(ctx context.Context) (int, error) {
+	now := d.now()
+	deliveries, err := d.webhookRepo.FindDueDeliveries(ctx, now, webhookRetryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[uuid.UUID]*domain.WebhookSubscription)
+	attempted := 0
+	for _, delivery := range deliveries {
+		claimed, err := d.webhookRepo.ClaimDueDelivery(ctx, delivery.ID, now, now.Add(webhookAttemptLease))
+		if err != nil {
+			return attempted, err
+		}
+		if !claimed {
+			continue
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = d.webhookRepo.FindSubscriptionByID(ctx, delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return attempted, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+		if subscription == nil || !subscription.Enabled {
+			// Disabled subscriptions do not receive retries; admins can redeliver after enabling
+			delivery.Status = domain.WebhookDeliveryDead
+			delivery.NextAttemptAt = nil
+			delivery.LastError = "webhook is disabled"
+			if err := d.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+				return attempted, err
+			}
+			continue
+		}
+
+		if err := d.Deliver(ctx, subscription, delivery); err != nil {
+			return attempted, err
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// webhookSubscribes checks whether the subscription filters in the event type (empty filter = all events)
+func webhookSubscribes(subscription *domain.WebhookSubscription, eventType string) bool {
+	eventTypes := decodeWebhookEventTypes(subscription.EventTypes)
+	if len(eventTypes) == 0 {
+		return true
+	}
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// Delivery log page size limits
+const (
+	defaultWebhookDeliveryLimit = 50
+	maxWebhookDeliveryLimit     = 200
+)
+
+// WebhookService defines the interface for outgoing webhook management
+type WebhookService interface {
+	GetWebhooks(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.WebhookResponse, error)
+	CreateWebhook(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+	UpdateWebhook(ctx context.Context, projectID, requesterID, webhookID uuid.UUID, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, projectID, requesterID, webhookID uuid.UUID) error
+	GetDeliveries(ctx context.Context, projectID, requesterID, webhookID uuid.UUID, limit int) ([]*dto.WebhookDeliveryResponse, error)
+	Redeliver(ctx context.Context, projectID, requesterID, webhookID, deliveryID uuid.UUID) (*dto.WebhookDeliveryResponse, error)
+}
+
+// webhookServiceImpl is the implementation of WebhookService
+type webhookServiceImpl struct {
+	webhookRepo repository.WebhookRepository
+	projectRepo repository.ProjectRepository
+	dispatcher  WebhookDispatcher
+}
+
+// NewWebhookService creates a new instance of WebhookService
+func NewWebhookService(webhookRepo repository.WebhookRepository, projectRepo repository.ProjectRepository, dispatcher WebhookDispatcher) WebhookService {
+	return &webhookServiceImpl{
+		webhookRepo: webhookRepo,
+		projectRepo: projectRepo,
+		dispatcher:  dispatcher,
+	}
+}
+
+// GetWebhooks retrieves all webhooks of a project (requires webhook.manage)
+func (s *webhookServiceImpl) GetWebhooks(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.WebhookResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.webhookRepo.FindSubscriptionsByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhooks", err.Error())
+	}
+
+	responses := make([]*dto.WebhookResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		responses[i] = toWebhookResponse(subscription)
+	}
+	return responses, nil
+}
+
+// CreateWebhook creates a new webhook (requires webhook.manage)
+// The signing secret is returned only in this response
+func (s *webhookServiceImpl) CreateWebhook(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := toWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to generate webhook secret", err.Error())
+		}
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	subscription := &domain.WebhookSubscription{
+		ProjectID:  projectID,
+		Name:       req.Name,
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: encodeWebhookEventTypes(eventTypes),
+		Enabled:    enabled,
+		CreatedBy:  requesterID,
+	}
+
+	if err := s.webhookRepo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create webhook", err.Error())
+	}
+
+	resp := toWebhookResponse(subscription)
+	resp.Secret = secret
+	return resp, nil
+}
+
+// UpdateWebhook updates a webhook (requires webhook.manage)
+func (s *webhookServiceImpl) UpdateWebhook(ctx context.Context, projectID, requesterID, webhookID uuid.UUID, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.findSubscription(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		subscription.Name = *req.Name
+	}
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *req.URL
+	}
+	if req.EventTypes != nil {
+		eventTypes, err := toWebhookEventTypes(*req.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		subscription.EventTypes = encodeWebhookEventTypes(eventTypes)
+	}
+	if req.Secret != nil {
+		subscription.Secret = *req.Secret
+	}
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+
+	if err := s.webhookRepo.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update webhook", err.Error())
+	}
+
+	return toWebhookResponse(subscription), nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log (requires webhook.manage)
+func (s *webhookServiceImpl) DeleteWebhook(ctx context.Context, projectID, requesterID, webhookID uuid.UUID) error {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	if _, err := s.findSubscription(ctx, projectID, webhookID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(ctx, webhookID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete webhook", err.Error())
+	}
+	return nil
+}
+
+// GetDeliveries retrieves the most recent deliveries of a webhook (requires webhook.manage)
+func (s *webhookServiceImpl) GetDeliveries(ctx context.Context, projectID, requesterID, webhookID uuid.UUID, limit int) ([]*dto.WebhookDeliveryResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findSubscription(ctx, projectID, webhookID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit > maxWebhookDeliveryLimit {
+		limit = maxWebhookDeliveryLimit
+	}
+
+	deliveries, err := s.webhookRepo.FindDeliveriesBySubscriptionID(ctx, webhookID, limit)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhook deliveries", err.Error())
+	}
+
+	responses := make([]*dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = toWebhookDeliveryResponse(delivery)
+	}
+	return responses, nil
+}
+
+// Redeliver sends a stored delivery again right away with the same payload (requires webhook.manage)
+// The attempt counter is reset so a failed redelivery gets a full retry schedule again
+func (s *webhookServiceImpl) Redeliver(ctx context.Context, projectID, requesterID, webhookID, deliveryID uuid.UUID) (*dto.WebhookDeliveryResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.findSubscription(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookRepo.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Webhook delivery not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhook delivery", err.Error())
+	}
+	if delivery.SubscriptionID != subscription.ID {
+		return nil, response.NewNotFoundError("Webhook delivery not found", "")
+	}
+
+	delivery.Attempts = 0
+	if err := s.dispatcher.Deliver(ctx, subscription, delivery); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to store webhook delivery", err.Error())
+	}
+
+	return toWebhookDeliveryResponse(delivery), nil
+}
+
+// checkManage verifies that the requester holds the webhook.manage permission
+func (s *webhookServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionWebhookManage, "You do not have permission to manage webhooks")
+}
+
+// findSubscription fetches a webhook and ensures it belongs to the project
+func (s *webhookServiceImpl) findSubscription(ctx context.Context, projectID, webhookID uuid.UUID) (*domain.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.FindSubscriptionByID(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Webhook not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhook", err.Error())
+	}
+	if subscription.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Webhook not found", "")
+	}
+	return subscription, nil
+}
+
+// validateWebhookURL checks that the URL is an absolute http(s) URL
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return response.NewValidationError(fmt.Sprintf("Invalid webhook URL: %s", rawURL), "")
+	}
+	return nil
+}
+
+// toWebhookEventTypes validates the event type filter and removes duplicates
+func toWebhookEventTypes(eventTypes []string) ([]string, error) {
+	result := make([]string, 0, len(eventTypes))
+	seen := make(map[string]bool, len(eventTypes))
+	for _, t := range eventTypes {
+		if !isValidWebhookEventType(t) {
+			return nil, response.NewValidationError(fmt.Sprintf("Invalid webhook event type: %s", t), "")
+		}
+		if !seen[t] {
+			seen[t] = true
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+// isValidWebhookEventType checks if the event type can be subscribed to
+func isValidWebhookEventType(eventType string) bool {
+	switch eventType {
+	case domain.WebhookEventBoardCreated, domain.WebhookEventBoardUpdated,
+		domain.WebhookEventBoardMoved, domain.WebhookEventBoardDeleted,
+		domain.WebhookEventBoardsRescheduled, domain.WebhookEventSprintUpdated:
+		return true
+	default:
+		return false
+	}
+}
+
+// generateWebhookSecret creates a random signing secret
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// encodeWebhookEventTypes converts event types to datatypes.JSON (nil when empty)
+func encodeWebhookEventTypes(eventTypes []string) datatypes.JSON {
+	if len(eventTypes) == 0 {
+		return nil
+	}
+	jsonBytes, _ := json.Marshal(eventTypes)
+	return jsonBytes
+}
+
+// decodeWebhookEventTypes converts datatypes.JSON to event types (empty slice on invalid data)
+func decodeWebhookEventTypes(data datatypes.JSON) []string {
+	eventTypes := make([]string, 0)
+	if len(data) == 0 {
+		return eventTypes
+	}
+	_ = json.Unmarshal(data, &eventTypes)
+	return eventTypes
+}
+
+// toWebhookResponse converts a domain subscription to a response DTO (without the secret)
+func toWebhookResponse(subscription *domain.WebhookSubscription) *dto.WebhookResponse {
+	return &dto.WebhookResponse{
+		WebhookID:  subscription.ID,
+		ProjectID:  subscription.ProjectID,
+		Name:       subscription.Name,
+		URL:        subscription.URL,
+		EventTypes: decodeWebhookEventTypes(subscription.EventTypes),
+		Enabled:    subscription.Enabled,
+		CreatedBy:  subscription.CreatedBy,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+// toWebhookDeliveryResponse converts a domain delivery to a response DTO
+func toWebhookDeliveryResponse(delivery *domain.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	return &dto.WebhookDeliveryResponse{
+		DeliveryID:     delivery.ID,
+		WebhookID:      delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payload:        json.RawMessage(delivery.Payload),
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// webhookReceiver is a local HTTP receiver that records requests and answers with a configurable status
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	status := r.status
+	r.mu.Unlock()
+	w.WriteHeader(status)
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func newTestWebhookDispatcher(store *webhookTestStore, now *time.Time) *webhookDispatcherImpl {
+	d := NewWebhookDispatcher(store.repo(), NewWebhookHTTPClient(true), zap.NewNop()).(*webhookDispatcherImpl)
+	d.now = func() time.Time { return *now }
+	return d
+}
+
+func TestWebhookDispatcher_Publish(t *testing.T) {
+	// Given
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	boardID := uuid.New()
+	allEvents := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID, URL: server.URL, Secret: "all-events-secret-123", Enabled: true,
+	}
+	movedOnly := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID, URL: server.URL, Secret: "moved-only-secret-123", Enabled: true,
+		EventTypes: encodeWebhookEventTypes([]string{domain.WebhookEventBoardMoved}),
+	}
+	store := newWebhookTestStore(allEvents, movedOnly)
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	dispatcher := newTestWebhookDispatcher(store, &now)
+
+	// When
+	dispatcher.publish(context.Background(), projectID, domain.WebhookEventBoardCreated, boardID.String(), map[string]string{"title": "새 보드"})
+
+	// Then
+	if receiver.count() != 1 {
+		t.Fatalf("receiver got %d requests, want 1 (event type filter)", receiver.count())
+	}
+	req, body := receiver.requests[0], receiver.bodies[0]
+	if got, want := req.Header.Get(WebhookHeaderSignature), SignWebhookPayload(allEvents.Secret, body); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+	if got := req.Header.Get(WebhookHeaderEvent); got != domain.WebhookEventBoardCreated {
+		t.Errorf("event header = %q, want %q", got, domain.WebhookEventBoardCreated)
+	}
+
+	var payload dto.WebhookEventPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("payload is not valid JSON: %v", err)
+	}
+	if payload.ProjectID != projectID || payload.BoardID != boardID.String() || payload.Type != domain.WebhookEventBoardCreated {
+		t.Errorf("payload = %+v, want project %s board %s", payload, projectID, boardID)
+	}
+
+	delivery := store.onlyDelivery(t)
+	if req.Header.Get(WebhookHeaderDelivery) != delivery.ID.String() {
+		t.Errorf("delivery header = %q, want %q", req.Header.Get(WebhookHeaderDelivery), delivery.ID)
+	}
+	if delivery.Status != domain.WebhookDeliverySucceeded || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusOK {
+		t.Errorf("delivery = %s/%d/%d, want SUCCEEDED/1/200", delivery.Status, delivery.Attempts, delivery.LastStatusCode)
+	}
+	if delivery.EventID != payload.ID {
+		t.Errorf("delivery event ID = %v, want %v", delivery.EventID, payload.ID)
+	}
+}
+
+func TestWebhookDispatcher_RetryBackoffAndDeadLetter(t *testing.T) {
+	// Given: a receiver that always fails
+	receiver := &webhookReceiver{status: http.StatusInternalServerError}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	sub := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID, URL: server.URL, Secret: "retry-secret-12345", Enabled: true,
+	}
+	store := newWebhookTestStore(sub)
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	dispatcher := newTestWebhookDispatcher(store, &now)
+
+	// When: the first attempt fails
+	dispatcher.publish(context.Background(), projectID, domain.WebhookEventBoardDeleted, "", nil)
+
+	// Then: a retry is scheduled with the base delay
+	delivery := store.onlyDelivery(t)
+	if delivery.Status != domain.WebhookDeliveryRetrying || delivery.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("delivery = %s/%d, want RETRYING/500", delivery.Status, delivery.LastStatusCode)
+	}
+	if want := now.Add(webhookRetryBaseDelay); !delivery.NextAttemptAt.Equal(want) {
+		t.Fatalf("next attempt = %v, want %v", delivery.NextAttemptAt, want)
+	}
+
+	// A retry before the backoff elapsed does nothing
+	if attempted, _ := dispatcher.RetryDue(context.Background()); attempted != 0 {
+		t.Fatalf("RetryDue() before backoff attempted %d, want 0", attempted)
+	}
+
+	// Each retry doubles the delay until the delivery goes to the dead letter state
+	for attempt := 2; attempt <= webhookMaxAttempts; attempt++ {
+		expectedDelay := delivery.NextAttemptAt.Sub(now)
+		if wantDelay := webhookBackoff(attempt - 1); expectedDelay != wantDelay {
+			t.Fatalf("delay before attempt %d = %v, want %v", attempt, expectedDelay, wantDelay)
+		}
+		now = *delivery.NextAttemptAt
+		attempted, err := dispatcher.RetryDue(context.Background())
+		if err != nil || attempted != 1 {
+			t.Fatalf("RetryDue() attempt %d = %d, %v", attempt, attempted, err)
+		}
+	}
+
+	if delivery.Status != domain.WebhookDeliveryDead || delivery.NextAttemptAt != nil {
+		t.Errorf("delivery status = %s, want DEAD without next attempt", delivery.Status)
+	}
+	if delivery.Attempts != webhookMaxAttempts || receiver.count() != webhookMaxAttempts {
+		t.Errorf("attempts = %d, requests = %d, want %d", delivery.Attempts, receiver.count(), webhookMaxAttempts)
+	}
+	if webhookBackoff(20) != webhookRetryMaxDelay {
+		t.Errorf("webhookBackoff(20) = %v, want cap %v", webhookBackoff(20), webhookRetryMaxDelay)
+	}
+}
+
+func TestWebhookDispatcher_BlocksPrivateTargets(t *testing.T) {
+	// Given: a receiver on loopback and a dispatcher without the private target opt-in
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	sub := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(), URL: server.URL, Secret: "loopback-secret-123", Enabled: true,
+	}
+	store := newWebhookTestStore(sub)
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	dispatcher := newTestWebhookDispatcher(store, &now)
+	dispatcher.httpClient = NewWebhookHTTPClient(false)
+
+	// When
+	dispatcher.publish(context.Background(), sub.ProjectID, domain.WebhookEventBoardCreated, uuid.New().String(), nil)
+
+	// Then
+	if receiver.count() != 0 {
+		t.Fatalf("receiver got %d requests, want 0", receiver.count())
+	}
+	delivery := store.onlyDelivery(t)
+	if delivery.Status != domain.WebhookDeliveryRetrying || !strings.Contains(delivery.LastError, errWebhookTargetBlocked.Error()) {
+		t.Errorf("delivery = %s/%q, want RETRYING with a blocked target error", delivery.Status, delivery.LastError)
+	}
+}
+
+func TestWebhookDispatcher_DoesNotFollowRedirects(t *testing.T) {
+	// Given: a receiver that redirects to another endpoint
+	target := &webhookReceiver{status: http.StatusOK}
+	targetServer := httptest.NewServer(target)
+	defer targetServer.Close()
+	redirector := httptest.NewServer(http.RedirectHandler(targetServer.URL, http.StatusTemporaryRedirect))
+	defer redirector.Close()
+
+	sub := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(), URL: redirector.URL, Secret: "redirect-secret-123", Enabled: true,
+	}
+	store := newWebhookTestStore(sub)
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	dispatcher := newTestWebhookDispatcher(store, &now)
+
+	// When
+	dispatcher.publish(context.Background(), sub.ProjectID, domain.WebhookEventBoardCreated, uuid.New().String(), nil)
+
+	// Then
+	if target.count() != 0 {
+		t.Fatalf("redirect target got %d requests, want 0", target.count())
+	}
+	delivery := store.onlyDelivery(t)
+	if delivery.Status != domain.WebhookDeliveryRetrying || delivery.LastStatusCode != http.StatusTemporaryRedirect {
+		t.Errorf("delivery = %s/%d, want RETRYING/307", delivery.Status, delivery.LastStatusCode)
+	}
+}
+
+func TestIsPublicWebhookIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.0.10", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+		{"0.1.2.3", false},
+		{"100.64.0.1", false},
+		{"100.127.255.254", false},
+		{"100.128.0.1", true},
+		{"198.18.0.1", false},
+		{"198.19.255.254", false},
+		{"198.20.0.1", true},
+		{"fc00::1", false},
+		{"64:ff9b::a9fe:a9fe", false},
+		{"64:ff9b:1::1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := isPublicWebhookIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("isPublicWebhookIP(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookService_Redeliver(t *testing.T) {
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+	sub := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID, URL: server.URL, Secret: "redeliver-secret-1", Enabled: true,
+	}
+	otherSub := &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID, URL: server.URL, Secret: "redeliver-secret-2", Enabled: true,
+	}
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		webhookID   uuid.UUID
+		wantErrCode string
+	}{
+		{name: "성공: DEAD 전송을 재전송", requesterID: adminID, webhookID: sub.ID},
+		{name: "실패: 일반 멤버는 재전송 불가", requesterID: memberID, webhookID: sub.ID, wantErrCode: response.ErrCodeForbidden},
+		{name: "실패: 다른 웹훅의 전송 내역", requesterID: adminID, webhookID: otherSub.ID, wantErrCode: response.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			store := newWebhookTestStore(sub, otherSub)
+			dead := &domain.WebhookDelivery{
+				BaseModel:      domain.BaseModel{ID: uuid.New()},
+				SubscriptionID: sub.ID,
+				ProjectID:      projectID,
+				EventType:      domain.WebhookEventBoardUpdated,
+				Payload:        []byte(`{"type":"BOARD_UPDATED"}`),
+				Status:         domain.WebhookDeliveryDead,
+				Attempts:       webhookMaxAttempts,
+				LastError:      "receiver responded 500",
+			}
+			store.deliveries[dead.ID] = dead
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					role := domain.ProjectRoleMember
+					if uID == adminID {
+						role = domain.ProjectRoleAdmin
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: role}, nil
+				},
+			}
+			now := time.Now()
+			dispatcher := newTestWebhookDispatcher(store, &now)
+			service := NewWebhookService(store.repo(), mockProjectRepo, dispatcher)
+
+			// When
+			got, err := service.Redeliver(context.Background(), projectID, tt.requesterID, tt.webhookID, dead.ID)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Fatalf("Redeliver() error = %v, want %s", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Redeliver() unexpected error = %v", err)
+			}
+			if got.Status != string(domain.WebhookDeliverySucceeded) || got.Attempts != 1 || got.LastError != "" {
+				t.Errorf("Redeliver() = %s/%d/%q, want SUCCEEDED/1/empty", got.Status, got.Attempts, got.LastError)
+			}
+		})
+	}
+}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	projectID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		req         *dto.CreateWebhookRequest
+		wantErrCode string
+	}{
+		{
+			name:        "성공: secret 자동 생성",
+			requesterID: adminID,
+			req:         &dto.CreateWebhookRequest{Name: "CI", URL: "https://example.com/hook", EventTypes: []string{"BOARD_MOVED", "BOARD_MOVED"}},
+		},
+		{
+			name:        "실패: 일반 멤버",
+			requesterID: memberID,
+			req:         &dto.CreateWebhookRequest{Name: "CI", URL: "https://example.com/hook"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: http(s)가 아닌 URL",
+			requesterID: adminID,
+			req:         &dto.CreateWebhookRequest{Name: "CI", URL: "ftp://example.com/hook"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 알 수 없는 이벤트 타입",
+			requesterID: adminID,
+			req:         &dto.CreateWebhookRequest{Name: "CI", URL: "https://example.com/hook", EventTypes: []string{"COMMENT_ADDED"}},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					role := domain.ProjectRoleMember
+					if uID == adminID {
+						role = domain.ProjectRoleAdmin
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: role}, nil
+				},
+			}
+			var created *domain.WebhookSubscription
+			mockWebhookRepo := &MockWebhookRepository{
+				CreateSubscriptionFunc: func(ctx context.Context, subscription *domain.WebhookSubscription) error {
+					subscription.ID = uuid.New()
+					created = subscription
+					return nil
+				},
+			}
+			service := NewWebhookService(mockWebhookRepo, mockProjectRepo, nil)
+
+			// When
+			got, err := service.CreateWebhook(context.Background(), projectID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Fatalf("CreateWebhook() error = %v, want %s", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateWebhook() unexpected error = %v", err)
+			}
+			if created == nil || got.Secret == "" || got.Secret != created.Secret {
+				t.Error("CreateWebhook() should persist and return the generated secret")
+			}
+			if len(got.EventTypes) != 1 || !got.Enabled {
+				t.Errorf("CreateWebhook() eventTypes = %v enabled = %v, want deduplicated and enabled", got.EventTypes, got.Enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// Board fields that can be required by a workflow transition (besides custom field keys)
+const (
+	WorkflowFieldAssignee     = "assignee"
+	WorkflowFieldStartDate    = "startDate"
+	WorkflowFieldDueDate      = "dueDate"
+	WorkflowFieldContent      = "content"
+	WorkflowFieldParticipants = "participants"
+)
+
+// WorkflowService defines the interface for workflow transition business logic
+type WorkflowService interface {
+	GetTransitions(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.WorkflowTransitionResponse, error)
+	CreateTransition(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateWorkflowTransitionRequest) (*dto.WorkflowTransitionResponse, error)
+	UpdateTransition(ctx context.Context, projectID, requesterID, transitionID uuid.UUID, req *dto.UpdateWorkflowTransitionRequest) (*dto.WorkflowTransitionResponse, error)
+	DeleteTransition(ctx context.Context, projectID, requesterID, transitionID uuid.UUID) error
+}
+
+// workflowServiceImpl is the implementation of WorkflowService
+type workflowServiceImpl struct {
+	workflowRepo    repository.WorkflowRepository
+	projectRepo     repository.ProjectRepository
+	fieldOptionRepo repository.FieldOptionRepository
+	roleRepo        repository.ProjectRoleRepository
+}
+
+// NewWorkflowService creates a new instance of WorkflowService
+func NewWorkflowService(workflowRepo repository.WorkflowRepository, projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, roleRepo repository.ProjectRoleRepository) WorkflowService {
+	return &workflowServiceImpl{
+		workflowRepo:    workflowRepo,
+		projectRepo:     projectRepo,
+		fieldOptionRepo: fieldOptionRepo,
+		roleRepo:        roleRepo,
+	}
+}
+
+// GetTransitions retrieves all workflow transitions of a project (any project member)
+func (s *workflowServiceImpl) GetTransitions(ctx context.Context, projectID, requesterID uuid.UUID) ([]*dto.WorkflowTransitionResponse, error) {
+	if _, err := findRequesterMember(ctx, s.projectRepo, projectID, requesterID); err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.workflowRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch workflow transitions", err.Error())
+	}
+
+	responses := make([]*dto.WorkflowTransitionResponse, len(transitions))
+	for i, t := range transitions {
+		responses[i] = toWorkflowTransitionResponse(t)
+	}
+	return responses, nil
+}
+
+// CreateTransition declares a new allowed transition (requires workflow.manage)
+func (s *workflowServiceImpl) CreateTransition(ctx context.Context, projectID, requesterID uuid.UUID, req *dto.CreateWorkflowTransitionRequest) (*dto.WorkflowTransitionResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	if req.FromStage == req.ToStage {
+		return nil, response.NewValidationError("fromStage and toStage must differ", "")
+	}
+	if req.FromStage != domain.WorkflowAnyStage {
+		if err := s.validateStage(ctx, projectID, req.FromStage); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.validateStage(ctx, projectID, req.ToStage); err != nil {
+		return nil, err
+	}
+	if err := validateWorkflowRequiredFields(req.RequiredFields); err != nil {
+		return nil, err
+	}
+	if err := s.validateRoles(ctx, projectID, req.AllowedRoles); err != nil {
+		return nil, err
+	}
+
+	transition := &domain.WorkflowTransition{
+		ProjectID:      projectID,
+		FromStage:      req.FromStage,
+		ToStage:        req.ToStage,
+		RequiredFields: encodeStringList(req.RequiredFields),
+		AllowedRoles:   encodeStringList(req.AllowedRoles),
+	}
+
+	if err := s.workflowRepo.Create(ctx, transition); err != nil {
+		if isUniqueViolation(err) {
+			return nil, response.NewAlreadyExistsError("Transition already exists", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create workflow transition", err.Error())
+	}
+
+	return toWorkflowTransitionResponse(transition), nil
+}
+
+// UpdateTransition updates the required fields and role restrictions of a transition (requires workflow.manage)
+func (s *workflowServiceImpl) UpdateTransition(ctx context.Context, projectID, requesterID, transitionID uuid.UUID, req *dto.UpdateWorkflowTransitionRequest) (*dto.WorkflowTransitionResponse, error) {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return nil, err
+	}
+
+	transition, err := s.findTransition(ctx, projectID, transitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.RequiredFields != nil {
+		if err := validateWorkflowRequiredFields(*req.RequiredFields); err != nil {
+			return nil, err
+		}
+		transition.RequiredFields = encodeStringList(*req.RequiredFields)
+	}
+	if req.AllowedRoles != nil {
+		if err := s.validateRoles(ctx, projectID, *req.AllowedRoles); err != nil {
+			return nil, err
+		}
+		transition.AllowedRoles = encodeStringList(*req.AllowedRoles)
+	}
+
+	if err := s.workflowRepo.Update(ctx, transition); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update workflow transition", err.Error())
+	}
+
+	return toWorkflowTransitionResponse(transition), nil
+}
+
+// DeleteTransition removes a transition (requires workflow.manage)
+func (s *workflowServiceImpl) DeleteTransition(ctx context.Context, projectID, requesterID, transitionID uuid.UUID) error {
+	if err := s.checkManage(ctx, projectID, requesterID); err != nil {
+		return err
+	}
+	if err := ensureProjectWritable(ctx, s.projectRepo, projectID); err != nil {
+		return err
+	}
+
+	if _, err := s.findTransition(ctx, projectID, transitionID); err != nil {
+		return err
+	}
+
+	if err := s.workflowRepo.Delete(ctx, transitionID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete workflow transition", err.Error())
+	}
+	return nil
+}
+
+// checkManage verifies that the requester holds the workflow.manage permission
+func (s *workflowServiceImpl) checkManage(ctx context.Context, projectID, requesterID uuid.UUID) error {
+	return requirePermission(ctx, s.projectRepo, projectID, requesterID, domain.PermissionWorkflowManage, "You do not have permission to manage workflow transitions")
+}
+
+// findTransition fetches a transition and ensures it belongs to the project
+func (s *workflowServiceImpl) findTransition(ctx context.Context, projectID, transitionID uuid.UUID) (*domain.WorkflowTransition, error) {
+	transition, err := s.workflowRepo.FindByID(ctx, transitionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Transition not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch workflow transition", err.Error())
+	}
+	if transition.ProjectID != projectID {
+		return nil, response.NewNotFoundError("Transition not found", "")
+	}
+	return transition, nil
+}
+
+// validateStage checks that the stage value exists as a field option of the project
+func (s *workflowServiceImpl) validateStage(ctx context.Context, projectID uuid.UUID, stage string) error {
+	option, err := s.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(ctx, projectID, domain.FieldTypeStage, stage)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to verify stage", err.Error())
+	}
+	if option == nil {
+		return response.NewValidationError(fmt.Sprintf("Invalid stage value: %s", stage), "")
+	}
+	return nil
+}
+
+// validateWorkflowRequiredFields checks that every required field is a known board field or custom field type
+func validateWorkflowRequiredFields(fields []string) error {
+	for _, f := range fields {
+		switch f {
+		case WorkflowFieldAssignee, WorkflowFieldStartDate, WorkflowFieldDueDate, WorkflowFieldContent, WorkflowFieldParticipants:
+			continue
+		}
+		if !isValidFieldType(domain.FieldType(f)) {
+			return response.NewValidationError(fmt.Sprintf("Invalid required field: %s", f), "")
+		}
+	}
+	return nil
+}
+
+// validateRoles checks that every role is a built-in role or a custom role of the project
+func (s *workflowServiceImpl) validateRoles(ctx context.Context, projectID uuid.UUID, roles []string) error {
+	for _, r := range roles {
+		if domain.IsBuiltinRole(r) {
+			continue
+		}
+		role, err := s.roleRepo.FindByProjectAndName(ctx, projectID, r)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to check project role", err.Error())
+		}
+		if role == nil {
+			return response.NewValidationError(fmt.Sprintf("Invalid role: %s", r), "")
+		}
+	}
+	return nil
+}
+
+// encodeStringList converts a string slice to datatypes.JSON (nil for an empty list)
+func encodeStringList(values []string) datatypes.JSON {
+	if len(values) == 0 {
+		return nil
+	}
+	jsonBytes, _ := json.Marshal(values)
+	return jsonBytes
+}
+
+// decodeStringList converts datatypes.JSON to a string slice (empty slice on invalid data)
+func decodeStringList(data datatypes.JSON) []string {
+	values := make([]string, 0)
+	if len(data) == 0 {
+		return values
+	}
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
+// toWorkflowTransitionResponse converts domain.WorkflowTransition to dto.WorkflowTransitionResponse
+func toWorkflowTransitionResponse(t *domain.WorkflowTransition) *dto.WorkflowTransitionResponse {
+	return &dto.WorkflowTransitionResponse{
+		TransitionID:   t.ID,
+		ProjectID:      t.ProjectID,
+		FromStage:      t.FromStage,
+		ToStage:        t.ToStage,
+		RequiredFields: decodeStringList(t.RequiredFields),
+		AllowedRoles:   decodeStringList(t.AllowedRoles),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
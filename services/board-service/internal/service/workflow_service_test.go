@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestWorkflowService_CreateTransition(t *testing.T) {
+	projectID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+
+	tests := []struct {
+		name        string
+		requesterID uuid.UUID
+		req         *dto.CreateWorkflowTransitionRequest
+		createErr   error
+		wantErrCode string
+	}{
+		{
+			name:        "성공: ADMIN이 전환 규칙 생성",
+			requesterID: adminID,
+			req: &dto.CreateWorkflowTransitionRequest{
+				FromStage:      "pending",
+				ToStage:        "in_progress",
+				RequiredFields: []string{"assignee", "importance"},
+				AllowedRoles:   []string{"ADMIN"},
+			},
+		},
+		{
+			name:        "성공: 와일드카드 fromStage",
+			requesterID: adminID,
+			req:         &dto.CreateWorkflowTransitionRequest{FromStage: "*", ToStage: "review"},
+		},
+		{
+			name:        "실패: MEMBER는 규칙을 만들 수 없음",
+			requesterID: memberID,
+			req:         &dto.CreateWorkflowTransitionRequest{FromStage: "pending", ToStage: "review"},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:        "실패: 같은 stage 간 전환",
+			requesterID: adminID,
+			req:         &dto.CreateWorkflowTransitionRequest{FromStage: "review", ToStage: "review"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 존재하지 않는 stage",
+			requesterID: adminID,
+			req:         &dto.CreateWorkflowTransitionRequest{FromStage: "pending", ToStage: "unknown"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 알 수 없는 필수 필드",
+			requesterID: adminID,
+			req: &dto.CreateWorkflowTransitionRequest{
+				FromStage:      "pending",
+				ToStage:        "review",
+				RequiredFields: []string{"reviewer"},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "실패: 잘못된 역할",
+			requesterID: adminID,
+			req: &dto.CreateWorkflowTransitionRequest{
+				FromStage:    "pending",
+				ToStage:      "review",
+				AllowedRoles: []string{"GUEST"},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:        "성공: 프로젝트의 커스텀 역할",
+			requesterID: adminID,
+			req: &dto.CreateWorkflowTransitionRequest{
+				FromStage:    "pending",
+				ToStage:      "review",
+				AllowedRoles: []string{"ADMIN", "Reviewer"},
+			},
+		},
+		{
+			name:        "실패: 중복 규칙",
+			requesterID: adminID,
+			req:         &dto.CreateWorkflowTransitionRequest{FromStage: "pending", ToStage: "review"},
+			createErr:   errors.New("duplicate key value violates unique constraint"),
+			wantErrCode: response.ErrCodeAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == adminID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+					}
+					return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+				},
+			}
+			mockFieldOptionRepo := &MockFieldOptionRepository{
+				FindByProjectAndFieldTypeAndValueFunc: func(ctx context.Context, pID uuid.UUID, fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
+					if value == "unknown" {
+						return nil, nil
+					}
+					return &domain.FieldOption{FieldType: fieldType, Value: value}, nil
+				},
+			}
+			mockRoleRepo := &MockProjectRoleRepository{
+				FindByProjectAndNameFunc: func(ctx context.Context, pID uuid.UUID, name string) (*domain.ProjectCustomRole, error) {
+					if pID == projectID && name == "Reviewer" {
+						return &domain.ProjectCustomRole{ProjectID: pID, Name: name}, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+			}
+			var created *domain.WorkflowTransition
+			mockWorkflowRepo := &MockWorkflowRepository{
+				CreateFunc: func(ctx context.Context, transition *domain.WorkflowTransition) error {
+					if tt.createErr != nil {
+						return tt.createErr
+					}
+					transition.ID = uuid.New()
+					created = transition
+					return nil
+				},
+			}
+			service := NewWorkflowService(mockWorkflowRepo, mockProjectRepo, mockFieldOptionRepo, mockRoleRepo)
+
+			// When
+			got, err := service.CreateTransition(context.Background(), projectID, tt.requesterID, tt.req)
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("CreateTransition() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("CreateTransition() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTransition() unexpected error = %v", err)
+			}
+			if created == nil || got.TransitionID != created.ID {
+				t.Fatal("CreateTransition() did not persist the transition")
+			}
+			if got.FromStage != tt.req.FromStage || got.ToStage != tt.req.ToStage {
+				t.Errorf("CreateTransition() stages = %s->%s, want %s->%s", got.FromStage, got.ToStage, tt.req.FromStage, tt.req.ToStage)
+			}
+			if len(got.RequiredFields) != len(tt.req.RequiredFields) {
+				t.Errorf("CreateTransition() RequiredFields = %v, want %v", got.RequiredFields, tt.req.RequiredFields)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DeleteTransition_OtherProject(t *testing.T) {
+	projectID := uuid.New()
+	adminID := uuid.New()
+
+	mockProjectRepo := &MockProjectRepository{
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleOwner}, nil
+		},
+	}
+	deleted := false
+	mockWorkflowRepo := &MockWorkflowRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.WorkflowTransition, error) {
+			return &domain.WorkflowTransition{BaseModel: domain.BaseModel{ID: id}, ProjectID: uuid.New()}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = true
+			return nil
+		},
+	}
+	service := NewWorkflowService(mockWorkflowRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockProjectRoleRepository{})
+
+	err := service.DeleteTransition(context.Background(), projectID, adminID, uuid.New())
+
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeNotFound {
+		t.Fatalf("DeleteTransition() error = %v, want NOT_FOUND", err)
+	}
+	if deleted {
+		t.Error("DeleteTransition() must not delete a transition of another project")
+	}
+}
+
+func TestBoardService_UpdateBoard_WorkflowTransition(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	adminID := uuid.New()
+	memberID := uuid.New()
+	reviewerID := uuid.New()
+
+	stringList := func(values ...string) datatypes.JSON {
+		b, _ := json.Marshal(values)
+		return b
+	}
+
+	tests := []struct {
+		name        string
+		actorID     uuid.UUID
+		assigneeID  *uuid.UUID
+		toStage     string
+		transitions []*domain.WorkflowTransition
+		wantErrCode string
+	}{
+		{
+			name:    "성공: 규칙이 없으면 자유롭게 이동",
+			actorID: memberID,
+			toStage: "approved",
+		},
+		{
+			name:    "성공: 허용된 전환",
+			actorID: memberID,
+			toStage: "review",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "review"},
+			},
+		},
+		{
+			name:    "성공: 와일드카드 전환",
+			actorID: memberID,
+			toStage: "review",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: domain.WorkflowAnyStage, ToStage: "review"},
+			},
+		},
+		{
+			name:    "실패: 정의되지 않은 전환",
+			actorID: memberID,
+			toStage: "approved",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "review"},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:    "실패: 필수 필드(담당자) 누락",
+			actorID: memberID,
+			toStage: "review",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "review", RequiredFields: stringList("assignee")},
+			},
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name:       "성공: 필수 필드 충족",
+			actorID:    memberID,
+			assigneeID: &memberID,
+			toStage:    "review",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "review", RequiredFields: stringList("assignee")},
+			},
+		},
+		{
+			name:    "실패: 허용되지 않은 역할",
+			actorID: memberID,
+			toStage: "approved",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "approved", AllowedRoles: stringList("OWNER", "ADMIN")},
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+		{
+			name:    "성공: 허용된 역할",
+			actorID: adminID,
+			toStage: "approved",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "approved", AllowedRoles: stringList("OWNER", "ADMIN")},
+			},
+		},
+		{
+			name:    "성공: 허용된 커스텀 역할",
+			actorID: reviewerID,
+			toStage: "approved",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "approved", AllowedRoles: stringList("ADMIN", "Reviewer")},
+			},
+		},
+		{
+			name:    "실패: 커스텀 역할이 허용되지 않음",
+			actorID: reviewerID,
+			toStage: "approved",
+			transitions: []*domain.WorkflowTransition{
+				{ProjectID: projectID, FromStage: "in_progress", ToStage: "approved", AllowedRoles: stringList("ADMIN")},
+			},
+			wantErrCode: response.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			updated := false
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					customFieldsJSON, _ := json.Marshal(map[string]interface{}{"stage": "in_progress"})
+					return &domain.Board{
+						BaseModel:    domain.BaseModel{ID: boardID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+						ProjectID:    projectID,
+						Title:        "Test Board",
+						AssigneeID:   tt.assigneeID,
+						CustomFields: customFieldsJSON,
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					updated = true
+					return nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+					if uID == adminID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleAdmin}, nil
+					}
+					if uID == memberID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember}, nil
+					}
+					if uID == reviewerID {
+						return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: domain.ProjectRoleMember,
+							CustomRole: &domain.ProjectCustomRole{ProjectID: pID, Name: "Reviewer"}}, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+			}
+			mockWorkflowRepo := &MockWorkflowRepository{
+				FindByProjectIDFunc: func(ctx context.Context, pID uuid.UUID) ([]*domain.WorkflowTransition, error) {
+					return tt.transitions, nil
+				},
+			}
+			logger, _ := zap.NewDevelopment()
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, mockWorkflowRepo, nil, nil, &MockFieldOptionConverter{}, nil, nil, nil, nil, nil, nil, logger)
+
+			ctx := context.WithValue(context.Background(), "user_id", tt.actorID)
+			customFields := map[string]interface{}{"stage": tt.toStage}
+
+			// When
+			_, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{CustomFields: &customFields})
+
+			// Then
+			if tt.wantErrCode != "" {
+				appErr, ok := err.(*response.AppError)
+				if !ok {
+					t.Fatalf("UpdateBoard() error = %v, want AppError %s", err, tt.wantErrCode)
+				}
+				if appErr.Code != tt.wantErrCode {
+					t.Errorf("UpdateBoard() error code = %v, want %v", appErr.Code, tt.wantErrCode)
+				}
+				if updated {
+					t.Error("UpdateBoard() must not persist a rejected transition")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateBoard() unexpected error = %v", err)
+			}
+			if !updated {
+				t.Error("UpdateBoard() did not persist the board")
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// defaultWorkloadWeight is the weight of a board without a value for the weight field
+const defaultWorkloadWeight = 1
+
+// WorkloadService defines the interface for the workspace workload view
+type WorkloadService interface {
+	GetWorkload(ctx context.Context, workspaceID, requesterID uuid.UUID, query *dto.WorkloadQuery, token string) (*dto.WorkloadResponse, error)
+}
+
+// workloadServiceImpl is the implementation of WorkloadService
+type workloadServiceImpl struct {
+	boardRepo            repository.BoardRepository
+	projectRepo          repository.ProjectRepository
+	fieldOptionRepo      repository.FieldOptionRepository
+	fieldOptionConverter converter.FieldOptionConverter
+	userClient           client.UserClient
+	logger               *zap.Logger
+	now                  func() time.Time
+}
+
+// NewWorkloadService creates a new instance of WorkloadService
+func NewWorkloadService(
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	fieldOptionRepo repository.FieldOptionRepository,
+	fieldOptionConverter converter.FieldOptionConverter,
+	userClient client.UserClient,
+	logger *zap.Logger,
+) WorkloadService {
+	return &workloadServiceImpl{
+		boardRepo:            boardRepo,
+		projectRepo:          projectRepo,
+		fieldOptionRepo:      fieldOptionRepo,
+		fieldOptionConverter: fieldOptionConverter,
+		userClient:           userClient,
+		logger:               logger,
+		now:                  time.Now,
+	}
+}
+
+// GetWorkload aggregates the open boards of every project the requester can see in the workspace
+// (public projects and projects they are a member of) per assignee and week
+func (s *workloadServiceImpl) GetWorkload(ctx context.Context, workspaceID, requesterID uuid.UUID, query *dto.WorkloadQuery, token string) (*dto.WorkloadResponse, error) {
+	if query == nil {
+		query = &dto.WorkloadQuery{}
+	}
+	weeks := query.Weeks
+	if weeks == 0 {
+		weeks = dto.DefaultWorkloadWeeks
+	}
+	if weeks < 1 || weeks > dto.MaxWorkloadWeeks {
+		return nil, response.NewValidationError("weeks must be between 1 and 26", "")
+	}
+	weightField := query.WeightField
+	if weightField == "" {
+		weightField = dto.DefaultWorkloadWeightField
+	}
+	from := s.now()
+	if query.From != nil {
+		from = *query.From
+	}
+	from = startOfWeek(from)
+
+	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, requesterID, token)
+	if err != nil || !isValid {
+		return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
+	}
+
+	projects, err := s.visibleProjects(ctx, workspaceID, requesterID, query.ProjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.WorkloadResponse{
+		WorkspaceID: workspaceID,
+		WeightField: weightField,
+		From:        from,
+		To:          from.AddDate(0, 0, 7*weeks),
+		Weeks:       make([]time.Time, weeks),
+		Projects:    make([]dto.WorkloadProjectResponse, 0, len(projects)),
+		Assignees:   []dto.WorkloadAssigneeResponse{},
+	}
+	for i := range resp.Weeks {
+		resp.Weeks[i] = from.AddDate(0, 0, 7*i)
+	}
+
+	projectByID := make(map[uuid.UUID]*domain.Project, len(projects))
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	for _, project := range projects {
+		projectByID[project.ID] = project
+		projectIDs = append(projectIDs, project.ID)
+		resp.Projects = append(resp.Projects, dto.WorkloadProjectResponse{
+			ProjectID: project.ID,
+			Name:      project.Name,
+			KeyPrefix: project.KeyPrefix,
+		})
+	}
+
+	boards, err := s.boardRepo.FindAssignedByProjectIDs(ctx, projectIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	stages, err := loadBoardStages(ctx, s.fieldOptionConverter, boards)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch stage options", err.Error())
+	}
+
+	assigneeFilter := make(map[uuid.UUID]bool, len(query.AssigneeIDs))
+	byAssignee := make(map[uuid.UUID]*dto.WorkloadAssigneeResponse)
+	for _, assigneeID := range query.AssigneeIDs {
+		assigneeFilter[assigneeID] = true
+		byAssignee[assigneeID] = newWorkloadAssignee(assigneeID, resp.Weeks)
+	}
+
+	weights := make(map[uuid.UUID]map[string]int)
+	for _, board := range boards {
+		if board.AssigneeID == nil || stages.isBoardClosed(board) {
+			continue
+		}
+		if len(assigneeFilter) > 0 && !assigneeFilter[*board.AssigneeID] {
+			continue
+		}
+
+		projectWeights, ok := weights[board.ProjectID]
+		if !ok {
+			projectWeights, err = s.loadWeights(ctx, board.ProjectID, weightField)
+			if err != nil {
+				return nil, err
+			}
+			weights[board.ProjectID] = projectWeights
+		}
+
+		item := toWorkloadBoard(board, projectByID[board.ProjectID], weightField, projectWeights)
+		assignee, ok := byAssignee[*board.AssigneeID]
+		if !ok {
+			assignee = newWorkloadAssignee(*board.AssigneeID, resp.Weeks)
+			byAssignee[*board.AssigneeID] = assignee
+		}
+		addToWorkload(assignee, item, from)
+	}
+
+	for _, assignee := range byAssignee {
+		sortWorkloadBoards(assignee.Boards)
+		resp.Assignees = append(resp.Assignees, *assignee)
+	}
+	sort.Slice(resp.Assignees, func(i, j int) bool {
+		a, b := resp.Assignees[i], resp.Assignees[j]
+		if a.TotalWeight != b.TotalWeight {
+			return a.TotalWeight > b.TotalWeight
+		}
+		if a.BoardCount != b.BoardCount {
+			return a.BoardCount > b.BoardCount
+		}
+		return a.AssigneeID.String() < b.AssigneeID.String()
+	})
+
+	s.logger.Debug("Workload aggregated",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.String("user.id", requesterID.String()),
+		zap.Int("project.count", len(projects)),
+		zap.Int("assignee.count", len(resp.Assignees)))
+	return resp, nil
+}
+
+// visibleProjects returns the active projects of the workspace that are public or have the requester as a member
+// When projectIDs is given, only those projects are kept
+func (s *workloadServiceImpl) visibleProjects(ctx context.Context, workspaceID, requesterID uuid.UUID, projectIDs []uuid.UUID) ([]*domain.Project, error) {
+	projects, err := s.projectRepo.FindByWorkspaceID(ctx, workspaceID, false)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch projects", err.Error())
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(projectIDs))
+	for _, id := range projectIDs {
+		wanted[id] = true
+	}
+
+	var candidates []*domain.Project
+	var privateIDs []uuid.UUID
+	for _, project := range projects {
+		if len(wanted) > 0 && !wanted[project.ID] {
+			continue
+		}
+		candidates = append(candidates, project)
+		if !project.IsPublic {
+			privateIDs = append(privateIDs, project.ID)
+		}
+	}
+
+	memberIDs, err := s.projectRepo.FindMemberProjectIDs(ctx, requesterID, privateIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	}
+	isMember := make(map[uuid.UUID]bool, len(memberIDs))
+	for _, id := range memberIDs {
+		isMember[id] = true
+	}
+
+	visible := make([]*domain.Project, 0, len(candidates))
+	for _, project := range candidates {
+		if project.IsPublic || isMember[project.ID] {
+			visible = append(visible, project)
+		}
+	}
+	return visible, nil
+}
+
+// loadWeights maps the option values of the weight field of a project to their weight
+// The first option in display order weighs as many points as there are options, the last one 1
+func (s *workloadServiceImpl) loadWeights(ctx context.Context, projectID uuid.UUID, weightField string) (map[string]int, error) {
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, domain.FieldType(weightField))
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+	}
+	weights := make(map[string]int, len(options))
+	for i, option := range options {
+		weights[option.Value] = len(options) - i
+	}
+	return weights, nil
+}
+
+// newWorkloadAssignee creates an empty workload with one bucket per week
+func newWorkloadAssignee(assigneeID uuid.UUID, weeks []time.Time) *dto.WorkloadAssigneeResponse {
+	assignee := &dto.WorkloadAssigneeResponse{
+		AssigneeID:  assigneeID,
+		Buckets:     make([]dto.WorkloadBucketResponse, len(weeks)),
+		Overdue:     dto.WorkloadSummaryResponse{BoardIDs: []uuid.UUID{}},
+		Unscheduled: dto.WorkloadSummaryResponse{BoardIDs: []uuid.UUID{}},
+		Boards:      []dto.WorkloadBoardResponse{},
+	}
+	for i, weekStart := range weeks {
+		assignee.Buckets[i] = dto.WorkloadBucketResponse{WeekStart: weekStart, BoardIDs: []uuid.UUID{}}
+	}
+	return assignee
+}
+
+// toWorkloadBoard converts a board to a workload board with its weight
+func toWorkloadBoard(board *domain.Board, project *domain.Project, weightField string, weights map[string]int) dto.WorkloadBoardResponse {
+	item := dto.WorkloadBoardResponse{
+		BoardID:     board.ID,
+		ProjectID:   board.ProjectID,
+		Title:       board.Title,
+		Stage:       customFieldValue(board, string(domain.FieldTypeStage)),
+		WeightValue: customFieldValue(board, weightField),
+		Weight:      defaultWorkloadWeight,
+		StartDate:   board.StartDate,
+		DueDate:     board.DueDate,
+	}
+	if project != nil {
+		item.Key = domain.FormatBoardKey(project.KeyPrefix, board.Number)
+	}
+	if weight, ok := weights[item.WeightValue]; ok {
+		item.Weight = weight
+	}
+	return item
+}
+
+// addToWorkload counts a board in the totals of the assignee and in every week its date range touches
+func addToWorkload(assignee *dto.WorkloadAssigneeResponse, item dto.WorkloadBoardResponse, from time.Time) {
+	assignee.BoardCount++
+	assignee.TotalWeight += item.Weight
+	assignee.Boards = append(assignee.Boards, item)
+
+	barStart, barEnd := item.StartDate, item.DueDate
+	if barStart == nil {
+		barStart = barEnd
+	}
+	if barEnd == nil {
+		barEnd = barStart
+	}
+	if barStart == nil {
+		addToSummary(&assignee.Unscheduled, item)
+		return
+	}
+
+	startDay, endDay := startOfDay(*barStart), startOfDay(*barEnd)
+	if endDay.Before(from) {
+		addToSummary(&assignee.Overdue, item)
+		return
+	}
+	for i := range assignee.Buckets {
+		bucket := &assignee.Buckets[i]
+		weekEnd := bucket.WeekStart.AddDate(0, 0, 7)
+		if startDay.Before(weekEnd) && !endDay.Before(bucket.WeekStart) {
+			bucket.BoardCount++
+			bucket.Weight += item.Weight
+			bucket.BoardIDs = append(bucket.BoardIDs, item.BoardID)
+		}
+	}
+}
+
+// addToSummary counts a board in a summary outside of the weekly buckets
+func addToSummary(summary *dto.WorkloadSummaryResponse, item dto.WorkloadBoardResponse) {
+	summary.BoardCount++
+	summary.Weight += item.Weight
+	summary.BoardIDs = append(summary.BoardIDs, item.BoardID)
+}
+
+// sortWorkloadBoards orders boards by due date (boards without one last), then by title
+func sortWorkloadBoards(boards []dto.WorkloadBoardResponse) {
+	sort.SliceStable(boards, func(i, j int) bool {
+		a, b := boards[i], boards[j]
+		if a.DueDate != nil && b.DueDate != nil && !a.DueDate.Equal(*b.DueDate) {
+			return a.DueDate.Before(*b.DueDate)
+		}
+		if (a.DueDate == nil) != (b.DueDate == nil) {
+			return a.DueDate != nil
+		}
+		return a.Title < b.Title
+	})
+}
+
+// startOfDay truncates a time to midnight UTC
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// startOfWeek returns the Monday (UTC) of the week of t
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestWorkloadService_GetWorkload(t *testing.T) {
+	workspaceID := uuid.New()
+	requesterID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+
+	publicProject := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "Web", KeyPrefix: "WEB", IsPublic: true}
+	memberProject := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "App", KeyPrefix: "APP"}
+	hiddenProject := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "Secret", KeyPrefix: "SEC"}
+
+	fields := func(stage, importance string) datatypes.JSON {
+		values := map[string]interface{}{"stage": stage}
+		if importance != "" {
+			values["importance"] = importance
+		}
+		data, _ := json.Marshal(values)
+		return data
+	}
+	board := func(project *domain.Project, number int64, title string, assigneeID uuid.UUID, stage, importance string, start, due int) *domain.Board {
+		b := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, Number: number, Title: title,
+			AssigneeID: &assigneeID, CustomFields: fields(stage, importance)}
+		if start > 0 {
+			b.StartDate = timelineDate(start)
+		}
+		if due > 0 {
+			b.DueDate = timelineDate(due)
+		}
+		return b
+	}
+	login := board(publicProject, 1, "login", aliceID, "in_progress", "urgent", 9, 16)
+	push := board(memberProject, 1, "push", aliceID, "pending", "low", 0, 20)
+	done := board(publicProject, 2, "done", aliceID, "approved", "urgent", 9, 10)
+	backlog := board(publicProject, 3, "backlog", aliceID, "pending", "", 0, 0)
+	late := board(publicProject, 4, "late", bobID, "review", "high", 0, 3)
+	secret := board(hiddenProject, 1, "secret", carolID, "pending", "urgent", 9, 10)
+
+	newService := func(isWorkspaceMember bool) WorkloadService {
+		userClient := &MockUserClient{
+			ValidateWorkspaceMemberFunc: func(ctx context.Context, wID, uID uuid.UUID, token string) (bool, error) {
+				return isWorkspaceMember, nil
+			},
+		}
+		projectRepo := &MockProjectRepository{
+			FindByWorkspaceIDFunc: func(ctx context.Context, wID uuid.UUID, includeArchived bool) ([]*domain.Project, error) {
+				return []*domain.Project{publicProject, memberProject, hiddenProject}, nil
+			},
+			FindMemberProjectIDsFunc: func(ctx context.Context, uID uuid.UUID, projectIDs []uuid.UUID) ([]uuid.UUID, error) {
+				var ids []uuid.UUID
+				for _, id := range projectIDs {
+					if id == memberProject.ID {
+						ids = append(ids, id)
+					}
+				}
+				return ids, nil
+			},
+		}
+		boardRepo := &MockBoardRepository{
+			FindAssignedByProjectIDsFunc: func(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.Board, error) {
+				visible := make(map[uuid.UUID]bool)
+				for _, id := range projectIDs {
+					visible[id] = true
+				}
+				var boards []*domain.Board
+				for _, b := range []*domain.Board{login, push, done, backlog, late, secret} {
+					if visible[b.ProjectID] {
+						boards = append(boards, b)
+					}
+				}
+				return boards, nil
+			},
+		}
+		fieldOptionRepo := &MockFieldOptionRepository{
+			FindByProjectAndFieldTypeFunc: func(ctx context.Context, pID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+				return []*domain.FieldOption{{Value: "urgent"}, {Value: "high"}, {Value: "normal"}, {Value: "low"}}, nil
+			},
+		}
+		return NewWorkloadService(boardRepo, projectRepo, fieldOptionRepo, &MockFieldOptionConverter{}, userClient, zap.NewNop())
+	}
+
+	t.Run("성공: 담당자별 주간 집계와 가중치", func(t *testing.T) {
+		workload, err := newService(true).GetWorkload(context.Background(), workspaceID, requesterID,
+			&dto.WorkloadQuery{From: timelineDate(10), Weeks: 2}, "token")
+		if err != nil {
+			t.Fatalf("GetWorkload() error = %v", err)
+		}
+
+		if !workload.From.Equal(*timelineDate(8)) || !workload.To.Equal(*timelineDate(22)) {
+			t.Errorf("Range = %v ~ %v, want 1/8 ~ 1/22", workload.From, workload.To)
+		}
+		if workload.WeightField != dto.DefaultWorkloadWeightField {
+			t.Errorf("WeightField = %s, want importance", workload.WeightField)
+		}
+		if len(workload.Projects) != 2 {
+			t.Errorf("len(Projects) = %d, want 2 (hidden project excluded)", len(workload.Projects))
+		}
+		if len(workload.Assignees) != 2 {
+			t.Fatalf("len(Assignees) = %d, want 2", len(workload.Assignees))
+		}
+
+		alice := workload.Assignees[0]
+		if alice.AssigneeID != aliceID || alice.BoardCount != 3 || alice.TotalWeight != 6 {
+			t.Errorf("alice = %d boards / weight %d, want 3 / 6", alice.BoardCount, alice.TotalWeight)
+		}
+		if alice.Buckets[0].Weight != 4 || alice.Buckets[0].BoardCount != 1 {
+			t.Errorf("alice week 1 = %+v, want login only (weight 4)", alice.Buckets[0])
+		}
+		if alice.Buckets[1].Weight != 5 || alice.Buckets[1].BoardCount != 2 {
+			t.Errorf("alice week 2 = %+v, want login and push (weight 5)", alice.Buckets[1])
+		}
+		if alice.Unscheduled.BoardCount != 1 || alice.Unscheduled.BoardIDs[0] != backlog.ID || alice.Unscheduled.Weight != 1 {
+			t.Errorf("alice unscheduled = %+v, want backlog with default weight", alice.Unscheduled)
+		}
+		if alice.Boards[0].Key != "WEB-1" || alice.Boards[1].Key != "APP-1" {
+			t.Errorf("alice boards = %s, %s, want WEB-1 then APP-1", alice.Boards[0].Key, alice.Boards[1].Key)
+		}
+
+		bob := workload.Assignees[1]
+		if bob.AssigneeID != bobID || bob.Overdue.BoardCount != 1 || bob.Overdue.Weight != 3 {
+			t.Errorf("bob overdue = %+v, want late (weight 3)", bob.Overdue)
+		}
+	})
+
+	t.Run("성공: 요청한 담당자만 포함하고 업무가 없어도 반환", func(t *testing.T) {
+		workload, err := newService(true).GetWorkload(context.Background(), workspaceID, requesterID,
+			&dto.WorkloadQuery{From: timelineDate(10), AssigneeIDs: []uuid.UUID{bobID, carolID}}, "token")
+		if err != nil {
+			t.Fatalf("GetWorkload() error = %v", err)
+		}
+		if len(workload.Assignees) != 2 || workload.Assignees[0].AssigneeID != bobID {
+			t.Fatalf("Assignees = %+v, want bob then carol", workload.Assignees)
+		}
+		carol := workload.Assignees[1]
+		if carol.BoardCount != 0 || len(carol.Buckets) != dto.DefaultWorkloadWeeks {
+			t.Errorf("carol = %d boards / %d buckets, want 0 / %d", carol.BoardCount, len(carol.Buckets), dto.DefaultWorkloadWeeks)
+		}
+	})
+
+	t.Run("실패: 워크스페이스 멤버가 아님", func(t *testing.T) {
+		_, err := newService(false).GetWorkload(context.Background(), workspaceID, requesterID, &dto.WorkloadQuery{}, "token")
+		assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	})
+
+	t.Run("실패: 주 수 범위 초과", func(t *testing.T) {
+		_, err := newService(true).GetWorkload(context.Background(), workspaceID, requesterID, &dto.WorkloadQuery{Weeks: dto.MaxWorkloadWeeks + 1}, "token")
+		assertAppErrorCode(t, err, response.ErrCodeValidation)
+	})
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+// cachedUserClient decorates a UserClient with a Redis-backed workspace profile cache.
+// user-service 의 프로필 무효화 이벤트를 받으면 해당 사용자의 캐시를 즉시 삭제합니다.
+type cachedUserClient struct {
+	UserClient
+	cache *commonclient.ProfileCache
+}
+
+// NewCachedUserClient wraps a UserClient with a workspace profile cache.
+// The invalidation listener runs until ctx is cancelled.
+func NewCachedUserClient(ctx context.Context, inner UserClient, rdb *redis.Client, ttl time.Duration, logger *zap.Logger) UserClient {
+	cache := commonclient.NewProfileCache(rdb, "chat", ttl, logger)
+	go cache.ListenForInvalidations(ctx)
+
+	return &cachedUserClient{
+		UserClient: inner,
+		cache:      cache,
+	}
+}
+
+// GetWorkspaceProfiles serves cached profiles and fetches only the misses in one batch
+func (c *cachedUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	profiles, missing := c.cache.GetMany(ctx, workspaceID, uniqueUserIDs(userIDs))
+	if len(missing) == 0 {
+		return profiles, nil
+	}
+
+	fetched, err := c.UserClient.GetWorkspaceProfiles(ctx, workspaceID, missing, token)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.SetMany(ctx, workspaceID, fetched)
+
+	for userID, profile := range fetched {
+		profiles[userID] = profile
+	}
+	return profiles, nil
+}
@@ -14,6 +14,7 @@ import (
 // UserClient defines the interface for User API interactions
 type UserClient interface {
 	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+	GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error)
 }
 
 // userClient implements UserClient interface using common HTTP client
@@ -58,3 +59,61 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 
 	return isValid, nil
 }
+
+// maxProfileBatchSize는 user-service /internal/profiles/batch 가 허용하는 최대 사용자 수입니다.
+const maxProfileBatchSize = 500
+
+type batchProfileRequest struct {
+	WorkspaceID uuid.UUID   `json:"workspaceId"`
+	UserIDs     []uuid.UUID `json:"userIds"`
+}
+
+type batchProfileResponse struct {
+	Profiles []commonclient.WorkspaceProfile `json:"profiles"`
+}
+
+// GetWorkspaceProfiles retrieves workspace profiles of several users with batch requests.
+// Users without a profile are missing from the returned map; on failure an empty map is returned.
+func (c *userClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	profiles := make(map[uuid.UUID]*commonclient.WorkspaceProfile, len(userIDs))
+	userIDs = uniqueUserIDs(userIDs)
+	url := c.BuildURL("/internal/profiles/batch")
+
+	for start := 0; start < len(userIDs); start += maxProfileBatchSize {
+		end := min(start+maxProfileBatchSize, len(userIDs))
+		body := batchProfileRequest{WorkspaceID: workspaceID, UserIDs: userIDs[start:end]}
+
+		var response batchProfileResponse
+		if err := c.DoRequestWithBody(ctx, "POST", url, token, body, &response); err != nil {
+			c.Logger.Error("Failed to get workspace profiles",
+				zap.Error(err),
+				zap.String("workspace_id", workspaceID.String()),
+				zap.Int("user_count", end-start),
+			)
+			// Graceful degradation: 프로필 없이 계속 진행
+			return profiles, nil
+		}
+		for i := range response.Profiles {
+			profiles[response.Profiles[i].UserID] = &response.Profiles[i]
+		}
+	}
+
+	return profiles, nil
+}
+
+// uniqueUserIDs removes duplicate and nil IDs while keeping the original order
+func uniqueUserIDs(userIDs []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(userIDs))
+	unique := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
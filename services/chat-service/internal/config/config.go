@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"gopkg.in/yaml.v3"
@@ -25,17 +26,24 @@ type S3Config struct {
 	PublicEndpoint string `yaml:"public_endpoint"` // 브라우저 접근용 공개 엔드포인트 (presigned URL용)
 }
 
+// MessagesConfig holds chat message policy configuration
+type MessagesConfig struct {
+	EditWindow time.Duration `yaml:"edit_window"` // 작성 후 메시지를 수정할 수 있는 기간
+}
+
 // Config contains all configuration for chat-service.
 type Config struct {
 	commonconfig.BaseConfig `yaml:",inline"`
 	Services                ServicesConfig  `yaml:"services"`
 	RateLimit               RateLimitConfig `yaml:"rate_limit"`
 	S3                      S3Config        `yaml:"s3"` // S3 configuration
+	Messages                MessagesConfig  `yaml:"messages"`
 }
 
 // ServicesConfig contains service URLs configuration.
 type ServicesConfig struct {
-	UserServiceURL string `yaml:"user_service_url"`
+	UserServiceURL  string        `yaml:"user_service_url"`
+	ProfileCacheTTL time.Duration `yaml:"profile_cache_ttl"` // Redis 프로필 캐시 TTL
 }
 
 // Load reads configuration from yaml file and environment variables.
@@ -63,6 +71,24 @@ func Load(path string) (*Config, error) {
 	if userURL := os.Getenv("USER_SERVICE_URL"); userURL != "" {
 		cfg.Services.UserServiceURL = userURL
 	}
+	if ttl := os.Getenv("USER_PROFILE_CACHE_TTL"); ttl != "" {
+		if d, err := time.ParseDuration(ttl); err == nil {
+			cfg.Services.ProfileCacheTTL = d
+		}
+	}
+	if cfg.Services.ProfileCacheTTL <= 0 {
+		cfg.Services.ProfileCacheTTL = 5 * time.Minute
+	}
+
+	// Message edit window
+	if window := os.Getenv("MESSAGE_EDIT_WINDOW"); window != "" {
+		if d, err := time.ParseDuration(window); err == nil {
+			cfg.Messages.EditWindow = d
+		}
+	}
+	if cfg.Messages.EditWindow <= 0 {
+		cfg.Messages.EditWindow = 15 * time.Minute
+	}
 
 	// Rate Limit environment variables
 	if rateLimitEnabled := os.Getenv("RATE_LIMIT_ENABLED"); rateLimitEnabled != "" {
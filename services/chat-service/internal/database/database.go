@@ -52,7 +52,9 @@ func NewDB(cfg *config.Config) (*gorm.DB, error) {
 			&domain.Chat{},
 			&domain.ChatParticipant{},
 			&domain.Message{},
+			&domain.MessageEdit{},
 			&domain.MessageRead{},
+			&domain.MessageReaction{},
 			&domain.UserPresence{},
 		); err != nil {
 			return nil, err
@@ -77,6 +79,14 @@ func createIndexes(db *gorm.DB) {
 	db.Exec(`CREATE INDEX IF NOT EXISTS idx_messages_chat_created
 		ON messages (chat_id, created_at DESC)`)
 
+	// Thread replies by root message and time
+	db.Exec(`CREATE INDEX IF NOT EXISTS idx_messages_parent_created
+		ON messages (parent_message_id, created_at) WHERE parent_message_id IS NOT NULL`)
+
+	// Idempotency key for retried sends (per sender)
+	db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_messages_user_client_id
+		ON messages (user_id, client_message_id) WHERE client_message_id IS NOT NULL`)
+
 	// Index for presence
 	db.Exec(`CREATE INDEX IF NOT EXISTS idx_presence_workspace_status
 		ON user_presences (workspace_id, status)`)
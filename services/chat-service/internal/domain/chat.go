@@ -54,12 +54,13 @@ func (Chat) TableName() string {
 
 // ChatParticipant represents a user in a chat
 type ChatParticipant struct {
-	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"participantId"`
-	ChatID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"chatId"`
-	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
-	JoinedAt   time.Time  `gorm:"type:timestamptz;default:now();not null" json:"joinedAt"`
-	LastReadAt *time.Time `gorm:"type:timestamptz" json:"lastReadAt,omitempty"`
-	IsActive   bool       `gorm:"default:true" json:"isActive"`
+	ID         uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"participantId"`
+	ChatID     uuid.UUID    `gorm:"type:uuid;not null;index" json:"chatId"`
+	UserID     uuid.UUID    `gorm:"type:uuid;not null;index" json:"userId"`
+	JoinedAt   time.Time    `gorm:"type:timestamptz;default:now();not null" json:"joinedAt"`
+	LastReadAt *time.Time   `gorm:"type:timestamptz" json:"lastReadAt,omitempty"`
+	IsActive   bool         `gorm:"default:true" json:"isActive"`
+	Profile    *UserSummary `gorm:"-" json:"profile,omitempty"`
 }
 
 func (ChatParticipant) TableName() string {
@@ -67,25 +68,116 @@ func (ChatParticipant) TableName() string {
 }
 
 // Message represents a chat message
+// ClientMessageID is the sender-supplied idempotency key: a retried send with the same key returns the stored message
+// A reply has ParentMessageID set to the thread's root message; replies are not part of the main channel.
+// ReplyCount, LastReplyAt and LastReplyUserID summarize the thread on the root message.
+// Reactions are the aggregated emoji reactions, with ReactedByMe relative to the requesting user.
 type Message struct {
-	ID          uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"messageId"`
-	ChatID      uuid.UUID     `gorm:"type:uuid;not null;index:idx_message_chat_created" json:"chatId"`
-	UserID      uuid.UUID     `gorm:"type:uuid;not null;index" json:"userId"`
-	Content     string        `gorm:"type:text;not null" json:"content"`
-	MessageType MessageType   `gorm:"type:varchar(20);default:'TEXT'" json:"messageType"`
-	FileURL     *string       `gorm:"type:text" json:"fileUrl,omitempty"`
-	FileName    *string       `gorm:"type:varchar(255)" json:"fileName,omitempty"`
-	FileSize    *int64        `gorm:"type:bigint" json:"fileSize,omitempty"`
-	CreatedAt   time.Time     `gorm:"type:timestamptz;default:now();not null;index:idx_message_chat_created" json:"createdAt"`
-	UpdatedAt   time.Time     `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
-	DeletedAt   *time.Time    `gorm:"type:timestamptz;index" json:"deletedAt,omitempty"`
-	Reads       []MessageRead `gorm:"foreignKey:MessageID" json:"reads,omitempty"`
+	ID              uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"messageId"`
+	ChatID          uuid.UUID         `gorm:"type:uuid;not null;index:idx_message_chat_created" json:"chatId"`
+	UserID          uuid.UUID         `gorm:"type:uuid;not null;index" json:"userId"`
+	ClientMessageID *string           `gorm:"type:varchar(64)" json:"clientMessageId,omitempty"`
+	ParentMessageID *uuid.UUID        `gorm:"type:uuid" json:"parentMessageId,omitempty"`
+	QuotedMessageID *uuid.UUID        `gorm:"type:uuid" json:"quotedMessageId,omitempty"`
+	Content         string            `gorm:"type:text;not null" json:"content"`
+	MessageType     MessageType       `gorm:"type:varchar(20);default:'TEXT'" json:"messageType"`
+	FileURL         *string           `gorm:"type:text" json:"fileUrl,omitempty"`
+	FileName        *string           `gorm:"type:varchar(255)" json:"fileName,omitempty"`
+	FileSize        *int64            `gorm:"type:bigint" json:"fileSize,omitempty"`
+	ReplyCount      int               `gorm:"default:0;not null" json:"replyCount"`
+	LastReplyAt     *time.Time        `gorm:"type:timestamptz" json:"lastReplyAt,omitempty"`
+	LastReplyUserID *uuid.UUID        `gorm:"type:uuid" json:"lastReplyUserId,omitempty"`
+	CreatedAt       time.Time         `gorm:"type:timestamptz;default:now();not null;index:idx_message_chat_created" json:"createdAt"`
+	UpdatedAt       time.Time         `gorm:"type:timestamptz;default:now();not null" json:"updatedAt"`
+	EditedAt        *time.Time        `gorm:"type:timestamptz" json:"editedAt,omitempty"`
+	DeletedAt       *time.Time        `gorm:"type:timestamptz;index" json:"deletedAt,omitempty"`
+	Reads           []MessageRead     `gorm:"foreignKey:MessageID" json:"reads,omitempty"`
+	Sender          *UserSummary      `gorm:"-" json:"sender,omitempty"`
+	Quote           *MessageQuote     `gorm:"-" json:"quote,omitempty"`
+	Reactions       []ReactionSummary `gorm:"-" json:"reactions,omitempty"`
 }
 
 func (Message) TableName() string {
 	return "messages"
 }
 
+// MessageQuote is a preview of the message a message quotes
+// Content is empty when the quoted message has been deleted
+type MessageQuote struct {
+	MessageID uuid.UUID `json:"messageId"`
+	UserID    uuid.UUID `json:"userId"`
+	Content   string    `json:"content"`
+	Deleted   bool      `json:"deleted"`
+}
+
+// ThreadSummary is the reply summary of a thread's root message
+type ThreadSummary struct {
+	ParentMessageID uuid.UUID  `json:"parentMessageId"`
+	ReplyCount      int        `json:"replyCount"`
+	LastReplyAt     *time.Time `json:"lastReplyAt,omitempty"`
+	LastReplyUserID *uuid.UUID `json:"lastReplyUserId,omitempty"`
+}
+
+// ThreadResponse represents a thread: its root message and a page of replies (oldest first)
+type ThreadResponse struct {
+	Parent  Message   `json:"parent"`
+	Replies []Message `json:"replies"`
+	HasMore bool      `json:"hasMore"`
+}
+
+// UserSummary is the workspace profile of a message sender or participant, resolved from user-service
+type UserSummary struct {
+	UserID          uuid.UUID `json:"userId"`
+	NickName        string    `json:"nickName"`
+	ProfileImageURL string    `json:"profileImageUrl,omitempty"`
+}
+
+// MessageEdit keeps a prior version of an edited message
+// Content is the message content that the edit at EditedAt replaced
+type MessageEdit struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"editId"`
+	MessageID uuid.UUID `gorm:"type:uuid;not null;index:idx_message_edits_message" json:"messageId"`
+	Content   string    `gorm:"type:text;not null" json:"content"`
+	EditedBy  uuid.UUID `gorm:"type:uuid;not null" json:"editedBy"`
+	EditedAt  time.Time `gorm:"type:timestamptz;default:now();not null" json:"editedAt"`
+}
+
+func (MessageEdit) TableName() string {
+	return "message_edits"
+}
+
+// MaxReactionEmojiLength is the maximum length (in characters) of a reaction emoji
+const MaxReactionEmojiLength = 32
+
+// MessageReaction represents one user's emoji reaction to a message
+// A user can react with the same emoji only once per message
+type MessageReaction struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"reactionId"`
+	MessageID uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_message_user_reaction" json:"messageId"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_message_user_reaction" json:"userId"`
+	Emoji     string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_message_user_reaction" json:"emoji"`
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+}
+
+func (MessageReaction) TableName() string {
+	return "message_reactions"
+}
+
+// ReactionSummary is the aggregated count of one emoji on a message
+type ReactionSummary struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reactedByMe"`
+}
+
+// ReactionCount is an aggregated reaction row of a message, computed for one user
+type ReactionCount struct {
+	MessageID   uuid.UUID
+	Emoji       string
+	Count       int
+	ReactedByMe bool
+}
+
 // MessageRead represents message read status
 type MessageRead struct {
 	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"readId"`
@@ -119,13 +211,29 @@ type CreateChatRequest struct {
 	Participants []uuid.UUID `json:"participants" binding:"required,min=1"`
 }
 
+// MaxClientMessageIDLength is the longest client message ID accepted
+const MaxClientMessageIDLength = 64
+
+// ReactionRequest represents adding a reaction to a message
+type ReactionRequest struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
 // SendMessageRequest represents message sending request
 type SendMessageRequest struct {
-	Content     string      `json:"content" binding:"required"`
-	MessageType MessageType `json:"messageType,omitempty"`
-	FileURL     *string     `json:"fileUrl,omitempty"`
-	FileName    *string     `json:"fileName,omitempty"`
-	FileSize    *int64      `json:"fileSize,omitempty"`
+	ClientMessageID *string     `json:"clientMessageId,omitempty"`
+	ParentMessageID *uuid.UUID  `json:"parentMessageId,omitempty"`
+	QuotedMessageID *uuid.UUID  `json:"quotedMessageId,omitempty"`
+	Content         string      `json:"content" binding:"required"`
+	MessageType     MessageType `json:"messageType,omitempty"`
+	FileURL         *string     `json:"fileUrl,omitempty"`
+	FileName        *string     `json:"fileName,omitempty"`
+	FileSize        *int64      `json:"fileSize,omitempty"`
+}
+
+// EditMessageRequest represents message editing request
+type EditMessageRequest struct {
+	Content string `json:"content" binding:"required"`
 }
 
 // ChatWithUnread represents chat with unread count
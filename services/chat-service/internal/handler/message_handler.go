@@ -4,6 +4,7 @@ import (
 	"chat-service/internal/domain"
 	"chat-service/internal/response"
 	"chat-service/internal/service"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -11,6 +12,8 @@ import (
 	"go.uber.org/zap"
 )
 
+// MessageHandler serves the /messages routes
+// The :id path parameter is the chat ID on chat routes and the message ID on message routes
 type MessageHandler struct {
 	chatService *service.ChatService
 	logger      *zap.Logger
@@ -27,7 +30,7 @@ func NewMessageHandler(chatService *service.ChatService, logger *zap.Logger) *Me
 func (h *MessageHandler) GetMessages(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 
-	chatID, err := uuid.Parse(c.Param("chatId"))
+	chatID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, "Invalid chat ID")
 		return
@@ -48,7 +51,7 @@ func (h *MessageHandler) GetMessages(c *gin.Context) {
 		}
 	}
 
-	messages, err := h.chatService.GetMessages(c.Request.Context(), chatID, limit, before)
+	messages, err := h.chatService.GetMessages(c.Request.Context(), chatID, userID, limit, before)
 	if err != nil {
 		h.logger.Error("failed to get messages", zap.Error(err))
 		response.InternalError(c, "Failed to get messages")
@@ -62,7 +65,7 @@ func (h *MessageHandler) GetMessages(c *gin.Context) {
 func (h *MessageHandler) SendMessage(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 
-	chatID, err := uuid.Parse(c.Param("chatId"))
+	chatID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, "Invalid chat ID")
 		return
@@ -75,7 +78,7 @@ func (h *MessageHandler) SendMessage(c *gin.Context) {
 	}
 
 	// Service layer validates participant and message content
-	message, err := h.chatService.SendMessage(c.Request.Context(), chatID, userID, &req)
+	message, created, err := h.chatService.SendMessage(c.Request.Context(), chatID, userID, &req)
 	if err != nil {
 		h.logger.Error("failed to send message",
 			zap.String("chat_id", chatID.String()),
@@ -84,6 +87,11 @@ func (h *MessageHandler) SendMessage(c *gin.Context) {
 		return
 	}
 
+	// A retried send (same clientMessageId) returns the stored message
+	if !created {
+		c.JSON(http.StatusOK, message)
+		return
+	}
 	response.Created(c, message)
 }
 
@@ -91,7 +99,7 @@ func (h *MessageHandler) SendMessage(c *gin.Context) {
 func (h *MessageHandler) DeleteMessage(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 
-	messageID, err := uuid.Parse(c.Param("messageId"))
+	messageID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, "Invalid message ID")
 		return
@@ -109,6 +117,125 @@ func (h *MessageHandler) DeleteMessage(c *gin.Context) {
 	response.NoContent(c)
 }
 
+// EditMessage edits the content of a message (author only, within the edit window)
+func (h *MessageHandler) EditMessage(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	messageID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid message ID")
+		return
+	}
+
+	var req domain.EditMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Service layer validates ownership, the edit window and keeps the prior version
+	message, err := h.chatService.EditMessage(c.Request.Context(), messageID, userID, &req)
+	if err != nil {
+		h.logger.Error("failed to edit message",
+			zap.String("message_id", messageID.String()),
+			zap.Error(err))
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, message)
+}
+
+// GetMessageEdits returns the prior versions of a message (chat participants only)
+func (h *MessageHandler) GetMessageEdits(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	messageID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid message ID")
+		return
+	}
+
+	edits, err := h.chatService.GetMessageEdits(c.Request.Context(), messageID, userID)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, edits)
+}
+
+// GetThread returns a thread's root message and a page of its replies, oldest first (chat participants only)
+func (h *MessageHandler) GetThread(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	messageID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid message ID")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	var before *uuid.UUID
+	if beforeStr := c.Query("before"); beforeStr != "" {
+		if b, err := uuid.Parse(beforeStr); err == nil {
+			before = &b
+		}
+	}
+
+	thread, err := h.chatService.GetThread(c.Request.Context(), messageID, userID, limit, before)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, thread)
+}
+
+// AddReaction adds the user's emoji reaction to a message and returns the message's reactions
+func (h *MessageHandler) AddReaction(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	messageID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid message ID")
+		return
+	}
+
+	var req domain.ReactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	reactions, err := h.chatService.AddReaction(c.Request.Context(), messageID, userID, req.Emoji)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, reactions)
+}
+
+// RemoveReaction removes the user's emoji reaction from a message and returns the message's reactions
+func (h *MessageHandler) RemoveReaction(c *gin.Context) {
+	userID := c.MustGet("user_id").(uuid.UUID)
+
+	messageID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid message ID")
+		return
+	}
+
+	reactions, err := h.chatService.RemoveReaction(c.Request.Context(), messageID, userID, c.Param("emoji"))
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.OK(c, reactions)
+}
+
 // MarkMessagesAsRead marks messages as read
 func (h *MessageHandler) MarkMessagesAsRead(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
@@ -134,7 +261,7 @@ func (h *MessageHandler) MarkMessagesAsRead(c *gin.Context) {
 func (h *MessageHandler) GetUnreadCount(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 
-	chatID, err := uuid.Parse(c.Param("chatId"))
+	chatID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, "Invalid chat ID")
 		return
@@ -154,7 +281,7 @@ func (h *MessageHandler) GetUnreadCount(c *gin.Context) {
 func (h *MessageHandler) UpdateLastRead(c *gin.Context) {
 	userID := c.MustGet("user_id").(uuid.UUID)
 
-	chatID, err := uuid.Parse(c.Param("chatId"))
+	chatID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, "Invalid chat ID")
 		return
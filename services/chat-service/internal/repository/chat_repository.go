@@ -55,7 +55,7 @@ func (r *ChatRepository) GetUserChats(userID uuid.UUID) ([]domain.ChatWithUnread
 
 		// Count unread messages
 		query := r.db.Model(&domain.Message{}).
-			Where("chat_id = ? AND deleted_at IS NULL AND user_id != ?", chat.ID, userID)
+			Where("chat_id = ? AND parent_message_id IS NULL AND deleted_at IS NULL AND user_id != ?", chat.ID, userID)
 
 		if participant.LastReadAt != nil {
 			query = query.Where("created_at > ?", participant.LastReadAt)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MessageRepository struct {
@@ -29,10 +30,34 @@ func (r *MessageRepository) GetByID(id uuid.UUID) (*domain.Message, error) {
 	return &message, nil
 }
 
-func (r *MessageRepository) GetByChatID(chatID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
+// GetByClientMessageID finds the message a user sent with a client message ID, deleted messages included
+// Returns nil when the user has not sent a message with the ID
+func (r *MessageRepository) GetByClientMessageID(userID uuid.UUID, clientMessageID string) (*domain.Message, error) {
 	var messages []domain.Message
+	err := r.db.Where("user_id = ? AND client_message_id = ?", userID, clientMessageID).
+		Limit(1).
+		Find(&messages).Error
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+	return &messages[0], nil
+}
 
-	query := r.db.Where("chat_id = ? AND deleted_at IS NULL", chatID)
+// GetByChatID returns a page of the main channel (thread replies excluded), oldest first
+func (r *MessageRepository) GetByChatID(chatID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
+	query := r.db.Where("chat_id = ? AND parent_message_id IS NULL AND deleted_at IS NULL", chatID)
+	return r.findPage(query, limit, before)
+}
+
+// GetThreadReplies returns a page of the replies of a thread, oldest first
+func (r *MessageRepository) GetThreadReplies(parentID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
+	query := r.db.Where("parent_message_id = ? AND deleted_at IS NULL", parentID)
+	return r.findPage(query, limit, before)
+}
+
+// findPage returns the newest messages of the query created before the cursor message, in chronological order
+func (r *MessageRepository) findPage(query *gorm.DB, limit int, before *uuid.UUID) ([]domain.Message, error) {
+	var messages []domain.Message
 
 	if before != nil {
 		var beforeMsg domain.Message
@@ -53,6 +78,75 @@ func (r *MessageRepository) GetByChatID(chatID uuid.UUID, limit int, before *uui
 	return messages, err
 }
 
+// GetByIDsIncludingDeleted returns the messages with the IDs, deleted messages included
+func (r *MessageRepository) GetByIDsIncludingDeleted(ids []uuid.UUID) ([]domain.Message, error) {
+	var messages []domain.Message
+	if len(ids) == 0 {
+		return messages, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&messages).Error
+	return messages, err
+}
+
+// GetThreadReplierIDs returns the users who replied in a thread
+func (r *MessageRepository) GetThreadReplierIDs(parentID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.Model(&domain.Message{}).
+		Where("parent_message_id = ? AND deleted_at IS NULL", parentID).
+		Distinct("user_id").
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// RefreshThreadSummary recomputes the reply count and last reply of a thread's root message
+// The root message is locked first so concurrent refreshes of a thread run one after another
+// and the last one to write has counted every committed reply
+func (r *MessageRepository) RefreshThreadSummary(parentID uuid.UUID) (*domain.ThreadSummary, error) {
+	summary := &domain.ThreadSummary{ParentMessageID: parentID}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var root domain.Message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", parentID).
+			First(&root).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&domain.Message{}).
+			Where("parent_message_id = ? AND deleted_at IS NULL", parentID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		summary.ReplyCount = int(count)
+
+		var last []domain.Message
+		if err := tx.Where("parent_message_id = ? AND deleted_at IS NULL", parentID).
+			Order("created_at DESC").
+			Limit(1).
+			Find(&last).Error; err != nil {
+			return err
+		}
+		if len(last) > 0 {
+			summary.LastReplyAt = &last[0].CreatedAt
+			summary.LastReplyUserID = &last[0].UserID
+		}
+
+		return tx.Model(&domain.Message{}).
+			Where("id = ?", parentID).
+			Updates(map[string]interface{}{
+				"reply_count":        summary.ReplyCount,
+				"last_reply_at":      summary.LastReplyAt,
+				"last_reply_user_id": summary.LastReplyUserID,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
 func (r *MessageRepository) SoftDelete(id uuid.UUID) error {
 	now := time.Now()
 	return r.db.Model(&domain.Message{}).
@@ -60,6 +154,51 @@ func (r *MessageRepository) SoftDelete(id uuid.UUID) error {
 		Update("deleted_at", now).Error
 }
 
+// UpdateContent replaces the content of a message and keeps the prior content as an edit
+// The message is locked and re-read first, so concurrent edits run one after another and each
+// edit keeps the content it actually replaced. A message deleted meanwhile gives gorm.ErrRecordNotFound
+func (r *MessageRepository) UpdateContent(message *domain.Message, content string, editedBy uuid.UUID, editedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var current domain.Message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "content").
+			Where("id = ? AND deleted_at IS NULL", message.ID).
+			First(&current).Error; err != nil {
+			return err
+		}
+		if current.Content == content {
+			return nil
+		}
+
+		edit := &domain.MessageEdit{
+			ID:        uuid.New(),
+			MessageID: message.ID,
+			Content:   current.Content,
+			EditedBy:  editedBy,
+			EditedAt:  editedAt,
+		}
+		if err := tx.Create(edit).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.Message{}).
+			Where("id = ?", message.ID).
+			Updates(map[string]interface{}{
+				"content":    content,
+				"edited_at":  editedAt,
+				"updated_at": editedAt,
+			}).Error
+	})
+}
+
+// GetEdits returns the prior versions of a message, oldest first
+func (r *MessageRepository) GetEdits(messageID uuid.UUID) ([]domain.MessageEdit, error) {
+	var edits []domain.MessageEdit
+	err := r.db.Where("message_id = ?", messageID).
+		Order("edited_at ASC").
+		Find(&edits).Error
+	return edits, err
+}
+
 func (r *MessageRepository) MarkAsRead(messageID, userID uuid.UUID) error {
 	read := &domain.MessageRead{
 		ID:        uuid.New(),
@@ -91,10 +230,43 @@ func (r *MessageRepository) MarkMultipleAsRead(messageIDs []uuid.UUID, userID uu
 	})
 }
 
+// AddReaction stores a reaction; it returns false when the user already reacted with the emoji
+func (r *MessageRepository) AddReaction(reaction *domain.MessageReaction) (bool, error) {
+	result := r.db.Exec(`
+		INSERT INTO message_reactions (id, message_id, user_id, emoji, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (message_id, user_id, emoji) DO NOTHING
+	`, reaction.ID, reaction.MessageID, reaction.UserID, reaction.Emoji, reaction.CreatedAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// RemoveReaction deletes a reaction; it returns false when there was none
+func (r *MessageRepository) RemoveReaction(messageID, userID uuid.UUID, emoji string) (bool, error) {
+	result := r.db.Where("message_id = ? AND user_id = ? AND emoji = ?", messageID, userID, emoji).
+		Delete(&domain.MessageReaction{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetReactionCounts aggregates the reactions of the messages per emoji, in order of first use
+// ReactedByMe tells whether userID is among the reactors
+func (r *MessageRepository) GetReactionCounts(messageIDs []uuid.UUID, userID uuid.UUID) ([]domain.ReactionCount, error) {
+	var counts []domain.ReactionCount
+	if len(messageIDs) == 0 {
+		return counts, nil
+	}
+	err := r.db.Model(&domain.MessageReaction{}).
+		Select("message_id, emoji, COUNT(*) AS count, BOOL_OR(user_id = ?) AS reacted_by_me", userID).
+		Where("message_id IN ?", messageIDs).
+		Group("message_id, emoji").
+		Order("MIN(created_at)").
+		Scan(&counts).Error
+	return counts, err
+}
+
 func (r *MessageRepository) GetUnreadCount(chatID, userID uuid.UUID, lastReadAt *time.Time) (int64, error) {
 	var count int64
 	query := r.db.Model(&domain.Message{}).
-		Where("chat_id = ? AND deleted_at IS NULL AND user_id != ?", chatID, userID)
+		Where("chat_id = ? AND parent_message_id IS NULL AND deleted_at IS NULL AND user_id != ?", chatID, userID)
 
 	if lastReadAt != nil {
 		query = query.Where("created_at > ?", lastReadAt)
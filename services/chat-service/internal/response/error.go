@@ -28,6 +28,19 @@ var (
 	ErrNotMessageOwner = errors.New("only message owner can perform this action")
 	ErrEmptyMessage    = errors.New("message content cannot be empty")
 
+	// 수정 가능 기간이 지난 메시지
+	ErrMessageEditWindowExpired = errors.New("message edit window has expired")
+
+	// 스레드 답장 대상 또는 인용 메시지가 같은 채팅방의 유효한 메시지가 아닌 경우
+	ErrInvalidParentMessage = errors.New("parent message must be an existing message in the same chat")
+	ErrInvalidQuotedMessage = errors.New("quoted message must be an existing message in the same chat")
+
+	// 리액션 이모지가 비어 있거나 너무 긴 경우
+	ErrInvalidReaction = errors.New("reaction emoji is invalid")
+
+	// 같은 clientMessageId를 다른 채팅방에서 재사용한 경우
+	ErrClientMessageIDReused = errors.New("client message ID was already used in another chat")
+
 	// 워크스페이스 에러
 	ErrNotWorkspaceMember = errors.New("user is not a member of this workspace")
 )
@@ -92,6 +105,21 @@ func HandleServiceError(c *gin.Context, err error) {
 	case errors.Is(err, ErrEmptyMessage):
 		BadRequest(c, "Message content cannot be empty")
 
+	case errors.Is(err, ErrMessageEditWindowExpired):
+		Forbidden(c, "The message can no longer be edited")
+
+	case errors.Is(err, ErrInvalidParentMessage):
+		BadRequest(c, "Parent message must be an existing message in the same chat")
+
+	case errors.Is(err, ErrInvalidQuotedMessage):
+		BadRequest(c, "Quoted message must be an existing message in the same chat")
+
+	case errors.Is(err, ErrInvalidReaction):
+		BadRequest(c, "Reaction emoji must be 1 to 32 characters without spaces")
+
+	case errors.Is(err, ErrClientMessageIDReused):
+		Conflict(c, "Client message ID was already used in another chat")
+
 	case errors.Is(err, ErrNotWorkspaceMember):
 		Forbidden(c, "You are not a member of this workspace")
 
@@ -9,6 +9,7 @@ import (
 	"chat-service/internal/repository"
 	"chat-service/internal/service"
 	"chat-service/internal/websocket"
+	"context"
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -84,12 +85,18 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	if cfg.UserAPI.BaseURL != "" {
 		userClient = client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger)
 		logger.Info("User client initialized", zap.String("url", cfg.UserAPI.BaseURL))
+
+		// 프로필 조회 캐시 (Redis) - user-service 의 무효화 이벤트를 구독
+		if redisClient != nil {
+			userClient = client.NewCachedUserClient(context.Background(), userClient, redisClient, cfg.Services.ProfileCacheTTL, logger)
+			logger.Info("User profile cache enabled", zap.Duration("ttl", cfg.Services.ProfileCacheTTL))
+		}
 	} else {
 		logger.Warn("User service URL not configured, workspace validation will be skipped")
 	}
 
 	// Initialize services (메트릭 연동)
-	chatService := service.NewChatService(chatRepo, messageRepo, userClient, redisClient, logger, m)
+	chatService := service.NewChatService(chatRepo, messageRepo, userClient, redisClient, cfg.Messages.EditWindow, logger, m)
 	presenceService := service.NewPresenceService(presenceRepo, redisClient, logger, m)
 
 	// Initialize auth middleware based on ISTIO_JWT_MODE
@@ -168,12 +175,19 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 			authenticated.DELETE("/:chatId/participants/:userId", chatHandler.RemoveParticipant)
 
 			// Message routes
-			authenticated.GET("/messages/:chatId", messageHandler.GetMessages)
-			authenticated.POST("/messages/:chatId", messageHandler.SendMessage)
-			authenticated.DELETE("/messages/:messageId", messageHandler.DeleteMessage)
+			// gin은 같은 위치의 와일드카드 이름이 같아야 하므로 /messages/:id 하나를 씁니다
+			// (채팅방 단위 경로에서는 채팅방 ID, 메시지 단위 경로에서는 메시지 ID)
+			authenticated.GET("/messages/:id", messageHandler.GetMessages)
+			authenticated.POST("/messages/:id", messageHandler.SendMessage)
+			authenticated.DELETE("/messages/:id", messageHandler.DeleteMessage)
+			authenticated.PUT("/messages/:id", messageHandler.EditMessage)
+			authenticated.GET("/messages/:id/edits", messageHandler.GetMessageEdits)
+			authenticated.GET("/messages/:id/thread", messageHandler.GetThread)
+			authenticated.POST("/messages/:id/reactions", messageHandler.AddReaction)
+			authenticated.DELETE("/messages/:id/reactions/:emoji", messageHandler.RemoveReaction)
 			authenticated.POST("/messages/read", messageHandler.MarkMessagesAsRead)
-			authenticated.GET("/messages/:chatId/unread", messageHandler.GetUnreadCount)
-			authenticated.PUT("/messages/:chatId/last-read", messageHandler.UpdateLastRead)
+			authenticated.GET("/messages/:id/unread", messageHandler.GetUnreadCount)
+			authenticated.PUT("/messages/:id/last-read", messageHandler.UpdateLastRead)
 
 			// Presence routes
 			authenticated.GET("/presence/online", presenceHandler.GetOnlineUsers)
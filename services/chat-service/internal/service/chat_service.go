@@ -12,31 +12,86 @@ import (
 	"chat-service/internal/response"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ChatEventBroadcaster는 이 인스턴스에 연결된 채팅방 클라이언트에게 이벤트를 전달합니다.
+type ChatEventBroadcaster func(chatID uuid.UUID, payload []byte)
+
+// UserEventSender는 이 인스턴스에 연결된 사용자의 클라이언트에게 이벤트를 전달합니다.
+type UserEventSender func(userID uuid.UUID, payload []byte)
+
+// ChatRepository는 ChatService가 사용하는 채팅방 저장소입니다.
+type ChatRepository interface {
+	Create(chat *domain.Chat) error
+	GetByID(id uuid.UUID) (*domain.Chat, error)
+	GetUserChats(userID uuid.UUID) ([]domain.ChatWithUnread, error)
+	GetWorkspaceChats(workspaceID uuid.UUID) ([]domain.Chat, error)
+	SoftDelete(id uuid.UUID) error
+	UpdateTimestamp(id uuid.UUID) error
+	AddParticipants(chatID uuid.UUID, userIDs []uuid.UUID) error
+	RemoveParticipant(chatID, userID uuid.UUID) error
+	IsUserInChat(chatID, userID uuid.UUID) (bool, error)
+	UpdateLastReadAt(chatID, userID uuid.UUID) error
+	CountAll() (int64, error)
+}
+
+// MessageRepository는 ChatService가 사용하는 메시지 저장소입니다.
+type MessageRepository interface {
+	Create(message *domain.Message) error
+	GetByID(id uuid.UUID) (*domain.Message, error)
+	GetByClientMessageID(userID uuid.UUID, clientMessageID string) (*domain.Message, error)
+	GetByChatID(chatID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error)
+	GetThreadReplies(parentID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error)
+	GetByIDsIncludingDeleted(ids []uuid.UUID) ([]domain.Message, error)
+	GetThreadReplierIDs(parentID uuid.UUID) ([]uuid.UUID, error)
+	RefreshThreadSummary(parentID uuid.UUID) (*domain.ThreadSummary, error)
+	SoftDelete(id uuid.UUID) error
+	UpdateContent(message *domain.Message, content string, editedBy uuid.UUID, editedAt time.Time) error
+	GetEdits(messageID uuid.UUID) ([]domain.MessageEdit, error)
+	MarkMultipleAsRead(messageIDs []uuid.UUID, userID uuid.UUID) error
+	AddReaction(reaction *domain.MessageReaction) (bool, error)
+	RemoveReaction(messageID, userID uuid.UUID, emoji string) (bool, error)
+	GetReactionCounts(messageIDs []uuid.UUID, userID uuid.UUID) ([]domain.ReactionCount, error)
+	GetUnreadCount(chatID, userID uuid.UUID, lastReadAt *time.Time) (int64, error)
+	CountAll() (int64, error)
+}
+
+var (
+	_ ChatRepository    = (*repository.ChatRepository)(nil)
+	_ MessageRepository = (*repository.MessageRepository)(nil)
 )
 
 // ChatService는 채팅 관련 비즈니스 로직을 처리합니다.
 type ChatService struct {
-	chatRepo    *repository.ChatRepository
-	messageRepo *repository.MessageRepository
-	userClient  client.UserClient
-	redis       *redis.Client
-	logger      *zap.Logger
-	metrics     *metrics.Metrics
+	chatRepo       ChatRepository
+	messageRepo    MessageRepository
+	userClient     client.UserClient
+	redis          *redis.Client
+	localBroadcast ChatEventBroadcaster
+	localUserSend  UserEventSender
+	editWindow     time.Duration
+	logger         *zap.Logger
+	metrics        *metrics.Metrics
 }
 
 // NewChatService는 새 ChatService를 생성합니다.
 func NewChatService(
-	chatRepo *repository.ChatRepository,
-	messageRepo *repository.MessageRepository,
+	chatRepo ChatRepository,
+	messageRepo MessageRepository,
 	userClient client.UserClient,
 	redis *redis.Client,
+	editWindow time.Duration,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *ChatService {
@@ -45,11 +100,23 @@ func NewChatService(
 		messageRepo: messageRepo,
 		userClient:  userClient,
 		redis:       redis,
+		editWindow:  editWindow,
 		logger:      logger,
 		metrics:     m,
 	}
 }
 
+// SetLocalBroadcaster는 Redis가 없을 때(단일 인스턴스) 사용할 로컬 전달 함수를 설정합니다.
+// Redis가 있으면 모든 이벤트는 Redis를 거쳐 각 인스턴스에 한 번씩 전달됩니다.
+func (s *ChatService) SetLocalBroadcaster(broadcast ChatEventBroadcaster) {
+	s.localBroadcast = broadcast
+}
+
+// SetLocalUserSender는 Redis가 없을 때 사용자 이벤트(스레드 알림 등)를 전달할 로컬 함수를 설정합니다.
+func (s *ChatService) SetLocalUserSender(send UserEventSender) {
+	s.localUserSend = send
+}
+
 // ============================================================
 // 비즈니스 검증 헬퍼 메서드
 // ============================================================
@@ -158,8 +225,22 @@ func (s *ChatService) CreateChat(ctx context.Context, req *domain.CreateChatRequ
 }
 
 // GetChatByID는 ID로 채팅방을 조회합니다.
+// 참가자 프로필은 user-service 배치 조회로 채웁니다.
 func (s *ChatService) GetChatByID(ctx context.Context, chatID uuid.UUID) (*domain.Chat, error) {
-	return s.chatRepo.GetByID(chatID)
+	chat, err := s.chatRepo.GetByID(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, len(chat.Participants))
+	for i := range chat.Participants {
+		userIDs[i] = chat.Participants[i].UserID
+	}
+	profiles := s.fetchUserSummaries(ctx, chat.WorkspaceID, userIDs)
+	for i := range chat.Participants {
+		chat.Participants[i].Profile = profiles[chat.Participants[i].UserID]
+	}
+	return chat, nil
 }
 
 // GetUserChats는 사용자가 참여 중인 채팅방 목록을 조회합니다.
@@ -283,13 +364,30 @@ func (s *ChatService) IsUserInChat(ctx context.Context, chatID, userID uuid.UUID
 
 // SendMessage는 채팅방에 메시지를 전송합니다.
 // 참가자 검증 후 메시지 생성 및 Redis를 통해 실시간 브로드캐스트합니다.
-func (s *ChatService) SendMessage(ctx context.Context, chatID, userID uuid.UUID, req *domain.SendMessageRequest) (*domain.Message, error) {
+// clientMessageId가 있으면 멱등하게 처리합니다: 같은 발신자가 같은 ID로 재전송하면 저장된 메시지를
+// 다시 브로드캐스트하지 않고 created=false로 반환합니다.
+// parentMessageId가 있으면 스레드 답장으로 저장하고, 메인 채널 대신 THREAD_REPLY_RECEIVED를 브로드캐스트하며
+// 스레드 참여자에게 THREAD_NOTIFICATION을 따로 보냅니다.
+func (s *ChatService) SendMessage(ctx context.Context, chatID, userID uuid.UUID, req *domain.SendMessageRequest) (*domain.Message, bool, error) {
 	// 📋 참가자 검증: 채팅방 참가자만 메시지 전송 가능
 	if err := s.validateChatParticipant(chatID, userID); err != nil {
 		s.logger.Warn("채팅 참가자 검증 실패",
 			zap.String("chat_id", chatID.String()),
 			zap.String("user_id", userID.String()))
-		return nil, err
+		return nil, false, err
+	}
+
+	clientMessageID, err := normalizeClientMessageID(req.ClientMessageID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 📋 재전송 확인: 이미 저장된 메시지면 그대로 반환
+	if clientMessageID != nil {
+		existing, err := s.findSentMessage(chatID, userID, *clientMessageID)
+		if err != nil || existing != nil {
+			return existing, false, err
+		}
 	}
 
 	// 📋 메시지 검증: 텍스트 메시지는 내용이 비어있으면 안됨
@@ -299,28 +397,57 @@ func (s *ChatService) SendMessage(ctx context.Context, chatID, userID uuid.UUID,
 	}
 
 	if messageType == domain.MessageTypeText && strings.TrimSpace(req.Content) == "" {
-		return nil, response.ErrEmptyMessage
+		return nil, false, response.ErrEmptyMessage
+	}
+
+	// 📋 스레드 검증: 답장의 답장은 스레드 루트 메시지에 연결
+	var parent *domain.Message
+	if req.ParentMessageID != nil {
+		parent, err = s.resolveThreadRoot(chatID, *req.ParentMessageID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// 📋 인용 검증: 같은 채팅방의 삭제되지 않은 메시지만 인용 가능
+	var quoted *domain.Message
+	if req.QuotedMessageID != nil {
+		quoted, err = s.messageRepo.GetByID(*req.QuotedMessageID)
+		if err != nil || quoted.ChatID != chatID {
+			return nil, false, response.ErrInvalidQuotedMessage
+		}
 	}
 
 	message := &domain.Message{
-		ID:          uuid.New(),
-		ChatID:      chatID,
-		UserID:      userID,
-		Content:     req.Content,
-		MessageType: messageType,
-		FileURL:     req.FileURL,
-		FileName:    req.FileName,
-		FileSize:    req.FileSize,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:              uuid.New(),
+		ChatID:          chatID,
+		UserID:          userID,
+		ClientMessageID: clientMessageID,
+		QuotedMessageID: req.QuotedMessageID,
+		Content:         req.Content,
+		MessageType:     messageType,
+		FileURL:         req.FileURL,
+		FileName:        req.FileName,
+		FileSize:        req.FileSize,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	if parent != nil {
+		message.ParentMessageID = &parent.ID
 	}
 
 	if err := s.messageRepo.Create(message); err != nil {
+		// 동시에 재전송된 같은 메시지가 먼저 저장된 경우 (유니크 인덱스 충돌)
+		if clientMessageID != nil {
+			if existing, findErr := s.findSentMessage(chatID, userID, *clientMessageID); findErr != nil || existing != nil {
+				return existing, false, findErr
+			}
+		}
 		s.logger.Error("메시지 생성 실패",
 			zap.String("chat_id", chatID.String()),
 			zap.String("user_id", userID.String()),
 			zap.Error(err))
-		return nil, err
+		return nil, false, err
 	}
 
 	// 채팅방 타임스탬프 업데이트
@@ -339,19 +466,339 @@ func (s *ChatService) SendMessage(ctx context.Context, chatID, userID uuid.UUID,
 		zap.String("user_id", userID.String()),
 		zap.String("message_type", string(messageType)))
 
-	// Redis를 통해 WebSocket 브로드캐스트
-	s.publishMessage(ctx, chatID, message)
+	if quoted != nil {
+		message.Quote = buildMessageQuote(quoted)
+	}
 
-	return message, nil
+	if parent != nil {
+		s.publishThreadReply(ctx, parent, message)
+		return message, true, nil
+	}
+
+	// Redis를 통해 WebSocket 브로드캐스트 (발신자를 포함한 모든 클라이언트가 이 경로로만 수신)
+	s.PublishChatEvent(ctx, chatID, map[string]interface{}{
+		"type":    "MESSAGE_RECEIVED",
+		"message": message,
+	})
+
+	return message, true, nil
+}
+
+// resolveThreadRoot는 답장 대상 메시지가 속한 스레드의 루트 메시지를 반환합니다.
+// 대상이 이미 답장이면 그 스레드의 루트를 반환하므로 스레드는 한 단계로만 유지됩니다.
+func (s *ChatService) resolveThreadRoot(chatID, parentID uuid.UUID) (*domain.Message, error) {
+	parent, err := s.messageRepo.GetByID(parentID)
+	if err != nil || parent.ChatID != chatID {
+		return nil, response.ErrInvalidParentMessage
+	}
+	if parent.ParentMessageID == nil {
+		return parent, nil
+	}
+
+	root, err := s.messageRepo.GetByID(*parent.ParentMessageID)
+	if err != nil {
+		return nil, response.ErrInvalidParentMessage
+	}
+	return root, nil
+}
+
+// publishThreadReply는 스레드 요약을 갱신하고 답장 이벤트를 전달합니다.
+// 채팅방에는 THREAD_REPLY_RECEIVED(답장 + 갱신된 요약)를, 스레드 참여자에게는 THREAD_NOTIFICATION을 보냅니다.
+func (s *ChatService) publishThreadReply(ctx context.Context, root, reply *domain.Message) {
+	summary, err := s.messageRepo.RefreshThreadSummary(root.ID)
+	if err != nil {
+		s.logger.Error("스레드 요약 갱신 실패",
+			zap.String("parent_message_id", root.ID.String()),
+			zap.Error(err))
+		summary = &domain.ThreadSummary{ParentMessageID: root.ID}
+	}
+
+	s.PublishChatEvent(ctx, reply.ChatID, map[string]interface{}{
+		"type":    "THREAD_REPLY_RECEIVED",
+		"message": reply,
+		"thread":  summary,
+	})
+
+	repliers, err := s.messageRepo.GetThreadReplierIDs(root.ID)
+	if err != nil {
+		s.logger.Warn("스레드 참여자 조회 실패",
+			zap.String("parent_message_id", root.ID.String()),
+			zap.Error(err))
+	}
+	chat, err := s.chatRepo.GetByID(reply.ChatID)
+	if err != nil {
+		s.logger.Warn("스레드 알림용 채팅방 조회 실패",
+			zap.String("chat_id", reply.ChatID.String()),
+			zap.Error(err))
+		return
+	}
+
+	for _, recipient := range threadRecipients(root.UserID, repliers, reply.UserID, chat.Participants) {
+		s.PublishUserEvent(ctx, recipient, map[string]interface{}{
+			"type":            "THREAD_NOTIFICATION",
+			"chatId":          reply.ChatID,
+			"parentMessageId": root.ID,
+			"message":         reply,
+		})
+	}
+}
+
+// threadRecipients는 스레드 알림을 받을 사용자를 반환합니다.
+// 루트 메시지 작성자와 답장 작성자 중 현재 채팅방 참가자만 포함하고, 답장을 보낸 본인은 제외합니다.
+func threadRecipients(rootAuthor uuid.UUID, repliers []uuid.UUID, senderID uuid.UUID, participants []domain.ChatParticipant) []uuid.UUID {
+	active := make(map[uuid.UUID]bool, len(participants))
+	for _, p := range participants {
+		if p.IsActive {
+			active[p.UserID] = true
+		}
+	}
+
+	seen := map[uuid.UUID]bool{senderID: true}
+	var recipients []uuid.UUID
+	for _, userID := range append([]uuid.UUID{rootAuthor}, repliers...) {
+		if seen[userID] || !active[userID] {
+			continue
+		}
+		seen[userID] = true
+		recipients = append(recipients, userID)
+	}
+	return recipients
+}
+
+// findSentMessage는 발신자가 clientMessageId로 이미 보낸 메시지를 찾습니다.
+// 다른 채팅방에서 사용한 ID면 ErrClientMessageIDReused를 반환합니다.
+func (s *ChatService) findSentMessage(chatID, userID uuid.UUID, clientMessageID string) (*domain.Message, error) {
+	existing, err := s.messageRepo.GetByClientMessageID(userID, clientMessageID)
+	if err != nil {
+		s.logger.Error("clientMessageId 메시지 조회 실패",
+			zap.String("chat_id", chatID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	if existing == nil {
+		return nil, nil
+	}
+	if existing.ChatID != chatID {
+		return nil, response.ErrClientMessageIDReused
+	}
+
+	s.logger.Debug("재전송된 메시지 중복 제거",
+		zap.String("message_id", existing.ID.String()),
+		zap.String("chat_id", chatID.String()),
+		zap.String("client_message_id", clientMessageID))
+	return existing, nil
+}
+
+// normalizeClientMessageID는 clientMessageId의 앞뒤 공백을 제거하고 길이를 검증합니다.
+// 비어 있으면 nil을 반환합니다 (멱등 처리 없음).
+func normalizeClientMessageID(clientMessageID *string) (*string, error) {
+	if clientMessageID == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*clientMessageID)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if len(trimmed) > domain.MaxClientMessageIDLength {
+		return nil, response.NewValidationErrorTyped(
+			fmt.Sprintf("clientMessageId must be at most %d characters", domain.MaxClientMessageIDLength), "")
+	}
+	return &trimmed, nil
 }
 
 // GetMessages는 채팅방의 메시지 목록을 조회합니다.
-// 커서 기반 페이지네이션을 지원합니다.
-func (s *ChatService) GetMessages(ctx context.Context, chatID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
+// 커서 기반 페이지네이션을 지원하며, 리액션 집계의 reactedByMe는 userID 기준입니다.
+func (s *ChatService) GetMessages(ctx context.Context, chatID, userID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 50
 	}
-	return s.messageRepo.GetByChatID(chatID, limit, before)
+	messages, err := s.messageRepo.GetByChatID(chatID, limit, before)
+	if err != nil || len(messages) == 0 {
+		return messages, err
+	}
+	s.attachQuotes(messages)
+	s.attachReactions(messages, userID)
+	if s.userClient == nil {
+		return messages, nil
+	}
+
+	// 발신자 프로필을 메시지마다 조회하지 않고 한 번에 조회
+	chat, err := s.chatRepo.GetByID(chatID)
+	if err != nil {
+		s.logger.Warn("Failed to load chat for sender profiles", zap.String("chatId", chatID.String()), zap.Error(err))
+		return messages, nil
+	}
+	attachSenders(messages, s.fetchUserSummaries(ctx, chat.WorkspaceID, senderIDs(messages)))
+	return messages, nil
+}
+
+// GetThread는 스레드 루트 메시지와 답장 목록을 조회합니다 (채팅방 참가자만).
+// 답장은 오래된 순이며 before 커서로 이전 답장을 이어서 조회합니다.
+func (s *ChatService) GetThread(ctx context.Context, parentID, userID uuid.UUID, limit int, before *uuid.UUID) (*domain.ThreadResponse, error) {
+	parent, err := s.messageRepo.GetByID(parentID)
+	if err != nil || parent.ParentMessageID != nil {
+		return nil, response.ErrMessageNotFound
+	}
+
+	if err := s.validateChatParticipant(parent.ChatID, userID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	// 한 건 더 조회해서 이전 답장이 남았는지 확인
+	replies, err := s.messageRepo.GetThreadReplies(parentID, limit+1, before)
+	if err != nil {
+		s.logger.Error("스레드 답장 조회 실패",
+			zap.String("parent_message_id", parentID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	replies, hasMore := trimThreadPage(replies, limit)
+
+	messages := append([]domain.Message{*parent}, replies...)
+	s.attachQuotes(messages)
+	s.attachReactions(messages, userID)
+	if s.userClient != nil {
+		chat, err := s.chatRepo.GetByID(parent.ChatID)
+		if err != nil {
+			s.logger.Warn("Failed to load chat for sender profiles", zap.String("chatId", parent.ChatID.String()), zap.Error(err))
+		} else {
+			attachSenders(messages, s.fetchUserSummaries(ctx, chat.WorkspaceID, senderIDs(messages)))
+		}
+	}
+
+	return &domain.ThreadResponse{
+		Parent:  messages[0],
+		Replies: messages[1:],
+		HasMore: hasMore,
+	}, nil
+}
+
+// trimThreadPage는 limit+1건으로 조회한 답장 목록(오래된 순)을 limit건으로 줄입니다.
+// 초과분은 가장 오래된 답장이며, 잘렸으면 이전 답장이 더 있다는 뜻입니다.
+func trimThreadPage(replies []domain.Message, limit int) ([]domain.Message, bool) {
+	if len(replies) <= limit {
+		return replies, false
+	}
+	return replies[len(replies)-limit:], true
+}
+
+// attachQuotes는 메시지가 인용한 메시지의 미리보기를 설정합니다.
+// 인용된 메시지가 삭제되었어도 삭제 표시와 함께 미리보기를 남깁니다.
+func (s *ChatService) attachQuotes(messages []domain.Message) {
+	var ids []uuid.UUID
+	for i := range messages {
+		if messages[i].QuotedMessageID != nil {
+			ids = append(ids, *messages[i].QuotedMessageID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	quoted, err := s.messageRepo.GetByIDsIncludingDeleted(ids)
+	if err != nil {
+		s.logger.Warn("인용 메시지 조회 실패", zap.Error(err))
+		return
+	}
+	byID := make(map[uuid.UUID]*domain.Message, len(quoted))
+	for i := range quoted {
+		byID[quoted[i].ID] = &quoted[i]
+	}
+	for i := range messages {
+		if messages[i].QuotedMessageID == nil {
+			continue
+		}
+		if q, ok := byID[*messages[i].QuotedMessageID]; ok {
+			messages[i].Quote = buildMessageQuote(q)
+		}
+	}
+}
+
+// attachReactions는 메시지별 리액션 집계를 설정합니다. 조회 실패 시 리액션 없이 반환합니다.
+func (s *ChatService) attachReactions(messages []domain.Message, userID uuid.UUID) {
+	ids := make([]uuid.UUID, len(messages))
+	for i := range messages {
+		ids[i] = messages[i].ID
+	}
+
+	counts, err := s.messageRepo.GetReactionCounts(ids, userID)
+	if err != nil {
+		s.logger.Warn("리액션 집계 조회 실패", zap.Error(err))
+		return
+	}
+	byMessage := groupReactions(counts)
+	for i := range messages {
+		messages[i].Reactions = byMessage[messages[i].ID]
+	}
+}
+
+// groupReactions는 리액션 집계 행을 메시지별 요약 목록으로 묶습니다 (행 순서 유지).
+func groupReactions(counts []domain.ReactionCount) map[uuid.UUID][]domain.ReactionSummary {
+	grouped := make(map[uuid.UUID][]domain.ReactionSummary)
+	for _, c := range counts {
+		grouped[c.MessageID] = append(grouped[c.MessageID], domain.ReactionSummary{
+			Emoji:       c.Emoji,
+			Count:       c.Count,
+			ReactedByMe: c.ReactedByMe,
+		})
+	}
+	return grouped
+}
+
+// buildMessageQuote는 인용 미리보기를 만듭니다. 삭제된 메시지는 내용을 숨깁니다.
+func buildMessageQuote(quoted *domain.Message) *domain.MessageQuote {
+	quote := &domain.MessageQuote{
+		MessageID: quoted.ID,
+		UserID:    quoted.UserID,
+		Deleted:   quoted.DeletedAt != nil,
+	}
+	if !quote.Deleted {
+		quote.Content = quoted.Content
+	}
+	return quote
+}
+
+// fetchUserSummaries resolves workspace profiles with a single (cached) batch lookup.
+// 조회 실패 시 빈 map을 반환합니다 (graceful degradation).
+func (s *ChatService) fetchUserSummaries(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) map[uuid.UUID]*domain.UserSummary {
+	summaries := make(map[uuid.UUID]*domain.UserSummary, len(userIDs))
+	if s.userClient == nil || len(userIDs) == 0 {
+		return summaries
+	}
+
+	profiles, err := s.userClient.GetWorkspaceProfiles(ctx, workspaceID, userIDs, "")
+	if err != nil {
+		s.logger.Warn("Failed to fetch user profiles", zap.String("workspaceId", workspaceID.String()), zap.Error(err))
+		return summaries
+	}
+	for userID, profile := range profiles {
+		summaries[userID] = &domain.UserSummary{
+			UserID:          userID,
+			NickName:        profile.NickName,
+			ProfileImageURL: profile.ProfileImageURL,
+		}
+	}
+	return summaries
+}
+
+// senderIDs returns the sender of every message
+func senderIDs(messages []domain.Message) []uuid.UUID {
+	ids := make([]uuid.UUID, len(messages))
+	for i := range messages {
+		ids[i] = messages[i].UserID
+	}
+	return ids
+}
+
+// attachSenders sets the sender profile of each message
+func attachSenders(messages []domain.Message, senders map[uuid.UUID]*domain.UserSummary) {
+	for i := range messages {
+		messages[i].Sender = senders[messages[i].UserID]
+	}
 }
 
 // DeleteMessage는 메시지를 소프트 삭제합니다.
@@ -385,9 +832,201 @@ func (s *ChatService) DeleteMessage(ctx context.Context, messageID, userID uuid.
 		zap.String("message_id", messageID.String()),
 		zap.String("deleted_by", userID.String()))
 
+	// 스레드 답장이면 루트 메시지의 답장 수와 마지막 답장을 다시 계산
+	if message.ParentMessageID != nil {
+		if _, err := s.messageRepo.RefreshThreadSummary(*message.ParentMessageID); err != nil {
+			s.logger.Error("스레드 요약 갱신 실패",
+				zap.String("parent_message_id", message.ParentMessageID.String()),
+				zap.Error(err))
+		}
+	}
+
 	return nil
 }
 
+// EditMessage는 메시지 내용을 수정합니다.
+// 작성자만, 작성 후 수정 가능 기간(editWindow) 안에 수정할 수 있으며 이전 내용은 수정 이력으로 보관합니다.
+// 수정되면 MESSAGE_EDITED 이벤트를 채팅방에 브로드캐스트합니다.
+func (s *ChatService) EditMessage(ctx context.Context, messageID, userID uuid.UUID, req *domain.EditMessageRequest) (*domain.Message, error) {
+	// 📋 메시지 존재 및 작성자 검증
+	message, err := s.messageRepo.GetByID(messageID)
+	if err != nil {
+		s.logger.Warn("메시지 조회 실패",
+			zap.String("message_id", messageID.String()),
+			zap.Error(err))
+		return nil, response.ErrMessageNotFound
+	}
+
+	if message.UserID != userID {
+		s.logger.Warn("메시지 수정 권한 없음",
+			zap.String("message_id", messageID.String()),
+			zap.String("user_id", userID.String()),
+			zap.String("owner_id", message.UserID.String()))
+		return nil, response.ErrNotMessageOwner
+	}
+
+	// 📋 채팅방을 나간 작성자는 수정 불가
+	if err := s.validateChatParticipant(message.ChatID, userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !canEditMessage(message, now, s.editWindow) {
+		return nil, response.ErrMessageEditWindowExpired
+	}
+
+	if message.MessageType == domain.MessageTypeText && strings.TrimSpace(req.Content) == "" {
+		return nil, response.ErrEmptyMessage
+	}
+
+	// 내용이 같으면 이력 없이 그대로 반환
+	if req.Content == message.Content {
+		return message, nil
+	}
+
+	if err := s.messageRepo.UpdateContent(message, req.Content, userID, now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 수정 중에 삭제된 메시지
+			return nil, response.ErrMessageNotFound
+		}
+		s.logger.Error("메시지 수정 실패",
+			zap.String("message_id", messageID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	message.Content = req.Content
+	message.EditedAt = &now
+	message.UpdatedAt = now
+
+	s.logger.Info("메시지 수정 완료",
+		zap.String("message_id", messageID.String()),
+		zap.String("chat_id", message.ChatID.String()),
+		zap.String("edited_by", userID.String()))
+
+	s.PublishChatEvent(ctx, message.ChatID, map[string]interface{}{
+		"type":    "MESSAGE_EDITED",
+		"message": message,
+	})
+
+	return message, nil
+}
+
+// GetMessageEdits는 메시지의 이전 버전 목록을 오래된 순으로 조회합니다 (채팅방 참가자만).
+func (s *ChatService) GetMessageEdits(ctx context.Context, messageID, userID uuid.UUID) ([]domain.MessageEdit, error) {
+	message, err := s.messageRepo.GetByID(messageID)
+	if err != nil {
+		return nil, response.ErrMessageNotFound
+	}
+
+	if err := s.validateChatParticipant(message.ChatID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.messageRepo.GetEdits(messageID)
+}
+
+// canEditMessage는 메시지가 작성 후 수정 가능 기간 안에 있는지 확인합니다.
+func canEditMessage(message *domain.Message, now time.Time, editWindow time.Duration) bool {
+	return !now.After(message.CreatedAt.Add(editWindow))
+}
+
+// AddReaction은 메시지에 이모지 리액션을 추가합니다 (채팅방 참가자만).
+// 같은 이모지로 이미 리액션했으면 아무것도 바꾸지 않고, 추가되면 REACTION_ADDED를 브로드캐스트합니다.
+// 메시지의 리액션 집계를 반환합니다.
+func (s *ChatService) AddReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) ([]domain.ReactionSummary, error) {
+	return s.changeReaction(ctx, messageID, userID, emoji, true)
+}
+
+// RemoveReaction은 메시지에서 사용자의 이모지 리액션을 제거합니다 (채팅방 참가자만).
+// 제거되면 REACTION_REMOVED를 브로드캐스트하고 메시지의 리액션 집계를 반환합니다.
+func (s *ChatService) RemoveReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) ([]domain.ReactionSummary, error) {
+	return s.changeReaction(ctx, messageID, userID, emoji, false)
+}
+
+func (s *ChatService) changeReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string, add bool) ([]domain.ReactionSummary, error) {
+	emoji, err := normalizeReactionEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	// 📋 메시지 존재 및 참가자 검증
+	message, err := s.messageRepo.GetByID(messageID)
+	if err != nil {
+		return nil, response.ErrMessageNotFound
+	}
+	if err := s.validateChatParticipant(message.ChatID, userID); err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	if add {
+		changed, err = s.messageRepo.AddReaction(&domain.MessageReaction{
+			ID:        uuid.New(),
+			MessageID: messageID,
+			UserID:    userID,
+			Emoji:     emoji,
+			CreatedAt: time.Now(),
+		})
+	} else {
+		changed, err = s.messageRepo.RemoveReaction(messageID, userID, emoji)
+	}
+	if err != nil {
+		s.logger.Error("리액션 변경 실패",
+			zap.String("message_id", messageID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Bool("add", add),
+			zap.Error(err))
+		return nil, err
+	}
+
+	counts, err := s.messageRepo.GetReactionCounts([]uuid.UUID{messageID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	reactions := groupReactions(counts)[messageID]
+
+	// 실제로 바뀐 경우에만 브로드캐스트 (중복 추가나 없는 리액션 제거는 무시)
+	if changed {
+		eventType := "REACTION_REMOVED"
+		if add {
+			eventType = "REACTION_ADDED"
+		}
+		s.PublishChatEvent(ctx, message.ChatID, map[string]interface{}{
+			"type":      eventType,
+			"chatId":    message.ChatID,
+			"messageId": messageID,
+			"userId":    userID,
+			"emoji":     emoji,
+			"count":     reactionCount(reactions, emoji),
+		})
+	}
+
+	return reactions, nil
+}
+
+// normalizeReactionEmoji는 리액션 이모지의 앞뒤 공백을 제거하고 검증합니다.
+// 비어 있거나, MaxReactionEmojiLength자를 넘거나, 공백을 포함하면 ErrInvalidReaction을 반환합니다.
+func normalizeReactionEmoji(emoji string) (string, error) {
+	trimmed := strings.TrimSpace(emoji)
+	if trimmed == "" || utf8.RuneCountInString(trimmed) > domain.MaxReactionEmojiLength {
+		return "", response.ErrInvalidReaction
+	}
+	if strings.IndexFunc(trimmed, unicode.IsSpace) >= 0 {
+		return "", response.ErrInvalidReaction
+	}
+	return trimmed, nil
+}
+
+// reactionCount는 리액션 집계에서 이모지의 개수를 반환합니다.
+func reactionCount(reactions []domain.ReactionSummary, emoji string) int {
+	for _, r := range reactions {
+		if r.Emoji == emoji {
+			return r.Count
+		}
+	}
+	return 0
+}
+
 // MarkMessagesAsRead는 메시지들을 읽음으로 표시합니다.
 func (s *ChatService) MarkMessagesAsRead(ctx context.Context, messageIDs []uuid.UUID, userID uuid.UUID) error {
 	if err := s.messageRepo.MarkMultipleAsRead(messageIDs, userID); err != nil {
@@ -435,26 +1074,54 @@ func (s *ChatService) GetUnreadCount(ctx context.Context, chatID, userID uuid.UU
 	return s.messageRepo.GetUnreadCount(chatID, userID, lastReadAt)
 }
 
-// publishMessage는 Redis를 통해 메시지를 브로드캐스트합니다.
-func (s *ChatService) publishMessage(ctx context.Context, chatID uuid.UUID, message *domain.Message) {
+// PublishChatEvent는 채팅방 이벤트를 모든 인스턴스의 클라이언트에게 전달합니다.
+// Redis가 있으면 chat:{chatId} 채널로 발행하고 각 인스턴스의 구독자가 로컬 클라이언트에게 전달합니다.
+// Redis가 없으면 로컬 전달 함수로 이 인스턴스의 클라이언트에게만 전달합니다.
+func (s *ChatService) PublishChatEvent(ctx context.Context, chatID uuid.UUID, event interface{}) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		s.logger.Error("채팅 이벤트 직렬화 실패",
+			zap.String("chat_id", chatID.String()),
+			zap.Error(err))
+		return
+	}
+
 	if s.redis == nil {
+		if s.localBroadcast != nil {
+			s.localBroadcast(chatID, data)
+		}
 		return
 	}
 
 	channel := fmt.Sprintf("chat:%s", chatID.String())
-	data, err := json.Marshal(map[string]interface{}{
-		"type":    "MESSAGE_RECEIVED",
-		"message": message,
-	})
+	if err := s.redis.Publish(ctx, channel, data).Err(); err != nil {
+		s.logger.Error("Redis 메시지 발행 실패",
+			zap.String("channel", channel),
+			zap.Error(err))
+	}
+}
+
+// PublishUserEvent는 사용자 이벤트를 모든 인스턴스에 연결된 해당 사용자의 클라이언트에게 전달합니다.
+// Redis가 있으면 chat-user:{userId} 채널로 발행하고, 없으면 로컬 전달 함수로 이 인스턴스에만 전달합니다.
+func (s *ChatService) PublishUserEvent(ctx context.Context, userID uuid.UUID, event interface{}) {
+	data, err := json.Marshal(event)
 	if err != nil {
-		s.logger.Error("메시지 직렬화 실패",
-			zap.String("chat_id", chatID.String()),
+		s.logger.Error("사용자 이벤트 직렬화 실패",
+			zap.String("user_id", userID.String()),
 			zap.Error(err))
 		return
 	}
 
+	if s.redis == nil {
+		if s.localUserSend != nil {
+			s.localUserSend(userID, data)
+		}
+		return
+	}
+
+	channel := fmt.Sprintf("chat-user:%s", userID.String())
 	if err := s.redis.Publish(ctx, channel, data).Err(); err != nil {
-		s.logger.Error("Redis 메시지 발행 실패",
+		s.logger.Error("Redis 사용자 이벤트 발행 실패",
 			zap.String("channel", channel),
 			zap.Error(err))
 	}
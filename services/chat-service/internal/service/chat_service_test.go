@@ -4,14 +4,19 @@ package service
 import (
 	"chat-service/internal/domain"
 	"chat-service/internal/metrics"
+	"chat-service/internal/response"
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // ============================================================
@@ -124,25 +129,78 @@ func (m *MockMessageRepository) CountAll() (int64, error) {
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockMessageRepository) GetByClientMessageID(userID uuid.UUID, clientMessageID string) (*domain.Message, error) {
+	args := m.Called(userID, clientMessageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Message), args.Error(1)
+}
+
+func (m *MockMessageRepository) GetThreadReplies(parentID uuid.UUID, limit int, before *uuid.UUID) ([]domain.Message, error) {
+	args := m.Called(parentID, limit, before)
+	return args.Get(0).([]domain.Message), args.Error(1)
+}
+
+func (m *MockMessageRepository) GetByIDsIncludingDeleted(ids []uuid.UUID) ([]domain.Message, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.Message), args.Error(1)
+}
+
+func (m *MockMessageRepository) GetThreadReplierIDs(parentID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(parentID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockMessageRepository) RefreshThreadSummary(parentID uuid.UUID) (*domain.ThreadSummary, error) {
+	args := m.Called(parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ThreadSummary), args.Error(1)
+}
+
+func (m *MockMessageRepository) UpdateContent(message *domain.Message, content string, editedBy uuid.UUID, editedAt time.Time) error {
+	args := m.Called(message, content, editedBy, editedAt)
+	return args.Error(0)
+}
+
+func (m *MockMessageRepository) GetEdits(messageID uuid.UUID) ([]domain.MessageEdit, error) {
+	args := m.Called(messageID)
+	return args.Get(0).([]domain.MessageEdit), args.Error(1)
+}
+
+func (m *MockMessageRepository) AddReaction(reaction *domain.MessageReaction) (bool, error) {
+	args := m.Called(reaction)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMessageRepository) RemoveReaction(messageID, userID uuid.UUID, emoji string) (bool, error) {
+	args := m.Called(messageID, userID, emoji)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMessageRepository) GetReactionCounts(messageIDs []uuid.UUID, userID uuid.UUID) ([]domain.ReactionCount, error) {
+	args := m.Called(messageIDs, userID)
+	return args.Get(0).([]domain.ReactionCount), args.Error(1)
+}
+
 // ============================================================
 // 테스트 헬퍼 함수
 // ============================================================
 
-// newTestChatService는 테스트용 ChatService를 생성합니다.
-//
-//nolint:unused // Test helper for future test cases
-func newTestChatService(chatRepo *MockChatRepository, msgRepo *MockMessageRepository) *ChatService {
-	logger, _ := zap.NewDevelopment()
-	m := metrics.NewForTest()
+// newTestChatService는 mock 저장소를 쓰는 테스트용 ChatService를 생성합니다.
+// Redis 없이 채팅방 이벤트를 돌려주는 함수로 브로드캐스트된 이벤트를 확인할 수 있습니다.
+func newTestChatService(chatRepo *MockChatRepository, msgRepo *MockMessageRepository) (*ChatService, func() []map[string]interface{}) {
+	s := NewChatService(chatRepo, msgRepo, nil, nil, 15*time.Minute, zap.NewNop(), metrics.NewForTest())
 
-	return &ChatService{
-		chatRepo:    nil, // repository 타입이 다르므로 직접 구조체 생성
-		messageRepo: nil,
-		userClient:  nil,
-		redis:       nil,
-		logger:      logger,
-		metrics:     m,
-	}
+	var events []map[string]interface{}
+	s.SetLocalBroadcaster(func(chatID uuid.UUID, payload []byte) {
+		var event map[string]interface{}
+		_ = json.Unmarshal(payload, &event)
+		events = append(events, event)
+	})
+	return s, func() []map[string]interface{} { return events }
 }
 
 // ============================================================
@@ -291,6 +349,410 @@ func TestChatService_SendMessage_CustomMessageType(t *testing.T) {
 	assert.Equal(t, int64(1024), *req.FileSize)
 }
 
+func TestChatService_SendMessage_IdempotentReplay(t *testing.T) {
+	chatID, userID := uuid.New(), uuid.New()
+	clientMessageID := "web-1f3a"
+
+	tests := []struct {
+		name        string
+		storedChat  uuid.UUID
+		wantErr     error
+		wantMessage bool
+	}{
+		{"같은 채팅방 재전송은 저장된 메시지 반환", chatID, nil, true},
+		{"다른 채팅방에서 쓴 ID는 거부", uuid.New(), response.ErrClientMessageIDReused, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given: 같은 clientMessageId로 이미 저장된 메시지
+			chatRepo, msgRepo := new(MockChatRepository), new(MockMessageRepository)
+			stored := &domain.Message{ID: uuid.New(), ChatID: tt.storedChat, UserID: userID, Content: "hi"}
+			chatRepo.On("IsUserInChat", chatID, userID).Return(true, nil)
+			msgRepo.On("GetByClientMessageID", userID, clientMessageID).Return(stored, nil)
+			s, events := newTestChatService(chatRepo, msgRepo)
+
+			// When
+			id := "  " + clientMessageID + " "
+			message, created, err := s.SendMessage(context.Background(), chatID, userID,
+				&domain.SendMessageRequest{Content: "hi", ClientMessageID: &id})
+
+			// Then: 새 메시지를 만들거나 브로드캐스트하지 않음
+			assert.Equal(t, tt.wantErr, err)
+			assert.False(t, created)
+			if tt.wantMessage {
+				assert.Equal(t, stored, message)
+			} else {
+				assert.Nil(t, message)
+			}
+			msgRepo.AssertNotCalled(t, "Create", mock.Anything)
+			assert.Empty(t, events())
+		})
+	}
+}
+
+func TestChatService_SendMessage_ReplyToReplyUsesThreadRoot(t *testing.T) {
+	// Given: 루트 메시지와 그 답장
+	chatID, userID := uuid.New(), uuid.New()
+	root := &domain.Message{ID: uuid.New(), ChatID: chatID, UserID: uuid.New()}
+	reply := &domain.Message{ID: uuid.New(), ChatID: chatID, UserID: uuid.New(), ParentMessageID: &root.ID}
+
+	chatRepo, msgRepo := new(MockChatRepository), new(MockMessageRepository)
+	chatRepo.On("IsUserInChat", chatID, userID).Return(true, nil)
+	chatRepo.On("UpdateTimestamp", chatID).Return(nil)
+	chatRepo.On("GetByID", chatID).Return(&domain.Chat{ID: chatID}, nil)
+	msgRepo.On("GetByID", reply.ID).Return(reply, nil)
+	msgRepo.On("GetByID", root.ID).Return(root, nil)
+	msgRepo.On("Create", mock.AnythingOfType("*domain.Message")).Return(nil)
+	msgRepo.On("CountAll").Return(int64(3), nil)
+	msgRepo.On("RefreshThreadSummary", root.ID).Return(&domain.ThreadSummary{ParentMessageID: root.ID, ReplyCount: 2}, nil)
+	msgRepo.On("GetThreadReplierIDs", root.ID).Return([]uuid.UUID{reply.UserID}, nil)
+	s, events := newTestChatService(chatRepo, msgRepo)
+
+	// When: 답장에 다시 답장
+	message, created, err := s.SendMessage(context.Background(), chatID, userID,
+		&domain.SendMessageRequest{Content: "me too", ParentMessageID: &reply.ID})
+
+	// Then: 루트 메시지의 스레드에 저장하고 스레드 이벤트만 브로드캐스트
+	assert.NoError(t, err)
+	assert.True(t, created)
+	if assert.NotNil(t, message.ParentMessageID) {
+		assert.Equal(t, root.ID, *message.ParentMessageID)
+	}
+	msgRepo.AssertCalled(t, "RefreshThreadSummary", root.ID)
+	if assert.Len(t, events(), 1) {
+		event := events()[0]
+		assert.Equal(t, "THREAD_REPLY_RECEIVED", event["type"])
+		assert.Equal(t, root.ID.String(), event["thread"].(map[string]interface{})["parentMessageId"])
+	}
+}
+
+// ============================================================
+// EditMessage 테스트
+// ============================================================
+
+func TestChatService_EditMessage(t *testing.T) {
+	authorID := uuid.New()
+
+	tests := []struct {
+		name      string
+		editorID  uuid.UUID
+		deleted   bool
+		updateErr error
+		wantErr   error
+	}{
+		{"성공: 작성자 수정", authorID, false, nil, nil},
+		{"실패: 작성자가 아님", uuid.New(), false, nil, response.ErrNotMessageOwner},
+		{"실패: 삭제된 메시지", authorID, true, nil, response.ErrMessageNotFound},
+		{"실패: 수정 중에 삭제됨", authorID, false, gorm.ErrRecordNotFound, response.ErrMessageNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			chatID := uuid.New()
+			message := &domain.Message{
+				ID:          uuid.New(),
+				ChatID:      chatID,
+				UserID:      authorID,
+				Content:     "before",
+				MessageType: domain.MessageTypeText,
+				CreatedAt:   time.Now().Add(-time.Minute),
+			}
+			chatRepo, msgRepo := new(MockChatRepository), new(MockMessageRepository)
+			if tt.deleted {
+				// 삭제된 메시지는 조회되지 않음
+				msgRepo.On("GetByID", message.ID).Return(nil, gorm.ErrRecordNotFound)
+			} else {
+				msgRepo.On("GetByID", message.ID).Return(message, nil)
+			}
+			chatRepo.On("IsUserInChat", chatID, tt.editorID).Return(true, nil)
+			msgRepo.On("UpdateContent", message, "after", tt.editorID, mock.AnythingOfType("time.Time")).Return(tt.updateErr)
+			s, events := newTestChatService(chatRepo, msgRepo)
+
+			// When
+			edited, err := s.EditMessage(context.Background(), message.ID, tt.editorID,
+				&domain.EditMessageRequest{Content: "after"})
+
+			// Then
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, edited)
+				assert.Empty(t, events())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "after", edited.Content)
+			assert.NotNil(t, edited.EditedAt)
+			if assert.Len(t, events(), 1) {
+				assert.Equal(t, "MESSAGE_EDITED", events()[0]["type"])
+			}
+		})
+	}
+}
+
+// ============================================================
+// 리액션 테스트
+// ============================================================
+
+func TestChatService_ChangeReaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		add       bool
+		changed   bool
+		wantEvent string
+	}{
+		{"추가하면 REACTION_ADDED", true, true, "REACTION_ADDED"},
+		{"이미 추가된 리액션은 이벤트 없음", true, false, ""},
+		{"제거하면 REACTION_REMOVED", false, true, "REACTION_REMOVED"},
+		{"없는 리액션 제거는 이벤트 없음", false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			chatID, userID := uuid.New(), uuid.New()
+			message := &domain.Message{ID: uuid.New(), ChatID: chatID, UserID: uuid.New()}
+			chatRepo, msgRepo := new(MockChatRepository), new(MockMessageRepository)
+			chatRepo.On("IsUserInChat", chatID, userID).Return(true, nil)
+			msgRepo.On("GetByID", message.ID).Return(message, nil)
+			msgRepo.On("AddReaction", mock.AnythingOfType("*domain.MessageReaction")).Return(tt.changed, nil)
+			msgRepo.On("RemoveReaction", message.ID, userID, "👍").Return(tt.changed, nil)
+			msgRepo.On("GetReactionCounts", []uuid.UUID{message.ID}, userID).Return([]domain.ReactionCount{
+				{MessageID: message.ID, Emoji: "👍", Count: 2, ReactedByMe: tt.add},
+			}, nil)
+			s, events := newTestChatService(chatRepo, msgRepo)
+
+			// When
+			var reactions []domain.ReactionSummary
+			var err error
+			if tt.add {
+				reactions, err = s.AddReaction(context.Background(), message.ID, userID, " 👍 ")
+			} else {
+				reactions, err = s.RemoveReaction(context.Background(), message.ID, userID, "👍")
+			}
+
+			// Then
+			assert.NoError(t, err)
+			assert.Len(t, reactions, 1)
+			if tt.wantEvent == "" {
+				assert.Empty(t, events())
+				return
+			}
+			if assert.Len(t, events(), 1) {
+				event := events()[0]
+				assert.Equal(t, tt.wantEvent, event["type"])
+				assert.Equal(t, message.ID.String(), event["messageId"])
+				assert.Equal(t, "👍", event["emoji"])
+				assert.Equal(t, float64(2), event["count"])
+			}
+		})
+	}
+}
+
+func TestNormalizeClientMessageID(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		input   *string
+		want    *string
+		wantErr bool
+	}{
+		{"없음", nil, nil, false},
+		{"공백만 있으면 무시", str("   "), nil, false},
+		{"앞뒤 공백 제거", str("  web-1f3a  "), str("web-1f3a"), false},
+		{"최대 길이", str(strings.Repeat("a", domain.MaxClientMessageIDLength)), str(strings.Repeat("a", domain.MaxClientMessageIDLength)), false},
+		{"최대 길이 초과", str(strings.Repeat("a", domain.MaxClientMessageIDLength+1)), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeClientMessageID(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChatService_PublishChatEvent_LocalWithoutRedis(t *testing.T) {
+	// Given: Redis 없이 로컬 전달 함수만 설정
+	chatID := uuid.New()
+	var delivered [][]byte
+	s := &ChatService{logger: zap.NewNop()}
+	s.SetLocalBroadcaster(func(id uuid.UUID, payload []byte) {
+		assert.Equal(t, chatID, id)
+		delivered = append(delivered, payload)
+	})
+
+	// When
+	s.PublishChatEvent(context.Background(), chatID, map[string]interface{}{
+		"type":    "MESSAGE_RECEIVED",
+		"message": &domain.Message{ID: uuid.New(), ChatID: chatID, Content: "hi"},
+	})
+
+	// Then: 이벤트는 한 번만 전달
+	if assert.Len(t, delivered, 1) {
+		var event map[string]interface{}
+		assert.NoError(t, json.Unmarshal(delivered[0], &event))
+		assert.Equal(t, "MESSAGE_RECEIVED", event["type"])
+	}
+}
+
+func TestCanEditMessage(t *testing.T) {
+	createdAt := time.Date(2024, 1, 19, 9, 0, 0, 0, time.UTC)
+	message := &domain.Message{CreatedAt: createdAt}
+	window := 15 * time.Minute
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"작성 직후", createdAt.Add(time.Second), true},
+		{"수정 가능 기간의 마지막 순간", createdAt.Add(window), true},
+		{"수정 가능 기간 이후", createdAt.Add(window + time.Second), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canEditMessage(message, tt.now, window))
+		})
+	}
+}
+
+// ============================================================
+// 스레드 및 인용 테스트
+// ============================================================
+
+func TestThreadRecipients(t *testing.T) {
+	rootAuthor, sender, replier, leftUser := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	participants := []domain.ChatParticipant{
+		{UserID: rootAuthor, IsActive: true},
+		{UserID: sender, IsActive: true},
+		{UserID: replier, IsActive: true},
+		{UserID: leftUser, IsActive: false},
+	}
+
+	// 루트 작성자와 답장 작성자에게 한 번씩, 보낸 사람과 나간 참가자는 제외
+	got := threadRecipients(rootAuthor, []uuid.UUID{sender, replier, leftUser, replier}, sender, participants)
+	assert.Equal(t, []uuid.UUID{rootAuthor, replier}, got)
+
+	// 루트 작성자가 자기 스레드에 답장하면 알림 없음
+	assert.Empty(t, threadRecipients(sender, []uuid.UUID{sender}, sender, participants))
+}
+
+func TestTrimThreadPage(t *testing.T) {
+	replies := []domain.Message{{Content: "1"}, {Content: "2"}, {Content: "3"}}
+
+	page, hasMore := trimThreadPage(replies, 2)
+	assert.True(t, hasMore)
+	// 가장 오래된 답장을 잘라내고 최신 답장을 남김
+	assert.Equal(t, "2", page[0].Content)
+	assert.Equal(t, "3", page[1].Content)
+
+	page, hasMore = trimThreadPage(replies, 3)
+	assert.False(t, hasMore)
+	assert.Len(t, page, 3)
+}
+
+func TestBuildMessageQuote(t *testing.T) {
+	quoted := &domain.Message{ID: uuid.New(), UserID: uuid.New(), Content: "원본 메시지"}
+
+	quote := buildMessageQuote(quoted)
+	assert.Equal(t, quoted.ID, quote.MessageID)
+	assert.Equal(t, quoted.UserID, quote.UserID)
+	assert.Equal(t, "원본 메시지", quote.Content)
+	assert.False(t, quote.Deleted)
+
+	// 삭제된 메시지는 내용을 숨김
+	deletedAt := time.Now()
+	quoted.DeletedAt = &deletedAt
+	quote = buildMessageQuote(quoted)
+	assert.True(t, quote.Deleted)
+	assert.Empty(t, quote.Content)
+}
+
+func TestChatService_PublishUserEvent_LocalWithoutRedis(t *testing.T) {
+	// Given: Redis 없이 로컬 사용자 전달 함수만 설정
+	userID := uuid.New()
+	var delivered [][]byte
+	s := &ChatService{logger: zap.NewNop()}
+	s.SetLocalUserSender(func(id uuid.UUID, payload []byte) {
+		assert.Equal(t, userID, id)
+		delivered = append(delivered, payload)
+	})
+
+	// When
+	s.PublishUserEvent(context.Background(), userID, map[string]interface{}{
+		"type":            "THREAD_NOTIFICATION",
+		"parentMessageId": uuid.New(),
+	})
+
+	// Then
+	if assert.Len(t, delivered, 1) {
+		var event map[string]interface{}
+		assert.NoError(t, json.Unmarshal(delivered[0], &event))
+		assert.Equal(t, "THREAD_NOTIFICATION", event["type"])
+	}
+}
+
+// ============================================================
+// 리액션 테스트
+// ============================================================
+
+func TestNormalizeReactionEmoji(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		want    string
+		wantErr bool
+	}{
+		{"이모지", "👍", "👍", false},
+		{"앞뒤 공백 제거", " 🎉 ", "🎉", false},
+		{"피부색이 들어간 이모지", "👍🏽", "👍🏽", false},
+		{"빈 문자열", "  ", "", true},
+		{"공백 포함", "👍 👍", "", true},
+		{"너무 김", strings.Repeat("a", domain.MaxReactionEmojiLength+1), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeReactionEmoji(tt.emoji)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, response.ErrInvalidReaction)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGroupReactions(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	counts := []domain.ReactionCount{
+		{MessageID: first, Emoji: "👍", Count: 3, ReactedByMe: true},
+		{MessageID: second, Emoji: "🎉", Count: 1},
+		{MessageID: first, Emoji: "❤️", Count: 1},
+	}
+
+	grouped := groupReactions(counts)
+
+	// 메시지별로 묶고 집계 순서를 유지
+	assert.Equal(t, []domain.ReactionSummary{
+		{Emoji: "👍", Count: 3, ReactedByMe: true},
+		{Emoji: "❤️", Count: 1},
+	}, grouped[first])
+	assert.Len(t, grouped[second], 1)
+	assert.Equal(t, 3, reactionCount(grouped[first], "👍"))
+	assert.Equal(t, 0, reactionCount(grouped[second], "👍"))
+}
+
 // ============================================================
 // GetMessages 테스트
 // ============================================================
@@ -319,6 +781,41 @@ func TestChatService_GetMessages_LimitValidation(t *testing.T) {
 	}
 }
 
+// fakeUserClient는 배치 프로필 조회 호출을 기록하는 UserClient 구현입니다.
+type fakeUserClient struct {
+	batchCalls int
+	profiles   map[uuid.UUID]*commonclient.WorkspaceProfile
+}
+
+func (f *fakeUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID, token string) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	f.batchCalls++
+	return f.profiles, nil
+}
+
+func TestChatService_GetMessages_AttachesSendersWithOneBatchLookup(t *testing.T) {
+	// Given
+	alice := uuid.New()
+	bob := uuid.New()
+	userClient := &fakeUserClient{profiles: map[uuid.UUID]*commonclient.WorkspaceProfile{
+		alice: {UserID: alice, NickName: "Alice", ProfileImageURL: "https://cdn/alice.png"},
+	}}
+	s := &ChatService{userClient: userClient, logger: zap.NewNop()}
+	messages := []domain.Message{{UserID: alice}, {UserID: bob}, {UserID: alice}}
+
+	// When
+	attachSenders(messages, s.fetchUserSummaries(context.Background(), uuid.New(), senderIDs(messages)))
+
+	// Then: 프로필이 없는 발신자는 sender 없이 반환
+	assert.Equal(t, 1, userClient.batchCalls)
+	assert.Equal(t, "Alice", messages[0].Sender.NickName)
+	assert.Equal(t, "https://cdn/alice.png", messages[2].Sender.ProfileImageURL)
+	assert.Nil(t, messages[1].Sender)
+}
+
 // ============================================================
 // MarkMessagesAsRead 테스트
 // ============================================================
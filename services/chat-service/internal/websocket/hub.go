@@ -7,11 +7,13 @@ import (
 	"chat-service/internal/service"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
@@ -64,9 +66,14 @@ func NewHub(
 		logger:          logger,
 	}
 
-	// Start Redis subscription handler
+	// Chat events reach clients only through the Redis subscription, so every replica
+	// (including the sender's) delivers each event once. Without Redis, deliver locally.
 	if redis != nil {
 		go hub.subscribeToRedis()
+		go hub.subscribeToUserEvents()
+	} else {
+		chatService.SetLocalBroadcaster(hub.broadcastToChat)
+		chatService.SetLocalUserSender(hub.SendToUser)
 	}
 
 	return hub
@@ -91,6 +98,25 @@ func (h *Hub) subscribeToRedis() {
 	}
 }
 
+// subscribeToUserEvents delivers per-user events (e.g. thread notifications) published on chat-user:{userId}
+func (h *Hub) subscribeToUserEvents() {
+	ctx := context.Background()
+	pubsub := h.redis.PSubscribe(ctx, "chat-user:*")
+	defer func() { _ = pubsub.Close() }()
+
+	ch := pubsub.Channel()
+	for msg := range ch {
+		var userIDStr string
+		_, _ = fmt.Sscanf(msg.Channel, "chat-user:%s", &userIDStr)
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			continue
+		}
+
+		h.SendToUser(userID, []byte(msg.Payload))
+	}
+}
+
 func (h *Hub) HandleChatWebSocket(c *gin.Context) {
 	// Get token from query param
 	token := c.Query("token")
@@ -295,14 +321,18 @@ func (c *Client) writePump() {
 
 func (c *Client) handleMessage(data []byte) {
 	var msg struct {
-		Type        string  `json:"type"`
-		Content     string  `json:"content,omitempty"`
-		MessageType string  `json:"messageType,omitempty"`
-		ChatID      string  `json:"chatId,omitempty"`
-		MessageID   string  `json:"messageId,omitempty"`
-		FileURL     *string `json:"fileUrl,omitempty"`
-		FileName    *string `json:"fileName,omitempty"`
-		FileSize    *int64  `json:"fileSize,omitempty"`
+		Type            string  `json:"type"`
+		Content         string  `json:"content,omitempty"`
+		MessageType     string  `json:"messageType,omitempty"`
+		ChatID          string  `json:"chatId,omitempty"`
+		MessageID       string  `json:"messageId,omitempty"`
+		ClientMessageID *string `json:"clientMessageId,omitempty"`
+		ParentMessageID string  `json:"parentMessageId,omitempty"`
+		QuotedMessageID string  `json:"quotedMessageId,omitempty"`
+		Emoji           string  `json:"emoji,omitempty"`
+		FileURL         *string `json:"fileUrl,omitempty"`
+		FileName        *string `json:"fileName,omitempty"`
+		FileSize        *int64  `json:"fileSize,omitempty"`
 	}
 
 	if err := json.Unmarshal(data, &msg); err != nil {
@@ -319,41 +349,79 @@ func (c *Client) handleMessage(data []byte) {
 			messageType = domain.MessageType(msg.MessageType)
 		}
 
-		message, err := c.Hub.chatService.SendMessage(ctx, c.ChatID, c.UserID, &domain.SendMessageRequest{
-			Content:     msg.Content,
-			MessageType: messageType,
-			FileURL:     msg.FileURL,
-			FileName:    msg.FileName,
-			FileSize:    msg.FileSize,
-		})
+		parentMessageID, err := optionalUUID(msg.ParentMessageID)
 		if err != nil {
-			c.sendError("SEND_FAILED", "Failed to send message")
+			c.sendSendError(msg.ClientMessageID, response.ErrInvalidParentMessage)
+			return
+		}
+		quotedMessageID, err := optionalUUID(msg.QuotedMessageID)
+		if err != nil {
+			c.sendSendError(msg.ClientMessageID, response.ErrInvalidQuotedMessage)
 			return
 		}
 
-		// Broadcast via Redis (already handled in service)
-		// But also send locally for immediate feedback
-		response, _ := json.Marshal(map[string]interface{}{
-			"type":    "MESSAGE_RECEIVED",
-			"message": message,
+		// The service publishes MESSAGE_RECEIVED (or THREAD_REPLY_RECEIVED) through Redis; the sender gets it from there like everyone else
+		message, created, err := c.Hub.chatService.SendMessage(ctx, c.ChatID, c.UserID, &domain.SendMessageRequest{
+			ClientMessageID: msg.ClientMessageID,
+			ParentMessageID: parentMessageID,
+			QuotedMessageID: quotedMessageID,
+			Content:         msg.Content,
+			MessageType:     messageType,
+			FileURL:         msg.FileURL,
+			FileName:        msg.FileName,
+			FileSize:        msg.FileSize,
 		})
-		c.Hub.broadcastToChat(c.ChatID, response)
+		if err != nil {
+			c.sendSendError(msg.ClientMessageID, err)
+			return
+		}
+
+		c.sendAck(message, !created)
+
+	case "EDIT_MESSAGE":
+		messageID, err := uuid.Parse(msg.MessageID)
+		if err != nil {
+			c.sendError("INVALID_MESSAGE_ID", "Invalid message ID")
+			return
+		}
+
+		// The service publishes MESSAGE_EDITED to the chat
+		if _, err := c.Hub.chatService.EditMessage(ctx, messageID, c.UserID, &domain.EditMessageRequest{
+			Content: msg.Content,
+		}); err != nil {
+			c.sendEditError(messageID, err)
+		}
+
+	case "REACT", "UNREACT":
+		messageID, err := uuid.Parse(msg.MessageID)
+		if err != nil {
+			c.sendError("INVALID_MESSAGE_ID", "Invalid message ID")
+			return
+		}
+
+		// The service publishes REACTION_ADDED / REACTION_REMOVED to the chat
+		if msg.Type == "REACT" {
+			_, err = c.Hub.chatService.AddReaction(ctx, messageID, c.UserID, msg.Emoji)
+		} else {
+			_, err = c.Hub.chatService.RemoveReaction(ctx, messageID, c.UserID, msg.Emoji)
+		}
+		if err != nil {
+			c.sendReactionError(messageID, err)
+		}
 
 	case "TYPING_START":
-		response, _ := json.Marshal(map[string]interface{}{
+		c.Hub.chatService.PublishChatEvent(ctx, c.ChatID, map[string]interface{}{
 			"type":   "USER_TYPING",
 			"userId": c.UserID.String(),
 			"chatId": c.ChatID.String(),
 		})
-		c.Hub.broadcastToChat(c.ChatID, response)
 
 	case "TYPING_STOP":
-		response, _ := json.Marshal(map[string]interface{}{
+		c.Hub.chatService.PublishChatEvent(ctx, c.ChatID, map[string]interface{}{
 			"type":   "USER_TYPING_STOP",
 			"userId": c.UserID.String(),
 			"chatId": c.ChatID.String(),
 		})
-		c.Hub.broadcastToChat(c.ChatID, response)
 
 	case "READ_MESSAGE":
 		messageID, err := uuid.Parse(msg.MessageID)
@@ -365,22 +433,137 @@ func (c *Client) handleMessage(data []byte) {
 		_ = c.Hub.chatService.MarkMessagesAsRead(ctx, []uuid.UUID{messageID}, c.UserID)
 		_ = c.Hub.chatService.UpdateLastReadAt(ctx, c.ChatID, c.UserID)
 
-		response, _ := json.Marshal(map[string]interface{}{
+		c.Hub.chatService.PublishChatEvent(ctx, c.ChatID, map[string]interface{}{
 			"type":      "MESSAGE_READ",
 			"messageId": messageID.String(),
 			"userId":    c.UserID.String(),
 		})
-		c.Hub.broadcastToChat(c.ChatID, response)
 	}
 }
 
+// optionalUUID parses an optional UUID field of a client event; an empty string means not set
+func optionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// sendAck confirms a MESSAGE to its sender with the persisted message ID and timestamp
+// duplicate is true when the send was a retry of an already stored message
+func (c *Client) sendAck(message *domain.Message, duplicate bool) {
+	c.send(ackPayload(message, duplicate))
+}
+
+// ackPayload builds the ACK event of a stored message
+func ackPayload(message *domain.Message, duplicate bool) map[string]interface{} {
+	payload := map[string]interface{}{
+		"type":      "ACK",
+		"messageId": message.ID.String(),
+		"chatId":    message.ChatID.String(),
+		"createdAt": message.CreatedAt,
+		"duplicate": duplicate,
+	}
+	if message.ClientMessageID != nil {
+		payload["clientMessageId"] = *message.ClientMessageID
+	}
+	return payload
+}
+
+// sendSendError reports a failed MESSAGE to its sender, echoing the client message ID so the client can retry it
+func (c *Client) sendSendError(clientMessageID *string, err error) {
+	payload := map[string]interface{}{
+		"type":    "ERROR",
+		"code":    "SEND_FAILED",
+		"message": "Failed to send message",
+	}
+	switch {
+	case errors.Is(err, response.ErrEmptyMessage):
+		payload["code"] = "EMPTY_MESSAGE"
+		payload["message"] = "Message content cannot be empty"
+	case errors.Is(err, response.ErrClientMessageIDReused):
+		payload["code"] = "CLIENT_MESSAGE_ID_REUSED"
+		payload["message"] = "Client message ID was already used in another chat"
+	case errors.Is(err, response.ErrInvalidParentMessage):
+		payload["code"] = "INVALID_PARENT_MESSAGE"
+		payload["message"] = "Parent message must be an existing message in the same chat"
+	case errors.Is(err, response.ErrInvalidQuotedMessage):
+		payload["code"] = "INVALID_QUOTED_MESSAGE"
+		payload["message"] = "Quoted message must be an existing message in the same chat"
+	case apperrors.AsAppError(err) != nil:
+		payload["code"] = "INVALID_MESSAGE"
+		payload["message"] = apperrors.AsAppError(err).Message
+	}
+	if clientMessageID != nil {
+		payload["clientMessageId"] = *clientMessageID
+	}
+	c.send(payload)
+}
+
+// sendEditError reports a failed EDIT_MESSAGE to its sender
+func (c *Client) sendEditError(messageID uuid.UUID, err error) {
+	code, message := "EDIT_FAILED", "Failed to edit message"
+	switch {
+	case errors.Is(err, response.ErrMessageNotFound):
+		code, message = "MESSAGE_NOT_FOUND", "Message not found"
+	case errors.Is(err, response.ErrNotMessageOwner):
+		code, message = "NOT_MESSAGE_OWNER", "Only the message owner can edit it"
+	case errors.Is(err, response.ErrMessageEditWindowExpired):
+		code, message = "EDIT_WINDOW_EXPIRED", "The message can no longer be edited"
+	case errors.Is(err, response.ErrEmptyMessage):
+		code, message = "EMPTY_MESSAGE", "Message content cannot be empty"
+	case errors.Is(err, response.ErrNotChatParticipant):
+		code, message = "NOT_PARTICIPANT", "You are not a participant of this chat"
+	}
+	c.send(map[string]interface{}{
+		"type":      "ERROR",
+		"code":      code,
+		"message":   message,
+		"messageId": messageID.String(),
+	})
+}
+
+// sendReactionError reports a failed REACT / UNREACT to its sender
+func (c *Client) sendReactionError(messageID uuid.UUID, err error) {
+	code, message := "REACTION_FAILED", "Failed to update reaction"
+	switch {
+	case errors.Is(err, response.ErrMessageNotFound):
+		code, message = "MESSAGE_NOT_FOUND", "Message not found"
+	case errors.Is(err, response.ErrInvalidReaction):
+		code, message = "INVALID_REACTION", "Reaction emoji must be 1 to 32 characters without spaces"
+	case errors.Is(err, response.ErrNotChatParticipant):
+		code, message = "NOT_PARTICIPANT", "You are not a participant of this chat"
+	}
+	c.send(map[string]interface{}{
+		"type":      "ERROR",
+		"code":      code,
+		"message":   message,
+		"messageId": messageID.String(),
+	})
+}
+
 func (c *Client) sendError(code, message string) {
-	response, _ := json.Marshal(map[string]interface{}{
+	c.send(map[string]interface{}{
 		"type":    "ERROR",
 		"code":    code,
 		"message": message,
 	})
-	c.Send <- response
+}
+
+// send queues an event for this client only
+func (c *Client) send(payload map[string]interface{}) {
+	response, _ := json.Marshal(payload)
+	select {
+	case c.Send <- response:
+	default:
+		c.Hub.logger.Warn("Client send buffer full, dropping event",
+			zap.String("userId", c.UserID.String()),
+			zap.String("chatId", c.ChatID.String()))
+	}
 }
 
 // PresenceClient represents a connected client for presence tracking
@@ -41,6 +41,7 @@ const (
 	NotificationTypeBoardCommentAdded    NotificationType = "BOARD_COMMENT_ADDED"
 	NotificationTypeBoardDueSoon         NotificationType = "BOARD_DUE_SOON"
 	NotificationTypeBoardOverdue         NotificationType = "BOARD_OVERDUE"
+	NotificationTypeBoardReminder        NotificationType = "BOARD_REMINDER"
 )
 
 // ResourceType defines the type of resource
@@ -68,6 +69,8 @@ type Notification struct {
 	IsRead       bool                   `gorm:"default:false" json:"isRead"`
 	ReadAt       *time.Time             `gorm:"type:timestamptz" json:"readAt,omitempty"`
 	CreatedAt    time.Time              `gorm:"type:timestamptz;default:now();not null" json:"createdAt"`
+	// IdempotencyKey deduplicates retried deliveries of the same event (NULL for callers that do not send one)
+	IdempotencyKey *string `gorm:"type:varchar(100);uniqueIndex" json:"-"`
 }
 
 func (Notification) TableName() string {
@@ -100,6 +103,8 @@ type NotificationEvent struct {
 	ResourceName *string                `json:"resourceName,omitempty"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
 	OccurredAt   *time.Time             `json:"occurredAt,omitempty"`
+	// IdempotencyKey makes retries safe: an event whose key was already stored returns the stored notification
+	IdempotencyKey *string `json:"idempotencyKey,omitempty" binding:"omitempty,max=100"`
 }
 
 // PaginatedNotifications represents paginated notification response
@@ -42,6 +42,16 @@ func (r *NotificationRepository) GetByID(id uuid.UUID) (*domain.Notification, er
 	return &notification, nil
 }
 
+// GetByIdempotencyKey retrieves the notification stored for an idempotency key.
+func (r *NotificationRepository) GetByIdempotencyKey(key string) (*domain.Notification, error) {
+	var notification domain.Notification
+	err := r.db.First(&notification, "idempotency_key = ?", key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
 // GetByIDAndUserID retrieves a notification ensuring it belongs to the specified user.
 func (r *NotificationRepository) GetByIDAndUserID(id, userID uuid.UUID) (*domain.Notification, error) {
 	var notification domain.Notification
@@ -64,6 +64,16 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 		zap.String("notification.type", string(event.Type)),
 		zap.String("target.user.id", event.TargetUserID.String()))
 
+	// A retried event returns the notification stored by the first delivery
+	if event.IdempotencyKey != nil {
+		if existing, err := s.repo.GetByIdempotencyKey(*event.IdempotencyKey); err == nil {
+			log.Debug("CreateNotification duplicate event ignored",
+				zap.String("notification.id", existing.ID.String()),
+				zap.String("idempotency.key", *event.IdempotencyKey))
+			return existing, nil
+		}
+	}
+
 	notification := &domain.Notification{
 		ID:           uuid.New(),
 		Type:         event.Type,
@@ -76,6 +86,8 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 		Metadata:     event.Metadata,
 		IsRead:       false,
 		CreatedAt:    time.Now(),
+
+		IdempotencyKey: event.IdempotencyKey,
 	}
 
 	if event.OccurredAt != nil {
@@ -83,6 +95,12 @@ func (s *NotificationService) CreateNotification(ctx context.Context, event *dom
 	}
 
 	if err := s.repo.Create(notification); err != nil {
+		// A concurrent delivery of the same event won the unique index
+		if event.IdempotencyKey != nil {
+			if existing, findErr := s.repo.GetByIdempotencyKey(*event.IdempotencyKey); findErr == nil {
+				return existing, nil
+			}
+		}
 		log.Error("CreateNotification failed to save", zap.Error(err))
 		return nil, err
 	}
@@ -66,3 +66,15 @@ func (p *UserProfile) ToResponse() UserProfileResponse {
 		UpdatedAt:       p.UpdatedAt,
 	}
 }
+
+// BatchProfileRequest represents the request to look up several users' profiles in one workspace
+type BatchProfileRequest struct {
+	WorkspaceID uuid.UUID   `json:"workspaceId" binding:"required"`
+	UserIDs     []uuid.UUID `json:"userIds" binding:"required,min=1,max=500"`
+}
+
+// BatchProfileResponse represents the batch profile lookup response
+// Users without a workspace or default profile are omitted
+type BatchProfileResponse struct {
+	Profiles []UserProfileResponse `json:"profiles"`
+}
@@ -364,3 +364,33 @@ func (h *ProfileHandler) ConfirmProfileImage(c *gin.Context) {
 
 	response.OK(c, updatedProfile.ToResponse())
 }
+
+// GetProfilesBatch godoc
+// @Summary Get profiles of several users in a workspace (internal)
+// @Description Falls back to the default profile per user; users without any profile are omitted
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param request body domain.BatchProfileRequest true "Batch profile request"
+// @Success 200 {object} domain.BatchProfileResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /internal/profiles/batch [post]
+func (h *ProfileHandler) GetProfilesBatch(c *gin.Context) {
+	var req domain.BatchProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	profiles, err := h.profileService.GetProfilesBatch(req.WorkspaceID, req.UserIDs)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	resp := domain.BatchProfileResponse{Profiles: make([]domain.UserProfileResponse, 0, len(profiles))}
+	for i := range profiles {
+		resp.Profiles = append(resp.Profiles, profiles[i].ToResponse())
+	}
+	response.OK(c, resp)
+}
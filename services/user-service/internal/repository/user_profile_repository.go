@@ -70,3 +70,10 @@ func (r *UserProfileRepository) Delete(id uuid.UUID) error {
 func (r *UserProfileRepository) DeleteByUserAndWorkspace(userID, workspaceID uuid.UUID) error {
 	return r.db.Delete(&domain.UserProfile{}, "user_id = ? AND workspace_id = ?", userID, workspaceID).Error
 }
+
+// FindByUsersAndWorkspaces finds the profiles of the given users in any of the given workspaces
+func (r *UserProfileRepository) FindByUsersAndWorkspaces(userIDs, workspaceIDs []uuid.UUID) ([]domain.UserProfile, error) {
+	var profiles []domain.UserProfile
+	err := r.db.Where("user_id IN ? AND workspace_id IN ?", userIDs, workspaceIDs).Find(&profiles).Error
+	return profiles, err
+}
@@ -94,6 +94,11 @@ func Setup(cfg Config) *gin.Engine {
 	// Initialize services
 	// 사용자 서비스 초기화 (메트릭 포함)
 	userService := service.NewUserService(userRepo, cfg.Logger, m)
+	// Redis가 있으면 프로필 생성/변경/삭제 시 다른 서비스의 프로필 캐시 무효화 이벤트 발행
+	var profileEvents service.ProfileEventPublisher
+	if cfg.RedisClient != nil {
+		profileEvents = service.NewRedisProfileEventPublisher(cfg.RedisClient, cfg.Logger)
+	}
 	// 워크스페이스 서비스 초기화 (메트릭 포함)
 	workspaceService := service.NewWorkspaceService(
 		workspaceRepo,
@@ -101,11 +106,12 @@ func Setup(cfg Config) *gin.Engine {
 		joinReqRepo,
 		profileRepo,
 		userRepo,
+		profileEvents,
 		cfg.Logger,
 		m,
 	)
 	// 프로필 서비스 초기화 (메트릭 포함)
-	profileService := service.NewProfileService(profileRepo, memberRepo, userRepo, cfg.Logger, m)
+	profileService := service.NewProfileService(profileRepo, memberRepo, userRepo, profileEvents, cfg.Logger, m)
 	attachmentService := service.NewAttachmentService(attachmentRepo, cfg.S3Client, cfg.Logger)
 
 	// Initialize handlers
@@ -142,6 +148,7 @@ func Setup(cfg Config) *gin.Engine {
 	{
 		internal.GET("/users/:userId/exists", userHandler.UserExists)
 		internal.POST("/oauth/login", userHandler.OAuthLogin)
+		internal.POST("/profiles/batch", profileHandler.GetProfilesBatch)
 	}
 
 	// ============================================================
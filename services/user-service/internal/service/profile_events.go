@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ProfileEventPublisher notifies other services that a cached profile is stale
+type ProfileEventPublisher interface {
+	PublishProfileInvalidated(userID, workspaceID uuid.UUID)
+}
+
+// RedisProfileEventPublisher publishes profile invalidation events over Redis Pub/Sub
+// board-service, chat-service 의 프로필 캐시가 이 이벤트를 구독해 무효화합니다.
+type RedisProfileEventPublisher struct {
+	redis  *redis.Client
+	logger *zap.Logger
+}
+
+// NewRedisProfileEventPublisher creates a new RedisProfileEventPublisher
+func NewRedisProfileEventPublisher(redisClient *redis.Client, logger *zap.Logger) *RedisProfileEventPublisher {
+	return &RedisProfileEventPublisher{
+		redis:  redisClient,
+		logger: logger,
+	}
+}
+
+// PublishProfileInvalidated publishes an invalidation event; failures are logged and the TTL on the consumers' side bounds staleness
+func (p *RedisProfileEventPublisher) PublishProfileInvalidated(userID, workspaceID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	event := commonclient.ProfileInvalidatedEvent{UserID: userID, WorkspaceID: workspaceID}
+	if err := commonclient.PublishProfileInvalidated(ctx, p.redis, event); err != nil {
+		p.logger.Warn("Failed to publish profile invalidation",
+			zap.String("userId", userID.String()),
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Error(err))
+	}
+}
@@ -19,6 +19,7 @@ type ProfileService struct {
 	profileRepo *repository.UserProfileRepository
 	memberRepo  *repository.WorkspaceMemberRepository
 	userRepo    *repository.UserRepository
+	events      ProfileEventPublisher // nil이면 프로필 무효화 이벤트를 발행하지 않음
 	logger      *zap.Logger
 	metrics     *metrics.Metrics // 메트릭 수집을 위한 필드
 }
@@ -29,6 +30,7 @@ func NewProfileService(
 	profileRepo *repository.UserProfileRepository,
 	memberRepo *repository.WorkspaceMemberRepository,
 	userRepo *repository.UserRepository,
+	events ProfileEventPublisher,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *ProfileService {
@@ -36,6 +38,7 @@ func NewProfileService(
 		profileRepo: profileRepo,
 		memberRepo:  memberRepo,
 		userRepo:    userRepo,
+		events:      events,
 		logger:      logger,
 		metrics:     m,
 	}
@@ -78,6 +81,7 @@ func (s *ProfileService) CreateProfile(userID uuid.UUID, req domain.CreateProfil
 	if s.metrics != nil {
 		s.metrics.RecordProfileCreated()
 	}
+	s.publishInvalidated(userID, req.WorkspaceID)
 
 	s.logger.Info("Profile created", zap.String("profileId", profile.ID.String()))
 	return profile, nil
@@ -122,6 +126,36 @@ func (s *ProfileService) GetUserProfile(viewerID, targetUserID, workspaceID uuid
 	return s.profileRepo.FindByUserAndWorkspace(targetUserID, workspaceID)
 }
 
+// GetProfilesBatch gets several users' profiles for a workspace in one query
+// Like GetMyProfile, users without a workspace profile fall back to their default profile;
+// users with neither are omitted from the result.
+func (s *ProfileService) GetProfilesBatch(workspaceID uuid.UUID, userIDs []uuid.UUID) ([]domain.UserProfile, error) {
+	defaultWorkspaceID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+	profiles, err := s.profileRepo.FindByUsersAndWorkspaces(userIDs, []uuid.UUID{workspaceID, defaultWorkspaceID})
+	if err != nil {
+		s.logger.Error("Failed to find profiles", zap.Error(err))
+		return nil, err
+	}
+
+	byUser := make(map[uuid.UUID]domain.UserProfile, len(profiles))
+	for _, profile := range profiles {
+		existing, ok := byUser[profile.UserID]
+		if !ok || (existing.WorkspaceID != workspaceID && profile.WorkspaceID == workspaceID) {
+			byUser[profile.UserID] = profile
+		}
+	}
+
+	// 요청 순서를 유지하고 중복 ID는 한 번만 반환
+	result := make([]domain.UserProfile, 0, len(byUser))
+	for _, userID := range userIDs {
+		if profile, ok := byUser[userID]; ok {
+			result = append(result, profile)
+			delete(byUser, userID)
+		}
+	}
+	return result, nil
+}
+
 // UpdateProfile updates a user profile (creates if not exists)
 func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain.UpdateProfileRequest) (*domain.UserProfile, error) {
 	profile, err := s.profileRepo.FindByUserAndWorkspace(userID, workspaceID)
@@ -165,6 +199,8 @@ func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain
 			s.metrics.RecordProfileCreated()
 		}
 
+		s.publishInvalidated(userID, workspaceID)
+
 		s.logger.Info("Profile created during update", zap.String("profileId", profile.ID.String()))
 		return profile, nil
 	}
@@ -181,6 +217,7 @@ func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain
 		s.logger.Error("Failed to update profile", zap.Error(err))
 		return nil, err
 	}
+	s.publishInvalidated(userID, workspaceID)
 
 	s.logger.Info("Profile updated", zap.String("profileId", profile.ID.String()))
 	return profile, nil
@@ -192,6 +229,7 @@ func (s *ProfileService) DeleteProfile(userID, workspaceID uuid.UUID) error {
 		s.logger.Error("Failed to delete profile", zap.Error(err))
 		return err
 	}
+	s.publishInvalidated(userID, workspaceID)
 	s.logger.Info("Profile deleted", zap.String("userId", userID.String()), zap.String("workspaceId", workspaceID.String()))
 	return nil
 }
@@ -210,6 +248,7 @@ func (s *ProfileService) UpdateProfileImage(userID, workspaceID uuid.UUID, image
 		s.logger.Error("Failed to update profile image", zap.Error(err))
 		return nil, err
 	}
+	s.publishInvalidated(userID, workspaceID)
 
 	s.logger.Info("Profile image updated", zap.String("profileId", profile.ID.String()))
 	return profile, nil
@@ -259,7 +298,15 @@ func (s *ProfileService) GetOrCreateProfile(userID, workspaceID uuid.UUID, defau
 	if s.metrics != nil {
 		s.metrics.RecordProfileCreated()
 	}
+	s.publishInvalidated(userID, workspaceID)
 
 	s.logger.Info("Profile created", zap.String("profileId", newProfile.ID.String()))
 	return newProfile, nil
 }
+
+// publishInvalidated tells profile caches in other services to drop the user's entries
+func (s *ProfileService) publishInvalidated(userID, workspaceID uuid.UUID) {
+	if s.events != nil {
+		s.events.PublishProfileInvalidated(userID, workspaceID)
+	}
+}
@@ -210,6 +210,8 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", user.ID.String()),
 			zap.Error(err))
+	} else {
+		s.publishProfileInvalidated(user.ID, workspaceID)
 	}
 
 	// User 정보 포함
@@ -344,6 +346,8 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 			zap.String("user_id", member.UserID.String()),
 			zap.String("workspace_id", workspaceID.String()),
 			zap.Error(err))
+	} else {
+		s.publishProfileInvalidated(member.UserID, workspaceID)
 	}
 
 	s.logger.Info("멤버 제거 완료",
@@ -454,7 +458,9 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 			if profile.NickName == "" {
 				profile.NickName = user.Email
 			}
-			_ = s.profileRepo.Create(profile)
+			if err := s.profileRepo.Create(profile); err == nil {
+				s.publishProfileInvalidated(userID, workspaceID)
+			}
 		}
 
 		s.logger.Info("자동 참여 완료 (승인 불필요)",
@@ -599,7 +605,9 @@ func (s *WorkspaceService) ProcessJoinRequest(workspaceID, requestID, processorI
 			if profile.NickName == "" {
 				profile.NickName = user.Email
 			}
-			_ = s.profileRepo.Create(profile)
+			if err := s.profileRepo.Create(profile); err == nil {
+				s.publishProfileInvalidated(request.UserID, workspaceID)
+			}
 		}
 
 		s.logger.Info("참여 요청 승인 완료",
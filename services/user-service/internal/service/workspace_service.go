@@ -25,6 +25,7 @@ type WorkspaceService struct {
 	joinReqRepo   *repository.JoinRequestRepository
 	profileRepo   *repository.UserProfileRepository
 	userRepo      *repository.UserRepository
+	events        ProfileEventPublisher // nil이면 프로필 무효화 이벤트를 발행하지 않음
 	logger        *zap.Logger
 	metrics       *metrics.Metrics // 메트릭 수집을 위한 필드
 }
@@ -37,6 +38,7 @@ func NewWorkspaceService(
 	joinReqRepo *repository.JoinRequestRepository,
 	profileRepo *repository.UserProfileRepository,
 	userRepo *repository.UserRepository,
+	events ProfileEventPublisher,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *WorkspaceService {
@@ -46,6 +48,7 @@ func NewWorkspaceService(
 		joinReqRepo:   joinReqRepo,
 		profileRepo:   profileRepo,
 		userRepo:      userRepo,
+		events:        events,
 		logger:        logger,
 		metrics:       m,
 	}
@@ -134,6 +137,8 @@ func (s *WorkspaceService) CreateWorkspace(ownerID uuid.UUID, req domain.CreateW
 		}
 		if err := s.profileRepo.Create(profile); err != nil {
 			s.logger.Error("소유자 프로필 생성 실패", zap.Error(err))
+		} else {
+			s.publishProfileInvalidated(ownerID, workspace.ID)
 		}
 	}
 
@@ -295,3 +300,11 @@ func (s *WorkspaceService) SetDefaultWorkspace(userID, workspaceID uuid.UUID) er
 
 	return s.memberRepo.SetDefault(userID, workspaceID)
 }
+
+// publishProfileInvalidated tells profile caches in other services to drop the user's entries
+// 멤버 추가/제거로 프로필이 생성되거나 삭제될 때 호출합니다.
+func (s *WorkspaceService) publishProfileInvalidated(userID, workspaceID uuid.UUID) {
+	if s.events != nil {
+		s.events.PublishProfileInvalidated(userID, workspaceID)
+	}
+}